@@ -34,6 +34,94 @@ const docTemplate = `{
                 }
             }
         },
+        "/api/v1/alertmanager/reconcile": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Fetches active alerts routed to ALERTMANAGER_RECEIVER from the Alertmanager v2 API and enqueues missing firing alerts and stale resolved alerts into the webhook inbox (503 when ALERTMANAGER_URL or ALERTMANAGER_RECEIVER is empty)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "alertmanager"
+                ],
+                "summary": "Reconcile alerts with Alertmanager",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AlertmanagerReconcileResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/alertmanager/silences/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "alertmanager"
+                ],
+                "summary": "Expire an Alertmanager silence early",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Silence record ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AlertmanagerSilenceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/alerts": {
             "get": {
                 "security": [
@@ -153,6 +241,58 @@ const docTemplate = `{
                 }
             }
         },
+        "/api/v1/alerts/{id}/ack": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Records who acknowledged the alert and the time to acknowledge, acknowledges its incident if not yet acknowledged, stops re-notification reminders and posts a note to the alert thread",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "alerts"
+                ],
+                "summary": "Acknowledge firing alert",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Alert ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AcknowledgeResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/alerts/{id}/analyze": {
             "post": {
                 "security": [
@@ -199,24 +339,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/alerts/{id}/incident": {
-            "put": {
+        "/api/v1/alerts/{id}/deliveries": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "사용자가 Alert을 다른 Incident에 연결할 때 사용",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Returns every delivery attempt (all targets, including failures and thread replies), newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "alerts"
                 ],
-                "summary": "Update alert's incident ID",
+                "summary": "List notification attempts for an alert",
                 "parameters": [
                     {
                         "type": "string",
@@ -226,20 +363,17 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Update alert incident payload",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.UpdateAlertIncidentRequest"
-                        }
+                        "type": "integer",
+                        "description": "Max entries (default 100, max 500)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.AlertUpdateResponse"
+                            "$ref": "#/definitions/model.NotificationAttemptListResponse"
                         }
                     },
                     "400": {
@@ -248,6 +382,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -257,20 +397,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/alerts/{id}/resolve": {
+        "/api/v1/alerts/{id}/flapping/clear": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Clears is_flapping for the alert's fingerprint, cancels its pending flapping clearance job and posts the flapping cleared message to the alert thread",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "alerts"
                 ],
-                "summary": "Resolve alert manually (수동 알림 종료)",
+                "summary": "Clear flapping status manually",
                 "parameters": [
                     {
                         "type": "string",
@@ -284,51 +425,38 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.AlertResolveResponse"
+                            "$ref": "#/definitions/model.FlappingClearResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "409": {
                         "description": "Conflict",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/api/v1/auth/config": {
-            "get": {
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "auth"
-                ],
-                "summary": "Get auth config",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/model.AuthConfigResponse"
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/api/v1/auth/login": {
-            "post": {
+        "/api/v1/alerts/{id}/incident": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "사용자가 Alert을 다른 Incident에 연결할 때 사용",
                 "consumes": [
                     "application/json"
                 ],
@@ -336,17 +464,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "alerts"
                 ],
-                "summary": "Login",
+                "summary": "Update alert's incident ID",
                 "parameters": [
                     {
-                        "description": "Login ID and password",
+                        "type": "string",
+                        "description": "Alert ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update alert incident payload",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.AuthRequest"
+                            "$ref": "#/definitions/model.UpdateAlertIncidentRequest"
                         }
                     }
                 ],
@@ -354,7 +489,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.AuthResponse"
+                            "$ref": "#/definitions/model.AlertUpdateResponse"
                         }
                     },
                     "400": {
@@ -363,12 +498,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/model.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -378,28 +507,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/auth/logout": {
+        "/api/v1/alerts/{id}/resolve": {
             "post": {
-                "description": "Revokes refresh token (if present) and clears cookie.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "auth"
-                ],
-                "summary": "Logout",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/model.AuthLogoutResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/v1/auth/me": {
-            "get": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -409,19 +518,268 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "alerts"
+                ],
+                "summary": "Resolve alert manually (수동 알림 종료)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Alert ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get current user",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.AuthMeResponse"
+                            "$ref": "#/definitions/model.AlertResolveResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/alerts/{id}/silence": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates an Alertmanager silence from the stored alert labels. The Alertmanager is chosen from the alert's webhook externalURL (mapped through ALERTMANAGER_URL_MAP). Defaults to 120 minutes when neither ends_at nor duration_minutes is given.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "alertmanager"
+                ],
+                "summary": "Silence an alert in Alertmanager",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Alert ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Silence options",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/model.AlertSilenceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.AlertmanagerSilenceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/alerts/{id}/silences": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "alertmanager"
+                ],
+                "summary": "List active Alertmanager silences for an alert",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Alert ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AlertmanagerSilenceListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/config": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Get auth config",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AuthConfigResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/login": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Login",
+                "parameters": [
+                    {
+                        "description": "Login ID and password",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.AuthRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AuthResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/logout": {
+            "post": {
+                "description": "Revokes refresh token (if present) and clears cookie.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Logout",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AuthLogoutResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/auth/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Get current user",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AuthMeResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
                             "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
@@ -662,7 +1020,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/incidents": {
+        "/api/v1/escalation-policies": {
             "get": {
                 "security": [
                     {
@@ -673,17 +1031,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "incidents"
+                    "escalation-policies"
                 ],
-                "summary": "List incidents",
+                "summary": "List escalation policies",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.IncidentListResponse"
-                            }
+                            "$ref": "#/definitions/model.EscalationPolicyListResponse"
                         }
                     },
                     "500": {
@@ -693,61 +1048,46 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/incidents/hidden": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "숨김 처리된(is_enabled=false) Incident 목록을 조회합니다.",
+                "description": "Firing incidents whose alert labels match the matchers escalate through the ordered levels until acknowledged. Level 1 targets are notified immediately and each following level after the previous level's timeout_minutes. The most specific matching policy wins.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "incidents"
+                    "escalation-policies"
                 ],
-                "summary": "List hidden incidents",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.IncidentListResponse"
-                            }
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                "summary": "Create an escalation policy",
+                "parameters": [
+                    {
+                        "description": "Escalation policy",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.ErrorResponse"
+                            "$ref": "#/definitions/model.EscalationPolicyRequest"
                         }
                     }
-                }
-            }
-        },
-        "/api/v1/incidents/mock": {
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "incidents"
                 ],
-                "summary": "Create mock incident",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/model.MockIncidentResponse"
+                            "$ref": "#/definitions/model.EscalationPolicyMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
@@ -759,7 +1099,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/incidents/{id}": {
+        "/api/v1/escalation-policies/{id}": {
             "get": {
                 "security": [
                     {
@@ -770,13 +1110,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "incidents"
+                    "escalation-policies"
                 ],
-                "summary": "Get incident detail with alerts",
+                "summary": "Get an escalation policy by ID",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Incident ID",
+                        "type": "integer",
+                        "description": "Escalation policy ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -786,7 +1126,19 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.IncidentDetailEnvelope"
+                            "$ref": "#/definitions/model.EscalationPolicyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
@@ -803,6 +1155,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
+                "description": "In-flight escalations use the updated levels from their next level onward",
                 "consumes": [
                     "application/json"
                 ],
@@ -810,24 +1163,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "incidents"
+                    "escalation-policies"
                 ],
-                "summary": "Update incident detail",
+                "summary": "Update an escalation policy",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Incident ID",
+                        "type": "integer",
+                        "description": "Escalation policy ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Incident update payload",
+                        "description": "Escalation policy",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.UpdateIncidentRequest"
+                            "$ref": "#/definitions/model.EscalationPolicyRequest"
                         }
                     }
                 ],
@@ -835,7 +1188,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.IncidentUpdateResponse"
+                            "$ref": "#/definitions/model.EscalationPolicyMutationResponse"
                         }
                     },
                     "400": {
@@ -844,6 +1197,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -852,23 +1211,24 @@ const docTemplate = `{
                     }
                 }
             },
-            "patch": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "In-flight escalations using the policy are cancelled when their next level is due",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "incidents"
+                    "escalation-policies"
                 ],
-                "summary": "Hide incident",
+                "summary": "Delete an escalation policy",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Incident ID",
+                        "type": "integer",
+                        "description": "Escalation policy ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -878,7 +1238,19 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.IncidentUpdateResponse"
+                            "$ref": "#/definitions/model.EscalationPolicyMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
@@ -890,7 +1262,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/incidents/{id}/alerts": {
+        "/api/v1/flapping-policies": {
             "get": {
                 "security": [
                     {
@@ -901,26 +1273,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "incidents"
-                ],
-                "summary": "Get alerts for incident",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Incident ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "flapping-policies"
                 ],
+                "summary": "List flapping policies",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.AlertListResponse"
-                            }
+                            "$ref": "#/definitions/model.FlappingPolicyListResponse"
                         }
                     },
                     "500": {
@@ -930,37 +1290,46 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/incidents/{id}/analyze": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "수동으로 Incident에 대한 AI 분석을 트리거합니다. 분석은 비동기로 실행됩니다.",
+                "description": "Alerts matching the matchers use the policy thresholds (0 falls back to the global flapping setting) or skip flapping detection when never_flapping is set. The most specific matching policy wins.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "incidents"
+                    "flapping-policies"
                 ],
-                "summary": "Trigger manual analysis for an incident",
+                "summary": "Create a flapping policy",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Incident ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Flapping policy",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.FlappingPolicyRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Accepted",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/model.IncidentUpdateResponse"
+                            "$ref": "#/definitions/model.FlappingPolicyMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
@@ -972,13 +1341,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/incidents/{id}/resolve": {
+        "/api/v1/flapping-policies/match": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Returns the most specific enabled policy for the labels and the effective detection window, cycle threshold and clearance window",
                 "consumes": [
                     "application/json"
                 ],
@@ -986,24 +1356,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "incidents"
+                    "flapping-policies"
                 ],
-                "summary": "Resolve incident (사용자가 장애 종료)",
+                "summary": "Preview which flapping policy applies to a label set",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Incident ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Resolve incident payload",
+                        "description": "Alert labels",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.ResolveIncidentRequest"
+                            "$ref": "#/definitions/model.FlappingPolicyMatchRequest"
                         }
                     }
                 ],
@@ -1011,7 +1374,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.IncidentUpdateResponse"
+                            "$ref": "#/definitions/model.FlappingPolicyMatchResponse"
                         }
                     },
                     "400": {
@@ -1029,25 +1392,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/incidents/{id}/unhide": {
-            "patch": {
+        "/api/v1/flapping-policies/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "숨김 처리된 Incident를 다시 활성화합니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "incidents"
+                    "flapping-policies"
                 ],
-                "summary": "Unhide incident (restore)",
+                "summary": "Get a flapping policy by ID",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Incident ID",
+                        "type": "integer",
+                        "description": "Flapping policy ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1057,37 +1419,19 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.IncidentUpdateResponse"
+                            "$ref": "#/definitions/model.FlappingPolicyResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/model.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/api/v1/settings/app": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "settings"
-                ],
-                "summary": "List all app settings",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/model.AppSettingListResponse"
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
                     "500": {
@@ -1097,36 +1441,46 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/settings/app/{key}": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "settings"
+                    "flapping-policies"
                 ],
-                "summary": "Get an app setting by key (with ENV fallback)",
+                "summary": "Update a flapping policy",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Setting key (flapping, slack, ai)",
-                        "name": "key",
+                        "type": "integer",
+                        "description": "Flapping policy ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Flapping policy",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.FlappingPolicyRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.AppSettingResponse"
+                            "$ref": "#/definitions/model.FlappingPolicyMutationResponse"
                         }
                     },
                     "400": {
@@ -1149,27 +1503,24 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "settings"
+                    "flapping-policies"
                 ],
-                "summary": "Update an app setting",
+                "summary": "Delete a flapping policy",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Setting key (flapping, slack, ai)",
-                        "name": "key",
+                        "type": "integer",
+                        "description": "Flapping policy ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
@@ -1178,7 +1529,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.AppSettingResponse"
+                            "$ref": "#/definitions/model.FlappingPolicyMutationResponse"
                         }
                     },
                     "400": {
@@ -1187,6 +1538,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1196,7 +1553,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/settings/webhooks": {
+        "/api/v1/incidents": {
             "get": {
                 "security": [
                     {
@@ -1207,14 +1564,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "settings"
+                    "incidents"
                 ],
-                "summary": "List webhook configs",
+                "summary": "List incidents",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.WebhookConfigListResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.IncidentListResponse"
+                            }
                         }
                     },
                     "500": {
@@ -1224,46 +1584,62 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/api/v1/incidents/hidden": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
+                "description": "숨김 처리된(is_enabled=false) Incident 목록을 조회합니다.",
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "settings"
-                ],
-                "summary": "Create a webhook config",
-                "parameters": [
-                    {
-                        "description": "Webhook config",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.WebhookConfigRequest"
-                        }
-                    }
+                    "incidents"
                 ],
+                "summary": "List hidden incidents",
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.WebhookConfigMutationResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.IncidentListResponse"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/model.ErrorResponse"
                         }
+                    }
+                }
+            }
+        },
+        "/api/v1/incidents/mock": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "incidents"
+                ],
+                "summary": "Create mock incident",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.MockIncidentResponse"
+                        }
                     },
                     "500": {
                         "description": "Internal Server Error",
@@ -1274,7 +1650,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/settings/webhooks/{id}": {
+        "/api/v1/incidents/{id}": {
             "get": {
                 "security": [
                     {
@@ -1285,13 +1661,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "settings"
+                    "incidents"
                 ],
-                "summary": "Get a webhook config by ID",
+                "summary": "Get incident detail with alerts",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Webhook Config ID",
+                        "type": "string",
+                        "description": "Incident ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1301,19 +1677,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.WebhookConfigResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/model.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/model.ErrorResponse"
+                            "$ref": "#/definitions/model.IncidentDetailEnvelope"
                         }
                     },
                     "500": {
@@ -1337,24 +1701,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "settings"
+                    "incidents"
                 ],
-                "summary": "Update a webhook config",
+                "summary": "Update incident detail",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Webhook Config ID",
+                        "type": "string",
+                        "description": "Incident ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Webhook config",
+                        "description": "Incident update payload",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.WebhookConfigRequest"
+                            "$ref": "#/definitions/model.UpdateIncidentRequest"
                         }
                     }
                 ],
@@ -1362,7 +1726,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.WebhookConfigMutationResponse"
+                            "$ref": "#/definitions/model.IncidentUpdateResponse"
                         }
                     },
                     "400": {
@@ -1371,12 +1735,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/model.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/model.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1385,7 +1743,7 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -1395,13 +1753,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "settings"
+                    "incidents"
                 ],
-                "summary": "Delete a webhook config",
+                "summary": "Hide incident",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Webhook Config ID",
+                        "type": "string",
+                        "description": "Incident ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1411,19 +1769,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.WebhookConfigMutationResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/model.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/model.ErrorResponse"
+                            "$ref": "#/definitions/model.IncidentUpdateResponse"
                         }
                     },
                     "500": {
@@ -1435,107 +1781,3256 @@ const docTemplate = `{
                 }
             }
         },
-        "/healthz": {
-            "get": {
+        "/api/v1/incidents/{id}/ack": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Records who acknowledged the incident and the time to acknowledge, and acknowledges all of its unacknowledged firing alerts",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "health"
+                    "incidents"
+                ],
+                "summary": "Acknowledge firing incident",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Incident ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Startup/health check (includes DB connectivity)",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.StatusResponse"
+                            "$ref": "#/definitions/model.AcknowledgeResponse"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/model.StatusResponse"
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/ping": {
+        "/api/v1/incidents/{id}/alerts": {
             "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "health"
+                    "incidents"
+                ],
+                "summary": "Get alerts for incident",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Incident ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Health check",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.PingResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.AlertListResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/readyz": {
-            "get": {
+        "/api/v1/incidents/{id}/analyze": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "수동으로 Incident에 대한 AI 분석을 트리거합니다. 분석은 비동기로 실행됩니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "health"
+                    "incidents"
+                ],
+                "summary": "Trigger manual analysis for an incident",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Incident ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Readiness check (includes DB connectivity)",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
-                            "$ref": "#/definitions/model.StatusResponse"
+                            "$ref": "#/definitions/model.IncidentUpdateResponse"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/model.StatusResponse"
+                            "$ref": "#/definitions/model.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/webhook/alertmanager": {
-            "post": {
-                "consumes": [
-                    "application/json"
+        "/api/v1/incidents/{id}/audit": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Acknowledgements and escalation steps (started, escalated, stopped, exhausted) in chronological order",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "webhook"
+                    "incidents"
                 ],
-                "summary": "Receive Alertmanager webhook",
+                "summary": "List the audit trail of an incident",
                 "parameters": [
                     {
-                        "description": "Alertmanager webhook payload",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/model.AlertmanagerWebhook"
-                        }
+                        "type": "string",
+                        "description": "Incident ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.AlertWebhookResponse"
+                            "$ref": "#/definitions/model.IncidentAuditListResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/incidents/{id}/escalation": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "incidents"
+                ],
+                "summary": "Get the escalation state of an incident",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Incident ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.IncidentEscalationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/incidents/{id}/resolve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "incidents"
+                ],
+                "summary": "Resolve incident (사용자가 장애 종료)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Incident ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Resolve incident payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ResolveIncidentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.IncidentUpdateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/incidents/{id}/silences": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "alertmanager"
+                ],
+                "summary": "List active Alertmanager silences for an incident",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Incident ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AlertmanagerSilenceListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/incidents/{id}/unhide": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "숨김 처리된 Incident를 다시 활성화합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "incidents"
+                ],
+                "summary": "Unhide incident (restore)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Incident ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.IncidentUpdateResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/inhibition-rules": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inhibition"
+                ],
+                "summary": "List inhibition rules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.InhibitionRuleListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "While an alert matching source_matchers is firing, alerts matching target_matchers with the same equal labels are stored with inhibited_by and skip notification and auto-analysis",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inhibition"
+                ],
+                "summary": "Create an inhibition rule",
+                "parameters": [
+                    {
+                        "description": "Inhibition rule",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.InhibitionRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.InhibitionRuleMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/inhibition-rules/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inhibition"
+                ],
+                "summary": "Get an inhibition rule by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Inhibition rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.InhibitionRuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inhibition"
+                ],
+                "summary": "Update an inhibition rule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Inhibition rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Inhibition rule",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.InhibitionRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.InhibitionRuleMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inhibition"
+                ],
+                "summary": "Delete an inhibition rule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Inhibition rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.InhibitionRuleMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/maintenance-windows": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "List maintenance windows",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.MaintenanceWindowListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Alerts matching the scope during a window are stored as in_maintenance and skip notification and auto-analysis",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "Create a maintenance window",
+                "parameters": [
+                    {
+                        "description": "Maintenance window",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.MaintenanceWindowRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.MaintenanceWindowMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/maintenance-windows/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "Get a maintenance window by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Maintenance window ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.MaintenanceWindowResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "Update a maintenance window",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Maintenance window ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Maintenance window",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.MaintenanceWindowRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.MaintenanceWindowMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "maintenance"
+                ],
+                "summary": "Delete a maintenance window",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Maintenance window ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.MaintenanceWindowMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/notification-outbox": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns outbound notification entries (newest first) without payloads",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification-outbox"
+                ],
+                "summary": "List notification outbox entries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by status (pending, sending, delivered, dead, discarded)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Max entries (default 100, max 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.NotificationOutboxListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/notification-outbox/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns an outbox entry including the stored event payload",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification-outbox"
+                ],
+                "summary": "Get a notification outbox entry",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Outbox entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.NotificationOutboxEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/notification-outbox/{id}/discard": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stops retrying a pending or dead entry",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification-outbox"
+                ],
+                "summary": "Discard a notification outbox entry",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Outbox entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.NotificationOutboxActionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/notification-outbox/{id}/retry": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Resets attempts and schedules a pending, dead or discarded entry for immediate redelivery",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification-outbox"
+                ],
+                "summary": "Retry a notification outbox entry",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Outbox entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/model.NotificationOutboxActionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/oncall/now": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the current on-call user of every enabled schedule (override first, then the last active layer) with the next hand-off time",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oncall"
+                ],
+                "summary": "Who is on call now",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.OncallNowResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/oncall/schedules": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oncall"
+                ],
+                "summary": "List on-call schedules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.OncallScheduleListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Layers rotate their users daily or weekly at handoff_time (schedule time zone), starting with the first user on start_date. Later layers take precedence while their weekdays/active hours apply. Alerts matching the matchers use the schedule; the most specific schedule wins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oncall"
+                ],
+                "summary": "Create an on-call schedule",
+                "parameters": [
+                    {
+                        "description": "On-call schedule",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.OncallScheduleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.OncallMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/oncall/schedules/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oncall"
+                ],
+                "summary": "Get an on-call schedule by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.OncallScheduleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oncall"
+                ],
+                "summary": "Update an on-call schedule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "On-call schedule",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.OncallScheduleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.OncallMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Also deletes its overrides. On-call users already recorded on incidents are kept.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oncall"
+                ],
+                "summary": "Delete an on-call schedule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.OncallMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/oncall/schedules/{id}/overrides": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oncall"
+                ],
+                "summary": "List current and upcoming overrides of a schedule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.OncallOverrideListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "The user is on call for the schedule from start_at (default now) to end_at, ahead of every layer. When overrides overlap, the latest one wins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oncall"
+                ],
+                "summary": "Create an on-call override",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Override",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.OncallOverrideRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.OncallMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/oncall/schedules/{id}/overrides/{overrideId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oncall"
+                ],
+                "summary": "Delete an on-call override",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Override ID",
+                        "name": "overrideId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.OncallMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/oncall/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oncall"
+                ],
+                "summary": "List users and their Slack user IDs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.OncallUserListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/oncall/users/{loginId}/slack": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Used to mention the on-call user in Slack. An empty slack_user_id removes the mapping.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "oncall"
+                ],
+                "summary": "Map a user to a Slack user ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User login ID",
+                        "name": "loginId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Slack user ID",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.OncallUserSlackRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.OncallMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/scheduled-jobs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns scheduled jobs ordered by run_at. Defaults to pending jobs; use type=flapping_clearance for pending flapping clearance checks.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "scheduled-jobs"
+                ],
+                "summary": "List scheduled jobs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by job type (e.g. flapping_clearance)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "pending (default), running, done, failed, cancelled, all",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Max jobs (default 100, max 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ScheduledJobListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/services": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "services"
+                ],
+                "summary": "List services in the ownership catalog",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ServiceListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Alerts whose namespace is listed in namespaces or whose labels match all selectors are resolved to this service at ingestion",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "services"
+                ],
+                "summary": "Create a service",
+                "parameters": [
+                    {
+                        "description": "Service",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ServiceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.ServiceMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/services/metrics": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Open incident count per service plus incidents and MTTR within the window. Maintenance-only incidents are excluded from MTTR.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "services"
+                ],
+                "summary": "Per-service open incidents and MTTR",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "30d",
+                        "description": "Window (e.g. 24h, 7d, 30d)",
+                        "name": "window",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ServiceMetricsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/services/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "services"
+                ],
+                "summary": "Get a service by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Service ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ServiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "services"
+                ],
+                "summary": "Update a service",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Service ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Service",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.ServiceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ServiceMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "services"
+                ],
+                "summary": "Delete a service",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Service ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ServiceMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/services/{id}/incidents": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "services"
+                ],
+                "summary": "List incidents owned by a service",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Service ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "firing (default), resolved, all",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ServiceIncidentListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/settings/app": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "settings"
+                ],
+                "summary": "List all app settings",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AppSettingListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/settings/app/{key}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "settings"
+                ],
+                "summary": "Get an app setting by key (with ENV fallback)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Setting key (flapping, slack, ai)",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AppSettingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "settings"
+                ],
+                "summary": "Update an app setting",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Setting key (flapping, slack, ai)",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AppSettingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/settings/webhook-auth": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns configured credential names (without secrets) and rejected request counters",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "settings"
+                ],
+                "summary": "Get inbound webhook authentication status",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookAuthStatusResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/settings/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "settings"
+                ],
+                "summary": "List webhook configs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookConfigListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "settings"
+                ],
+                "summary": "Create a webhook config",
+                "parameters": [
+                    {
+                        "description": "Webhook config",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookConfigRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookConfigMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/settings/webhooks/preview": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Renders title/body/payload templates against a stored alert. Empty template fields fall back to the templates of webhook_config_id when given.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "settings"
+                ],
+                "summary": "Preview webhook message templates",
+                "parameters": [
+                    {
+                        "description": "Templates and alert",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookTemplatePreviewRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookTemplatePreviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/settings/webhooks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "settings"
+                ],
+                "summary": "Get a webhook config by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook Config ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookConfigResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "settings"
+                ],
+                "summary": "Update a webhook config",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook Config ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook config",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookConfigRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookConfigMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "settings"
+                ],
+                "summary": "Delete a webhook config",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook Config ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookConfigMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/settings/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns delivery attempts sent through the webhook config, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "settings"
+                ],
+                "summary": "List notification attempts for a webhook config",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook config ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Max entries (default 100, max 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.NotificationAttemptListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/silences": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "silences"
+                ],
+                "summary": "List silence rules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SilenceRuleListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Matching alerts are still stored but skip notification and auto-analysis",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "silences"
+                ],
+                "summary": "Create a silence rule",
+                "parameters": [
+                    {
+                        "description": "Silence rule",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SilenceRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/model.SilenceRuleMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/silences/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "silences"
+                ],
+                "summary": "Get a silence rule by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Silence rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SilenceRuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "silences"
+                ],
+                "summary": "Update a silence rule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Silence rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Silence rule",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.SilenceRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SilenceRuleMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "silences"
+                ],
+                "summary": "Delete a silence rule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Silence rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.SilenceRuleMutationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhook-inbox": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns inbox entries (newest first) without payloads",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook-inbox"
+                ],
+                "summary": "List stored inbound webhooks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by status (pending, processing, done, dead)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Max entries (default 100, max 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookInboxListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhook-inbox/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns an inbox entry including the raw payload",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook-inbox"
+                ],
+                "summary": "Get a stored inbound webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Inbox entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookInboxEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/webhook-inbox/{id}/replay": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Resets the entry to pending so workers process the stored payload again",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook-inbox"
+                ],
+                "summary": "Re-process a stored inbound webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Inbox entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookInboxReplayResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/healthz": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Startup/health check (includes DB connectivity)",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.StatusResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/model.StatusResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/ping": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Health check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.PingResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Readiness check (includes DB connectivity)",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.StatusResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/model.StatusResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/alertmanager": {
+            "post": {
+                "description": "Stores the payload in the webhook inbox and returns 202; alerts are processed asynchronously",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Receive Alertmanager webhook",
+                "parameters": [
+                    {
+                        "description": "Alertmanager webhook payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.AlertmanagerWebhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookAcceptedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/generic": {
+            "post": {
+                "description": "Accepts {\"source\",\"alerts\":[...]}, a JSON array of alerts or a single alert object; normalizes, stores in the webhook inbox and returns 202",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Receive generic JSON alerts",
+                "parameters": [
+                    {
+                        "description": "Generic alert payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.GenericWebhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookAcceptedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/grafana": {
+            "post": {
+                "description": "Normalizes Grafana alerts, stores them in the webhook inbox and returns 202",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Receive Grafana unified alerting webhook",
+                "parameters": [
+                    {
+                        "description": "Grafana webhook payload",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.GrafanaWebhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookAcceptedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/kubernetes-events": {
+            "post": {
+                "description": "Accepts a core/v1 Event or EventList (e.g. from an event-exporter webhook sink). Warning events become alerts deduplicated on involvedObject + reason; Normal events are ignored",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Receive Kubernetes Events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Cluster name label (overrides KUBE_EVENT_CLUSTER_NAME when the event has no clusterName)",
+                        "name": "cluster",
+                        "in": "query"
+                    },
+                    {
+                        "description": "core/v1 Event (EventList is also accepted)",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.KubernetesEvent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/model.KubernetesEventIngestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/pagerduty": {
+            "post": {
+                "description": "Normalizes a trigger/resolve event, stores it in the webhook inbox and returns 202. Acknowledge events and resolves for unknown dedup keys return 202 with status \"ignored\" and are not stored",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Receive PagerDuty Events API v2 event",
+                "parameters": [
+                    {
+                        "description": "PagerDuty Events v2 event",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.PagerDutyEvent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/model.WebhookAcceptedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/model.ErrorResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
                         "schema": {
                             "$ref": "#/definitions/model.ErrorResponse"
                         }
@@ -1545,37 +5040,2093 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "model.AcknowledgeResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.Acknowledgement"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.Acknowledgement": {
+            "type": "object",
+            "properties": {
+                "acknowledged_alerts": {
+                    "description": "함께 확인 처리된 alert ID",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "acknowledged_at": {
+                    "type": "string"
+                },
+                "acknowledged_by": {
+                    "type": "string"
+                },
+                "alert_id": {
+                    "type": "string"
+                },
+                "incident_acknowledged": {
+                    "description": "alert 확인 시 연결된 incident도 처음 확인되었는지",
+                    "type": "boolean"
+                },
+                "incident_id": {
+                    "type": "string"
+                },
+                "time_to_ack_seconds": {
+                    "type": "integer"
+                }
+            }
+        },
         "model.Alert": {
             "type": "object",
             "properties": {
-                "annotations": {
-                    "description": "- summary: 알림 요약\n- description: 알림 상세 설명\n- runbook_url: 대응 매뉴얼 URL",
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
+                "annotations": {
+                    "description": "- summary: 알림 요약\n- description: 알림 상세 설명\n- runbook_url: 대응 매뉴얼 URL",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "endsAt": {
+                    "description": "EndsAt: 알림 종료 시각 (UTC)\nresolved 상태일 때만 유효한 값 설정\nfiring 상태일 때는 \"0001-01-01T00:00:00Z\"",
+                    "type": "string"
+                },
+                "fingerprint": {
+                    "description": "Fingerprint: 알림 고유 식별자 (Labels의 조합으로 생성되는 해시값)\nthread_ts 매핑에 사용하여 같은 스레드로 메시지 전송이 가능하게함",
+                    "type": "string"
+                },
+                "generatorURL": {
+                    "description": "GeneratorURL: 알림을 생성한 Prometheus 쿼리 URL",
+                    "type": "string"
+                },
+                "labels": {
+                    "description": "- alertname: 알림 이름 (예: \"PodCrashLooping\", \"HighMemoryUsage\")\n- severity: 심각도 (예: \"critical\", \"warning\", \"info\")\n- namespace: 문제 발생 네임스페이스\n- pod: 문제 발생 파드 이름",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "startsAt": {
+                    "description": "StartsAt: 알림 발생 시각 (UTC)",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AlertAnalysisItem": {
+            "type": "object",
+            "properties": {
+                "analysis_id": {
+                    "type": "integer"
+                },
+                "analysis_model": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AlertDetailResponse": {
+            "type": "object",
+            "properties": {
+                "acknowledged": {
+                    "description": "확인(acknowledge) 정보 (확인 후에는 재알림 중단)",
+                    "type": "boolean"
+                },
+                "acknowledged_at": {
+                    "type": "string"
+                },
+                "acknowledged_by": {
+                    "type": "string"
+                },
+                "alarm_title": {
+                    "type": "string"
+                },
+                "alert_id": {
+                    "type": "string"
+                },
+                "analyses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AlertAnalysisItem"
+                    }
+                },
+                "analysis_detail": {
+                    "type": "string"
+                },
+                "analysis_summary": {
+                    "type": "string"
+                },
+                "annotations": {
+                    "type": "object"
+                },
+                "correlation_detail": {
+                    "type": "string"
+                },
+                "correlation_key": {
+                    "description": "Incident 상관관계 매칭 정보 (어떤 이유로 Incident에 연결되었는지)",
+                    "type": "string"
+                },
+                "correlation_reason": {
+                    "type": "string"
+                },
+                "correlation_score": {
+                    "type": "number"
+                },
+                "enrichments": {
+                    "description": "enrichment 파이프라인이 추가한 라벨/annotation과 출처 (AlertEnrichment 목록)",
+                    "type": "array",
+                    "items": {
+                        "type": "object"
+                    }
+                },
+                "external_url": {
+                    "description": "웹훅을 보낸 Alertmanager의 externalURL (silence 생성 대상)",
+                    "type": "string"
+                },
+                "fingerprint": {
+                    "type": "string"
+                },
+                "fired_at": {
+                    "type": "string"
+                },
+                "flap_cycle_count": {
+                    "type": "integer"
+                },
+                "flap_window_start": {
+                    "type": "string"
+                },
+                "flapping_policy_id": {
+                    "description": "flapping 판정에 적용된 정책 ID (전역 flapping 설정을 사용했으면 null)",
+                    "type": "integer"
+                },
+                "in_maintenance": {
+                    "description": "점검 시간대에 수신된 alert 여부 (알림/분석 스킵, MTTR 제외)",
+                    "type": "boolean"
+                },
+                "incident_id": {
+                    "type": "string"
+                },
+                "inhibited_by": {
+                    "description": "억제 규칙에 의해 알림/분석이 스킵된 경우 억제한 source alert ID와 규칙 ID (억제되지 않았으면 null)",
+                    "type": "string"
+                },
+                "inhibition_rule_id": {
+                    "type": "integer"
+                },
+                "is_analyzing": {
+                    "type": "boolean"
+                },
+                "is_flapping": {
+                    "type": "boolean"
+                },
+                "labels": {
+                    "type": "object"
+                },
+                "last_reminder_at": {
+                    "description": "장시간 firing 재알림 마지막 전송 시각과 횟수 (severity 레벨의 reminderMinutes)",
+                    "type": "string"
+                },
+                "maintenance_window_id": {
+                    "type": "integer"
+                },
+                "reminder_count": {
+                    "type": "integer"
+                },
+                "resolved_at": {
+                    "type": "string"
+                },
+                "service_id": {
+                    "description": "서비스 카탈로그에서 매칭된 소유 서비스 ID (매칭되지 않았으면 null)",
+                    "type": "integer"
+                },
+                "severity": {
+                    "type": "string"
+                },
+                "silenced_by": {
+                    "description": "알림/자동 분석을 음소거한 silence 규칙 ID (음소거되지 않았으면 null)",
+                    "type": "integer"
+                },
+                "source": {
+                    "description": "알림 수신 경로 (alertmanager, grafana, pagerduty, generic, kubernetes)",
+                    "type": "string"
+                },
+                "source_credential": {
+                    "description": "웹훅 수신 시 인증에 사용된 credential 이름 (인증 비활성 시 빈 문자열)",
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "thread_ts": {
+                    "type": "string"
+                },
+                "time_to_ack_seconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.AlertListResponse": {
+            "type": "object",
+            "properties": {
+                "acknowledged": {
+                    "description": "확인(acknowledge) 정보",
+                    "type": "boolean"
+                },
+                "acknowledged_at": {
+                    "type": "string"
+                },
+                "acknowledged_by": {
+                    "type": "string"
+                },
+                "alarm_title": {
+                    "type": "string"
+                },
+                "alert_id": {
+                    "type": "string"
+                },
+                "analysis_summary": {
+                    "type": "string"
+                },
+                "fired_at": {
+                    "type": "string"
+                },
+                "incident_id": {
+                    "description": "null 가능 (아직 Incident에 연결되지 않은 경우)",
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "object"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "resolved_at": {
+                    "type": "string"
+                },
+                "severity": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "firing, resolved",
+                    "type": "string"
+                },
+                "time_to_ack_seconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.AlertNotificationDelivery": {
+            "type": "object",
+            "properties": {
+                "alert_id": {
+                    "type": "string"
+                },
+                "channel_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "delivery_id": {
+                    "type": "integer"
+                },
+                "fingerprint": {
+                    "type": "string"
+                },
+                "incident_id": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "notifier_type": {
+                    "type": "string"
+                },
+                "root_message_ts": {
+                    "type": "string"
+                },
+                "route_key": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "thread_ts": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "webhook_config_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.AlertResolveResponse": {
+            "type": "object",
+            "properties": {
+                "alert_id": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AlertSilenceRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "duration_minutes": {
+                    "type": "integer"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "match_labels": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.AlertUpdateResponse": {
+            "type": "object",
+            "properties": {
+                "alert_id": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AlertmanagerReconcileResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.AlertmanagerReconcileResult"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AlertmanagerReconcileResult": {
+            "type": "object",
+            "properties": {
+                "fetched": {
+                    "description": "Alertmanager에서 조회한 active alert 수",
+                    "type": "integer"
+                },
+                "inbox_id": {
+                    "type": "integer"
+                },
+                "ran_at": {
+                    "type": "string"
+                },
+                "recovered": {
+                    "description": "DB에 firing으로 없어 새로 enqueue한 alert 수",
+                    "type": "integer"
+                },
+                "resolved": {
+                    "description": "Alertmanager에 없어 resolved로 enqueue한 alert 수",
+                    "type": "integer"
+                },
+                "trigger": {
+                    "description": "startup, interval, truncated, manual",
+                    "type": "string"
+                }
+            }
+        },
+        "model.AlertmanagerSilence": {
+            "type": "object",
+            "properties": {
+                "alert_id": {
+                    "type": "string"
+                },
+                "alertmanager_url": {
+                    "description": "silence를 생성한 Alertmanager",
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "expired_at": {
+                    "description": "kube-rca에서 조기 만료한 시각",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "incident_id": {
+                    "type": "string"
+                },
+                "matchers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelMatcher"
+                    }
+                },
+                "silence_id": {
+                    "description": "Alertmanager silence ID",
+                    "type": "string"
+                },
+                "starts_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AlertmanagerSilenceListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AlertmanagerSilence"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AlertmanagerSilenceResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.AlertmanagerSilence"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AlertmanagerWebhook": {
+            "type": "object",
+            "properties": {
+                "alerts": {
+                    "description": "개별 알림 리스트",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Alert"
+                    }
+                },
+                "commonAnnotations": {
+                    "description": "그룹 내 모든 알림에 공통으로 존재하는 어노테이션",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "commonLabels": {
+                    "description": "그룹 내 모든 알림에 공통으로 존재하는 라벨",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "externalURL": {
+                    "type": "string"
+                },
+                "groupKey": {
+                    "description": "동일한 GroupKey를 가진 알림들은 함께 그룹핑됨",
+                    "type": "string"
+                },
+                "groupLabels": {
+                    "description": "route.group_by 설정에 따라 결정되는 그룹핑에 사용된 라벨",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "receiver": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "truncatedAlerts": {
+                    "description": "max_alerts 설정으로 인해 생략된 알림이 있을 경우 그 개수",
+                    "type": "integer"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AppSetting": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AppSettingListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AppSetting"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AppSettingResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.AppSetting"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AuthConfigResponse": {
+            "type": "object",
+            "properties": {
+                "allowSignup": {
+                    "type": "boolean"
+                },
+                "oidcEnabled": {
+                    "type": "boolean"
+                },
+                "oidcLoginUrl": {
+                    "type": "string"
+                },
+                "oidcProvider": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AuthLogoutResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AuthMeResponse": {
+            "type": "object",
+            "properties": {
+                "authProvider": {
+                    "type": "string"
+                },
+                "displayName": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "loginId": {
+                    "type": "string"
+                },
+                "slackUserId": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.AuthRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AuthResponse": {
+            "type": "object",
+            "properties": {
+                "accessToken": {
+                    "type": "string"
+                },
+                "expiresIn": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.BulkResolveAlertsRequest": {
+            "type": "object",
+            "required": [
+                "alert_ids"
+            ],
+            "properties": {
+                "alert_ids": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.BulkResolveAlertsResponse": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "resolved": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.EffectiveFlappingPolicy": {
+            "type": "object",
+            "properties": {
+                "clearance_window_minutes": {
+                    "type": "integer"
+                },
+                "cycle_threshold": {
+                    "type": "integer"
+                },
+                "detection_window_minutes": {
+                    "type": "integer"
+                },
+                "enabled": {
+                    "description": "전역 비활성 또는 never_flapping이면 false",
+                    "type": "boolean"
+                },
+                "policy_id": {
+                    "description": "null = 전역 설정",
+                    "type": "integer"
+                },
+                "policy_name": {
+                    "description": "빈 문자열 = 전역 설정",
+                    "type": "string"
+                }
+            }
+        },
+        "model.EmbeddingRequest": {
+            "type": "object",
+            "properties": {
+                "incident_id": {
+                    "type": "string"
+                },
+                "incident_summary": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.EmbeddingResponse": {
+            "type": "object",
+            "properties": {
+                "embedding_id": {
+                    "type": "integer"
+                },
+                "model": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.EscalationLevel": {
+            "type": "object",
+            "properties": {
+                "targets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.EscalationTarget"
+                    }
+                },
+                "timeout_minutes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.EscalationPolicy": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "levels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.EscalationLevel"
+                    }
+                },
+                "matchers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelMatcher"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.EscalationPolicyListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.EscalationPolicy"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.EscalationPolicyMutationResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.EscalationPolicyRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "levels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.EscalationLevel"
+                    }
+                },
+                "matchers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelMatcher"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.EscalationPolicyResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.EscalationPolicy"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.EscalationTarget": {
+            "type": "object",
+            "properties": {
+                "channel": {
+                    "description": "type=channel (Slack 채널 ID, C...)",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "webhook, user, channel",
+                    "type": "string"
+                },
+                "user": {
+                    "description": "type=user (Slack 사용자 ID, U...)",
+                    "type": "string"
+                },
+                "webhook_config_id": {
+                    "description": "type=webhook",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.FlappingClearResponse": {
+            "type": "object",
+            "properties": {
+                "alert_id": {
+                    "type": "string"
+                },
+                "cancelled_jobs": {
+                    "description": "취소된 pending clearance 작업 수",
+                    "type": "integer"
+                },
+                "fingerprint": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.FlappingPolicy": {
+            "type": "object",
+            "properties": {
+                "clearance_window_minutes": {
+                    "description": "0 = 전역 설정",
+                    "type": "integer"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "cycle_threshold": {
+                    "description": "0 = 전역 설정",
+                    "type": "integer"
+                },
+                "detection_window_minutes": {
+                    "description": "0 = 전역 설정",
+                    "type": "integer"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "matchers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelMatcher"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "never_flapping": {
+                    "type": "boolean"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.FlappingPolicyListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.FlappingPolicy"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.FlappingPolicyMatchRequest": {
+            "type": "object",
+            "properties": {
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.FlappingPolicyMatchResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.EffectiveFlappingPolicy"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.FlappingPolicyMutationResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.FlappingPolicyRequest": {
+            "type": "object",
+            "properties": {
+                "clearance_window_minutes": {
+                    "type": "integer"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "cycle_threshold": {
+                    "type": "integer"
+                },
+                "detection_window_minutes": {
+                    "type": "integer"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "matchers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelMatcher"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "never_flapping": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model.FlappingPolicyResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.FlappingPolicy"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GenericAlert": {
+            "type": "object",
+            "properties": {
+                "annotations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "endsAt": {
+                    "type": "string"
+                },
+                "fingerprint": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "severity": {
+                    "type": "string"
+                },
+                "startsAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "firing(기본값) 또는 resolved",
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.GenericWebhook": {
+            "type": "object",
+            "properties": {
+                "alerts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.GenericAlert"
+                    }
+                },
+                "source": {
+                    "description": "송신 시스템 이름 (labels.source_system으로 기록)",
+                    "type": "string"
+                }
+            }
+        },
+        "model.GrafanaAlert": {
+            "type": "object",
+            "properties": {
+                "annotations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "dashboardURL": {
+                    "type": "string"
+                },
+                "endsAt": {
+                    "type": "string"
+                },
+                "fingerprint": {
+                    "type": "string"
+                },
+                "generatorURL": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "panelURL": {
+                    "type": "string"
+                },
+                "silenceURL": {
+                    "type": "string"
+                },
+                "startsAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "valueString": {
+                    "type": "string"
+                },
+                "values": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number",
+                        "format": "float64"
+                    }
+                }
+            }
+        },
+        "model.GrafanaWebhook": {
+            "type": "object",
+            "properties": {
+                "alerts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.GrafanaAlert"
+                    }
+                },
+                "commonAnnotations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "commonLabels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "externalURL": {
+                    "type": "string"
+                },
+                "groupKey": {
+                    "type": "string"
+                },
+                "groupLabels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "orgId": {
+                    "type": "integer"
+                },
+                "receiver": {
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "truncatedAlerts": {
+                    "type": "integer"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.IncidentAuditEvent": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "description": "사용자 login ID (시스템 동작이면 system)",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "detail": {
+                    "type": "object"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "incident_id": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.IncidentAuditListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.IncidentAuditEvent"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.IncidentDetailEnvelope": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.IncidentDetailResponse"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.IncidentDetailResponse": {
+            "type": "object",
+            "properties": {
+                "acknowledged": {
+                    "description": "확인(acknowledge) 정보",
+                    "type": "boolean"
+                },
+                "acknowledged_at": {
+                    "type": "string"
+                },
+                "acknowledged_by": {
+                    "type": "string"
+                },
+                "alerts": {
+                    "description": "연결된 Alert 목록 (상세 조회 시 포함)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.AlertListResponse"
+                    }
+                },
+                "analysis_detail": {
+                    "type": "string"
+                },
+                "analysis_summary": {
+                    "type": "string"
+                },
+                "correlation_key": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "fired_at": {
+                    "type": "string"
+                },
+                "incident_id": {
+                    "type": "string"
+                },
+                "is_analyzing": {
+                    "type": "boolean"
+                },
+                "oncall_schedule_id": {
+                    "type": "integer"
+                },
+                "oncall_user": {
+                    "description": "발생 시점의 on-call 사용자 (매칭된 on-call 스케줄 기준)",
+                    "type": "string"
+                },
+                "resolved_at": {
+                    "type": "string"
+                },
+                "resolved_by": {
+                    "type": "string"
+                },
+                "severity": {
+                    "type": "string"
+                },
+                "similar_incidents": {
+                    "description": "DB의 JSONB 컬럼을 그대로 바이트로 받아서 전달",
+                    "type": "object"
+                },
+                "status": {
+                    "description": "firing, resolved",
+                    "type": "string"
+                },
+                "time_to_ack_seconds": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.IncidentEscalation": {
+            "type": "object",
+            "properties": {
+                "current_level": {
+                    "description": "알림을 보낸 마지막 레벨 (1부터, 0 = 시작 전)",
+                    "type": "integer"
+                },
+                "incident_id": {
+                    "type": "string"
+                },
+                "last_escalated_at": {
+                    "type": "string"
+                },
+                "next_escalation_at": {
+                    "description": "active일 때 다음 레벨 예정 시각",
+                    "type": "string"
+                },
+                "policy_id": {
+                    "type": "integer"
+                },
+                "policy_name": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "active, acknowledged, resolved, exhausted, cancelled",
+                    "type": "string"
+                },
+                "stopped_reason": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.IncidentEscalationResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.IncidentEscalation"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.IncidentListResponse": {
+            "type": "object",
+            "properties": {
+                "acknowledged": {
+                    "description": "확인(acknowledge) 정보 (확인 전이면 acknowledged=false, 나머지 null/빈 값)",
+                    "type": "boolean"
+                },
+                "acknowledged_at": {
+                    "type": "string"
+                },
+                "acknowledged_by": {
+                    "type": "string"
+                },
+                "alert_count": {
+                    "description": "연결된 Alert 개수",
+                    "type": "integer"
+                },
+                "correlation_key": {
+                    "description": "Alert 그룹핑 키",
+                    "type": "string"
+                },
+                "fired_at": {
+                    "type": "string"
+                },
+                "in_maintenance": {
+                    "description": "연결된 Alert가 모두 점검 시간대에 수신됨 (MTTR 제외)",
+                    "type": "boolean"
+                },
+                "incident_id": {
+                    "type": "string"
+                },
+                "resolved_at": {
+                    "type": "string"
+                },
+                "severity": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "firing, resolved",
+                    "type": "string"
+                },
+                "time_to_ack_seconds": {
+                    "description": "fired_at → acknowledged_at (MTTA 계산용)",
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.IncidentUpdateResponse": {
+            "type": "object",
+            "properties": {
+                "incident_id": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.InhibitionRule": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "equal": {
+                    "description": "source/target 간 값이 같아야 하는 라벨 (예: [\"node\", \"cluster\"])",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "source_matchers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelMatcher"
+                    }
+                },
+                "target_matchers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelMatcher"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.InhibitionRuleListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.InhibitionRule"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.InhibitionRuleMutationResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.InhibitionRuleRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "equal": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "source_matchers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelMatcher"
+                    }
+                },
+                "target_matchers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelMatcher"
+                    }
+                }
+            }
+        },
+        "model.InhibitionRuleResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.InhibitionRule"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.KubernetesEvent": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "type": "string"
+                },
+                "clusterName": {
+                    "description": "ClusterName - event-exporter가 채우는 클러스터 이름 (없으면 쿼리 파라미터 cluster 사용)",
+                    "type": "string"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "eventTime": {
+                    "type": "string"
+                },
+                "firstTimestamp": {
+                    "type": "string"
+                },
+                "involvedObject": {
+                    "$ref": "#/definitions/model.KubernetesObjectReference"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "lastTimestamp": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/model.KubernetesObjectMeta"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "reportingComponent": {
+                    "type": "string"
+                },
+                "reportingInstance": {
+                    "type": "string"
+                },
+                "source": {
+                    "$ref": "#/definitions/model.KubernetesEventSource"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.KubernetesEventIngestResponse": {
+            "type": "object",
+            "properties": {
+                "alertCount": {
+                    "description": "새로 firing 처리할 alert 수",
+                    "type": "integer"
+                },
+                "deduplicated": {
+                    "description": "이미 firing 중이라 갱신만 한 이벤트 수",
+                    "type": "integer"
+                },
+                "ignored": {
+                    "description": "Warning이 아니거나 대상 정보가 없는 이벤트 수",
+                    "type": "integer"
+                },
+                "inboxId": {
+                    "description": "새 alert가 있을 때만 inbox에 저장",
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.KubernetesEventSource": {
+            "type": "object",
+            "properties": {
+                "component": {
+                    "type": "string"
+                },
+                "host": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.KubernetesObjectMeta": {
+            "type": "object",
+            "properties": {
+                "creationTimestamp": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "uid": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.KubernetesObjectReference": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "type": "string"
+                },
+                "fieldPath": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "uid": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.LabelMatcher": {
+            "type": "object",
+            "properties": {
+                "is_equal": {
+                    "type": "boolean"
+                },
+                "is_regex": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.MaintenanceWindow": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "조회 시점 기준으로 계산",
+                    "type": "boolean"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "duration_minutes": {
+                    "type": "integer"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "matchers": {
+                    "description": "적용 범위 (예: namespace, cluster)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelMatcher"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "next_start": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "description": "5필드 cron 표현식 (예: \"0 22 * * TUE\")",
+                    "type": "string"
+                },
+                "time_zone": {
+                    "description": "IANA 타임존 (예: Asia/Seoul)",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.MaintenanceWindowListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.MaintenanceWindow"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.MaintenanceWindowMutationResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.MaintenanceWindowRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "duration_minutes": {
+                    "type": "integer"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "matchers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelMatcher"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "type": "string"
+                },
+                "time_zone": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.MaintenanceWindowResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.MaintenanceWindow"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.MockIncidentResponse": {
+            "type": "object",
+            "properties": {
+                "incident_id": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.NotificationAttempt": {
+            "type": "object",
+            "properties": {
+                "alert_id": {
+                    "type": "string"
+                },
+                "channel": {
+                    "description": "Slack 채널 (root/thread/escalation 대상)",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "fingerprint": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "incident_id": {
+                    "type": "string"
+                },
+                "latency_ms": {
+                    "type": "integer"
+                },
+                "mode": {
+                    "description": "notify, root, thread, escalation",
+                    "type": "string"
+                },
+                "outbox_id": {
+                    "description": "outbox를 거친 전송이면 entry ID",
+                    "type": "integer"
+                },
+                "payload_hash": {
+                    "description": "이벤트 내용 sha256 (같으면 같은 알림)",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "success, failed",
+                    "type": "string"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "webhook_config_id": {
+                    "description": "nil = 기본(환경변수) notifier",
+                    "type": "integer"
+                },
+                "webhook_config_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.NotificationAttemptListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.NotificationAttempt"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.NotificationOutboxActionResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.NotificationOutboxEntry": {
+            "type": "object",
+            "properties": {
+                "alert_id": {
+                    "type": "string"
+                },
+                "attempts": {
+                    "type": "integer"
+                },
+                "channel": {
+                    "description": "Slack root 메시지 채널 (서비스 채널 포함)",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "delivery": {
+                    "description": "thread 전송 대상 스레드",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.AlertNotificationDelivery"
+                        }
+                    ]
+                },
+                "event_type": {
+                    "description": "alert.status_changed 등",
+                    "type": "string"
+                },
+                "fingerprint": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "incident_id": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "mode": {
+                    "description": "notify, root, thread",
+                    "type": "string"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "payload": {
+                    "description": "상세 조회 시에만 포함",
+                    "type": "object"
+                },
+                "status": {
+                    "description": "pending, sending, delivered, dead, discarded",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "webhook_config_id": {
+                    "description": "nil = 기본(환경변수) notifier",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.NotificationOutboxEntryResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.NotificationOutboxEntry"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.NotificationOutboxListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.NotificationOutboxEntry"
                     }
                 },
-                "endsAt": {
-                    "description": "EndsAt: 알림 종료 시각 (UTC)\nresolved 상태일 때만 유효한 값 설정\nfiring 상태일 때는 \"0001-01-01T00:00:00Z\"",
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.OncallLayer": {
+            "type": "object",
+            "properties": {
+                "active_from": {
+                    "description": "적용 시간대 시작 (HH:MM, active_to와 함께 지정, 자정 넘김 가능)",
+                    "type": "string"
+                },
+                "active_to": {
+                    "description": "적용 시간대 끝 (HH:MM)",
                     "type": "string"
                 },
-                "fingerprint": {
-                    "description": "Fingerprint: 알림 고유 식별자 (Labels의 조합으로 생성되는 해시값)\nthread_ts 매핑에 사용하여 같은 스레드로 메시지 전송이 가능하게함",
+                "handoff_time": {
+                    "description": "교대 시각 (HH:MM, 생략 시 09:00)",
                     "type": "string"
                 },
-                "generatorURL": {
-                    "description": "GeneratorURL: 알림을 생성한 Prometheus 쿼리 URL",
+                "name": {
                     "type": "string"
                 },
-                "labels": {
-                    "description": "- alertname: 알림 이름 (예: \"PodCrashLooping\", \"HighMemoryUsage\")\n- severity: 심각도 (예: \"critical\", \"warning\", \"info\")\n- namespace: 문제 발생 네임스페이스\n- pod: 문제 발생 파드 이름",
-                    "type": "object",
-                    "additionalProperties": {
+                "rotation": {
+                    "description": "daily, weekly",
+                    "type": "string"
+                },
+                "start_date": {
+                    "description": "첫 사용자의 근무 시작일 (YYYY-MM-DD, 스케줄 타임존)",
+                    "type": "string"
+                },
+                "users": {
+                    "description": "교대 순서대로 사용자 login ID",
+                    "type": "array",
+                    "items": {
                         "type": "string"
                     }
                 },
-                "startsAt": {
-                    "description": "StartsAt: 알림 발생 시각 (UTC)",
+                "weekdays": {
+                    "description": "적용 요일 (mon..sun, 비어 있으면 매일)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.OncallMutationResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "message": {
                     "type": "string"
                 },
                 "status": {
@@ -1583,505 +7134,652 @@ const docTemplate = `{
                 }
             }
         },
-        "model.AlertAnalysisItem": {
+        "model.OncallNowResponse": {
             "type": "object",
             "properties": {
-                "analysis_id": {
-                    "type": "integer"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.OncallShift"
+                    }
                 },
-                "analysis_model": {
+                "status": {
                     "type": "string"
-                },
+                }
+            }
+        },
+        "model.OncallOverride": {
+            "type": "object",
+            "properties": {
                 "created_at": {
                     "type": "string"
                 },
-                "detail": {
+                "created_by": {
                     "type": "string"
                 },
-                "status": {
+                "end_at": {
                     "type": "string"
                 },
-                "summary": {
+                "id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "schedule_id": {
+                    "type": "integer"
+                },
+                "start_at": {
+                    "type": "string"
+                },
+                "user": {
+                    "description": "login ID",
                     "type": "string"
                 }
             }
         },
-        "model.AlertDetailResponse": {
+        "model.OncallOverrideListResponse": {
             "type": "object",
             "properties": {
-                "alarm_title": {
-                    "type": "string"
-                },
-                "alert_id": {
-                    "type": "string"
-                },
-                "analyses": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.AlertAnalysisItem"
+                        "$ref": "#/definitions/model.OncallOverride"
                     }
                 },
-                "analysis_detail": {
+                "status": {
                     "type": "string"
-                },
-                "analysis_summary": {
+                }
+            }
+        },
+        "model.OncallOverrideRequest": {
+            "type": "object",
+            "properties": {
+                "end_at": {
                     "type": "string"
                 },
-                "annotations": {
-                    "type": "object"
-                },
-                "fingerprint": {
+                "reason": {
                     "type": "string"
                 },
-                "fired_at": {
+                "start_at": {
                     "type": "string"
                 },
-                "flap_cycle_count": {
-                    "type": "integer"
+                "user": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.OncallSchedule": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
                 },
-                "flap_window_start": {
+                "created_at": {
                     "type": "string"
                 },
-                "incident_id": {
+                "created_by": {
                     "type": "string"
                 },
-                "is_analyzing": {
+                "enabled": {
                     "type": "boolean"
                 },
-                "is_flapping": {
-                    "type": "boolean"
+                "id": {
+                    "type": "integer"
                 },
-                "labels": {
-                    "type": "object"
+                "layers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.OncallLayer"
+                    }
                 },
-                "resolved_at": {
-                    "type": "string"
+                "matchers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelMatcher"
+                    }
                 },
-                "severity": {
+                "mention_in_slack": {
+                    "description": "firing 알림에 현재 on-call 사용자 멘션",
+                    "type": "boolean"
+                },
+                "name": {
                     "type": "string"
                 },
-                "status": {
+                "time_zone": {
+                    "description": "IANA 타임존 (예: Asia/Seoul)",
                     "type": "string"
                 },
-                "thread_ts": {
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "model.AlertListResponse": {
+        "model.OncallScheduleListResponse": {
             "type": "object",
             "properties": {
-                "alarm_title": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.OncallSchedule"
+                    }
                 },
-                "alert_id": {
+                "status": {
                     "type": "string"
-                },
-                "analysis_summary": {
+                }
+            }
+        },
+        "model.OncallScheduleRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
                     "type": "string"
                 },
-                "fired_at": {
-                    "type": "string"
+                "enabled": {
+                    "type": "boolean"
                 },
-                "incident_id": {
-                    "description": "null 가능 (아직 Incident에 연결되지 않은 경우)",
-                    "type": "string"
+                "layers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.OncallLayer"
+                    }
                 },
-                "labels": {
-                    "type": "object"
+                "matchers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelMatcher"
+                    }
                 },
-                "namespace": {
-                    "type": "string"
+                "mention_in_slack": {
+                    "type": "boolean"
                 },
-                "resolved_at": {
+                "name": {
                     "type": "string"
                 },
-                "severity": {
+                "time_zone": {
                     "type": "string"
+                }
+            }
+        },
+        "model.OncallScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.OncallSchedule"
                 },
                 "status": {
-                    "description": "firing, resolved",
                     "type": "string"
                 }
             }
         },
-        "model.AlertResolveResponse": {
+        "model.OncallShift": {
             "type": "object",
             "properties": {
-                "alert_id": {
+                "layer_name": {
+                    "description": "source=layer",
                     "type": "string"
                 },
-                "message": {
+                "mention_in_slack": {
+                    "type": "boolean"
+                },
+                "override_id": {
+                    "type": "integer"
+                },
+                "schedule_id": {
+                    "type": "integer"
+                },
+                "schedule_name": {
                     "type": "string"
                 },
-                "status": {
+                "slack_user_id": {
+                    "type": "string"
+                },
+                "source": {
+                    "description": "override, layer, none",
+                    "type": "string"
+                },
+                "until": {
+                    "description": "override 종료 또는 레이어의 다음 교대 시각",
+                    "type": "string"
+                },
+                "user": {
+                    "description": "비어 있으면 on-call 없음",
                     "type": "string"
                 }
             }
         },
-        "model.AlertUpdateResponse": {
+        "model.OncallUser": {
             "type": "object",
             "properties": {
-                "alert_id": {
+                "display_name": {
                     "type": "string"
                 },
-                "message": {
+                "login_id": {
                     "type": "string"
                 },
-                "status": {
+                "slack_user_id": {
                     "type": "string"
                 }
             }
         },
-        "model.AlertWebhookResponse": {
+        "model.OncallUserListResponse": {
             "type": "object",
             "properties": {
-                "alertCount": {
-                    "type": "integer"
-                },
-                "slackFailed": {
-                    "type": "integer"
-                },
-                "slackSent": {
-                    "type": "integer"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.OncallUser"
+                    }
                 },
                 "status": {
                     "type": "string"
                 }
             }
         },
-        "model.AlertmanagerWebhook": {
+        "model.OncallUserSlackRequest": {
             "type": "object",
             "properties": {
-                "alerts": {
-                    "description": "개별 알림 리스트",
+                "slack_user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.PagerDutyEvent": {
+            "type": "object",
+            "properties": {
+                "client": {
+                    "type": "string"
+                },
+                "client_url": {
+                    "type": "string"
+                },
+                "dedup_key": {
+                    "type": "string"
+                },
+                "event_action": {
+                    "type": "string"
+                },
+                "links": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.Alert"
+                        "$ref": "#/definitions/model.PagerDutyLink"
                     }
                 },
-                "commonAnnotations": {
-                    "description": "그룹 내 모든 알림에 공통으로 존재하는 어노테이션",
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
+                "payload": {
+                    "$ref": "#/definitions/model.PagerDutyPayload"
                 },
-                "commonLabels": {
-                    "description": "그룹 내 모든 알림에 공통으로 존재하는 라벨",
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
+                "routing_key": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.PagerDutyLink": {
+            "type": "object",
+            "properties": {
+                "href": {
+                    "type": "string"
                 },
-                "externalURL": {
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.PagerDutyPayload": {
+            "type": "object",
+            "properties": {
+                "class": {
                     "type": "string"
                 },
-                "groupKey": {
-                    "description": "동일한 GroupKey를 가진 알림들은 함께 그룹핑됨",
+                "component": {
                     "type": "string"
                 },
-                "groupLabels": {
-                    "description": "route.group_by 설정에 따라 결정되는 그룹핑에 사용된 라벨",
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
+                "custom_details": {
+                    "type": "object"
                 },
-                "receiver": {
+                "group": {
                     "type": "string"
                 },
-                "status": {
+                "severity": {
                     "type": "string"
                 },
-                "truncatedAlerts": {
-                    "description": "max_alerts 설정으로 인해 생략된 알림이 있을 경우 그 개수",
-                    "type": "integer"
+                "source": {
+                    "type": "string"
                 },
-                "version": {
+                "summary": {
+                    "type": "string"
+                },
+                "timestamp": {
                     "type": "string"
                 }
             }
         },
-        "model.AppSetting": {
+        "model.PingResponse": {
             "type": "object",
             "properties": {
-                "key": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
-                },
-                "value": {
+                "message": {
                     "type": "string"
                 }
             }
         },
-        "model.AppSettingListResponse": {
+        "model.ResolveIncidentRequest": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.AppSetting"
-                    }
-                },
-                "status": {
+                "resolved_by": {
                     "type": "string"
                 }
             }
         },
-        "model.AppSettingResponse": {
+        "model.RootResponse": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/model.AppSetting"
+                "message": {
+                    "type": "string"
                 },
                 "status": {
                     "type": "string"
                 }
             }
         },
-        "model.AuthConfigResponse": {
+        "model.ScheduledJob": {
             "type": "object",
             "properties": {
-                "allowSignup": {
-                    "type": "boolean"
+                "attempts": {
+                    "type": "integer"
                 },
-                "oidcEnabled": {
-                    "type": "boolean"
+                "completed_at": {
+                    "type": "string"
                 },
-                "oidcLoginUrl": {
+                "created_at": {
                     "type": "string"
                 },
-                "oidcProvider": {
+                "id": {
+                    "type": "integer"
+                },
+                "job_key": {
+                    "description": "같은 종류의 pending 작업은 key당 하나 (예: fingerprint)",
+                    "type": "string"
+                },
+                "job_type": {
+                    "description": "flapping_clearance 등",
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "run_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "pending, running, done, failed, cancelled",
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "model.AuthLogoutResponse": {
+        "model.ScheduledJobListResponse": {
             "type": "object",
             "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ScheduledJob"
+                    }
+                },
                 "status": {
                     "type": "string"
                 }
             }
         },
-        "model.AuthMeResponse": {
+        "model.Service": {
             "type": "object",
             "properties": {
-                "authProvider": {
+                "created_at": {
                     "type": "string"
                 },
-                "displayName": {
+                "created_by": {
                     "type": "string"
                 },
-                "email": {
+                "description": {
                     "type": "string"
                 },
-                "loginId": {
+                "escalation_contact": {
+                    "description": "에스컬레이션 연락처 (사람/그룹/전화번호 등)",
                     "type": "string"
                 },
-                "userId": {
-                    "type": "integer"
-                }
-            }
-        },
-        "model.AuthRequest": {
-            "type": "object",
-            "properties": {
                 "id": {
+                    "type": "integer"
+                },
+                "name": {
                     "type": "string"
                 },
-                "password": {
+                "namespaces": {
+                    "description": "서비스가 배포된 namespace (비어 있으면 namespace 무관)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "selectors": {
+                    "description": "라벨 셀렉터 (비어 있으면 셀렉터 무관)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelMatcher"
+                    }
+                },
+                "slack_channel": {
+                    "description": "팀 Slack 채널 ID (use_service_channel 웹훅 설정에서 사용)",
+                    "type": "string"
+                },
+                "team": {
+                    "description": "소유 팀",
+                    "type": "string"
+                },
+                "tier": {
+                    "description": "서비스 등급 (예: tier-1)",
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "model.AuthResponse": {
+        "model.ServiceIncidentListResponse": {
             "type": "object",
             "properties": {
-                "accessToken": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.IncidentListResponse"
+                    }
                 },
-                "expiresIn": {
-                    "type": "integer"
+                "status": {
+                    "type": "string"
                 }
             }
         },
-        "model.BulkResolveAlertsRequest": {
+        "model.ServiceListResponse": {
             "type": "object",
-            "required": [
-                "alert_ids"
-            ],
             "properties": {
-                "alert_ids": {
+                "data": {
                     "type": "array",
-                    "maxItems": 50,
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/model.Service"
                     }
+                },
+                "status": {
+                    "type": "string"
                 }
             }
         },
-        "model.BulkResolveAlertsResponse": {
+        "model.ServiceMetrics": {
             "type": "object",
             "properties": {
-                "failed": {
-                    "type": "integer"
+                "mttr_minutes": {
+                    "description": "기간 내 해결된 Incident 평균 해결 시간 (점검 시간대 Incident 제외)",
+                    "type": "number"
                 },
-                "message": {
+                "name": {
                     "type": "string"
                 },
-                "resolved": {
+                "open_incidents": {
+                    "description": "현재 firing Incident 수 (기간 무관)",
                     "type": "integer"
                 },
-                "status": {
-                    "type": "string"
-                }
-            }
-        },
-        "model.EmbeddingRequest": {
-            "type": "object",
-            "properties": {
-                "incident_id": {
+                "resolved_incidents": {
+                    "description": "기간 내 발생해 해결된 Incident 수",
+                    "type": "integer"
+                },
+                "service_id": {
+                    "type": "integer"
+                },
+                "team": {
                     "type": "string"
                 },
-                "incident_summary": {
+                "tier": {
                     "type": "string"
+                },
+                "total_incidents": {
+                    "description": "기간 내 발생한 Incident 수",
+                    "type": "integer"
                 }
             }
         },
-        "model.EmbeddingResponse": {
+        "model.ServiceMetricsListResponse": {
             "type": "object",
             "properties": {
-                "embedding_id": {
-                    "type": "integer"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ServiceMetrics"
+                    }
                 },
-                "model": {
+                "status": {
                     "type": "string"
                 },
-                "status": {
+                "window": {
                     "type": "string"
                 }
             }
         },
-        "model.ErrorResponse": {
+        "model.ServiceMutationResponse": {
             "type": "object",
             "properties": {
-                "error": {
+                "id": {
+                    "type": "integer"
+                },
+                "message": {
                     "type": "string"
-                }
-            }
-        },
-        "model.IncidentDetailEnvelope": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "$ref": "#/definitions/model.IncidentDetailResponse"
                 },
                 "status": {
                     "type": "string"
                 }
             }
         },
-        "model.IncidentDetailResponse": {
+        "model.ServiceRequest": {
             "type": "object",
             "properties": {
-                "alerts": {
-                    "description": "연결된 Alert 목록 (상세 조회 시 포함)",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.AlertListResponse"
-                    }
-                },
-                "analysis_detail": {
+                "description": {
                     "type": "string"
                 },
-                "analysis_summary": {
-                    "type": "string"
-                },
-                "created_by": {
+                "escalation_contact": {
                     "type": "string"
                 },
-                "fired_at": {
+                "name": {
                     "type": "string"
                 },
-                "incident_id": {
-                    "type": "string"
+                "namespaces": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "is_analyzing": {
-                    "type": "boolean"
+                "selectors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelMatcher"
+                    }
                 },
-                "resolved_at": {
+                "slack_channel": {
                     "type": "string"
                 },
-                "resolved_by": {
+                "team": {
                     "type": "string"
                 },
-                "severity": {
+                "tier": {
                     "type": "string"
-                },
-                "similar_incidents": {
-                    "description": "DB의 JSONB 컬럼을 그대로 바이트로 받아서 전달",
-                    "type": "object"
+                }
+            }
+        },
+        "model.ServiceResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.Service"
                 },
                 "status": {
-                    "description": "firing, resolved",
-                    "type": "string"
-                },
-                "title": {
                     "type": "string"
                 }
             }
         },
-        "model.IncidentListResponse": {
+        "model.SilenceRule": {
             "type": "object",
             "properties": {
-                "alert_count": {
-                    "description": "연결된 Alert 개수",
-                    "type": "integer"
+                "comment": {
+                    "type": "string"
                 },
-                "fired_at": {
+                "created_at": {
                     "type": "string"
                 },
-                "incident_id": {
+                "created_by": {
                     "type": "string"
                 },
-                "resolved_at": {
+                "ends_at": {
                     "type": "string"
                 },
-                "severity": {
+                "id": {
+                    "type": "integer"
+                },
+                "matchers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelMatcher"
+                    }
+                },
+                "starts_at": {
                     "type": "string"
                 },
                 "status": {
-                    "description": "firing, resolved",
                     "type": "string"
                 },
-                "title": {
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "model.IncidentUpdateResponse": {
+        "model.SilenceRuleListResponse": {
             "type": "object",
             "properties": {
-                "incident_id": {
-                    "type": "string"
-                },
-                "message": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.SilenceRule"
+                    }
                 },
                 "status": {
                     "type": "string"
                 }
             }
         },
-        "model.MockIncidentResponse": {
+        "model.SilenceRuleMutationResponse": {
             "type": "object",
             "properties": {
-                "incident_id": {
-                    "type": "string"
+                "id": {
+                    "type": "integer"
                 },
                 "message": {
                     "type": "string"
@@ -2091,27 +7789,31 @@ const docTemplate = `{
                 }
             }
         },
-        "model.PingResponse": {
-            "type": "object",
-            "properties": {
-                "message": {
-                    "type": "string"
-                }
-            }
-        },
-        "model.ResolveIncidentRequest": {
+        "model.SilenceRuleRequest": {
             "type": "object",
             "properties": {
-                "resolved_by": {
+                "comment": {
+                    "type": "string"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "matchers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelMatcher"
+                    }
+                },
+                "starts_at": {
                     "type": "string"
                 }
             }
         },
-        "model.RootResponse": {
+        "model.SilenceRuleResponse": {
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/model.SilenceRule"
                 },
                 "status": {
                     "type": "string"
@@ -2193,25 +7895,146 @@ const docTemplate = `{
                 }
             }
         },
-        "model.WebhookConfig": {
+        "model.WebhookAcceptedResponse": {
             "type": "object",
             "properties": {
-                "channel": {
-                    "type": "string"
+                "alertCount": {
+                    "type": "integer"
                 },
-                "id": {
+                "inboxId": {
                     "type": "integer"
                 },
+                "status": {
+                    "description": "accepted, ignored (처리할 alert가 없어 inbox에 저장하지 않음)",
+                    "type": "string"
+                }
+            }
+        },
+        "model.WebhookAuthCredentialInfo": {
+            "type": "object",
+            "properties": {
                 "name": {
                     "type": "string"
                 },
-                "severities": {
-                    "description": "빈 배열 = 모든 severity 수신",
+                "type": {
+                    "description": "bearer, basic, hmac",
+                    "type": "string"
+                }
+            }
+        },
+        "model.WebhookAuthStatus": {
+            "type": "object",
+            "properties": {
+                "credentials": {
                     "type": "array",
                     "items": {
+                        "$ref": "#/definitions/model.WebhookAuthCredentialInfo"
+                    }
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "last_rejected_at": {
+                    "type": "string"
+                },
+                "rejected_by_reason": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer",
+                        "format": "int64"
+                    }
+                },
+                "rejected_total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.WebhookAuthStatusResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.WebhookAuthStatus"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.WebhookConfig": {
+            "type": "object",
+            "properties": {
+                "basic_auth_username": {
+                    "description": "token(bearer)과 함께 사용할 수 없음",
+                    "type": "string"
+                },
+                "body_template": {
+                    "description": "Slack: alert 메시지 본문 (기본 필드/설명 대체)",
+                    "type": "string"
+                },
+                "channel": {
+                    "type": "string"
+                },
+                "continue": {
+                    "description": "매칭 후에도 다음 규칙 계속 평가",
+                    "type": "boolean"
+                },
+                "custom_headers": {
+                    "description": "추가 요청 헤더 (X-KubeRCA-* 등 예약 헤더 제외)",
+                    "type": "object",
+                    "additionalProperties": {
                         "type": "string"
                     }
                 },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "has_basic_auth_password": {
+                    "type": "boolean"
+                },
+                "has_signing_secret": {
+                    "description": "secret 필드는 응답에 포함하지 않고 설정 여부만 노출",
+                    "type": "boolean"
+                },
+                "has_tls_client_key": {
+                    "type": "boolean"
+                },
+                "http_method": {
+                    "description": "HTTP/Teams 전송 옵션",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "matchers": {
+                    "description": "모든 매처가 alert 라벨과 일치해야 수신 (빈 배열 = catch-all)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelMatcher"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "payload_template": {
+                    "description": "HTTP/Teams: 요청 본문 (기본 JSON envelope 대체)",
+                    "type": "string"
+                },
+                "priority": {
+                    "description": "평가 순서 (오름차순, 같으면 ID순)",
+                    "type": "integer"
+                },
+                "title_template": {
+                    "description": "사용자 정의 메시지 템플릿 (Go text/template, 빈 문자열 = 기본 포맷)",
+                    "type": "string"
+                },
+                "tls_ca_cert": {
+                    "description": "서버 인증서 검증용 CA (PEM, 빈 값 = 시스템 CA)",
+                    "type": "string"
+                },
+                "tls_client_cert": {
+                    "description": "mTLS 클라이언트 인증서 (PEM)",
+                    "type": "string"
+                },
                 "token": {
                     "type": "string"
                 },
@@ -2223,6 +8046,10 @@ const docTemplate = `{
                 },
                 "url": {
                     "type": "string"
+                },
+                "use_service_channel": {
+                    "description": "Slack 전용: alert가 서비스 카탈로그에 매칭되고 서비스에 Slack 채널이 있으면 root 메시지를 그 채널로 전송 (없으면 Channel)",
+                    "type": "boolean"
                 }
             }
         },
@@ -2260,19 +8087,80 @@ const docTemplate = `{
                 "name"
             ],
             "properties": {
+                "basic_auth_password": {
+                    "type": "string"
+                },
+                "basic_auth_username": {
+                    "type": "string"
+                },
+                "body_template": {
+                    "type": "string"
+                },
                 "channel": {
                     "type": "string"
                 },
+                "clear_secrets": {
+                    "description": "수정 시 삭제할 secret 필드 이름 (signing_secret, basic_auth_password, tls_client_key)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "continue": {
+                    "type": "boolean"
+                },
+                "custom_headers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "enabled": {
+                    "description": "생략 시 true",
+                    "type": "boolean"
+                },
+                "http_method": {
+                    "description": "HTTP/Teams 전송 옵션 (http_method 생략 시 POST)\nsigning_secret, basic_auth_password, tls_client_key는 수정 시 빈 값이면 저장된 값을 유지",
+                    "type": "string"
+                },
+                "matchers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelMatcher"
+                    }
+                },
                 "name": {
                     "type": "string"
                 },
+                "payload_template": {
+                    "type": "string"
+                },
+                "priority": {
+                    "description": "생략 시 100",
+                    "type": "integer"
+                },
                 "severities": {
-                    "description": "빈 배열 = 모든 severity 수신",
+                    "description": "Deprecated: matchers의 severity 매처로 변환된다 (severity=~\"critical|warning\")",
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
+                "signing_secret": {
+                    "type": "string"
+                },
+                "title_template": {
+                    "type": "string"
+                },
+                "tls_ca_cert": {
+                    "type": "string"
+                },
+                "tls_client_cert": {
+                    "type": "string"
+                },
+                "tls_client_key": {
+                    "type": "string"
+                },
                 "token": {
                     "type": "string"
                 },
@@ -2281,6 +8169,10 @@ const docTemplate = `{
                 },
                 "url": {
                     "type": "string"
+                },
+                "use_service_channel": {
+                    "description": "Slack 전용: 서비스 카탈로그의 팀 Slack 채널로 root 메시지 전송",
+                    "type": "boolean"
                 }
             }
         },
@@ -2294,6 +8186,152 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "model.WebhookInboxEntry": {
+            "type": "object",
+            "properties": {
+                "alert_count": {
+                    "type": "integer"
+                },
+                "attempts": {
+                    "type": "integer"
+                },
+                "credential": {
+                    "description": "인증에 사용된 credential 이름",
+                    "type": "string"
+                },
+                "group_key": {
+                    "description": "처리 순서 보장 단위 (수신 경로 + groupKey)",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "notifications_failed": {
+                    "type": "integer"
+                },
+                "notifications_sent": {
+                    "type": "integer"
+                },
+                "payload": {
+                    "description": "상세 조회 시에만 포함",
+                    "type": "object"
+                },
+                "processed_at": {
+                    "type": "string"
+                },
+                "received_at": {
+                    "type": "string"
+                },
+                "source": {
+                    "description": "alertmanager 등 수신 엔드포인트",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "pending, processing, done, dead",
+                    "type": "string"
+                },
+                "truncated_alerts": {
+                    "description": "max_alerts로 생략된 alert 수",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.WebhookInboxEntryResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.WebhookInboxEntry"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.WebhookInboxListResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.WebhookInboxEntry"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.WebhookInboxReplayResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.WebhookTemplatePreview": {
+            "type": "object",
+            "properties": {
+                "alert_id": {
+                    "type": "string"
+                },
+                "body": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.WebhookTemplatePreviewRequest": {
+            "type": "object",
+            "required": [
+                "alert_id"
+            ],
+            "properties": {
+                "alert_id": {
+                    "type": "string"
+                },
+                "body_template": {
+                    "type": "string"
+                },
+                "payload_template": {
+                    "type": "string"
+                },
+                "title_template": {
+                    "type": "string"
+                },
+                "webhook_config_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.WebhookTemplatePreviewResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/model.WebhookTemplatePreview"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
         }
     },
     "securityDefinitions": {
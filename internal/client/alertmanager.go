@@ -0,0 +1,133 @@
+// Alertmanager v2 API 클라이언트 정의
+//
+// 환경변수:
+//   - ALERTMANAGER_URL: Alertmanager URL (예: http://alertmanager-operated.monitoring.svc:9093)
+//   - ALERTMANAGER_RECEIVER: kube-rca로 라우팅되는 receiver 이름 (정규식, 비우면 동기화 비활성)
+//
+// 웹훅으로 전달되지 않은 alert(max_alerts truncation, backend 다운타임)를 복구하기 위해 현재 상태를 조회한다.
+// silence 생성/만료는 alert를 보낸 Alertmanager(externalURL 기준)로 호출하므로 baseURL을 인자로 받는다.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// AlertmanagerClient 구조체 정의
+type AlertmanagerClient struct {
+	baseURL    string
+	receiver   string
+	httpClient *http.Client
+}
+
+// NewAlertmanagerClient 객체 생성
+func NewAlertmanagerClient(cfg config.AlertmanagerConfig) *AlertmanagerClient {
+	timeout := time.Duration(cfg.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &AlertmanagerClient{
+		baseURL:  strings.TrimRight(cfg.URL, "/"),
+		receiver: cfg.Receiver,
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// IsConfigured - Alertmanager URL 설정 여부
+func (c *AlertmanagerClient) IsConfigured() bool {
+	return c.baseURL != ""
+}
+
+// ListAlerts - GET /api/v2/alerts
+// includeSuppressed=false면 웹훅으로 전달되는 alert(silence/inhibit되지 않고 라우팅 완료된 active alert)만 조회
+func (c *AlertmanagerClient) ListAlerts(ctx context.Context, includeSuppressed bool) ([]model.AlertmanagerAPIAlert, error) {
+	suppressed := strconv.FormatBool(includeSuppressed)
+	query := url.Values{}
+	query.Set("active", "true")
+	query.Set("silenced", suppressed)
+	query.Set("inhibited", suppressed)
+	query.Set("unprocessed", suppressed)
+	if c.receiver != "" {
+		query.Set("receiver", c.receiver)
+	}
+
+	var alerts []model.AlertmanagerAPIAlert
+	if err := c.doJSON(ctx, http.MethodGet, c.baseURL, "/api/v2/alerts?"+query.Encode(), nil, &alerts); err != nil {
+		return nil, err
+	}
+	return alerts, nil
+}
+
+// CreateSilence - POST /api/v2/silences (생성된 silence ID 반환)
+func (c *AlertmanagerClient) CreateSilence(ctx context.Context, baseURL string, silence model.AlertmanagerAPISilence) (string, error) {
+	var resp struct {
+		SilenceID string `json:"silenceID"`
+	}
+	if err := c.doJSON(ctx, http.MethodPost, baseURL, "/api/v2/silences", silence, &resp); err != nil {
+		return "", err
+	}
+	if resp.SilenceID == "" {
+		return "", fmt.Errorf("alertmanager returned empty silence id")
+	}
+	return resp.SilenceID, nil
+}
+
+// ExpireSilence - DELETE /api/v2/silence/{id} (silence 조기 만료)
+func (c *AlertmanagerClient) ExpireSilence(ctx context.Context, baseURL, silenceID string) error {
+	return c.doJSON(ctx, http.MethodDelete, baseURL, "/api/v2/silence/"+url.PathEscape(silenceID), nil, nil)
+}
+
+func (c *AlertmanagerClient) doJSON(ctx context.Context, method, baseURL, path string, body, out any) error {
+	baseURL = strings.TrimRight(baseURL, "/")
+	if baseURL == "" {
+		return fmt.Errorf("alertmanager url is not configured")
+	}
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to marshal alertmanager request: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, baseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("failed to create alertmanager request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call alertmanager: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("alertmanager returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode alertmanager response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+func TestAlertmanagerClient_ListAlerts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/alerts" {
+			t.Errorf("path = %s; want /api/v2/alerts", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("receiver") != "kube-rca" || q.Get("silenced") != "false" || q.Get("inhibited") != "false" || q.Get("unprocessed") != "false" {
+			t.Errorf("query = %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"fingerprint":"abc","labels":{"alertname":"X"},"startsAt":"2026-05-01T10:00:00Z","status":{"state":"suppressed","silencedBy":["s1"]},"receivers":[{"name":"kube-rca"}]}]`))
+	}))
+	defer srv.Close()
+
+	c := NewAlertmanagerClient(config.AlertmanagerConfig{URL: srv.URL + "/", Receiver: "kube-rca"})
+	alerts, err := c.ListAlerts(context.Background(), false)
+	if err != nil {
+		t.Fatalf("ListAlerts() error = %v", err)
+	}
+	if len(alerts) != 1 || alerts[0].Fingerprint != "abc" || alerts[0].Status.State != "suppressed" || alerts[0].Labels["alertname"] != "X" {
+		t.Fatalf("alerts = %+v", alerts)
+	}
+}
+
+func TestAlertmanagerClient_ErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "boom", http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	c := NewAlertmanagerClient(config.AlertmanagerConfig{URL: srv.URL})
+	if _, err := c.ListAlerts(context.Background(), false); err == nil {
+		t.Fatal("ListAlerts() error = nil; want error for 502")
+	}
+}
+
+func TestAlertmanagerClient_CreateAndExpireSilence(t *testing.T) {
+	var deleted string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/silences":
+			var body model.AlertmanagerAPISilence
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil || len(body.Matchers) != 1 || body.Matchers[0].Name != "alertname" || !body.Matchers[0].IsEqual {
+				t.Errorf("silence body = %+v, err = %v", body, err)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"silenceID":"sil-1"}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v2/silence/sil-1":
+			deleted = "sil-1"
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	// 설정된 URL과 무관하게 전달된 baseURL로 호출
+	c := NewAlertmanagerClient(config.AlertmanagerConfig{})
+	id, err := c.CreateSilence(context.Background(), srv.URL+"/", model.AlertmanagerAPISilence{
+		Matchers: []model.AlertmanagerAPIMatcher{{Name: "alertname", Value: "X", IsEqual: true}},
+	})
+	if err != nil || id != "sil-1" {
+		t.Fatalf("CreateSilence() = %q, %v; want sil-1", id, err)
+	}
+	if err := c.ExpireSilence(context.Background(), srv.URL, id); err != nil {
+		t.Fatalf("ExpireSilence() error = %v", err)
+	}
+	if deleted != "sil-1" {
+		t.Fatalf("deleted = %q; want sil-1", deleted)
+	}
+}
@@ -0,0 +1,192 @@
+// 웹훅 설정별 사용자 정의 메시지 템플릿 (Go text/template)
+//
+// 처리 흐름:
+//  1. 웹훅 설정 저장 시 ValidateMessageTemplate으로 문법 검증
+//  2. 전송 시 이벤트 → MessageTemplateData 변환 후 RenderMessageTemplate으로 렌더링
+//     - Slack: title/body 템플릿이 attachment 제목/본문을 대체 (렌더링 실패 시 기본 포맷)
+//     - HTTP/Teams: payload 템플릿이 기본 JSON envelope를 대체 (렌더링 실패 시 전송 실패)
+//  3. 미리보기 API도 같은 함수로 저장된 alert를 렌더링
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// maxRenderedTemplateBytes - 렌더링 결과 최대 크기 (잘못된 range 등으로 인한 거대 메시지 방지)
+const maxRenderedTemplateBytes = 64 * 1024
+
+// ErrInvalidMessageTemplate - 템플릿 파싱/렌더링 실패
+var ErrInvalidMessageTemplate = errors.New("invalid message template")
+
+// MessageTemplateData - 템플릿에서 사용할 수 있는 데이터
+type MessageTemplateData struct {
+	EventType   string            // alert.status_changed 등
+	Status      string            // firing, resolved
+	Alert       model.Alert       // 원본 alert (alert가 없는 이벤트는 빈 값)
+	Labels      map[string]string // Alert.Labels (nil이면 빈 map)
+	Annotations map[string]string // Alert.Annotations (nil이면 빈 map)
+	AlertID     string
+	IncidentID  string
+	IsManual    bool          // 수동 resolve 여부
+	FrontendURL string        // 프론트엔드 기본 URL (끝의 / 제거)
+	Event       NotifierEvent // 원본 이벤트 (payload 템플릿에서 toJSON으로 사용)
+}
+
+// NewMessageTemplateData - 이벤트에서 템플릿 데이터 생성
+func NewMessageTemplateData(event NotifierEvent, frontendURL string) MessageTemplateData {
+	data := MessageTemplateData{
+		EventType:   event.EventType(),
+		FrontendURL: strings.TrimRight(frontendURL, "/"),
+		Event:       event,
+	}
+	switch e := event.(type) {
+	case AlertStatusChangedEvent:
+		data.Alert, data.IncidentID, data.IsManual = e.Alert, e.IncidentID, e.IsManual
+	case *AlertStatusChangedEvent:
+		data.Alert, data.IncidentID, data.IsManual = e.Alert, e.IncidentID, e.IsManual
+	case FlappingDetectedEvent:
+		data.Alert, data.IncidentID = e.Alert, e.IncidentID
+	case *FlappingDetectedEvent:
+		data.Alert, data.IncidentID = e.Alert, e.IncidentID
+	case AlertReminderEvent:
+		data.Alert, data.AlertID, data.IncidentID = e.Alert, e.AlertID, e.IncidentID
+	case *AlertReminderEvent:
+		data.Alert, data.AlertID, data.IncidentID = e.Alert, e.AlertID, e.IncidentID
+	case AlertAcknowledgedEvent:
+		data.Alert, data.AlertID, data.IncidentID = e.Alert, e.AlertID, e.IncidentID
+	case *AlertAcknowledgedEvent:
+		data.Alert, data.AlertID, data.IncidentID = e.Alert, e.AlertID, e.IncidentID
+	case IncidentEscalatedEvent:
+		data.IncidentID = e.IncidentID
+	case *IncidentEscalatedEvent:
+		data.IncidentID = e.IncidentID
+	}
+	data.Status = data.Alert.Status
+	data.Labels = data.Alert.Labels
+	if data.Labels == nil {
+		data.Labels = map[string]string{}
+	}
+	data.Annotations = data.Alert.Annotations
+	if data.Annotations == nil {
+		data.Annotations = map[string]string{}
+	}
+	return data
+}
+
+// messageTemplateFuncs - 템플릿 헬퍼 함수
+//   - label "namespace" / annotation "description": 라벨/annotation 조회 (없으면 빈 문자열)
+//   - default "-" .Labels.pod: 값이 비어 있으면 기본값
+//   - formatTime "2006-01-02 15:04" .Alert.StartsAt, inZone "Asia/Seoul" .Alert.StartsAt: 시각 포맷
+//   - since .Alert.StartsAt: 현재까지 경과 시간 (초 단위 반올림)
+//   - incidentURL, link "/alerts": 프론트엔드 링크 (FrontendURL이 없으면 빈 문자열)
+//   - toJSON .Labels: JSON 인코딩 (payload 템플릿용)
+//   - upper, lower, join, trim
+func messageTemplateFuncs(data MessageTemplateData) template.FuncMap {
+	return template.FuncMap{
+		"label":      func(name string) string { return data.Labels[name] },
+		"annotation": func(name string) string { return data.Annotations[name] },
+		"default": func(fallback string, value interface{}) string {
+			if s := fmt.Sprint(value); value != nil && s != "" {
+				return s
+			}
+			return fallback
+		},
+		"formatTime": func(layout string, t time.Time) string {
+			if t.IsZero() {
+				return ""
+			}
+			if layout == "RFC3339" {
+				layout = time.RFC3339
+			}
+			return t.Format(layout)
+		},
+		"inZone": func(zone string, t time.Time) (time.Time, error) {
+			loc, err := time.LoadLocation(zone)
+			if err != nil {
+				return t, err
+			}
+			return t.In(loc), nil
+		},
+		"since": func(t time.Time) string {
+			if t.IsZero() {
+				return ""
+			}
+			return time.Since(t).Round(time.Second).String()
+		},
+		"incidentURL": func() string {
+			if data.FrontendURL == "" || data.IncidentID == "" {
+				return ""
+			}
+			return data.FrontendURL + "/incidents/" + data.IncidentID
+		},
+		"link": func(path string) string {
+			if data.FrontendURL == "" {
+				return ""
+			}
+			return data.FrontendURL + "/" + strings.TrimLeft(path, "/")
+		},
+		"toJSON": func(v interface{}) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+		"join":  strings.Join,
+		"trim":  strings.TrimSpace,
+	}
+}
+
+// ValidateMessageTemplate - 템플릿 문법 검증 (빈 문자열은 기본 포맷 사용으로 유효)
+func ValidateMessageTemplate(text string) error {
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+	_, err := parseMessageTemplate(text, MessageTemplateData{})
+	return err
+}
+
+// RenderMessageTemplate - 템플릿 렌더링
+func RenderMessageTemplate(text string, data MessageTemplateData) (string, error) {
+	tmpl, err := parseMessageTemplate(text, data)
+	if err != nil {
+		return "", err
+	}
+	out := &limitedBuffer{limit: maxRenderedTemplateBytes}
+	if err := tmpl.Execute(out, data); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidMessageTemplate, err)
+	}
+	return out.String(), nil
+}
+
+func parseMessageTemplate(text string, data MessageTemplateData) (*template.Template, error) {
+	tmpl, err := template.New("message").
+		Option("missingkey=zero").
+		Funcs(messageTemplateFuncs(data)).
+		Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMessageTemplate, err)
+	}
+	return tmpl, nil
+}
+
+// limitedBuffer - limit를 넘으면 쓰기를 거부하는 버퍼
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > b.limit {
+		return 0, fmt.Errorf("rendered message exceeds %d bytes", b.limit)
+	}
+	return b.Buffer.Write(p)
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+func TestRenderMessageTemplate(t *testing.T) {
+	if _, err := time.LoadLocation("Asia/Seoul"); err != nil {
+		t.Skipf("tzdata not available: %v", err)
+	}
+	data := NewMessageTemplateData(AlertStatusChangedEvent{
+		Alert: model.Alert{
+			Status:      "firing",
+			Labels:      map[string]string{"alertname": "KubeJobFailed", "namespace": "batch"},
+			Annotations: map[string]string{"runbook_url": "https://runbooks/job"},
+			StartsAt:    time.Date(2026, 5, 1, 0, 30, 0, 0, time.UTC),
+		},
+		IncidentID: "INC-7",
+	}, "https://rca.example.com/")
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "label lookup", text: `{{ label "alertname" }}/{{ .Labels.namespace }}`, want: "KubeJobFailed/batch"},
+		{name: "missing label is empty", text: `[{{ label "pod" }}][{{ .Labels.pod }}]`, want: "[][]"},
+		{name: "default", text: `{{ default "n/a" .Labels.pod }}`, want: "n/a"},
+		{name: "time in zone", text: `{{ formatTime "2006-01-02 15:04 MST" (inZone "Asia/Seoul" .Alert.StartsAt) }}`, want: "2026-05-01 09:30 KST"},
+		{name: "rfc3339", text: `{{ formatTime "RFC3339" .Alert.StartsAt }}`, want: "2026-05-01T00:30:00Z"},
+		{name: "links", text: `{{ incidentURL }} {{ link "/alerts" }}`, want: "https://rca.example.com/incidents/INC-7 https://rca.example.com/alerts"},
+		{name: "json", text: `{{ toJSON .Labels }}`, want: `{"alertname":"KubeJobFailed","namespace":"batch"}`},
+		{name: "event type", text: `{{ .EventType }} {{ upper .Status }}`, want: "alert.status_changed FIRING"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RenderMessageTemplate(tt.text, data)
+			if err != nil {
+				t.Fatalf("RenderMessageTemplate() error = %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("RenderMessageTemplate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderMessageTemplate_Errors(t *testing.T) {
+	data := NewMessageTemplateData(FlappingClearedEvent{Fingerprint: "fp"}, "")
+
+	if err := ValidateMessageTemplate("{{ if }}"); !errors.Is(err, ErrInvalidMessageTemplate) {
+		t.Fatalf("ValidateMessageTemplate() error = %v, want ErrInvalidMessageTemplate", err)
+	}
+	if err := ValidateMessageTemplate("  "); err != nil {
+		t.Fatalf("blank template should be valid, got %v", err)
+	}
+	if _, err := RenderMessageTemplate(`{{ incidentURL }}{{ .Missing }}`, data); !errors.Is(err, ErrInvalidMessageTemplate) {
+		t.Fatalf("unknown field error = %v, want ErrInvalidMessageTemplate", err)
+	}
+	big := `{{ define "x" }}` + strings.Repeat("a", 1024) + `{{ end }}` + strings.Repeat(`{{ template "x" }}`, 100)
+	if _, err := RenderMessageTemplate(big, data); !errors.Is(err, ErrInvalidMessageTemplate) {
+		t.Fatalf("oversized output error = %v, want ErrInvalidMessageTemplate", err)
+	}
+}
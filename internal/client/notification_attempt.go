@@ -0,0 +1,148 @@
+// 알림 전송 기록 (대상별 전송 시도 1회 = 1행)
+//
+// 처리 흐름:
+//  1. webhookRoutingNotifier의 모든 대상 전송(일반/root/thread/에스컬레이션/outbox 재전송)을 attempt로 감싸 실행
+//  2. 전송 시간(latency), 응답 HTTP 상태 코드, 오류, 이벤트 내용 해시를 NotificationAttemptRecorder에 전달
+//     - 상태 코드: HTTP/Teams는 실제 응답 코드, Slack은 성공 시 200 / 실패 시 HTTPStatusError·RetryAfterError의 코드
+//     - 네트워크 오류 등 응답이 없으면 상태 코드 없음
+//  3. recorder(service.NotificationAttemptService)가 notification_attempts에 저장 → 알림/웹훅 설정별 전송 기록 API
+
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// NotificationAttemptRecorder - 대상별 전송 시도를 기록한다 (service.NotificationAttemptService)
+type NotificationAttemptRecorder interface {
+	RecordNotificationAttempt(attempt model.NotificationAttempt)
+}
+
+// HTTPStatusError - 수신 측이 실패 HTTP 상태 코드로 응답한 전송 실패
+type HTTPStatusError struct {
+	StatusCode int
+	Err        error
+}
+
+func (e *HTTPStatusError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *HTTPStatusError) Unwrap() error {
+	return e.Err
+}
+
+// ResponseStatusCode - 오류에 포함된 HTTP 응답 상태 코드 (응답이 없었으면 0)
+func ResponseStatusCode(err error) int {
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode
+	}
+	var retryErr *RetryAfterError
+	if errors.As(err, &retryErr) {
+		return retryErr.StatusCode
+	}
+	return 0
+}
+
+// slackResult - Slack Web API 전송 결과의 상태 코드 (ok 응답은 항상 HTTP 200)
+func slackResult(err error) (int, error) {
+	if err == nil {
+		return http.StatusOK, nil
+	}
+	return ResponseStatusCode(err), err
+}
+
+// notifyWithStatus - notifier로 전송하고 응답 상태 코드를 함께 반환 (알 수 없으면 0)
+func notifyWithStatus(notifier Notifier, event NotifierEvent) (int, error) {
+	switch target := notifier.(type) {
+	case *webhookEndpointNotifier:
+		return target.send(event)
+	case *SlackClient:
+		return slackResult(target.Notify(event))
+	}
+	err := notifier.Notify(event)
+	return ResponseStatusCode(err), err
+}
+
+// NotificationEventRefs - 이벤트(와 thread 전송 대상)에서 alert/incident 식별자 추출
+func NotificationEventRefs(event NotifierEvent, delivery *model.AlertNotificationDelivery) (alertID, fingerprint, incidentID string) {
+	data := NewMessageTemplateData(event, "")
+	alertID, fingerprint, incidentID = data.AlertID, data.Alert.Fingerprint, data.IncidentID
+	switch e := event.(type) {
+	case FlappingClearedEvent:
+		fingerprint = e.Fingerprint
+	case *FlappingClearedEvent:
+		fingerprint = e.Fingerprint
+	}
+	if delivery != nil {
+		if alertID == "" {
+			alertID = delivery.AlertID
+		}
+		if fingerprint == "" {
+			fingerprint = delivery.Fingerprint
+		}
+		if incidentID == "" && delivery.IncidentID != nil {
+			incidentID = *delivery.IncidentID
+		}
+	}
+	return alertID, fingerprint, incidentID
+}
+
+// eventPayloadHash - 이벤트 내용 sha256 (같은 알림의 중복 전송 식별용)
+func eventPayloadHash(event NotifierEvent) string {
+	body, err := json.Marshal(event)
+	if err != nil {
+		body = []byte(fmt.Sprintf("%+v", event))
+	}
+	sum := sha256.Sum256(append([]byte(event.EventType()+"\n"), body...))
+	return hex.EncodeToString(sum[:])
+}
+
+// SetNotificationAttemptRecorder - 전송 시도 기록 대상 설정 (nil이면 기록 안 함)
+func (n *webhookRoutingNotifier) SetNotificationAttemptRecorder(recorder NotificationAttemptRecorder) {
+	n.attempts = recorder
+}
+
+// attempt - 대상 전송 1회를 실행하고 결과를 기록한다 (outboxID 0 = outbox 미사용)
+func (n *webhookRoutingNotifier) attempt(event NotifierEvent, target OutboxTarget, outboxID int64, send func() (int, error)) error {
+	start := time.Now()
+	statusCode, err := send()
+	if n.attempts == nil {
+		return err
+	}
+
+	record := model.NotificationAttempt{
+		EventType:       event.EventType(),
+		Mode:            target.Mode,
+		WebhookConfigID: target.WebhookConfigID,
+		Channel:         target.Channel,
+		Status:          model.NotificationAttemptStatusSuccess,
+		LatencyMs:       time.Since(start).Milliseconds(),
+		PayloadHash:     eventPayloadHash(event),
+	}
+	record.AlertID, record.Fingerprint, record.IncidentID = NotificationEventRefs(event, target.Delivery)
+	if record.Channel == "" && target.Delivery != nil {
+		record.Channel = target.Delivery.ChannelID
+	}
+	if outboxID > 0 {
+		record.OutboxID = &outboxID
+	}
+	if statusCode > 0 {
+		record.StatusCode = &statusCode
+	}
+	if err != nil {
+		record.Status = model.NotificationAttemptStatusFailed
+		record.Error = err.Error()
+	}
+	n.attempts.RecordNotificationAttempt(record)
+	return err
+}
@@ -0,0 +1,172 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+type attemptRecorderStub struct {
+	attempts []model.NotificationAttempt
+}
+
+func (r *attemptRecorderStub) RecordNotificationAttempt(attempt model.NotificationAttempt) {
+	r.attempts = append(r.attempts, attempt)
+}
+
+func TestWebhookRoutingNotifier_RecordsHTTPAttempts(t *testing.T) {
+	repo := webhookConfigRepoStub{
+		configs: []model.WebhookConfig{
+			{ID: 1, Type: "http", URL: "https://ok.example.com/hook", Enabled: true, Continue: true},
+			{ID: 2, Type: "http", URL: "https://down.example.com/hook", Enabled: true},
+		},
+	}
+	fallback := &fallbackNotifierStub{}
+	n := NewWebhookRoutingNotifier(repo, fallback, fallback, "")
+	impl := n.(*webhookRoutingNotifier)
+	impl.httpClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusAccepted
+		if strings.HasPrefix(req.URL.Host, "down.") {
+			status = http.StatusInternalServerError
+		}
+		return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader("")), Header: make(http.Header)}, nil
+	})}
+	recorder := &attemptRecorderStub{}
+	impl.SetNotificationAttemptRecorder(recorder)
+
+	event := AlertStatusChangedEvent{Alert: model.Alert{Status: "firing", Fingerprint: "fp-1"}, IncidentID: "INC-1"}
+	if err := n.Notify(event); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	if len(recorder.attempts) != 2 {
+		t.Fatalf("recorded attempts = %d, want 2", len(recorder.attempts))
+	}
+
+	ok, failed := recorder.attempts[0], recorder.attempts[1]
+	if ok.Status != model.NotificationAttemptStatusSuccess || ok.StatusCode == nil || *ok.StatusCode != http.StatusAccepted {
+		t.Fatalf("unexpected success attempt: %+v", ok)
+	}
+	if ok.WebhookConfigID == nil || *ok.WebhookConfigID != 1 || ok.Fingerprint != "fp-1" || ok.IncidentID != "INC-1" {
+		t.Fatalf("unexpected success attempt target: %+v", ok)
+	}
+	if failed.Status != model.NotificationAttemptStatusFailed || failed.StatusCode == nil || *failed.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("unexpected failed attempt: %+v", failed)
+	}
+	if !strings.Contains(failed.Error, "500") {
+		t.Fatalf("failed attempt error = %q", failed.Error)
+	}
+	if ok.PayloadHash == "" || ok.PayloadHash != failed.PayloadHash {
+		t.Fatalf("payload hash = %q / %q, want equal non-empty", ok.PayloadHash, failed.PayloadHash)
+	}
+	if ok.OutboxID != nil {
+		t.Fatalf("outbox id = %v, want nil without outbox", *ok.OutboxID)
+	}
+}
+
+func TestWebhookRoutingNotifier_RecordsThreadAttemptWithOutboxID(t *testing.T) {
+	repo := webhookConfigRepoStub{
+		configs: []model.WebhookConfig{{ID: 7, Type: "slack", Token: "token-7", Channel: "C999"}},
+	}
+	fallback := &fallbackNotifierStub{}
+	n := NewWebhookRoutingNotifier(repo, fallback, fallback, "")
+	impl := n.(*webhookRoutingNotifier)
+	impl.slackClients[7] = NewSlackClient(config.SlackConfig{BotToken: "token-7", ChannelID: "C999"})
+	impl.slackClients[7].httpClient = &http.Client{Transport: roundTripFunc(func(_ *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"ok":false,"error":"channel_not_found"}`)),
+			Header:     make(http.Header),
+		}, nil
+	})}
+	recorder := &attemptRecorderStub{}
+	impl.SetNotificationAttemptRecorder(recorder)
+	impl.SetNotificationOutbox(outboxFunc(func(send func(int64) error) error { return send(42) }))
+
+	configID := 7
+	err := n.NotifyThreadEvent(AnalysisResultPostedEvent{Content: "analysis"}, []model.AlertNotificationDelivery{{
+		AlertID:         "ALR-1",
+		Fingerprint:     "fp-1",
+		NotifierType:    "slack",
+		WebhookConfigID: &configID,
+		ChannelID:       "C777",
+		ThreadTS:        "1712345678.000123",
+		IsActive:        true,
+	}})
+	if err == nil {
+		t.Fatalf("expected NotifyThreadEvent() error")
+	}
+	if len(recorder.attempts) != 1 {
+		t.Fatalf("recorded attempts = %d, want 1", len(recorder.attempts))
+	}
+	a := recorder.attempts[0]
+	if a.Mode != model.NotificationOutboxModeThread || a.AlertID != "ALR-1" || a.Channel != "C777" {
+		t.Fatalf("unexpected thread attempt: %+v", a)
+	}
+	if a.Status != model.NotificationAttemptStatusFailed || !strings.Contains(a.Error, "channel_not_found") {
+		t.Fatalf("unexpected thread attempt result: %+v", a)
+	}
+	if a.OutboxID == nil || *a.OutboxID != 42 {
+		t.Fatalf("outbox id = %v, want 42", a.OutboxID)
+	}
+}
+
+func TestWebhookRoutingNotifier_RecordsEscalationAttempt(t *testing.T) {
+	repo := webhookConfigRepoStub{
+		configs: []model.WebhookConfig{{ID: 3, Type: "http", URL: "https://example.com/hook", Enabled: true}},
+	}
+	fallback := &fallbackNotifierStub{}
+	n := NewWebhookRoutingNotifier(repo, fallback, fallback, "")
+	impl := n.(*webhookRoutingNotifier)
+	impl.httpClient = &http.Client{Transport: roundTripFunc(func(_ *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	})}
+	recorder := &attemptRecorderStub{}
+	impl.SetNotificationAttemptRecorder(recorder)
+
+	err := impl.NotifyEscalationTargets(IncidentEscalatedEvent{IncidentID: "INC-9", Level: 1}, []model.EscalationTarget{
+		{Type: model.EscalationTargetWebhook, WebhookConfigID: 3},
+	})
+	if err == nil {
+		t.Fatalf("expected escalation error")
+	}
+	if len(recorder.attempts) != 1 {
+		t.Fatalf("recorded attempts = %d, want 1", len(recorder.attempts))
+	}
+	a := recorder.attempts[0]
+	if a.Mode != model.NotificationAttemptModeEscalation || a.IncidentID != "INC-9" || a.WebhookConfigID == nil || *a.WebhookConfigID != 3 {
+		t.Fatalf("unexpected escalation attempt: %+v", a)
+	}
+	if a.StatusCode != nil {
+		t.Fatalf("status code = %d, want nil without a response", *a.StatusCode)
+	}
+}
+
+func TestResponseStatusCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		want int
+	}{
+		{err: nil, want: 0},
+		{err: errors.New("connection refused"), want: 0},
+		{err: &HTTPStatusError{StatusCode: 502, Err: errors.New("bad gateway")}, want: 502},
+		{err: fmt.Errorf("wrapped: %w", &RetryAfterError{Err: errors.New("rate limited"), StatusCode: 429}), want: 429},
+	}
+	for _, tt := range tests {
+		if got := ResponseStatusCode(tt.err); got != tt.want {
+			t.Errorf("ResponseStatusCode(%v) = %d, want %d", tt.err, got, tt.want)
+		}
+	}
+}
+
+// outboxFunc - send 실행 방식만 지정하는 NotificationOutbox
+type outboxFunc func(send func(int64) error) error
+
+func (f outboxFunc) Deliver(_ NotifierEvent, _ OutboxTarget, send func(outboxID int64) error) error {
+	return f(send)
+}
@@ -0,0 +1,387 @@
+// 알림 outbox 연동 (전송 대상별 기록 + 재전송)
+//
+// 처리 흐름:
+//  1. alert 알림은 AlertService가 PlanOutboxTargets/PlanThreadOutboxTargets로 라우팅된 대상을 미리 계산해
+//     alert 저장 트랜잭션에 대상별 entry로 함께 기록 (service/alert.go)
+//  2. 그 밖의 이벤트는 webhookRoutingNotifier가 대상(웹훅 설정, 스레드, 기본 notifier)별 전송을 NotificationOutbox.Deliver로 기록
+//     - outbox 기록에 실패한 경우에만 즉시 전송 (service/notification_outbox.go)
+//  3. 이벤트는 EncodeNotifierEvent로 직렬화 (json:"-"인 alert 라우팅 필드도 함께 저장)
+//  4. worker가 첫 전송과 재시도 모두 RedeliverOutboxEntry로 해당 대상에만 전송
+//  5. Slack rate limit(429)과 HTTP 429/503은 Retry-After를 RetryAfterError로 전달
+
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// OutboxTarget - outbox에 기록할 전송 대상
+type OutboxTarget struct {
+	Mode            string                           // model.NotificationOutboxMode*
+	WebhookConfigID *int                             // nil = 기본 notifier
+	Channel         string                           // Slack root 메시지 채널
+	Delivery        *model.AlertNotificationDelivery // thread 전송 대상 스레드
+}
+
+// NotificationOutbox - 대상별 전송을 기록하고 worker가 전송/재시도한다 (service.NotificationOutboxService)
+// Deliver는 entry가 저장되면 nil을 반환하고 전송은 worker에 맡긴다.
+// 저장에 실패하면 send(0)를 즉시 실행하여 그 결과를 반환한다.
+type NotificationOutbox interface {
+	Deliver(event NotifierEvent, target OutboxTarget, send func(outboxID int64) error) error
+}
+
+// OutboxPlanner - 이벤트를 받을 전송 대상을 전송 없이 계산하는 capability (webhookRoutingNotifier)
+// alert 저장 트랜잭션에 outbox entry를 함께 기록할 때 사용한다.
+type OutboxPlanner interface {
+	// PlanOutboxTargets - firing alert는 root 메시지, 그 밖의 이벤트는 notify 대상
+	PlanOutboxTargets(event NotifierEvent) ([]OutboxTarget, error)
+	// PlanThreadOutboxTargets - 기록된 스레드 delivery 중 답글을 보낼 수 있는 대상
+	PlanThreadOutboxTargets(event NotifierEvent, deliveries []model.AlertNotificationDelivery) []OutboxTarget
+}
+
+// OutboxDeliverer - outbox entry를 기록된 대상에만 다시 전송하는 capability
+// Slack root 메시지를 다시 보낸 경우 receipt를 반환한다.
+type OutboxDeliverer interface {
+	RedeliverOutboxEntry(entry model.NotificationOutboxEntry) (*NotificationDeliveryReceipt, error)
+}
+
+// RetryAfterError - 수신 측이 재시도 시각을 지정한 전송 실패 (Slack 429, HTTP 429/503)
+type RetryAfterError struct {
+	Err        error
+	StatusCode int
+	RetryAfter time.Duration
+}
+
+func (e *RetryAfterError) Error() string {
+	return fmt.Sprintf("%v (retry after %s)", e.Err, e.RetryAfter)
+}
+
+func (e *RetryAfterError) Unwrap() error {
+	return e.Err
+}
+
+// RetryAfter - 오류에 포함된 Retry-After 대기 시간
+func RetryAfter(err error) (time.Duration, bool) {
+	var retryErr *RetryAfterError
+	if errors.As(err, &retryErr) {
+		return retryErr.RetryAfter, true
+	}
+	return 0, false
+}
+
+// parseRetryAfter - Retry-After 헤더 (초 또는 HTTP 날짜), 없거나 잘못된 값이면 0
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+	return 0
+}
+
+// outboxAlertContext - 이벤트 JSON에 포함되지 않는 alert 라우팅 필드 (json:"-")
+type outboxAlertContext struct {
+	ServiceChannel    string `json:"service_channel,omitempty"`
+	OncallSlackUserID string `json:"oncall_slack_user_id,omitempty"`
+}
+
+// outboxEventPayload - outbox payload 형식
+type outboxEventPayload struct {
+	Event        json.RawMessage    `json:"event"`
+	AlertContext outboxAlertContext `json:"alert_context"`
+}
+
+// EncodeNotifierEvent - outbox 저장용 이벤트 직렬화
+func EncodeNotifierEvent(event NotifierEvent) (json.RawMessage, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal notifier event: %w", err)
+	}
+	payload := outboxEventPayload{Event: body}
+	if alert := eventAlert(event); alert != nil {
+		payload.AlertContext = outboxAlertContext{
+			ServiceChannel:    alert.ServiceChannel,
+			OncallSlackUserID: alert.OncallSlackUserID,
+		}
+	}
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal outbox payload: %w", err)
+	}
+	return raw, nil
+}
+
+// DecodeNotifierEvent - outbox payload에서 이벤트 복원
+func DecodeNotifierEvent(eventType string, raw json.RawMessage) (NotifierEvent, error) {
+	var payload outboxEventPayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, fmt.Errorf("failed to decode outbox payload: %w", err)
+	}
+
+	var event NotifierEvent
+	var err error
+	switch eventType {
+	case NotifierEventAlertStatusChanged:
+		event, err = decodeOutboxEvent[AlertStatusChangedEvent](payload)
+	case NotifierEventFlappingDetected:
+		event, err = decodeOutboxEvent[FlappingDetectedEvent](payload)
+	case NotifierEventFlappingCleared:
+		event, err = decodeOutboxEvent[FlappingClearedEvent](payload)
+	case NotifierEventAnalysisResultPosted:
+		event, err = decodeOutboxEvent[AnalysisResultPostedEvent](payload)
+	case NotifierEventAlertReminder:
+		event, err = decodeOutboxEvent[AlertReminderEvent](payload)
+	case NotifierEventAlertAcknowledged:
+		event, err = decodeOutboxEvent[AlertAcknowledgedEvent](payload)
+	case NotifierEventIncidentEscalated:
+		event, err = decodeOutboxEvent[IncidentEscalatedEvent](payload)
+	default:
+		return nil, fmt.Errorf("unsupported outbox event type: %s", eventType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func decodeOutboxEvent[T NotifierEvent](payload outboxEventPayload) (NotifierEvent, error) {
+	var event T
+	if err := json.Unmarshal(payload.Event, &event); err != nil {
+		return nil, fmt.Errorf("failed to decode %s event: %w", event.EventType(), err)
+	}
+	if alert := eventAlert(&event); alert != nil {
+		alert.ServiceChannel = payload.AlertContext.ServiceChannel
+		alert.OncallSlackUserID = payload.AlertContext.OncallSlackUserID
+	}
+	return event, nil
+}
+
+// eventAlert - alert를 가진 이벤트의 alert (포인터 이벤트면 수정 가능)
+func eventAlert(event any) *model.Alert {
+	switch e := event.(type) {
+	case AlertStatusChangedEvent:
+		return &e.Alert
+	case *AlertStatusChangedEvent:
+		return &e.Alert
+	case FlappingDetectedEvent:
+		return &e.Alert
+	case *FlappingDetectedEvent:
+		return &e.Alert
+	case AlertReminderEvent:
+		return &e.Alert
+	case *AlertReminderEvent:
+		return &e.Alert
+	case AlertAcknowledgedEvent:
+		return &e.Alert
+	case *AlertAcknowledgedEvent:
+		return &e.Alert
+	default:
+		return nil
+	}
+}
+
+// SetNotificationOutbox - 대상별 전송 기록/재시도 outbox 설정 (nil이면 즉시 전송만)
+func (n *webhookRoutingNotifier) SetNotificationOutbox(outbox NotificationOutbox) {
+	n.outbox = outbox
+}
+
+// deliver - outbox가 있으면 대상 전송을 기록하고 worker가 전송한다 (기록 실패 시 즉시 전송, 전송 시도는 attempt로 기록).
+func (n *webhookRoutingNotifier) deliver(event NotifierEvent, target OutboxTarget, send func() (int, error)) error {
+	if n.outbox == nil {
+		return n.attempt(event, target, 0, send)
+	}
+	return n.outbox.Deliver(event, target, func(outboxID int64) error {
+		return n.attempt(event, target, outboxID, send)
+	})
+}
+
+// PlanOutboxTargets - 이벤트를 받을 대상 계산 (Notify/NotifyRootWithReceipts와 같은 라우팅 규칙)
+// 웹훅 설정 조회에 실패하거나 받을 설정이 없으면 기본 notifier 대상
+func (n *webhookRoutingNotifier) PlanOutboxTargets(event NotifierEvent) ([]OutboxTarget, error) {
+	mode := model.NotificationOutboxModeNotify
+	if e, ok := event.(AlertStatusChangedEvent); ok && e.Alert.Status == "firing" {
+		mode = model.NotificationOutboxModeRoot
+	}
+
+	configs, err := n.loadWebhookConfigs()
+	if err != nil {
+		log.Printf("Failed to load webhook configs, falling back to default notifier: %v", err)
+		configs = nil
+	}
+
+	var targets []OutboxTarget
+	for _, cfg := range routeWebhookConfigs(configs, extractEventLabels(event)) {
+		configID := cfg.ID
+		switch normalizeWebhookType(cfg.Type) {
+		case "slack":
+			if _, ok := n.slackClientForConfig(cfg); !ok {
+				continue
+			}
+			target := OutboxTarget{Mode: mode, WebhookConfigID: &configID}
+			if alert := eventAlert(event); alert != nil && mode == model.NotificationOutboxModeRoot {
+				target.Channel = rootChannelForConfig(cfg, *alert)
+			}
+			targets = append(targets, target)
+		case "http", "teams":
+			if strings.TrimSpace(cfg.URL) == "" {
+				continue
+			}
+			if _, err := n.endpointNotifier(cfg); err != nil {
+				log.Printf("Skipping webhook config %d: %v", cfg.ID, err)
+				continue
+			}
+			targets = append(targets, OutboxTarget{Mode: mode, WebhookConfigID: &configID})
+		}
+	}
+	if len(targets) > 0 {
+		return targets, nil
+	}
+	if n.fallback == nil {
+		return nil, fmt.Errorf("no notifier target configured")
+	}
+	return []OutboxTarget{{Mode: mode}}, nil
+}
+
+// PlanThreadOutboxTargets - 스레드 답글을 보낼 delivery 대상 (NotifyThreadEvent와 같은 조건)
+func (n *webhookRoutingNotifier) PlanThreadOutboxTargets(event NotifierEvent, deliveries []model.AlertNotificationDelivery) []OutboxTarget {
+	var targets []OutboxTarget
+	for _, delivery := range deliveries {
+		if normalizeWebhookType(delivery.NotifierType) != "slack" {
+			continue
+		}
+		if strings.TrimSpace(delivery.ChannelID) == "" || strings.TrimSpace(delivery.ThreadTS) == "" {
+			n.logThreadDeliverySkip(event, delivery, "delivery", "missing_channel_or_thread")
+			continue
+		}
+		if _, lookupSource, ok := n.slackClientForDelivery(delivery); !ok {
+			n.logThreadDeliverySkip(event, delivery, lookupSource, "no_delivery_owner")
+			continue
+		}
+		targets = append(targets, OutboxTarget{Mode: model.NotificationOutboxModeThread, WebhookConfigID: delivery.WebhookConfigID, Delivery: &delivery})
+	}
+	return targets
+}
+
+// RedeliverOutboxEntry - outbox entry를 기록된 대상에만 전송 (전송 시도는 attempt로 기록)
+func (n *webhookRoutingNotifier) RedeliverOutboxEntry(entry model.NotificationOutboxEntry) (*NotificationDeliveryReceipt, error) {
+	event, err := DecodeNotifierEvent(entry.EventType, entry.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	target := OutboxTarget{Mode: entry.Mode, WebhookConfigID: entry.WebhookConfigID, Channel: entry.Channel, Delivery: entry.Delivery}
+	var receipt *NotificationDeliveryReceipt
+	err = n.attempt(event, target, entry.ID, func() (int, error) {
+		var statusCode int
+		var sendErr error
+		receipt, statusCode, sendErr = n.redeliver(entry, event)
+		return statusCode, sendErr
+	})
+	return receipt, err
+}
+
+// redeliver - entry의 전송 방식(thread/root/notify)과 대상 설정에 맞춰 전송
+func (n *webhookRoutingNotifier) redeliver(entry model.NotificationOutboxEntry, event NotifierEvent) (*NotificationDeliveryReceipt, int, error) {
+	if entry.Mode == model.NotificationOutboxModeThread {
+		if entry.Delivery == nil {
+			return nil, 0, fmt.Errorf("outbox entry has no thread delivery")
+		}
+		slackNotifier, lookupSource, ok := n.slackClientForDelivery(*entry.Delivery)
+		if !ok {
+			return nil, 0, fmt.Errorf("no slack client for thread delivery (lookup_source=%s)", lookupSource)
+		}
+		statusCode, err := slackResult(n.sendSlackThreadEvent(slackNotifier, event, *entry.Delivery))
+		return nil, statusCode, err
+	}
+
+	if entry.WebhookConfigID == nil {
+		return n.redeliverToFallback(entry, event)
+	}
+
+	configs, err := n.loadWebhookConfigs()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to load webhook configs: %w", err)
+	}
+	for _, cfg := range configs {
+		if cfg.ID != *entry.WebhookConfigID {
+			continue
+		}
+		if !cfg.Enabled {
+			return nil, 0, fmt.Errorf("webhook config %d is disabled", cfg.ID)
+		}
+		switch normalizeWebhookType(cfg.Type) {
+		case "slack":
+			slackNotifier, ok := n.slackClientForConfig(cfg)
+			if !ok {
+				return nil, 0, fmt.Errorf("slack webhook config is missing token or channel")
+			}
+			if entry.Mode != model.NotificationOutboxModeRoot {
+				statusCode, err := slackResult(slackNotifier.Notify(event))
+				return nil, statusCode, err
+			}
+			alertEvent, ok := event.(AlertStatusChangedEvent)
+			if !ok {
+				return nil, 0, fmt.Errorf("unsupported root event: %T", event)
+			}
+			channel := entry.Channel
+			if channel == "" {
+				channel = rootChannelForConfig(cfg, alertEvent.Alert)
+			}
+			receipt, err := slackNotifier.sendAlertWithThread(alertEvent.Alert, alertEvent.Alert.Status, alertEvent.IncidentID, alertEvent.IsManual, channel, "")
+			if err != nil {
+				statusCode, err := slackResult(err)
+				return nil, statusCode, err
+			}
+			if receipt != nil {
+				configID := cfg.ID
+				receipt.WebhookConfigID = &configID
+			}
+			return receipt, http.StatusOK, nil
+		case "http", "teams":
+			endpoint, err := n.endpointNotifier(cfg)
+			if err != nil {
+				return nil, 0, err
+			}
+			statusCode, err := endpoint.send(event)
+			return nil, statusCode, err
+		default:
+			return nil, 0, fmt.Errorf("unsupported webhook type: %s", cfg.Type)
+		}
+	}
+	return nil, 0, fmt.Errorf("webhook config %d not found", *entry.WebhookConfigID)
+}
+
+// redeliverToFallback - 기본 notifier로 다시 전송 (Slack root는 receipt 반환)
+func (n *webhookRoutingNotifier) redeliverToFallback(entry model.NotificationOutboxEntry, event NotifierEvent) (*NotificationDeliveryReceipt, int, error) {
+	if n.fallback == nil {
+		return nil, 0, fmt.Errorf("no notifier target configured")
+	}
+	if entry.Mode == model.NotificationOutboxModeRoot {
+		if slackFallback, ok := n.fallback.(*SlackClient); ok {
+			if alertEvent, ok := event.(AlertStatusChangedEvent); ok {
+				receipt, err := slackFallback.SendAlertWithReceipt(alertEvent.Alert, alertEvent.Alert.Status, alertEvent.IncidentID, alertEvent.IsManual)
+				statusCode, err := slackResult(err)
+				return receipt, statusCode, err
+			}
+		}
+	}
+	statusCode, err := notifyWithStatus(n.fallback, event)
+	return nil, statusCode, err
+}
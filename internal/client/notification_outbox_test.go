@@ -0,0 +1,219 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// outboxStub - 전송 대상만 기록하는 NotificationOutbox (전송은 worker 몫)
+// unavailable이면 outbox 저장 실패처럼 send를 즉시 실행한다.
+type outboxStub struct {
+	targets     []OutboxTarget
+	unavailable bool
+}
+
+func (o *outboxStub) Deliver(_ NotifierEvent, target OutboxTarget, send func(outboxID int64) error) error {
+	if o.unavailable {
+		return send(0)
+	}
+	o.targets = append(o.targets, target)
+	return nil
+}
+
+func TestEncodeDecodeNotifierEvent_KeepsAlertContext(t *testing.T) {
+	event := AlertStatusChangedEvent{
+		Alert: model.Alert{
+			Status:            "firing",
+			Fingerprint:       "fp-1",
+			ServiceChannel:    "C-SVC",
+			OncallSlackUserID: "U123",
+		},
+		IncidentID: "INC-1",
+	}
+	raw, err := EncodeNotifierEvent(event)
+	if err != nil {
+		t.Fatalf("EncodeNotifierEvent() error = %v", err)
+	}
+	decoded, err := DecodeNotifierEvent(event.EventType(), raw)
+	if err != nil {
+		t.Fatalf("DecodeNotifierEvent() error = %v", err)
+	}
+	got, ok := decoded.(AlertStatusChangedEvent)
+	if !ok {
+		t.Fatalf("decoded type = %T", decoded)
+	}
+	if got.IncidentID != "INC-1" || got.Alert.Fingerprint != "fp-1" {
+		t.Fatalf("unexpected event: %+v", got)
+	}
+	if got.Alert.ServiceChannel != "C-SVC" || got.Alert.OncallSlackUserID != "U123" {
+		t.Fatalf("alert context not restored: channel=%q oncall=%q", got.Alert.ServiceChannel, got.Alert.OncallSlackUserID)
+	}
+
+	cleared, err := EncodeNotifierEvent(FlappingClearedEvent{Fingerprint: "fp-2", ThreadRef: "1.1"})
+	if err != nil {
+		t.Fatalf("EncodeNotifierEvent() error = %v", err)
+	}
+	if decoded, err := DecodeNotifierEvent(NotifierEventFlappingCleared, cleared); err != nil || decoded.(FlappingClearedEvent).Fingerprint != "fp-2" {
+		t.Fatalf("DecodeNotifierEvent(flapping cleared) = %+v, %v", decoded, err)
+	}
+	if _, err := DecodeNotifierEvent("unknown", raw); err == nil {
+		t.Fatalf("expected error for unknown event type")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{value: "", want: 0},
+		{value: "30", want: 30 * time.Second},
+		{value: "-1", want: 0},
+		{value: now.Add(90 * time.Second).Format(http.TimeFormat), want: 90 * time.Second},
+		{value: now.Add(-time.Minute).Format(http.TimeFormat), want: 0},
+		{value: "soon", want: 0},
+	}
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.value, now); got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestWebhookEndpointNotifier_ReturnsRetryAfterError(t *testing.T) {
+	n := &webhookEndpointNotifier{
+		cfg: model.WebhookConfig{URL: "https://example.com/hook"},
+		httpClient: &http.Client{Transport: roundTripFunc(func(_ *http.Request) (*http.Response, error) {
+			header := make(http.Header)
+			header.Set("Retry-After", "45")
+			return &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Body:       io.NopCloser(strings.NewReader("slow down")),
+				Header:     header,
+			}, nil
+		})},
+	}
+
+	err := n.Notify(AlertStatusChangedEvent{Alert: model.Alert{Status: "firing"}})
+	retryAfter, ok := RetryAfter(err)
+	if !ok || retryAfter != 45*time.Second {
+		t.Fatalf("RetryAfter(%v) = %v, %v; want 45s", err, retryAfter, ok)
+	}
+}
+
+func TestWebhookRoutingNotifier_OutboxQueuesTargetsWithoutSending(t *testing.T) {
+	repo := webhookConfigRepoStub{
+		configs: []model.WebhookConfig{
+			{ID: 3, Type: "http", URL: "https://example.com/hook", Enabled: true},
+		},
+	}
+	fallback := &fallbackNotifierStub{}
+	n := NewWebhookRoutingNotifier(repo, fallback, fallback, "")
+	impl := n.(*webhookRoutingNotifier)
+	requests := 0
+	impl.httpClient = &http.Client{Transport: roundTripFunc(func(_ *http.Request) (*http.Response, error) {
+		requests++
+		return nil, errors.New("connection refused")
+	})}
+	outbox := &outboxStub{}
+	impl.SetNotificationOutbox(outbox)
+
+	if err := n.Notify(AlertStatusChangedEvent{Alert: model.Alert{Status: "firing"}}); err != nil {
+		t.Fatalf("Notify() error = %v, want nil once queued", err)
+	}
+	if requests != 0 {
+		t.Fatalf("http requests = %d, want 0 (worker sends queued targets)", requests)
+	}
+	if fallback.notifyCount != 0 {
+		t.Fatalf("fallback notify count = %d, want 0", fallback.notifyCount)
+	}
+	if len(outbox.targets) != 1 {
+		t.Fatalf("outbox targets = %d, want 1", len(outbox.targets))
+	}
+	target := outbox.targets[0]
+	if target.Mode != model.NotificationOutboxModeNotify || target.WebhookConfigID == nil || *target.WebhookConfigID != 3 {
+		t.Fatalf("unexpected outbox target: %+v", target)
+	}
+}
+
+func TestWebhookRoutingNotifier_OutboxUnavailableUsesFallback(t *testing.T) {
+	repo := webhookConfigRepoStub{
+		configs: []model.WebhookConfig{
+			{ID: 3, Type: "http", URL: "https://example.com/hook", Enabled: true},
+		},
+	}
+	fallback := &fallbackNotifierStub{}
+	n := NewWebhookRoutingNotifier(repo, fallback, fallback, "")
+	impl := n.(*webhookRoutingNotifier)
+	impl.httpClient = &http.Client{Transport: roundTripFunc(func(_ *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	})}
+	impl.SetNotificationOutbox(&outboxStub{unavailable: true})
+
+	if err := n.Notify(AlertStatusChangedEvent{Alert: model.Alert{Status: "firing"}}); err != nil {
+		t.Fatalf("Notify() error = %v, want fallback success", err)
+	}
+	if fallback.notifyCount != 1 {
+		t.Fatalf("fallback notify count = %d, want 1 (no retry was persisted)", fallback.notifyCount)
+	}
+}
+
+func TestWebhookRoutingNotifier_PlanOutboxTargets(t *testing.T) {
+	repo := webhookConfigRepoStub{
+		configs: []model.WebhookConfig{
+			{ID: 1, Type: "slack", Token: "token-1", Channel: "C-OPS", Enabled: true, UseServiceChannel: true, Continue: true},
+			{ID: 2, Type: "http", URL: "https://example.com/hook", Enabled: true, Continue: true},
+			{ID: 3, Type: "teams", Enabled: true},
+		},
+	}
+	fallback := &fallbackNotifierStub{}
+	n := NewWebhookRoutingNotifier(repo, fallback, fallback, "").(*webhookRoutingNotifier)
+
+	firing := AlertStatusChangedEvent{Alert: model.Alert{Status: "firing", Fingerprint: "fp-1", ServiceChannel: "C-SVC"}}
+	targets, err := n.PlanOutboxTargets(firing)
+	if err != nil {
+		t.Fatalf("PlanOutboxTargets() error = %v", err)
+	}
+	if len(targets) != 2 {
+		t.Fatalf("targets = %+v, want slack and http", targets)
+	}
+	if targets[0].Mode != model.NotificationOutboxModeRoot || *targets[0].WebhookConfigID != 1 || targets[0].Channel != "C-SVC" {
+		t.Fatalf("unexpected slack root target: %+v", targets[0])
+	}
+	if targets[1].Mode != model.NotificationOutboxModeRoot || *targets[1].WebhookConfigID != 2 {
+		t.Fatalf("unexpected http root target: %+v", targets[1])
+	}
+
+	targets, err = n.PlanOutboxTargets(AlertStatusChangedEvent{Alert: model.Alert{Status: "unknown"}})
+	if err != nil || len(targets) != 2 || targets[0].Mode != model.NotificationOutboxModeNotify || targets[0].Channel != "" {
+		t.Fatalf("PlanOutboxTargets(non-firing) = %+v, %v", targets, err)
+	}
+
+	empty := NewWebhookRoutingNotifier(webhookConfigRepoStub{}, fallback, fallback, "").(*webhookRoutingNotifier)
+	targets, err = empty.PlanOutboxTargets(firing)
+	if err != nil || len(targets) != 1 || targets[0].WebhookConfigID != nil || targets[0].Mode != model.NotificationOutboxModeRoot {
+		t.Fatalf("PlanOutboxTargets(no configs) = %+v, %v; want fallback root target", targets, err)
+	}
+
+	none := NewWebhookRoutingNotifier(webhookConfigRepoStub{}, nil, nil, "").(*webhookRoutingNotifier)
+	if _, err := none.PlanOutboxTargets(firing); err == nil {
+		t.Fatalf("expected error without any notifier target")
+	}
+
+	configID := 1
+	thread := n.PlanThreadOutboxTargets(firing, []model.AlertNotificationDelivery{
+		{AlertID: "ALR-1", NotifierType: "slack", WebhookConfigID: &configID, ChannelID: "C-SVC", ThreadTS: "1.1"},
+		{AlertID: "ALR-1", NotifierType: "slack", ChannelID: "C-OPS"},
+		{AlertID: "ALR-1", NotifierType: "http", ChannelID: "C-OPS", ThreadTS: "1.2"},
+	})
+	if len(thread) != 1 || thread[0].Mode != model.NotificationOutboxModeThread || thread[0].Delivery == nil || thread[0].Delivery.ThreadTS != "1.1" {
+		t.Fatalf("PlanThreadOutboxTargets() = %+v", thread)
+	}
+}
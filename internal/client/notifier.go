@@ -1,12 +1,19 @@
 package client
 
-import "github.com/kube-rca/backend/internal/model"
+import (
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+)
 
 const (
 	NotifierEventAlertStatusChanged   = "alert.status_changed"
 	NotifierEventFlappingDetected     = "alert.flapping_detected"
 	NotifierEventFlappingCleared      = "alert.flapping_cleared"
 	NotifierEventAnalysisResultPosted = "analysis.result_posted"
+	NotifierEventAlertReminder        = "alert.reminder"
+	NotifierEventAlertAcknowledged    = "alert.acknowledged"
+	NotifierEventIncidentEscalated    = "incident.escalated"
 )
 
 // NotifierEvent는 알림 채널(Slack, Teams 등) 전송 이벤트를 표현한다.
@@ -56,6 +63,48 @@ func (AnalysisResultPostedEvent) EventType() string {
 	return NotifierEventAnalysisResultPosted
 }
 
+// AlertReminderEvent는 장시간 firing 중인 alert의 재알림 스레드 메시지 이벤트다.
+type AlertReminderEvent struct {
+	Alert         model.Alert
+	AlertID       string
+	IncidentID    string
+	FiringFor     time.Duration
+	ReminderCount int
+}
+
+func (AlertReminderEvent) EventType() string {
+	return NotifierEventAlertReminder
+}
+
+// AlertAcknowledgedEvent는 alert 확인(acknowledge) 스레드 메시지 이벤트다.
+type AlertAcknowledgedEvent struct {
+	Alert          model.Alert
+	AlertID        string
+	IncidentID     string
+	AcknowledgedBy string
+	TimeToAck      time.Duration
+}
+
+func (AlertAcknowledgedEvent) EventType() string {
+	return NotifierEventAlertAcknowledged
+}
+
+// IncidentEscalatedEvent는 확인되지 않은 Incident의 에스컬레이션 레벨 알림 이벤트다.
+type IncidentEscalatedEvent struct {
+	IncidentID     string
+	Title          string
+	Severity       string
+	FiredAt        time.Time
+	PolicyName     string
+	Level          int // 1부터
+	LevelCount     int
+	Unacknowledged time.Duration // 발생 후 확인되지 않은 시간
+}
+
+func (IncidentEscalatedEvent) EventType() string {
+	return NotifierEventIncidentEscalated
+}
+
 // Notifier는 플랫폼별 알림 전송 구현의 공통 인터페이스다.
 type Notifier interface {
 	Notify(event NotifierEvent) error
@@ -104,3 +153,8 @@ type DeliveryAwareNotifier interface {
 	NotifyThreadEvent(event NotifierEvent, deliveries []model.AlertNotificationDelivery) error
 	ListSlackRoutes() ([]NotificationRoute, error)
 }
+
+// EscalationNotifier는 에스컬레이션 대상(webhook 설정, Slack 사용자/채널)에 직접 전송하는 capability다.
+type EscalationNotifier interface {
+	NotifyEscalationTargets(event IncidentEscalatedEvent, targets []model.EscalationTarget) error
+}
@@ -37,6 +37,11 @@ type SlackClient struct {
 	//   - 추후 AI 분석 결과를 같은 스레드로 보내기 위함
 	// sync.Map 사용 이유: 동시성 안전 (여러 알림이 동시에 처리될 수 있음)
 	threadMap sync.Map
+
+	// 웹훅 설정의 사용자 정의 alert 메시지 템플릿 (빈 문자열 = 기본 포맷)
+	templateMu    sync.RWMutex
+	titleTemplate string
+	bodyTemplate  string
 }
 
 var _ Notifier = (*SlackClient)(nil)
@@ -92,6 +97,19 @@ func NewSlackClient(cfg config.SlackConfig) *SlackClient {
 	}
 }
 
+// SetMessageTemplates - alert 메시지 제목/본문 템플릿 설정
+func (c *SlackClient) SetMessageTemplates(title, body string) {
+	c.templateMu.Lock()
+	defer c.templateMu.Unlock()
+	c.titleTemplate, c.bodyTemplate = title, body
+}
+
+func (c *SlackClient) messageTemplates() (string, string) {
+	c.templateMu.RLock()
+	defer c.templateMu.RUnlock()
+	return c.titleTemplate, c.bodyTemplate
+}
+
 // SlackClient에 Bot Token과 Channel ID가 모두 설정되어 있는지 체크
 func (c *SlackClient) IsConfigured() bool {
 	return c.botToken != "" && c.channelID != ""
@@ -148,6 +166,19 @@ func (c *SlackClient) send(msg SlackMessage) (*SlackResponse, error) {
 	}
 	defer resp.Body.Close()
 
+	// rate limit: Retry-After 이후 재시도하도록 전달 (outbox worker)
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, &RetryAfterError{
+			Err:        fmt.Errorf("slack API rate limited"),
+			StatusCode: resp.StatusCode,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		}
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, &HTTPStatusError{StatusCode: resp.StatusCode, Err: fmt.Errorf("slack API returned status: %d", resp.StatusCode)}
+	}
+
 	// 응답 읽기
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
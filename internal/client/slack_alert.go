@@ -4,6 +4,7 @@ package client
 
 import (
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -72,13 +73,35 @@ func (c *SlackClient) sendAlertWithThread(alert model.Alert, status, incidentID
 		fields = append(fields, SlackField{Title: "Incident", Value: incidentLink, Short: false})
 	}
 
+	text := alert.Annotations["description"]
+
+	// 웹훅 설정의 사용자 정의 템플릿 (렌더링 실패 시 기본 포맷 유지)
+	if titleTmpl, bodyTmpl := c.messageTemplates(); titleTmpl != "" || bodyTmpl != "" {
+		data := NewMessageTemplateData(AlertStatusChangedEvent{Alert: alert, IncidentID: incidentID, IsManual: isManual}, c.frontendURL)
+		data.Status = status
+		if titleTmpl != "" {
+			if rendered, err := RenderMessageTemplate(titleTmpl, data); err != nil {
+				log.Printf("Failed to render slack title template, using default: %v", err)
+			} else {
+				title = rendered
+			}
+		}
+		if bodyTmpl != "" {
+			if rendered, err := RenderMessageTemplate(bodyTmpl, data); err != nil {
+				log.Printf("Failed to render slack body template, using default: %v", err)
+			} else {
+				text, fields = rendered, nil
+			}
+		}
+	}
+
 	msg := SlackMessage{
 		Channel: channelID,
 		Attachments: []SlackAttachment{
 			{
 				Color:      color,
 				Title:      title,
-				Text:       alert.Annotations["description"],
+				Text:       text,
 				MrkdwnIn:   []string{"text", "fields"},
 				Fields:     fields,
 				Footer:     "kube-rca",
@@ -91,6 +114,10 @@ func (c *SlackClient) sendAlertWithThread(alert model.Alert, status, incidentID
 	if strings.TrimSpace(threadTS) != "" {
 		msg.ThreadTS = threadTS
 	}
+	// 현재 on-call 사용자 멘션 (attachment 안의 멘션은 알림이 가지 않으므로 본문에 추가)
+	if status == "firing" && alert.OncallSlackUserID != "" {
+		msg.Text = fmt.Sprintf("On-call: <@%s>", alert.OncallSlackUserID)
+	}
 
 	resp, err := c.send(msg)
 	if err != nil {
@@ -242,3 +269,151 @@ func (c *SlackClient) SendFlappingClearedInChannel(channelID, threadTS string) e
 	_, err := c.send(msg)
 	return err
 }
+
+// SendAlertReminderInChannel - 장시간 firing 중인 alert의 재알림을 기존 스레드에 전송
+func (c *SlackClient) SendAlertReminderInChannel(alert model.Alert, incidentID string, firingFor time.Duration, reminderCount int, channelID, threadTS string) error {
+	if !c.IsConfigured() {
+		return fmt.Errorf("slack bot token or channel ID not configured")
+	}
+	if strings.TrimSpace(channelID) == "" {
+		return fmt.Errorf("channel ID not configured")
+	}
+	if strings.TrimSpace(threadTS) == "" {
+		return fmt.Errorf("thread_ts is required for alert reminder")
+	}
+
+	title := fmt.Sprintf("⏰ [STILL FIRING] %s", alert.Labels["alertname"])
+	description := fmt.Sprintf("이 알림이 %s째 firing 상태입니다. (재알림 %d회)", formatFiringDuration(firingFor), reminderCount)
+
+	fields := []SlackField{
+		{Title: "Alert Name", Value: alert.Labels["alertname"], Short: true},
+		{Title: "Namespace", Value: alert.Labels["namespace"], Short: true},
+		{Title: "Severity", Value: alert.Labels["severity"], Short: true},
+		{Title: "Firing Since", Value: alert.StartsAt.UTC().Format(time.RFC3339), Short: true},
+	}
+	if incidentID != "" && c.frontendURL != "" {
+		incidentLink := fmt.Sprintf("<%s/incidents/%s|🔍 Incident 대시보드>", c.frontendURL, incidentID)
+		fields = append(fields, SlackField{Title: "Incident", Value: incidentLink, Short: false})
+	}
+
+	msg := SlackMessage{
+		Channel:  channelID,
+		ThreadTS: threadTS,
+		Attachments: []SlackAttachment{
+			{
+				Color:      c.getColorByStatus("firing", alert.Labels["severity"]),
+				Title:      title,
+				Text:       description,
+				MrkdwnIn:   []string{"text", "fields"},
+				Fields:     fields,
+				Footer:     "kube-rca",
+				FooterIcon: "https://kubernetes.io/images/favicon.png",
+				Ts:         time.Now().Unix(),
+			},
+		},
+	}
+
+	_, err := c.send(msg)
+	return err
+}
+
+// SendAlertAcknowledgedInChannel - alert 확인(acknowledge) 메모를 기존 스레드에 전송
+func (c *SlackClient) SendAlertAcknowledgedInChannel(alert model.Alert, acknowledgedBy string, timeToAck time.Duration, channelID, threadTS string) error {
+	if !c.IsConfigured() {
+		return fmt.Errorf("slack bot token or channel ID not configured")
+	}
+	if strings.TrimSpace(channelID) == "" {
+		return fmt.Errorf("channel ID not configured")
+	}
+	if strings.TrimSpace(threadTS) == "" {
+		return fmt.Errorf("thread_ts is required for acknowledgement notification")
+	}
+
+	if acknowledgedBy == "" {
+		acknowledgedBy = "unknown"
+	}
+	title := fmt.Sprintf("👀 [ACKNOWLEDGED] %s", alert.Labels["alertname"])
+	description := fmt.Sprintf("*%s*님이 이 알림을 확인했습니다. (발생 후 %s)\n확인 이후에는 재알림이 전송되지 않습니다.", acknowledgedBy, formatFiringDuration(timeToAck))
+
+	msg := SlackMessage{
+		Channel:  channelID,
+		ThreadTS: threadTS,
+		Attachments: []SlackAttachment{
+			{
+				Color:      "#439fe0", // Blue for acknowledged
+				Title:      title,
+				Text:       description,
+				MrkdwnIn:   []string{"text"},
+				Footer:     "kube-rca",
+				FooterIcon: "https://kubernetes.io/images/favicon.png",
+				Ts:         time.Now().Unix(),
+			},
+		},
+	}
+
+	_, err := c.send(msg)
+	return err
+}
+
+// SendIncidentEscalation - 확인되지 않은 Incident의 에스컬레이션 알림을 채널(또는 사용자 DM)에 새 메시지로 전송
+func (c *SlackClient) SendIncidentEscalation(event IncidentEscalatedEvent, channelID string) error {
+	if c.botToken == "" {
+		return fmt.Errorf("slack bot token not configured")
+	}
+	if strings.TrimSpace(channelID) == "" {
+		return fmt.Errorf("channel ID not configured")
+	}
+
+	title := fmt.Sprintf("🚨 [ESCALATION L%d/%d] %s", event.Level, event.LevelCount, event.Title)
+	description := fmt.Sprintf("Incident가 발생 후 %s 동안 확인되지 않아 에스컬레이션되었습니다. (정책: %s)", formatFiringDuration(event.Unacknowledged), event.PolicyName)
+
+	fields := []SlackField{
+		{Title: "Incident ID", Value: event.IncidentID, Short: true},
+		{Title: "Severity", Value: event.Severity, Short: true},
+		{Title: "Fired At", Value: event.FiredAt.UTC().Format(time.RFC3339), Short: true},
+		{Title: "Level", Value: fmt.Sprintf("%d / %d", event.Level, event.LevelCount), Short: true},
+	}
+	if c.frontendURL != "" {
+		incidentLink := fmt.Sprintf("<%s/incidents/%s|🔍 Incident 대시보드>", c.frontendURL, event.IncidentID)
+		fields = append(fields, SlackField{Title: "Incident", Value: incidentLink, Short: false})
+	}
+
+	msg := SlackMessage{
+		Channel: channelID,
+		Attachments: []SlackAttachment{
+			{
+				Color:      c.getColorByStatus("firing", event.Severity),
+				Title:      title,
+				Text:       description,
+				MrkdwnIn:   []string{"text", "fields"},
+				Fields:     fields,
+				Footer:     "kube-rca",
+				FooterIcon: "https://kubernetes.io/images/favicon.png",
+				Ts:         time.Now().Unix(),
+			},
+		},
+	}
+
+	_, err := c.send(msg)
+	return err
+}
+
+// formatFiringDuration - 경과 시간을 "1일 2시간 5분" 형식으로 변환 (1분 미만은 "1분")
+func formatFiringDuration(d time.Duration) string {
+	minutes := int(d / time.Minute)
+	if minutes < 1 {
+		minutes = 1
+	}
+	days, hours, mins := minutes/(24*60), minutes/60%24, minutes%60
+	var parts []string
+	if days > 0 {
+		parts = append(parts, fmt.Sprintf("%d일", days))
+	}
+	if hours > 0 {
+		parts = append(parts, fmt.Sprintf("%d시간", hours))
+	}
+	if mins > 0 {
+		parts = append(parts, fmt.Sprintf("%d분", mins))
+	}
+	return strings.Join(parts, " ")
+}
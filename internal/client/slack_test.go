@@ -2,8 +2,14 @@ package client
 
 import (
 	"encoding/json"
+	"io"
+	"net/http"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/model"
 )
 
 func TestToSlackMarkdown(t *testing.T) {
@@ -95,3 +101,97 @@ func TestSlackAttachmentMarshalsMrkdwnIn(t *testing.T) {
 		t.Fatalf("expected mrkdwn_in in payload, got %s", got)
 	}
 }
+
+func TestFormatFiringDuration(t *testing.T) {
+	tests := map[time.Duration]string{
+		20 * time.Second:              "1분",
+		45 * time.Minute:              "45분",
+		2 * time.Hour:                 "2시간",
+		26*time.Hour + 5*time.Minute:  "1일 2시간 5분",
+		48*time.Hour + 30*time.Second: "2일",
+	}
+	for d, want := range tests {
+		if got := formatFiringDuration(d); got != want {
+			t.Errorf("formatFiringDuration(%v) = %q; want %q", d, got, want)
+		}
+	}
+}
+
+func TestSlackClient_SendAlert_MentionsOncallUser(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   string
+		wantText string
+	}{
+		{name: "firing mentions on-call user", status: "firing", wantText: "On-call: <@U123ABC>"},
+		{name: "resolved does not mention", status: "resolved", wantText: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewSlackClient(config.SlackConfig{BotToken: "token", ChannelID: "C123"})
+			var posted SlackMessage
+			client.httpClient = &http.Client{
+				Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					if err := json.NewDecoder(req.Body).Decode(&posted); err != nil {
+						t.Fatalf("decode slack message: %v", err)
+					}
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(`{"ok":true,"ts":"1712345678.000100"}`)),
+						Header:     make(http.Header),
+					}, nil
+				}),
+			}
+
+			alert := model.Alert{
+				Status:            tt.status,
+				OncallSlackUserID: "U123ABC",
+				Labels:            map[string]string{"alertname": "test", "severity": "critical"},
+			}
+			if err := client.SendAlert(alert, tt.status, "INC-1", false); err != nil {
+				t.Fatalf("SendAlert() error = %v", err)
+			}
+			if posted.Text != tt.wantText {
+				t.Fatalf("text = %q, want %q", posted.Text, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestSlackClient_SendAlert_UsesMessageTemplates(t *testing.T) {
+	client := NewSlackClient(config.SlackConfig{BotToken: "token", ChannelID: "C123"})
+	client.SetMessageTemplates(
+		`{{ label "alertname" }} in {{ default "unknown" .Labels.cluster }}`,
+		`{{ annotation "summary" }} ({{ .Status }})`,
+	)
+	var posted SlackMessage
+	client.httpClient = &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&posted); err != nil {
+				t.Fatalf("decode slack message: %v", err)
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"ok":true,"ts":"1712345678.000100"}`)),
+				Header:     make(http.Header),
+			}, nil
+		}),
+	}
+
+	alert := model.Alert{
+		Status:      "firing",
+		Labels:      map[string]string{"alertname": "HighLatency", "severity": "warning"},
+		Annotations: map[string]string{"summary": "p99 above 2s"},
+	}
+	if err := client.SendAlert(alert, "firing", "INC-1", false); err != nil {
+		t.Fatalf("SendAlert() error = %v", err)
+	}
+	attachment := posted.Attachments[0]
+	if attachment.Title != "HighLatency in unknown" {
+		t.Fatalf("title = %q", attachment.Title)
+	}
+	if attachment.Text != "p99 above 2s (firing)" || len(attachment.Fields) != 0 {
+		t.Fatalf("text = %q fields = %d; want rendered body without default fields", attachment.Text, len(attachment.Fields))
+	}
+}
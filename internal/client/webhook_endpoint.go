@@ -0,0 +1,234 @@
+// HTTP/Teams 웹훅 엔드포인트 전송
+//
+// 처리 흐름:
+//  1. payload 생성 (payload 템플릿 또는 기본 JSON envelope)
+//  2. 설정의 HTTP 메서드로 요청 생성, 사용자 정의 헤더 → 인증(bearer/basic) → kube-rca 헤더 순으로 설정
+//  3. signing_secret이 있으면 인바운드 웹훅 hmac 인증과 같은 방식으로 서명
+//     X-KubeRCA-Signature: sha256=<hex(hmac_sha256(secret, timestamp + "." + body))>
+//  4. mTLS 설정이 있으면 설정별 HTTP 클라이언트(클라이언트 인증서/CA)로 전송
+//  5. 429/503 응답은 Retry-After를 RetryAfterError로 반환 (outbox 재시도 시각)
+
+package client
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// 아웃바운드 웹훅 헤더
+const (
+	WebhookHeaderDelivery  = "X-KubeRCA-Delivery"  // 전송 ID (같은 설정 + 같은 이벤트 내용이면 동일, 멱등 처리용)
+	WebhookHeaderEvent     = "X-KubeRCA-Event"     // 이벤트 타입
+	WebhookHeaderTimestamp = "X-KubeRCA-Timestamp" // 서명 시각 (unix seconds)
+	WebhookHeaderSignature = "X-KubeRCA-Signature" // sha256=<hex>
+)
+
+type webhookEventEnvelope struct {
+	EventType string      `json:"event_type"`
+	SentAt    time.Time   `json:"sent_at"`
+	Data      interface{} `json:"data"`
+}
+
+type webhookEndpointNotifier struct {
+	cfg         model.WebhookConfig
+	httpClient  *http.Client
+	frontendURL string
+}
+
+func (n *webhookEndpointNotifier) Notify(event NotifierEvent) error {
+	_, err := n.send(event)
+	return err
+}
+
+// send - 요청 전송 후 응답 상태 코드 반환 (응답을 받지 못했으면 0)
+func (n *webhookEndpointNotifier) send(event NotifierEvent) (int, error) {
+	payload, err := n.buildPayload(event)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := n.buildRequest(event, payload, WebhookDeliveryID(n.cfg.ID, event), time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send webhook request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		return resp.StatusCode, &RetryAfterError{
+			Err:        fmt.Errorf("webhook returned status: %d", resp.StatusCode),
+			StatusCode: resp.StatusCode,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		}
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, &HTTPStatusError{StatusCode: resp.StatusCode, Err: fmt.Errorf("webhook returned status: %d", resp.StatusCode)}
+	}
+
+	return resp.StatusCode, nil
+}
+
+// buildPayload - payload 템플릿이 있으면 렌더링 결과, 없으면 기본 JSON envelope
+func (n *webhookEndpointNotifier) buildPayload(event NotifierEvent) ([]byte, error) {
+	if strings.TrimSpace(n.cfg.PayloadTemplate) != "" {
+		rendered, err := RenderMessageTemplate(n.cfg.PayloadTemplate, NewMessageTemplateData(event, n.frontendURL))
+		if err != nil {
+			return nil, fmt.Errorf("failed to render webhook payload template: %w", err)
+		}
+		return []byte(rendered), nil
+	}
+	payload, err := json.Marshal(webhookEventEnvelope{
+		EventType: event.EventType(),
+		SentAt:    time.Now().UTC(),
+		Data:      event,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+	return payload, nil
+}
+
+// buildRequest - 메서드/헤더/인증/서명을 적용한 요청 생성
+func (n *webhookEndpointNotifier) buildRequest(event NotifierEvent, payload []byte, deliveryID string, now time.Time) (*http.Request, error) {
+	method := strings.ToUpper(strings.TrimSpace(n.cfg.HTTPMethod))
+	if method == "" {
+		method = http.MethodPost
+	}
+	req, err := http.NewRequest(method, n.cfg.URL, bytes.NewBuffer(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "kube-rca-webhook")
+	for name, value := range n.cfg.CustomHeaders {
+		req.Header.Set(name, value)
+	}
+	if token := strings.TrimSpace(n.cfg.Token); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else if n.cfg.BasicAuthUsername != "" {
+		req.SetBasicAuth(n.cfg.BasicAuthUsername, n.cfg.BasicAuthPassword)
+	}
+
+	req.Header.Set(WebhookHeaderDelivery, deliveryID)
+	req.Header.Set(WebhookHeaderEvent, event.EventType())
+	if n.cfg.SigningSecret != "" {
+		timestamp := strconv.FormatInt(now.Unix(), 10)
+		req.Header.Set(WebhookHeaderTimestamp, timestamp)
+		req.Header.Set(WebhookHeaderSignature, SignWebhookPayload(n.cfg.SigningSecret, timestamp, payload))
+	}
+	return req, nil
+}
+
+// SignWebhookPayload - "sha256=<hex(hmac_sha256(secret, timestamp + "." + body))>" 서명 생성
+func SignWebhookPayload(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookDeliveryID - 설정 ID와 이벤트 내용으로 만든 전송 ID
+// 같은 알림을 다시 보내는 경우(웹훅 inbox 재처리 등)에도 같은 값이므로 수신 측에서 중복 제거에 사용할 수 있다.
+func WebhookDeliveryID(configID int, event NotifierEvent) string {
+	body, err := json.Marshal(event)
+	if err != nil {
+		body = []byte(fmt.Sprintf("%+v", event))
+	}
+	h := sha256.New()
+	fmt.Fprintf(h, "%d\n%s\n", configID, event.EventType())
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))[:32]
+}
+
+// HasWebhookTLS - mTLS 클라이언트 인증서 또는 사용자 정의 CA 사용 여부
+func HasWebhookTLS(cfg model.WebhookConfig) bool {
+	return cfg.TLSClientCert != "" || cfg.TLSClientKey != "" || cfg.TLSCACert != ""
+}
+
+// BuildWebhookTLSConfig - mTLS 클라이언트 인증서/CA로 TLS 설정 생성 (설정이 없으면 nil)
+func BuildWebhookTLSConfig(cfg model.WebhookConfig) (*tls.Config, error) {
+	if !HasWebhookTLS(cfg) {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.TLSClientCert != "" || cfg.TLSClientKey != "" {
+		if cfg.TLSClientCert == "" || cfg.TLSClientKey == "" {
+			return nil, fmt.Errorf("tls_client_cert and tls_client_key must be set together")
+		}
+		cert, err := tls.X509KeyPair([]byte(cfg.TLSClientCert), []byte(cfg.TLSClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	if cfg.TLSCACert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(cfg.TLSCACert)) {
+			return nil, fmt.Errorf("invalid tls_ca_cert: no PEM certificates found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
+
+// webhookTLSClient - 설정별 mTLS HTTP 클라이언트 캐시 항목
+type webhookTLSClient struct {
+	key    string // 인증서/CA 해시 (변경 시 재생성)
+	client *http.Client
+}
+
+// httpClientForConfig - mTLS 설정이 있으면 설정별 클라이언트, 없으면 공용 클라이언트
+func (n *webhookRoutingNotifier) httpClientForConfig(cfg model.WebhookConfig) (*http.Client, error) {
+	if !HasWebhookTLS(cfg) {
+		return n.httpClient, nil
+	}
+	sum := sha256.Sum256([]byte(cfg.TLSClientCert + "\x00" + cfg.TLSClientKey + "\x00" + cfg.TLSCACert))
+	key := hex.EncodeToString(sum[:])
+
+	n.mu.RLock()
+	cached, ok := n.tlsClients[cfg.ID]
+	n.mu.RUnlock()
+	if ok && cached.key == key {
+		return cached.client, nil
+	}
+
+	tlsConfig, err := BuildWebhookTLSConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build webhook tls config: %w", err)
+	}
+	client := &http.Client{
+		Timeout:   n.httpClient.Timeout,
+		Transport: &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: tlsConfig},
+	}
+
+	n.mu.Lock()
+	n.tlsClients[cfg.ID] = webhookTLSClient{key: key, client: client}
+	n.mu.Unlock()
+	return client, nil
+}
+
+// endpointNotifier - HTTP/Teams 설정의 전송기 생성
+func (n *webhookRoutingNotifier) endpointNotifier(cfg model.WebhookConfig) (*webhookEndpointNotifier, error) {
+	httpClient, err := n.httpClientForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &webhookEndpointNotifier{cfg: cfg, httpClient: httpClient, frontendURL: n.frontendURL}, nil
+}
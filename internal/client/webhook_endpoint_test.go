@@ -0,0 +1,225 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+func TestWebhookRoutingNotifier_SignsAndCustomizesHTTPRequest(t *testing.T) {
+	repo := webhookConfigRepoStub{
+		configs: []model.WebhookConfig{
+			{
+				ID:                7,
+				Type:              "http",
+				URL:               "https://example.com/hook",
+				Enabled:           true,
+				HTTPMethod:        "PUT",
+				CustomHeaders:     map[string]string{"X-Tenant": "team-a"},
+				SigningSecret:     "s3cret",
+				BasicAuthUsername: "rca",
+				BasicAuthPassword: "pw",
+			},
+		},
+	}
+	fallback := &fallbackNotifierStub{}
+	n := NewWebhookRoutingNotifier(repo, fallback, fallback, "")
+	impl := n.(*webhookRoutingNotifier)
+
+	var requests []*http.Request
+	var bodies [][]byte
+	impl.httpClient = &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			b, _ := io.ReadAll(req.Body)
+			requests = append(requests, req)
+			bodies = append(bodies, b)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader("ok")),
+				Header:     make(http.Header),
+			}, nil
+		}),
+	}
+
+	event := AlertStatusChangedEvent{
+		Alert:      model.Alert{Status: "firing", Fingerprint: "fp-1", Labels: map[string]string{"alertname": "DiskFull"}},
+		IncidentID: "INC-1",
+	}
+	for i := 0; i < 2; i++ {
+		if err := n.Notify(event); err != nil {
+			t.Fatalf("Notify() error = %v", err)
+		}
+	}
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+
+	req := requests[0]
+	if req.Method != http.MethodPut {
+		t.Fatalf("method = %s, want PUT", req.Method)
+	}
+	if got := req.Header.Get("X-Tenant"); got != "team-a" {
+		t.Fatalf("X-Tenant = %q, want team-a", got)
+	}
+	if user, pass, ok := req.BasicAuth(); !ok || user != "rca" || pass != "pw" {
+		t.Fatalf("basic auth = %q/%q/%v, want rca/pw", user, pass, ok)
+	}
+	if got := req.Header.Get(WebhookHeaderEvent); got != NotifierEventAlertStatusChanged {
+		t.Fatalf("%s = %q, want %q", WebhookHeaderEvent, got, NotifierEventAlertStatusChanged)
+	}
+
+	timestamp := req.Header.Get(WebhookHeaderTimestamp)
+	if timestamp == "" {
+		t.Fatalf("expected %s header", WebhookHeaderTimestamp)
+	}
+	if got, want := req.Header.Get(WebhookHeaderSignature), SignWebhookPayload("s3cret", timestamp, bodies[0]); got != want {
+		t.Fatalf("signature = %q, want %q", got, want)
+	}
+
+	deliveryID := req.Header.Get(WebhookHeaderDelivery)
+	if len(deliveryID) != 32 {
+		t.Fatalf("delivery id = %q, want 32 hex chars", deliveryID)
+	}
+	if got := requests[1].Header.Get(WebhookHeaderDelivery); got != deliveryID {
+		t.Fatalf("delivery id changed between sends: %q != %q", got, deliveryID)
+	}
+	if other := WebhookDeliveryID(8, event); other == deliveryID {
+		t.Fatalf("expected different delivery id for another config")
+	}
+}
+
+func TestWebhookEndpointNotifier_BuildRequestDefaults(t *testing.T) {
+	n := &webhookEndpointNotifier{cfg: model.WebhookConfig{URL: "https://example.com/hook", Token: "abc"}}
+	req, err := n.buildRequest(AlertStatusChangedEvent{}, []byte(`{}`), "id-1", time.Unix(1700000000, 0))
+	if err != nil {
+		t.Fatalf("buildRequest() error = %v", err)
+	}
+	if req.Method != http.MethodPost {
+		t.Fatalf("method = %s, want POST", req.Method)
+	}
+	if got := req.Header.Get("Authorization"); got != "Bearer abc" {
+		t.Fatalf("Authorization = %q, want bearer token", got)
+	}
+	if req.Header.Get(WebhookHeaderSignature) != "" || req.Header.Get(WebhookHeaderTimestamp) != "" {
+		t.Fatalf("expected no signature headers without signing secret")
+	}
+	if got := req.Header.Get(WebhookHeaderDelivery); got != "id-1" {
+		t.Fatalf("%s = %q, want id-1", WebhookHeaderDelivery, got)
+	}
+}
+
+func TestWebhookRoutingNotifier_UsesMTLSClient(t *testing.T) {
+	clientCertPEM, clientKeyPEM, clientCert := generateTestCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+
+	var gotClientCert bool
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotClientCert = r.TLS != nil && len(r.TLS.PeerCertificates) > 0
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	server.StartTLS()
+	defer server.Close()
+
+	serverCAPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	repo := webhookConfigRepoStub{
+		configs: []model.WebhookConfig{
+			{
+				ID:            1,
+				Type:          "http",
+				URL:           server.URL,
+				Enabled:       true,
+				TLSClientCert: clientCertPEM,
+				TLSClientKey:  clientKeyPEM,
+				TLSCACert:     serverCAPEM,
+			},
+		},
+	}
+	fallback := &fallbackNotifierStub{}
+	n := NewWebhookRoutingNotifier(repo, fallback, fallback, "")
+
+	if err := n.Notify(AlertStatusChangedEvent{Alert: model.Alert{Status: "firing"}}); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	if !gotClientCert {
+		t.Fatalf("expected client certificate to be presented")
+	}
+}
+
+func TestBuildWebhookTLSConfig_Validation(t *testing.T) {
+	certPEM, keyPEM, _ := generateTestCertificate(t)
+
+	tests := []struct {
+		name    string
+		cfg     model.WebhookConfig
+		wantNil bool
+		wantErr bool
+	}{
+		{name: "no tls", cfg: model.WebhookConfig{}, wantNil: true},
+		{name: "cert and key", cfg: model.WebhookConfig{TLSClientCert: certPEM, TLSClientKey: keyPEM}},
+		{name: "ca only", cfg: model.WebhookConfig{TLSCACert: certPEM}},
+		{name: "cert without key", cfg: model.WebhookConfig{TLSClientCert: certPEM}, wantErr: true},
+		{name: "invalid key pair", cfg: model.WebhookConfig{TLSClientCert: certPEM, TLSClientKey: "bad"}, wantErr: true},
+		{name: "invalid ca", cfg: model.WebhookConfig{TLSCACert: "not a pem"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BuildWebhookTLSConfig(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BuildWebhookTLSConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (got == nil) != tt.wantNil {
+				t.Fatalf("BuildWebhookTLSConfig() = %v, wantNil %v", got, tt.wantNil)
+			}
+		})
+	}
+}
+
+// generateTestCertificate - 테스트용 self-signed 인증서/키 (PEM) 생성
+func generateTestCertificate(t *testing.T) (string, string, *x509.Certificate) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "kube-rca-test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate() error = %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("ParseCertificate() error = %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalECPrivateKey() error = %v", err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM), cert
+}
@@ -1,13 +1,12 @@
 package client
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -31,16 +30,20 @@ type webhookRoutingNotifier struct {
 	slackClients   map[int]*SlackClient
 	threadStore    sync.Map // alertKey → thread_ts
 	threadRefOwner sync.Map // thread_ts value → configID (which Slack client sent the original message)
-}
 
-var _ DeliveryAwareNotifier = (*webhookRoutingNotifier)(nil)
+	// mTLS 설정이 있는 HTTP/Teams 설정별 클라이언트 (mu로 보호)
+	tlsClients map[int]webhookTLSClient
 
-type webhookEventEnvelope struct {
-	EventType string      `json:"event_type"`
-	SentAt    time.Time   `json:"sent_at"`
-	Data      interface{} `json:"data"`
+	// 대상별 전송 기록/재시도 (nil이면 즉시 전송만, notification_outbox.go)
+	outbox NotificationOutbox
+	// 전송 시도 기록 (nil이면 기록 안 함, notification_attempt.go)
+	attempts NotificationAttemptRecorder
 }
 
+var _ DeliveryAwareNotifier = (*webhookRoutingNotifier)(nil)
+var _ EscalationNotifier = (*webhookRoutingNotifier)(nil)
+var _ OutboxDeliverer = (*webhookRoutingNotifier)(nil)
+
 // NewWebhookRoutingNotifier는 DB webhook_configs(type)을 기반으로 notifier를 라우팅한다.
 // DB 설정이 없으면 fallback notifier를 사용한다.
 func NewWebhookRoutingNotifier(
@@ -58,6 +61,7 @@ func NewWebhookRoutingNotifier(
 			Timeout: 10 * time.Second,
 		},
 		slackClients: make(map[int]*SlackClient),
+		tlsClients:   make(map[int]webhookTLSClient),
 	}
 }
 
@@ -74,27 +78,33 @@ func (n *webhookRoutingNotifier) Notify(event NotifierEvent) error {
 		return n.notifyByThread(e.ThreadRef, event)
 	}
 
-	severity := extractEventSeverity(event)
-	targets, err := n.resolveNotifiers(severity)
+	targets, err := n.resolveNotifiers(extractEventLabels(event))
 	if err != nil {
 		log.Printf("Failed to load webhook configs, falling back to default notifier: %v", err)
 		if n.fallback != nil {
-			return n.fallback.Notify(event)
+			return n.attempt(event, OutboxTarget{Mode: model.NotificationOutboxModeNotify}, 0, func() (int, error) {
+				return notifyWithStatus(n.fallback, event)
+			})
 		}
 		return err
 	}
 
 	if len(targets) == 0 {
 		if n.fallback != nil {
-			return n.fallback.Notify(event)
+			return n.deliver(event, OutboxTarget{Mode: model.NotificationOutboxModeNotify}, func() (int, error) {
+				return notifyWithStatus(n.fallback, event)
+			})
 		}
 		return fmt.Errorf("no notifier target configured")
 	}
 
 	var errs []error
-	success := 0
+	success := 0 // outbox에 기록된 대상은 worker가 전송하므로 성공으로 취급 (fallback으로 중복 전송하지 않음)
 	for _, target := range targets {
-		if err := target.Notify(event); err != nil {
+		configID := target.configID
+		if err := n.deliver(event, OutboxTarget{Mode: model.NotificationOutboxModeNotify, WebhookConfigID: &configID}, func() (int, error) {
+			return notifyWithStatus(target.notifier, event)
+		}); err != nil {
 			errs = append(errs, err)
 			continue
 		}
@@ -106,7 +116,9 @@ func (n *webhookRoutingNotifier) Notify(event NotifierEvent) error {
 	}
 
 	if n.fallback != nil {
-		if err := n.fallback.Notify(event); err == nil {
+		if err := n.attempt(event, OutboxTarget{Mode: model.NotificationOutboxModeNotify}, 0, func() (int, error) {
+			return notifyWithStatus(n.fallback, event)
+		}); err == nil {
 			return nil
 		} else {
 			errs = append(errs, fmt.Errorf("fallback notify failed: %w", err))
@@ -137,9 +149,9 @@ func (n *webhookRoutingNotifier) notifyByThread(threadRef string, event Notifier
 				return
 			}
 			found = true
-			if err := c.Notify(event); err != nil {
-				sendErr = err
-			}
+			sendErr = n.attempt(event, OutboxTarget{Mode: model.NotificationOutboxModeThread, WebhookConfigID: &configID}, 0, func() (int, error) {
+				return slackResult(c.Notify(event))
+			})
 		})
 		if found {
 			return sendErr
@@ -150,7 +162,7 @@ func (n *webhookRoutingNotifier) notifyByThread(threadRef string, event Notifier
 	// fallback: threadMap에서 값으로 검색 (재시작 후 threadRefOwner가 비어있는 경우)
 	var found bool
 	var sendErr error
-	n.forEachSlackClient(func(_ int, c *SlackClient) {
+	n.forEachSlackClient(func(configID int, c *SlackClient) {
 		if found {
 			return
 		}
@@ -158,9 +170,9 @@ func (n *webhookRoutingNotifier) notifyByThread(threadRef string, event Notifier
 			return
 		}
 		found = true
-		if err := c.Notify(event); err != nil {
-			sendErr = err
-		}
+		sendErr = n.attempt(event, OutboxTarget{Mode: model.NotificationOutboxModeThread, WebhookConfigID: &configID}, 0, func() (int, error) {
+			return slackResult(c.Notify(event))
+		})
 	})
 
 	if found {
@@ -168,7 +180,9 @@ func (n *webhookRoutingNotifier) notifyByThread(threadRef string, event Notifier
 	}
 
 	if slackFallback, ok := n.fallback.(*SlackClient); ok && slackFallback.HasThreadValue(threadRef) {
-		return slackFallback.Notify(event)
+		return n.attempt(event, OutboxTarget{Mode: model.NotificationOutboxModeThread}, 0, func() (int, error) {
+			return slackResult(slackFallback.Notify(event))
+		})
 	}
 
 	log.Printf(
@@ -195,7 +209,6 @@ func (n *webhookRoutingNotifier) notifyAlertRootWithReceipts(event AlertStatusCh
 		return nil, fmt.Errorf("root receipts are only supported for firing alerts")
 	}
 
-	severity := extractEventSeverity(event)
 	configs, err := n.loadWebhookConfigs()
 	if err != nil {
 		log.Printf("Failed to load webhook configs, falling back to default notifier: %v", err)
@@ -205,44 +218,37 @@ func (n *webhookRoutingNotifier) notifyAlertRootWithReceipts(event AlertStatusCh
 		return n.notifyRootWithFallback(event)
 	}
 
-	claimed := make(map[string]bool)
-	for _, cfg := range configs {
-		for _, s := range cfg.Severities {
-			claimed[s] = true
-		}
+	labels := event.Alert.Labels
+	if labels == nil {
+		labels = map[string]string{}
 	}
 
 	var (
 		receipts []NotificationDeliveryReceipt
 		errs     []error
-		success  int
+		success  int // outbox에 기록된 대상 포함 (receipt는 worker가 전송 후 기록)
 	)
 
-	for _, cfg := range configs {
-		var matches bool
-		if len(cfg.Severities) == 0 {
-			matches = !claimed[severity]
-		} else {
-			matches = severityMatches(cfg.Severities, severity)
-		}
-		if !matches {
-			continue
-		}
-
+	for _, cfg := range routeWebhookConfigs(configs, labels) {
+		configID := cfg.ID
 		switch normalizeWebhookType(cfg.Type) {
 		case "slack":
 			slackNotifier, ok := n.slackClientForConfig(cfg)
 			if !ok {
 				continue
 			}
-			receipt, err := slackNotifier.SendAlertWithReceipt(event.Alert, event.Alert.Status, event.IncidentID, event.IsManual)
-			if err != nil {
+			channel := rootChannelForConfig(cfg, event.Alert)
+			var receipt *NotificationDeliveryReceipt
+			if err := n.deliver(event, OutboxTarget{Mode: model.NotificationOutboxModeRoot, WebhookConfigID: &configID, Channel: channel}, func() (int, error) {
+				var sendErr error
+				receipt, sendErr = slackNotifier.sendAlertWithThread(event.Alert, event.Alert.Status, event.IncidentID, event.IsManual, channel, "")
+				return slackResult(sendErr)
+			}); err != nil {
 				errs = append(errs, err)
 				continue
 			}
 			success++
 			if receipt != nil {
-				configID := cfg.ID
 				receipt.WebhookConfigID = &configID
 				receipts = append(receipts, *receipt)
 			}
@@ -250,7 +256,14 @@ func (n *webhookRoutingNotifier) notifyAlertRootWithReceipts(event AlertStatusCh
 			if strings.TrimSpace(cfg.URL) == "" {
 				continue
 			}
-			if err := (&webhookEndpointNotifier{cfg: cfg, httpClient: n.httpClient}).Notify(event); err != nil {
+			endpoint, err := n.endpointNotifier(cfg)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if err := n.deliver(event, OutboxTarget{Mode: model.NotificationOutboxModeRoot, WebhookConfigID: &configID}, func() (int, error) {
+				return endpoint.send(event)
+			}); err != nil {
 				errs = append(errs, err)
 				continue
 			}
@@ -265,8 +278,14 @@ func (n *webhookRoutingNotifier) notifyAlertRootWithReceipts(event AlertStatusCh
 }
 
 func (n *webhookRoutingNotifier) notifyRootWithFallback(event AlertStatusChangedEvent) ([]NotificationDeliveryReceipt, error) {
+	target := OutboxTarget{Mode: model.NotificationOutboxModeRoot}
 	if slackFallback, ok := n.fallback.(*SlackClient); ok {
-		receipt, err := slackFallback.SendAlertWithReceipt(event.Alert, event.Alert.Status, event.IncidentID, event.IsManual)
+		var receipt *NotificationDeliveryReceipt
+		err := n.deliver(event, target, func() (int, error) {
+			var sendErr error
+			receipt, sendErr = slackFallback.SendAlertWithReceipt(event.Alert, event.Alert.Status, event.IncidentID, event.IsManual)
+			return slackResult(sendErr)
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -280,7 +299,9 @@ func (n *webhookRoutingNotifier) notifyRootWithFallback(event AlertStatusChanged
 	// back to legacy thread_ts lookup. This is acceptable only when the fallback
 	// is a single-channel SlackClient that stores thread_ts via StoreThreadRef.
 	if n.fallback != nil {
-		return nil, n.fallback.Notify(event)
+		return nil, n.deliver(event, target, func() (int, error) {
+			return notifyWithStatus(n.fallback, event)
+		})
 	}
 	return nil, fmt.Errorf("no notifier target configured")
 }
@@ -306,7 +327,9 @@ func (n *webhookRoutingNotifier) NotifyThreadEvent(event NotifierEvent, deliveri
 			n.logThreadDeliverySkip(event, delivery, lookupSource, "no_delivery_owner")
 			continue
 		}
-		if err := n.sendSlackThreadEvent(slackNotifier, event, delivery); err != nil {
+		if err := n.deliver(event, OutboxTarget{Mode: model.NotificationOutboxModeThread, WebhookConfigID: delivery.WebhookConfigID, Delivery: &delivery}, func() (int, error) {
+			return slackResult(n.sendSlackThreadEvent(slackNotifier, event, delivery))
+		}); err != nil {
 			errs = append(errs, fmt.Errorf("delivery route_key=%s: %w", delivery.RouteKey, err))
 			continue
 		}
@@ -322,6 +345,114 @@ func (n *webhookRoutingNotifier) NotifyThreadEvent(event NotifierEvent, deliveri
 	return errors.Join(errs...)
 }
 
+// NotifyEscalationTargets는 에스컬레이션 레벨의 대상마다 이벤트를 전송한다.
+// webhook 대상은 해당 설정(Slack: 설정 채널, HTTP/Teams: JSON POST)으로, user/channel 대상은 기본 Slack 봇으로 보낸다.
+func (n *webhookRoutingNotifier) NotifyEscalationTargets(event IncidentEscalatedEvent, targets []model.EscalationTarget) error {
+	if len(targets) == 0 {
+		return fmt.Errorf("no escalation targets provided")
+	}
+	configs, err := n.loadWebhookConfigs()
+	if err != nil {
+		log.Printf("Failed to load webhook configs for escalation: %v", err)
+	}
+
+	var errs []error
+	for _, target := range targets {
+		if err := n.attempt(event, escalationAttemptTarget(target), 0, func() (int, error) {
+			return n.notifyEscalationTarget(event, target, configs)
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("target %s: %w", describeEscalationTarget(target), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (n *webhookRoutingNotifier) notifyEscalationTarget(event IncidentEscalatedEvent, target model.EscalationTarget, configs []model.WebhookConfig) (int, error) {
+	switch target.Type {
+	case model.EscalationTargetWebhook:
+		for _, cfg := range configs {
+			if cfg.ID != target.WebhookConfigID {
+				continue
+			}
+			switch normalizeWebhookType(cfg.Type) {
+			case "slack":
+				slackNotifier, ok := n.slackClientForConfig(cfg)
+				if !ok {
+					return 0, fmt.Errorf("slack webhook config is missing token or channel")
+				}
+				return slackResult(slackNotifier.SendIncidentEscalation(event, strings.TrimSpace(cfg.Channel)))
+			case "http", "teams":
+				if strings.TrimSpace(cfg.URL) == "" {
+					return 0, fmt.Errorf("webhook config has no url")
+				}
+				endpoint, err := n.endpointNotifier(cfg)
+				if err != nil {
+					return 0, err
+				}
+				return endpoint.send(event)
+			default:
+				return 0, fmt.Errorf("unsupported webhook type: %s", cfg.Type)
+			}
+		}
+		return 0, fmt.Errorf("webhook config not found")
+	case model.EscalationTargetUser, model.EscalationTargetChannel:
+		slackNotifier, ok := n.defaultSlackClient(configs)
+		if !ok {
+			return 0, fmt.Errorf("no slack bot configured")
+		}
+		destination := target.Channel
+		if target.Type == model.EscalationTargetUser {
+			// 사용자 ID로 chat.postMessage를 호출하면 봇 DM으로 전송된다
+			destination = target.User
+		}
+		return slackResult(slackNotifier.SendIncidentEscalation(event, strings.TrimSpace(destination)))
+	default:
+		return 0, fmt.Errorf("unsupported escalation target type: %s", target.Type)
+	}
+}
+
+// escalationAttemptTarget - 에스컬레이션 대상의 전송 기록 대상 (webhook 설정 또는 Slack 사용자/채널)
+func escalationAttemptTarget(target model.EscalationTarget) OutboxTarget {
+	attemptTarget := OutboxTarget{Mode: model.NotificationAttemptModeEscalation}
+	switch target.Type {
+	case model.EscalationTargetWebhook:
+		configID := target.WebhookConfigID
+		attemptTarget.WebhookConfigID = &configID
+	case model.EscalationTargetUser:
+		attemptTarget.Channel = target.User
+	default:
+		attemptTarget.Channel = target.Channel
+	}
+	return attemptTarget
+}
+
+// defaultSlackClient - user/channel 대상에 사용할 Slack 봇 (환경변수 기본 봇 → 첫 Slack webhook 설정 순)
+func (n *webhookRoutingNotifier) defaultSlackClient(configs []model.WebhookConfig) (*SlackClient, bool) {
+	if slackFallback, ok := n.fallback.(*SlackClient); ok && slackFallback.IsConfigured() {
+		return slackFallback, true
+	}
+	for _, cfg := range configs {
+		if normalizeWebhookType(cfg.Type) != "slack" {
+			continue
+		}
+		if client, ok := n.slackClientForConfig(cfg); ok {
+			return client, true
+		}
+	}
+	return nil, false
+}
+
+func describeEscalationTarget(target model.EscalationTarget) string {
+	switch target.Type {
+	case model.EscalationTargetWebhook:
+		return fmt.Sprintf("webhook:%d", target.WebhookConfigID)
+	case model.EscalationTargetUser:
+		return "user:" + target.User
+	default:
+		return target.Type + ":" + target.Channel
+	}
+}
+
 func (n *webhookRoutingNotifier) ListSlackRoutes() ([]NotificationRoute, error) {
 	configs, err := n.loadWebhookConfigs()
 	if err != nil {
@@ -360,36 +491,94 @@ func (n *webhookRoutingNotifier) ListSlackRoutes() ([]NotificationRoute, error)
 	return nil, nil
 }
 
-// extractEventSeverity - 이벤트에서 severity를 추출한다.
-// severity가 없는 이벤트(FlappingCleared, AnalysisResult)는 "" 반환 → 모든 채널로 전송.
-func extractEventSeverity(event NotifierEvent) string {
+// extractEventLabels - 이벤트의 alert 라벨을 추출한다.
+// alert가 없는 이벤트(FlappingCleared, AnalysisResult 등)는 nil 반환 → 활성화된 모든 설정으로 전송.
+func extractEventLabels(event NotifierEvent) map[string]string {
+	var labels map[string]string
 	switch e := event.(type) {
 	case AlertStatusChangedEvent:
-		return e.Alert.Labels["severity"]
+		labels = e.Alert.Labels
 	case *AlertStatusChangedEvent:
-		return e.Alert.Labels["severity"]
+		labels = e.Alert.Labels
 	case FlappingDetectedEvent:
-		return e.Alert.Labels["severity"]
+		labels = e.Alert.Labels
 	case *FlappingDetectedEvent:
-		return e.Alert.Labels["severity"]
+		labels = e.Alert.Labels
+	case AlertReminderEvent:
+		labels = e.Alert.Labels
+	case *AlertReminderEvent:
+		labels = e.Alert.Labels
+	case AlertAcknowledgedEvent:
+		labels = e.Alert.Labels
+	case *AlertAcknowledgedEvent:
+		labels = e.Alert.Labels
 	default:
-		return ""
+		return nil
+	}
+	if labels == nil {
+		labels = map[string]string{}
 	}
+	return labels
 }
 
-// severityMatches - 명시적 severity 필터(비어있지 않은 배열)와 이벤트 severity가 매칭되는지 확인.
-// eventSeverity가 빈 문자열(FlappingCleared 등)이면 항상 true.
-// cfgSeverities가 비어있는 경우는 resolveNotifiers에서 별도 분기하므로 여기서는 비어있지 않음.
-func severityMatches(cfgSeverities []string, eventSeverity string) bool {
-	if eventSeverity == "" {
-		return true
+// routeWebhookConfigs - 라우팅 규칙을 평가해 이벤트를 받을 설정을 priority, id 순으로 반환한다.
+//  1. 비활성 설정은 제외
+//  2. 매처가 있는 규칙을 순서대로 평가해 매칭되면 수신, continue=false면 평가 중단
+//  3. 매처 규칙에 하나도 매칭되지 않은 이벤트만 catch-all(매처 없음) 설정을 같은 방식으로 평가
+//
+// labels == nil(alert가 없는 이벤트)이면 활성화된 모든 설정을 반환한다.
+func routeWebhookConfigs(configs []model.WebhookConfig, labels map[string]string) []model.WebhookConfig {
+	ordered := make([]model.WebhookConfig, 0, len(configs))
+	for _, cfg := range configs {
+		if cfg.Enabled {
+			ordered = append(ordered, cfg)
+		}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].Priority != ordered[j].Priority {
+			return ordered[i].Priority < ordered[j].Priority
+		}
+		return ordered[i].ID < ordered[j].ID
+	})
+	if labels == nil {
+		return ordered
+	}
+
+	var matched []model.WebhookConfig
+	for _, cfg := range ordered {
+		if cfg.IsCatchAll() || !cfg.Matchers.Matches(labels) {
+			continue
+		}
+		matched = append(matched, cfg)
+		if !cfg.Continue {
+			return matched
+		}
+	}
+	if len(matched) > 0 {
+		return matched
 	}
-	for _, s := range cfgSeverities {
-		if s == eventSeverity {
-			return true
+	for _, cfg := range ordered {
+		if !cfg.IsCatchAll() {
+			continue
+		}
+		matched = append(matched, cfg)
+		if !cfg.Continue {
+			break
+		}
+	}
+	return matched
+}
+
+// rootChannelForConfig - Slack root 메시지를 보낼 채널
+// use_service_channel 설정이고 alert의 소유 서비스에 Slack 채널이 있으면 그 채널, 아니면 설정 채널.
+// 이후 스레드 이벤트는 receipt에 기록된 채널로 전송된다.
+func rootChannelForConfig(cfg model.WebhookConfig, alert model.Alert) string {
+	if cfg.UseServiceChannel {
+		if channel := strings.TrimSpace(alert.ServiceChannel); channel != "" {
+			return channel
 		}
 	}
-	return false
+	return strings.TrimSpace(cfg.Channel)
 }
 
 // forEachSlackClient DB에 등록된 모든 유효한 Slack 클라이언트에 fn을 적용한다.
@@ -498,7 +687,13 @@ func (n *webhookRoutingNotifier) RequiresThreadRef() bool {
 	return n.fallbackThreadStore != nil
 }
 
-func (n *webhookRoutingNotifier) resolveNotifiers(severity string) ([]Notifier, error) {
+// routedNotifier - 라우팅된 웹훅 설정의 전송기
+type routedNotifier struct {
+	configID int
+	notifier Notifier
+}
+
+func (n *webhookRoutingNotifier) resolveNotifiers(labels map[string]string) ([]routedNotifier, error) {
 	if n.cfgSource == nil {
 		return nil, nil
 	}
@@ -511,45 +706,26 @@ func (n *webhookRoutingNotifier) resolveNotifiers(severity string) ([]Notifier,
 		return nil, nil
 	}
 
-	// 어떤 웹훅이든 명시적으로 claim한 severity 집합 계산.
-	// severity가 없는 이벤트(FlappingCleared 등)는 이 로직을 건너뜀.
-	claimed := make(map[string]bool)
-	if severity != "" {
-		for _, cfg := range configs {
-			for _, s := range cfg.Severities {
-				claimed[s] = true
-			}
-		}
-	}
-
-	targets := make([]Notifier, 0, len(configs))
-	for _, cfg := range configs {
-		var matches bool
-		if len(cfg.Severities) == 0 {
-			// 빈 배열(catch-all): 아무도 claim하지 않은 severity만 수신.
-			// severity == ""인 이벤트(FlappingCleared, AnalysisResult)는 항상 수신.
-			matches = severity == "" || !claimed[severity]
-		} else {
-			matches = severityMatches(cfg.Severities, severity)
-		}
-		if !matches {
-			continue
-		}
+	routed := routeWebhookConfigs(configs, labels)
+	targets := make([]routedNotifier, 0, len(routed))
+	for _, cfg := range routed {
 		switch normalizeWebhookType(cfg.Type) {
 		case "slack":
 			slackNotifier, ok := n.slackClientForConfig(cfg)
 			if !ok {
 				continue
 			}
-			targets = append(targets, slackNotifier)
+			targets = append(targets, routedNotifier{configID: cfg.ID, notifier: slackNotifier})
 		case "http", "teams":
 			if strings.TrimSpace(cfg.URL) == "" {
 				continue
 			}
-			targets = append(targets, &webhookEndpointNotifier{
-				cfg:        cfg,
-				httpClient: n.httpClient,
-			})
+			endpoint, err := n.endpointNotifier(cfg)
+			if err != nil {
+				log.Printf("Skipping webhook config %d: %v", cfg.ID, err)
+				continue
+			}
+			targets = append(targets, routedNotifier{configID: cfg.ID, notifier: endpoint})
 		default:
 			continue
 		}
@@ -605,6 +781,14 @@ func (n *webhookRoutingNotifier) sendSlackThreadEvent(slackNotifier *SlackClient
 		return slackNotifier.SendFlappingClearedInChannel(delivery.ChannelID, delivery.ThreadTS)
 	case *FlappingClearedEvent:
 		return slackNotifier.SendFlappingClearedInChannel(delivery.ChannelID, delivery.ThreadTS)
+	case AlertReminderEvent:
+		return slackNotifier.SendAlertReminderInChannel(e.Alert, e.IncidentID, e.FiringFor, e.ReminderCount, delivery.ChannelID, delivery.ThreadTS)
+	case *AlertReminderEvent:
+		return slackNotifier.SendAlertReminderInChannel(e.Alert, e.IncidentID, e.FiringFor, e.ReminderCount, delivery.ChannelID, delivery.ThreadTS)
+	case AlertAcknowledgedEvent:
+		return slackNotifier.SendAlertAcknowledgedInChannel(e.Alert, e.AcknowledgedBy, e.TimeToAck, delivery.ChannelID, delivery.ThreadTS)
+	case *AlertAcknowledgedEvent:
+		return slackNotifier.SendAlertAcknowledgedInChannel(e.Alert, e.AcknowledgedBy, e.TimeToAck, delivery.ChannelID, delivery.ThreadTS)
 	default:
 		return fmt.Errorf("unsupported thread event: %T", event)
 	}
@@ -648,6 +832,7 @@ func (n *webhookRoutingNotifier) slackClientForConfig(cfg model.WebhookConfig) (
 	n.mu.RUnlock()
 
 	if ok && existing.botToken == token && existing.channelID == channel {
+		existing.SetMessageTemplates(cfg.TitleTemplate, cfg.BodyTemplate)
 		return existing, true
 	}
 
@@ -656,6 +841,7 @@ func (n *webhookRoutingNotifier) slackClientForConfig(cfg model.WebhookConfig) (
 
 	if existing, ok := n.slackClients[cfg.ID]; ok {
 		if existing.botToken == token && existing.channelID == channel {
+			existing.SetMessageTemplates(cfg.TitleTemplate, cfg.BodyTemplate)
 			return existing, true
 		}
 	}
@@ -666,6 +852,7 @@ func (n *webhookRoutingNotifier) slackClientForConfig(cfg model.WebhookConfig) (
 		FrontendURL: n.frontendURL,
 	}
 	newClient := NewSlackClient(clientCfg)
+	newClient.SetMessageTemplates(cfg.TitleTemplate, cfg.BodyTemplate)
 	n.slackClients[cfg.ID] = newClient
 	return newClient, true
 }
@@ -673,41 +860,3 @@ func (n *webhookRoutingNotifier) slackClientForConfig(cfg model.WebhookConfig) (
 func normalizeWebhookType(t string) string {
 	return strings.ToLower(strings.TrimSpace(t))
 }
-
-type webhookEndpointNotifier struct {
-	cfg        model.WebhookConfig
-	httpClient *http.Client
-}
-
-func (n *webhookEndpointNotifier) Notify(event NotifierEvent) error {
-	payload, err := json.Marshal(webhookEventEnvelope{
-		EventType: event.EventType(),
-		SentAt:    time.Now().UTC(),
-		Data:      event,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to marshal webhook payload: %w", err)
-	}
-
-	req, err := http.NewRequest("POST", n.cfg.URL, bytes.NewBuffer(payload))
-	if err != nil {
-		return fmt.Errorf("failed to create webhook request: %w", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	if token := strings.TrimSpace(n.cfg.Token); token != "" {
-		req.Header.Set("Authorization", "Bearer "+token)
-	}
-
-	resp, err := n.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to send webhook request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("webhook returned status: %d", resp.StatusCode)
-	}
-
-	return nil
-}
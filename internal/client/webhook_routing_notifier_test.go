@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"strings"
@@ -84,10 +85,11 @@ func TestWebhookRoutingNotifier_SendsHTTPWebhook(t *testing.T) {
 	repo := webhookConfigRepoStub{
 		configs: []model.WebhookConfig{
 			{
-				ID:    1,
-				Type:  "http",
-				URL:   "https://example.com/webhook",
-				Token: "token-1",
+				ID:      1,
+				Type:    "http",
+				URL:     "https://example.com/webhook",
+				Token:   "token-1",
+				Enabled: true,
 			},
 		},
 	}
@@ -148,7 +150,7 @@ func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
 func TestWebhookRoutingNotifier_ThreadRefStoreFallsBackToThreadStore(t *testing.T) {
 	repo := webhookConfigRepoStub{
 		configs: []model.WebhookConfig{
-			{ID: 1, Type: "http", URL: "https://example.com/webhook"},
+			{ID: 1, Type: "http", URL: "https://example.com/webhook", Enabled: true},
 		},
 	}
 	fallback := &fallbackNotifierStub{}
@@ -169,11 +171,12 @@ func TestWebhookRoutingNotifier_NotifyRootWithReceipts_ReturnsSlackReceipt(t *te
 	repo := webhookConfigRepoStub{
 		configs: []model.WebhookConfig{
 			{
-				ID:         1,
-				Type:       "slack",
-				Token:      "token-1",
-				Channel:    "C123",
-				Severities: []string{"warning"},
+				ID:       1,
+				Type:     "slack",
+				Token:    "token-1",
+				Channel:  "C123",
+				Matchers: model.LabelMatchers{{Name: "severity", Value: "warning", IsEqual: true}},
+				Enabled:  true,
 			},
 		},
 	}
@@ -220,6 +223,63 @@ func TestWebhookRoutingNotifier_NotifyRootWithReceipts_ReturnsSlackReceipt(t *te
 	}
 }
 
+func TestWebhookRoutingNotifier_NotifyRootWithReceipts_UsesServiceChannel(t *testing.T) {
+	repo := webhookConfigRepoStub{
+		configs: []model.WebhookConfig{
+			{
+				ID:                1,
+				Type:              "slack",
+				Token:             "token-1",
+				Channel:           "C123",
+				UseServiceChannel: true,
+				Enabled:           true,
+			},
+		},
+	}
+	fallback := &fallbackNotifierStub{}
+	n := NewWebhookRoutingNotifier(repo, fallback, fallback, "")
+	impl := n.(*webhookRoutingNotifier)
+	impl.slackClients[1] = NewSlackClient(config.SlackConfig{
+		BotToken:  "token-1",
+		ChannelID: "C123",
+	})
+	var postedChannel string
+	impl.slackClients[1].httpClient = &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			var msg SlackMessage
+			if err := json.NewDecoder(req.Body).Decode(&msg); err != nil {
+				t.Fatalf("decode slack message: %v", err)
+			}
+			postedChannel = msg.Channel
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"ok":true,"ts":"1712345678.000100"}`)),
+				Header:     make(http.Header),
+			}, nil
+		}),
+	}
+
+	receipts, err := n.NotifyRootWithReceipts(AlertStatusChangedEvent{
+		Alert: model.Alert{
+			Status:         "firing",
+			ServiceChannel: "C-PAYMENTS",
+			Labels: map[string]string{
+				"severity":  "critical",
+				"alertname": "test",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NotifyRootWithReceipts() error = %v", err)
+	}
+	if postedChannel != "C-PAYMENTS" {
+		t.Fatalf("posted channel = %q, want %q", postedChannel, "C-PAYMENTS")
+	}
+	if len(receipts) != 1 || receipts[0].ChannelID != "C-PAYMENTS" {
+		t.Fatalf("receipts = %+v, want one receipt for C-PAYMENTS", receipts)
+	}
+}
+
 func TestWebhookRoutingNotifier_NotifyThreadEvent_UsesExplicitDelivery(t *testing.T) {
 	repo := webhookConfigRepoStub{
 		configs: []model.WebhookConfig{
@@ -322,3 +382,145 @@ func TestWebhookRoutingNotifier_Notify_UsesFallbackSlackWhenThreadKnown(t *testi
 		t.Fatalf("thread_ts = %q, want %q", captured.ThreadTS, "1712345678.000111")
 	}
 }
+
+func TestWebhookRoutingNotifier_NotifyEscalationTargets_RoutesByTargetType(t *testing.T) {
+	repo := webhookConfigRepoStub{
+		configs: []model.WebhookConfig{
+			{ID: 3, Type: "http", URL: "https://example.com/pager", Enabled: true},
+		},
+	}
+	fallback := NewSlackClient(config.SlackConfig{BotToken: "token-fallback", ChannelID: "C000"})
+	n := NewWebhookRoutingNotifier(repo, fallback, fallback, "")
+	impl := n.(*webhookRoutingNotifier)
+
+	var mu sync.Mutex
+	var slackChannels []string
+	var httpEvents []string
+	fallback.httpClient = &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			defer req.Body.Close()
+			body, _ := io.ReadAll(req.Body)
+			var msg struct {
+				Channel string `json:"channel"`
+			}
+			_ = json.Unmarshal(body, &msg)
+			mu.Lock()
+			slackChannels = append(slackChannels, msg.Channel)
+			mu.Unlock()
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"ok":true,"ts":"1.1"}`)), Header: make(http.Header)}, nil
+		}),
+	}
+	impl.httpClient = &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			defer req.Body.Close()
+			body, _ := io.ReadAll(req.Body)
+			var payload struct {
+				EventType string `json:"event_type"`
+			}
+			_ = json.Unmarshal(body, &payload)
+			httpEvents = append(httpEvents, payload.EventType)
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("ok")), Header: make(http.Header)}, nil
+		}),
+	}
+
+	escalator, ok := n.(EscalationNotifier)
+	if !ok {
+		t.Fatal("routing notifier does not implement EscalationNotifier")
+	}
+	err := escalator.NotifyEscalationTargets(
+		IncidentEscalatedEvent{IncidentID: "INC-1", Title: "Ongoing", Severity: "critical", Level: 2, LevelCount: 3},
+		[]model.EscalationTarget{
+			{Type: model.EscalationTargetWebhook, WebhookConfigID: 3},
+			{Type: model.EscalationTargetUser, User: "U123"},
+			{Type: model.EscalationTargetChannel, Channel: "C456"},
+			{Type: model.EscalationTargetWebhook, WebhookConfigID: 99},
+		},
+	)
+	if err == nil || !strings.Contains(err.Error(), "webhook:99") {
+		t.Fatalf("NotifyEscalationTargets() error = %v; want missing webhook:99 error", err)
+	}
+	if len(httpEvents) != 1 || httpEvents[0] != NotifierEventIncidentEscalated {
+		t.Fatalf("http events = %v; want one %s", httpEvents, NotifierEventIncidentEscalated)
+	}
+	if len(slackChannels) != 2 || slackChannels[0] != "U123" || slackChannels[1] != "C456" {
+		t.Fatalf("slack channels = %v; want [U123 C456]", slackChannels)
+	}
+}
+
+func TestRouteWebhookConfigs(t *testing.T) {
+	payments := model.LabelMatchers{{Name: "namespace", Value: "payments", IsEqual: true}}
+	prod := model.LabelMatchers{{Name: "cluster", Value: "prod-.*", IsRegex: true, IsEqual: true}}
+	notInfo := model.LabelMatchers{{Name: "severity", Value: "info", IsEqual: false}}
+	configs := []model.WebhookConfig{
+		{ID: 5, Name: "catch-all", Enabled: true, Priority: 1000},
+		{ID: 4, Name: "audit", Matchers: notInfo, Enabled: true, Priority: 10, Continue: true},
+		{ID: 1, Name: "payments", Matchers: payments, Enabled: true, Priority: 20},
+		{ID: 2, Name: "prod-teams", Matchers: prod, Enabled: true, Priority: 30},
+		{ID: 3, Name: "disabled", Matchers: payments, Enabled: false, Priority: 0},
+		{ID: 6, Name: "catch-all-2", Enabled: true, Priority: 1000},
+	}
+
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   []int
+	}{
+		{name: "continue then first stop", labels: map[string]string{"namespace": "payments", "cluster": "prod-1", "severity": "critical"}, want: []int{4, 1}},
+		{name: "regex match", labels: map[string]string{"namespace": "shop", "cluster": "prod-eu", "severity": "warning"}, want: []int{4, 2}},
+		{name: "continue rule alone keeps catch-all away", labels: map[string]string{"namespace": "shop", "severity": "warning"}, want: []int{4}},
+		{name: "unmatched goes to first catch-all", labels: map[string]string{"namespace": "shop", "severity": "info"}, want: []int{5}},
+		{name: "event without labels goes everywhere enabled", labels: nil, want: []int{4, 1, 2, 5, 6}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for _, cfg := range routeWebhookConfigs(configs, tt.labels) {
+				got = append(got, cfg.ID)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("routed config ids = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookRoutingNotifier_SendsHTTPPayloadTemplate(t *testing.T) {
+	repo := webhookConfigRepoStub{
+		configs: []model.WebhookConfig{
+			{
+				ID:              1,
+				Type:            "teams",
+				URL:             "https://example.com/teams",
+				Enabled:         true,
+				PayloadTemplate: `{"text":"{{ upper .Status }} {{ label "alertname" }} {{ incidentURL }}"}`,
+			},
+		},
+	}
+	fallback := &fallbackNotifierStub{}
+	n := NewWebhookRoutingNotifier(repo, fallback, fallback, "https://rca.example.com")
+	impl := n.(*webhookRoutingNotifier)
+	var body string
+	impl.httpClient = &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			b, _ := io.ReadAll(req.Body)
+			body = string(b)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader("ok")),
+				Header:     make(http.Header),
+			}, nil
+		}),
+	}
+
+	err := n.Notify(AlertStatusChangedEvent{
+		Alert:      model.Alert{Status: "firing", Labels: map[string]string{"alertname": "DiskFull"}},
+		IncidentID: "INC-9",
+	})
+	if err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	if want := `{"text":"FIRING DiskFull https://rca.example.com/incidents/INC-9"}`; body != want {
+		t.Fatalf("payload = %s, want %s", body, want)
+	}
+}
@@ -17,6 +17,16 @@ type Config struct {
 	Flapping  FlappingConfig
 	AI        AIConfig
 	Analysis  AnalysisConfig
+
+	WebhookAuth  WebhookAuthConfig
+	WebhookInbox WebhookInboxConfig
+	Outbox       NotificationOutboxConfig
+	Attempts     NotificationAttemptConfig
+	KubeEvent    KubeEventConfig
+	AlertDedupe  AlertDedupeConfig
+	Alertmanager AlertmanagerConfig
+	ScheduledJob ScheduledJobConfig
+	Reminder     AlertReminderConfig
 }
 
 type SlackConfig struct {
@@ -92,6 +102,90 @@ type AnalysisConfig struct {
 	ManualAnalyzeSeverities string // comma-separated severities requiring manual analysis, empty = all auto
 }
 
+// WebhookAuthConfig - 인바운드 웹훅(/webhook/*) 인증 설정
+// Credentials 형식: JSON 배열 (secret에 콤마/콜론이 있어도 안전, 권장)
+//
+//	[{"name":"am-prod","type":"bearer","secret":"<token>"},
+//	 {"name":"am-stg","type":"basic","username":"<username>","password":"<password>"},
+//	 {"name":"grafana","type":"hmac","secret":"<secret>"}]
+//
+// 기존 "name:type:secret" 콤마 구분 형식도 허용 (secret에 콤마가 없을 때만)
+// CredentialsFile이 있으면 파일 내용(JSON 배열)을 사용 (Kubernetes Secret 마운트용, Credentials와 동시 설정 불가)
+//
+// 둘 다 비어 있으면 인증 없이 수신 (하위 호환)
+type WebhookAuthConfig struct {
+	Credentials         string
+	CredentialsFile     string
+	SignatureHeader     string
+	TimestampHeader     string
+	MaxClockSkewSeconds int
+}
+
+// WebhookInboxConfig - 수신 웹훅 inbox 비동기 처리 설정
+type WebhookInboxConfig struct {
+	Workers              int
+	MaxAttempts          int
+	RetryBaseBackoffSecs int
+	RetryMaxBackoffSecs  int
+	PollIntervalSecs     int
+	StaleLockSeconds     int
+	RetentionDays        int // 처리 완료(done) entry 보관 기간 (0 = 삭제 안 함)
+}
+
+// NotificationOutboxConfig - 알림 outbox 재시도/보관 설정
+type NotificationOutboxConfig struct {
+	MaxAttempts          int
+	RetryBaseBackoffSecs int
+	RetryMaxBackoffSecs  int
+	PollIntervalSecs     int
+	StaleLockSeconds     int
+	RetentionDays        int // 전송 완료 entry 보관 기간 (0 = 삭제 안 함)
+}
+
+// NotificationAttemptConfig - 알림 전송 시도 기록 보관 설정
+type NotificationAttemptConfig struct {
+	RetentionDays int // 0 = 삭제 안 함
+}
+
+// ScheduledJobConfig - 예약 작업(scheduled_jobs) worker 설정
+type ScheduledJobConfig struct {
+	PollIntervalSecs int
+	StaleLockSeconds int
+	MaxAttempts      int
+	RetryBackoffSecs int
+	RetentionDays    int // 완료(done)/취소(cancelled) 작업 보관 기간 (0 = 삭제 안 함)
+}
+
+// AlertReminderConfig - 장시간 firing 재알림 ticker 설정 (간격 자체는 severity 레벨별 reminderMinutes, 0 = ticker 비활성)
+type AlertReminderConfig struct {
+	PollIntervalSecs int
+}
+
+// KubeEventConfig - Kubernetes Event 수집 설정
+// Event에는 해결 신호가 없으므로 ResolveAfterMinutes 동안 다시 수신되지 않으면 resolved 처리 (0 = 자동 해결 안 함)
+type KubeEventConfig struct {
+	ClusterName          string
+	ResolveAfterMinutes  int
+	SweepIntervalSeconds int
+}
+
+// AlertDedupeConfig - Alertmanager HA 복제본의 중복 전송 제거 설정
+// fingerprint + status + startsAt/endsAt 기준 멱등 키를 WindowSeconds 동안 유지 (0 = 비활성)
+type AlertDedupeConfig struct {
+	WindowSeconds int
+}
+
+// AlertmanagerConfig - Alertmanager v2 API 연동 설정 (truncated/누락 alert 복구)
+// URL 또는 Receiver가 비어 있으면 동기화 비활성. kube-rca receiver(정규식)로 라우팅된 alert만 동기화한다.
+// URLMap은 웹훅 externalURL(브라우저용 주소)을 클러스터 내부 API 주소로 바꾸는 "external=internal,..." 목록 (silence 생성 시 사용, 목록에 없는 주소는 호출하지 않음).
+type AlertmanagerConfig struct {
+	URL                      string
+	URLMap                   string
+	Receiver                 string
+	TimeoutSeconds           int
+	ReconcileIntervalSeconds int
+}
+
 func Load() Config {
 	_ = godotenv.Load()
 	return Config{
@@ -159,6 +253,58 @@ func Load() Config {
 		Analysis: AnalysisConfig{
 			ManualAnalyzeSeverities: os.Getenv("MANUAL_ANALYZE_SEVERITIES"), // empty = all auto (default)
 		},
+		WebhookAuth: WebhookAuthConfig{
+			Credentials:         os.Getenv("WEBHOOK_AUTH_CREDENTIALS"),
+			CredentialsFile:     os.Getenv("WEBHOOK_AUTH_CREDENTIALS_FILE"),
+			SignatureHeader:     getenv("WEBHOOK_AUTH_SIGNATURE_HEADER", "X-KubeRCA-Signature"),
+			TimestampHeader:     getenv("WEBHOOK_AUTH_TIMESTAMP_HEADER", "X-KubeRCA-Timestamp"),
+			MaxClockSkewSeconds: getenvInt("WEBHOOK_AUTH_MAX_CLOCK_SKEW_SECONDS", 300),
+		},
+		WebhookInbox: WebhookInboxConfig{
+			Workers:              getenvInt("WEBHOOK_INBOX_WORKERS", 4),
+			MaxAttempts:          getenvInt("WEBHOOK_INBOX_MAX_ATTEMPTS", 5),
+			RetryBaseBackoffSecs: getenvInt("WEBHOOK_INBOX_RETRY_BASE_BACKOFF_SECONDS", 5),
+			RetryMaxBackoffSecs:  getenvInt("WEBHOOK_INBOX_RETRY_MAX_BACKOFF_SECONDS", 300),
+			PollIntervalSecs:     getenvInt("WEBHOOK_INBOX_POLL_INTERVAL_SECONDS", 2),
+			StaleLockSeconds:     getenvInt("WEBHOOK_INBOX_STALE_LOCK_SECONDS", 300),
+			RetentionDays:        getenvInt("WEBHOOK_INBOX_RETENTION_DAYS", 7),
+		},
+		Outbox: NotificationOutboxConfig{
+			MaxAttempts:          getenvInt("NOTIFICATION_OUTBOX_MAX_ATTEMPTS", 8),
+			RetryBaseBackoffSecs: getenvInt("NOTIFICATION_OUTBOX_RETRY_BASE_BACKOFF_SECONDS", 10),
+			RetryMaxBackoffSecs:  getenvInt("NOTIFICATION_OUTBOX_RETRY_MAX_BACKOFF_SECONDS", 900),
+			PollIntervalSecs:     getenvInt("NOTIFICATION_OUTBOX_POLL_INTERVAL_SECONDS", 5),
+			StaleLockSeconds:     getenvInt("NOTIFICATION_OUTBOX_STALE_LOCK_SECONDS", 300),
+			RetentionDays:        getenvInt("NOTIFICATION_OUTBOX_RETENTION_DAYS", 7),
+		},
+		Attempts: NotificationAttemptConfig{
+			RetentionDays: getenvInt("NOTIFICATION_ATTEMPT_RETENTION_DAYS", 30),
+		},
+		ScheduledJob: ScheduledJobConfig{
+			PollIntervalSecs: getenvInt("SCHEDULED_JOB_POLL_INTERVAL_SECONDS", 5),
+			StaleLockSeconds: getenvInt("SCHEDULED_JOB_STALE_LOCK_SECONDS", 300),
+			MaxAttempts:      getenvInt("SCHEDULED_JOB_MAX_ATTEMPTS", 5),
+			RetryBackoffSecs: getenvInt("SCHEDULED_JOB_RETRY_BACKOFF_SECONDS", 30),
+			RetentionDays:    getenvInt("SCHEDULED_JOB_RETENTION_DAYS", 7),
+		},
+		Reminder: AlertReminderConfig{
+			PollIntervalSecs: getenvInt("ALERT_REMINDER_POLL_INTERVAL_SECONDS", 60),
+		},
+		KubeEvent: KubeEventConfig{
+			ClusterName:          os.Getenv("KUBE_EVENT_CLUSTER_NAME"),
+			ResolveAfterMinutes:  getenvInt("KUBE_EVENT_RESOLVE_AFTER_MINUTES", 30),
+			SweepIntervalSeconds: getenvInt("KUBE_EVENT_SWEEP_INTERVAL_SECONDS", 60),
+		},
+		AlertDedupe: AlertDedupeConfig{
+			WindowSeconds: getenvInt("ALERT_DEDUPE_WINDOW_SECONDS", 120),
+		},
+		Alertmanager: AlertmanagerConfig{
+			URL:                      os.Getenv("ALERTMANAGER_URL"),
+			URLMap:                   os.Getenv("ALERTMANAGER_URL_MAP"),
+			Receiver:                 os.Getenv("ALERTMANAGER_RECEIVER"),
+			TimeoutSeconds:           getenvInt("ALERTMANAGER_TIMEOUT_SECONDS", 10),
+			ReconcileIntervalSeconds: getenvInt("ALERTMANAGER_RECONCILE_INTERVAL_SECONDS", 300),
+		},
 	}
 }
 
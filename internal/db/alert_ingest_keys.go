@@ -0,0 +1,68 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// EnsureAlertIngestKeySchema - alert_ingest_keys 테이블 생성 (Alertmanager HA 중복 수신 방지용 멱등 키)
+func (p *Postgres) EnsureAlertIngestKeySchema() error {
+	queries := []string{
+		`
+		CREATE TABLE IF NOT EXISTS alert_ingest_keys (
+			ingest_key TEXT PRIMARY KEY,
+			received_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)
+		`,
+		`CREATE INDEX IF NOT EXISTS alert_ingest_keys_received_at_idx ON alert_ingest_keys(received_at)`,
+	}
+
+	for _, query := range queries {
+		if _, err := p.Pool.Exec(context.Background(), query); err != nil {
+			return fmt.Errorf("failed to ensure alert_ingest_keys schema: %w", err)
+		}
+	}
+	return nil
+}
+
+// ClaimAlertIngestKey - 멱등 키 점유 (true: 처음 수신, false: window 내 중복)
+// INSERT ... ON CONFLICT 한 문장으로 처리하므로 동시에 도착한 HA 복제본 중 하나만 true를 받는다.
+// window가 지난 키는 다시 점유할 수 있다.
+func (p *Postgres) ClaimAlertIngestKey(key string, window time.Duration) (bool, error) {
+	var claimed string
+	err := p.Pool.QueryRow(context.Background(), `
+		INSERT INTO alert_ingest_keys (ingest_key, received_at)
+		VALUES ($1, NOW())
+		ON CONFLICT (ingest_key) DO UPDATE SET received_at = NOW()
+		WHERE alert_ingest_keys.received_at < NOW() - make_interval(secs => $2)
+		RETURNING ingest_key
+	`, key, window.Seconds()).Scan(&claimed)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to claim alert ingest key: %w", err)
+	}
+	return true, nil
+}
+
+// ReleaseAlertIngestKey - 멱등 키 해제 (저장 실패 시 재시도가 중복으로 버려지지 않도록)
+func (p *Postgres) ReleaseAlertIngestKey(key string) error {
+	if _, err := p.Pool.Exec(context.Background(), `DELETE FROM alert_ingest_keys WHERE ingest_key = $1`, key); err != nil {
+		return fmt.Errorf("failed to release alert ingest key: %w", err)
+	}
+	return nil
+}
+
+// PurgeAlertIngestKeys - before 이전에 수신된 멱등 키 삭제
+func (p *Postgres) PurgeAlertIngestKeys(before time.Time) (int64, error) {
+	tag, err := p.Pool.Exec(context.Background(), `DELETE FROM alert_ingest_keys WHERE received_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge alert ingest keys: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,137 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// EnsureAlertmanagerSilenceSchema - alertmanager_silences 테이블 생성 (kube-rca에서 생성한 Alertmanager silence 기록)
+func (p *Postgres) EnsureAlertmanagerSilenceSchema() error {
+	queries := []string{
+		`
+		CREATE TABLE IF NOT EXISTS alertmanager_silences (
+			id BIGSERIAL PRIMARY KEY,
+			silence_id TEXT NOT NULL,
+			alertmanager_url TEXT NOT NULL DEFAULT '',
+			alert_id TEXT NOT NULL,
+			incident_id TEXT NOT NULL DEFAULT '',
+			matchers JSONB NOT NULL DEFAULT '[]',
+			starts_at TIMESTAMPTZ NOT NULL,
+			ends_at TIMESTAMPTZ NOT NULL,
+			comment TEXT NOT NULL DEFAULT '',
+			created_by TEXT NOT NULL DEFAULT '',
+			expired_at TIMESTAMPTZ,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)
+		`,
+		`CREATE INDEX IF NOT EXISTS alertmanager_silences_alert_idx ON alertmanager_silences(alert_id, ends_at)`,
+		`CREATE INDEX IF NOT EXISTS alertmanager_silences_incident_idx ON alertmanager_silences(incident_id, ends_at)`,
+	}
+
+	for _, query := range queries {
+		if _, err := p.Pool.Exec(context.Background(), query); err != nil {
+			return fmt.Errorf("failed to ensure alertmanager_silences schema: %w", err)
+		}
+	}
+	return nil
+}
+
+const alertmanagerSilenceColumns = `id, silence_id, alertmanager_url, alert_id, incident_id, matchers, starts_at, ends_at, comment, created_by, expired_at, created_at`
+
+func scanAlertmanagerSilence(row pgx.Row) (model.AlertmanagerSilence, error) {
+	var (
+		s        model.AlertmanagerSilence
+		matchers []byte
+	)
+	if err := row.Scan(&s.ID, &s.SilenceID, &s.AlertmanagerURL, &s.AlertID, &s.IncidentID, &matchers, &s.StartsAt, &s.EndsAt, &s.Comment, &s.CreatedBy, &s.ExpiredAt, &s.CreatedAt); err != nil {
+		return s, err
+	}
+	if err := json.Unmarshal(matchers, &s.Matchers); err != nil {
+		return s, fmt.Errorf("failed to decode alertmanager silence matchers (id=%d): %w", s.ID, err)
+	}
+	return s, nil
+}
+
+func (p *Postgres) queryAlertmanagerSilences(ctx context.Context, query string, args ...any) ([]model.AlertmanagerSilence, error) {
+	rows, err := p.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query alertmanager silences: %w", err)
+	}
+	defer rows.Close()
+
+	silences := []model.AlertmanagerSilence{}
+	for rows.Next() {
+		s, err := scanAlertmanagerSilence(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan alertmanager silence: %w", err)
+		}
+		silences = append(silences, s)
+	}
+	return silences, rows.Err()
+}
+
+// CreateAlertmanagerSilence - 생성한 silence 기록 저장
+func (p *Postgres) CreateAlertmanagerSilence(ctx context.Context, s model.AlertmanagerSilence) (int64, error) {
+	matchers, err := json.Marshal(s.Matchers)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode alertmanager silence matchers: %w", err)
+	}
+	var id int64
+	err = p.Pool.QueryRow(ctx, `
+		INSERT INTO alertmanager_silences (silence_id, alertmanager_url, alert_id, incident_id, matchers, starts_at, ends_at, comment, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id
+	`, s.SilenceID, s.AlertmanagerURL, s.AlertID, s.IncidentID, matchers, s.StartsAt, s.EndsAt, s.Comment, s.CreatedBy).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert alertmanager silence: %w", err)
+	}
+	return id, nil
+}
+
+// GetAlertmanagerSilence - ID로 단건 조회 (없으면 nil)
+func (p *Postgres) GetAlertmanagerSilence(ctx context.Context, id int64) (*model.AlertmanagerSilence, error) {
+	s, err := scanAlertmanagerSilence(p.Pool.QueryRow(ctx, `SELECT `+alertmanagerSilenceColumns+` FROM alertmanager_silences WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alertmanager silence: %w", err)
+	}
+	return &s, nil
+}
+
+// ListActiveAlertmanagerSilencesByAlert - alert에 대해 at 시점에 유효한 silence 목록
+func (p *Postgres) ListActiveAlertmanagerSilencesByAlert(ctx context.Context, alertID string, at time.Time) ([]model.AlertmanagerSilence, error) {
+	return p.queryAlertmanagerSilences(ctx, `
+		SELECT `+alertmanagerSilenceColumns+` FROM alertmanager_silences
+		WHERE alert_id = $1 AND expired_at IS NULL AND ends_at > $2
+		ORDER BY ends_at
+	`, alertID, at)
+}
+
+// ListActiveAlertmanagerSilencesByIncident - incident에 연결된 alert들의 at 시점에 유효한 silence 목록
+func (p *Postgres) ListActiveAlertmanagerSilencesByIncident(ctx context.Context, incidentID string, at time.Time) ([]model.AlertmanagerSilence, error) {
+	return p.queryAlertmanagerSilences(ctx, `
+		SELECT `+alertmanagerSilenceColumns+` FROM alertmanager_silences
+		WHERE incident_id = $1 AND expired_at IS NULL AND ends_at > $2
+		ORDER BY ends_at
+	`, incidentID, at)
+}
+
+// MarkAlertmanagerSilenceExpired - 조기 만료 시각 기록
+func (p *Postgres) MarkAlertmanagerSilenceExpired(ctx context.Context, id int64, at time.Time) error {
+	tag, err := p.Pool.Exec(ctx, `UPDATE alertmanager_silences SET expired_at = $2 WHERE id = $1 AND expired_at IS NULL`, id, at)
+	if err != nil {
+		return fmt.Errorf("failed to expire alertmanager silence: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("alertmanager silence not found or already expired: id=%d", id)
+	}
+	return nil
+}
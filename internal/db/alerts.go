@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -62,6 +63,45 @@ func (db *Postgres) EnsureAlertSchema() error {
 		`UPDATE alert_state_transitions SET fingerprint = alert_id WHERE fingerprint = ''`,
 		// fingerprint 인덱스
 		`CREATE INDEX IF NOT EXISTS alert_state_transitions_fingerprint_idx ON alert_state_transitions(fingerprint, transitioned_at DESC)`,
+		// Incident 상관관계 매칭 정보 (어떤 이유로 Incident에 연결되었는지)
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS correlation_key TEXT NOT NULL DEFAULT ''`,
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS correlation_reason TEXT NOT NULL DEFAULT ''`,
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS correlation_score DOUBLE PRECISION`,
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS correlation_detail TEXT NOT NULL DEFAULT ''`,
+		// 웹훅 수신 시 인증에 사용된 credential 이름
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS source_credential TEXT NOT NULL DEFAULT ''`,
+		// 알림 수신 경로 (alertmanager, grafana, pagerduty, generic, kubernetes)
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS source TEXT NOT NULL DEFAULT 'alertmanager'`,
+		// 마지막으로 알림 소스에서 수신된 시각 (Kubernetes Event 등 해결 이벤트가 없는 소스의 자동 해결 판단용)
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS last_seen_at TIMESTAMPTZ`,
+		`CREATE INDEX IF NOT EXISTS alerts_source_firing_idx ON alerts(source, last_seen_at) WHERE status = 'firing'`,
+		// 알림/자동 분석을 음소거한 silence 규칙 ID (silence_rules.id, 규칙 삭제 후에도 기록 유지)
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS silenced_by BIGINT`,
+		// 점검 시간대(maintenance_windows.id)에 수신된 alert (알림/분석 스킵, analytics MTTR 제외)
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS in_maintenance BOOLEAN NOT NULL DEFAULT FALSE`,
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS maintenance_window_id BIGINT`,
+		// 억제(inhibition) 규칙에 의해 알림/분석이 스킵된 경우 억제한 source alert_id와 규칙 ID
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS inhibited_by TEXT`,
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS inhibition_rule_id BIGINT`,
+		// 웹훅을 보낸 Alertmanager의 externalURL (kube-rca에서 silence 생성 시 호출 대상)
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS external_url TEXT NOT NULL DEFAULT ''`,
+		// enrichment 파이프라인이 추가한 라벨/annotation과 출처 (model.AlertEnrichment 목록)
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS enrichments JSONB NOT NULL DEFAULT '[]'`,
+		// Kubernetes Event 자동 해결 sweeper가 resolved를 enqueue한 시각 (replica 간 중복 enqueue 방지, Event 재수신 시 해제)
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS resolve_claimed_at TIMESTAMPTZ`,
+		// severity 정규화/enrichment 전 수신 라벨 원본 (Alertmanager silence 매처, NULL = 컬럼 추가 전 저장된 alert)
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS source_labels JSONB`,
+		// 서비스 카탈로그(services.id)에서 매칭된 소유 서비스
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS service_id BIGINT`,
+		// flapping 판정에 적용된 정책(flapping_policies.id, NULL = 전역 설정)
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS flapping_policy_id BIGINT`,
+		// 장시간 firing 재알림 마지막 전송 시각과 횟수
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS last_reminder_at TIMESTAMPTZ`,
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS reminder_count INT NOT NULL DEFAULT 0`,
+		// 확인(acknowledge) 시각/사용자와 fired_at → 확인까지 걸린 시간 (MTTA)
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS acknowledged_at TIMESTAMPTZ`,
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS acknowledged_by TEXT NOT NULL DEFAULT ''`,
+		`ALTER TABLE alerts ADD COLUMN IF NOT EXISTS time_to_ack_seconds BIGINT`,
 	}
 
 	for _, query := range queries {
@@ -77,41 +117,104 @@ func (db *Postgres) EnsureAlertSchema() error {
 // 원자적 COALESCE 서브쿼리 + RETURNING으로 TOCTOU race condition 최소화
 // 반환: 생성/업데이트된 alertID
 func (db *Postgres) SaveAlert(alert model.Alert, incidentID string) (string, error) {
-	alertID, err := db.saveAlertInner(alert, incidentID)
+	alertID, _, err := db.SaveAlertWithOutbox(alert, incidentID, nil)
+	return alertID, err
+}
+
+// SaveAlertWithOutbox - alert 저장과 알림 outbox entry 기록을 한 트랜잭션으로 처리
+// entry의 alert_id가 비어 있으면 저장된 alertID로 채운다 (firing root 알림은 저장 전에 alertID를 알 수 없음).
+// 반환: 생성/업데이트된 alertID, 기록된 outbox entry ID
+func (db *Postgres) SaveAlertWithOutbox(alert model.Alert, incidentID string, entries []model.NotificationOutboxEntry) (string, []int64, error) {
+	alertID, outboxIDs, err := db.saveAlertTx(alert, incidentID, entries)
 	if err != nil {
 		// Retry once: concurrent insert로 partial unique index 위반 시
 		// 재시도하면 COALESCE가 방금 생성된 firing row를 찾아서 UPDATE
-		alertID, err = db.saveAlertInner(alert, incidentID)
+		alertID, outboxIDs, err = db.saveAlertTx(alert, incidentID, entries)
 	}
-	return alertID, err
+	return alertID, outboxIDs, err
 }
 
-func (db *Postgres) saveAlertInner(alert model.Alert, incidentID string) (string, error) {
+func (db *Postgres) saveAlertTx(alert model.Alert, incidentID string, entries []model.NotificationOutboxEntry) (string, []int64, error) {
+	ctx := context.Background()
+	if len(entries) == 0 {
+		alertID, err := db.saveAlertInner(ctx, db.Pool, alert, incidentID)
+		return alertID, nil, err
+	}
+
+	tx, err := db.Pool.Begin(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	alertID, err := db.saveAlertInner(ctx, tx, alert, incidentID)
+	if err != nil {
+		return "", nil, err
+	}
+	outboxIDs := make([]int64, 0, len(entries))
+	for _, entry := range entries {
+		if entry.AlertID == "" {
+			entry.AlertID = alertID
+		}
+		id, err := insertNotificationOutboxEntry(ctx, tx, entry)
+		if err != nil {
+			return "", nil, err
+		}
+		outboxIDs = append(outboxIDs, id)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return "", nil, err
+	}
+	return alertID, outboxIDs, nil
+}
+
+func (db *Postgres) saveAlertInner(ctx context.Context, q rowQuerier, alert model.Alert, incidentID string) (string, error) {
 	alertName := alert.Labels["alertname"]
 	severity := alert.Labels["severity"]
 	if severity == "" {
 		severity = "warning"
 	}
+	source := alert.Source
+	if source == "" {
+		source = model.AlertSourceAlertmanager
+	}
 
 	var incidentIDPtr *string
 	if incidentID != "" {
 		incidentIDPtr = &incidentID
 	}
 
+	enrichments := alert.Enrichments
+	if enrichments == nil {
+		enrichments = []model.AlertEnrichment{}
+	}
+	enrichmentsJSON, err := json.Marshal(enrichments)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode alert enrichments: %w", err)
+	}
+
+	var sourceLabels any
+	if alert.SourceLabels != nil {
+		sourceLabels = alert.SourceLabels
+	}
+
 	newUUID := "ALR-" + uuid.New().String()[:8]
 
 	// 원자적 COALESCE: 동일 fingerprint + firing alert가 있으면 그 ID 재사용, 없으면 새 UUID
 	query := `
 		INSERT INTO alerts (
 			alert_id, incident_id, alarm_title, severity, status, fired_at,
-			fingerprint, labels, annotations, created_at, updated_at
+			fingerprint, labels, annotations, source_credential, source, external_url, enrichments, source_labels,
+			last_seen_at, created_at, updated_at
 		)
 		VALUES (
 			COALESCE(
 				(SELECT alert_id FROM alerts WHERE fingerprint = $7 AND status = 'firing' LIMIT 1),
 				$1
 			),
-			$2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW()
+			$2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, NOW(), NOW(), NOW()
 		)
 		ON CONFLICT (alert_id) DO UPDATE SET
 			incident_id = COALESCE(EXCLUDED.incident_id, alerts.incident_id),
@@ -120,12 +223,18 @@ func (db *Postgres) saveAlertInner(alert model.Alert, incidentID string) (string
 			status = EXCLUDED.status,
 			labels = EXCLUDED.labels,
 			annotations = EXCLUDED.annotations,
+			source_credential = COALESCE(NULLIF(EXCLUDED.source_credential, ''), alerts.source_credential),
+			source = EXCLUDED.source,
+			external_url = COALESCE(NULLIF(EXCLUDED.external_url, ''), alerts.external_url),
+			enrichments = EXCLUDED.enrichments,
+			source_labels = COALESCE(EXCLUDED.source_labels, alerts.source_labels),
+			last_seen_at = NOW(),
 			updated_at = NOW()
 		RETURNING alert_id
 	`
 
 	var alertID string
-	err := db.Pool.QueryRow(context.Background(), query,
+	err = q.QueryRow(ctx, query,
 		newUUID,           // $1 (fallback UUID)
 		incidentIDPtr,     // $2
 		alertName,         // $3
@@ -135,6 +244,11 @@ func (db *Postgres) saveAlertInner(alert model.Alert, incidentID string) (string
 		alert.Fingerprint, // $7
 		alert.Labels,      // $8
 		alert.Annotations, // $9
+		alert.Credential,  // $10
+		source,            // $11
+		alert.ExternalURL, // $12
+		enrichmentsJSON,   // $13
+		sourceLabels,      // $14
 	).Scan(&alertID)
 	return alertID, err
 }
@@ -151,6 +265,103 @@ func (db *Postgres) GetFiringAlertByFingerprint(fingerprint string) (string, err
 	return alertID, nil
 }
 
+// GetAlertSourceLabels - severity 정규화/enrichment 전 수신 라벨 원본 조회 (기록되지 않은 이전 alert는 nil)
+func (db *Postgres) GetAlertSourceLabels(alertID string) (map[string]string, error) {
+	query := `SELECT source_labels FROM alerts WHERE alert_id = $1`
+
+	var labels map[string]string
+	err := db.Pool.QueryRow(context.Background(), query, alertID).Scan(&labels)
+	if err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// GetFiringAlertSuppression - fingerprint 기준 firing alert의 알림이 억제된 이유 조회 (억제되지 않았으면 빈 문자열)
+func (db *Postgres) GetFiringAlertSuppression(fingerprint string) (string, error) {
+	query := `
+		SELECT CASE
+			WHEN silenced_by IS NOT NULL THEN 'silence'
+			WHEN in_maintenance THEN 'maintenance'
+			WHEN inhibited_by IS NOT NULL THEN 'inhibition'
+			ELSE ''
+		END
+		FROM alerts
+		WHERE fingerprint = $1 AND status = 'firing'
+		LIMIT 1
+	`
+
+	var reason string
+	err := db.Pool.QueryRow(context.Background(), query, fingerprint).Scan(&reason)
+	if err != nil {
+		return "", err
+	}
+	return reason, nil
+}
+
+// GetFiringAlertIncidentID - fingerprint 기준 firing alert가 연결된 incident_id 조회 (없으면 빈 문자열)
+func (db *Postgres) GetFiringAlertIncidentID(fingerprint string) (string, error) {
+	query := `
+		SELECT COALESCE(incident_id, '') FROM alerts
+		WHERE fingerprint = $1 AND status = 'firing'
+		LIMIT 1
+	`
+
+	var incidentID string
+	err := db.Pool.QueryRow(context.Background(), query, fingerprint).Scan(&incidentID)
+	if err != nil {
+		if IsNoRows(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return incidentID, nil
+}
+
+// UpdateAlertCorrelation - Alert가 Incident에 연결된 이유 저장
+func (db *Postgres) UpdateAlertCorrelation(alertID string, match model.IncidentMatch) error {
+	query := `
+		UPDATE alerts
+		SET correlation_key = $2, correlation_reason = $3, correlation_score = $4, correlation_detail = $5, updated_at = NOW()
+		WHERE alert_id = $1
+	`
+	_, err := db.Pool.Exec(context.Background(), query, alertID, match.CorrelationKey, match.Reason, match.Score, match.Detail)
+	return err
+}
+
+// UpdateAlertSilence - firing Alert를 음소거한 silence 규칙 기록 (nil이면 해제, resolved에서는 호출하지 않음)
+func (db *Postgres) UpdateAlertSilence(alertID string, silenceID *int64) error {
+	query := `
+		UPDATE alerts
+		SET silenced_by = $2, updated_at = NOW()
+		WHERE alert_id = $1 AND silenced_by IS DISTINCT FROM $2
+	`
+	_, err := db.Pool.Exec(context.Background(), query, alertID, silenceID)
+	return err
+}
+
+// UpdateAlertMaintenance - firing Alert가 수신된 점검 시간대 기록 (nil이면 점검 아님, resolved에서는 호출하지 않음)
+func (db *Postgres) UpdateAlertMaintenance(alertID string, windowID *int64) error {
+	query := `
+		UPDATE alerts
+		SET in_maintenance = $2::BIGINT IS NOT NULL, maintenance_window_id = $2, updated_at = NOW()
+		WHERE alert_id = $1 AND maintenance_window_id IS DISTINCT FROM $2
+	`
+	_, err := db.Pool.Exec(context.Background(), query, alertID, windowID)
+	return err
+}
+
+// UpdateAlertInhibition - firing Alert를 억제한 source alert와 규칙 기록 (nil이면 해제, resolved에서는 호출하지 않음)
+func (db *Postgres) UpdateAlertInhibition(alertID string, inhibitedBy *string, ruleID *int64) error {
+	query := `
+		UPDATE alerts
+		SET inhibited_by = $2, inhibition_rule_id = $3, updated_at = NOW()
+		WHERE alert_id = $1 AND (inhibited_by IS DISTINCT FROM $2 OR inhibition_rule_id IS DISTINCT FROM $3)
+	`
+	_, err := db.Pool.Exec(context.Background(), query, alertID, inhibitedBy, ruleID)
+	return err
+}
+
 // GetLatestAlertByFingerprint - fingerprint 기준 최신 alert 조회
 func (db *Postgres) GetLatestAlertByFingerprint(fingerprint string) (*model.AlertDetailResponse, error) {
 	query := `
@@ -193,7 +404,8 @@ func (db *Postgres) GetLatestAlertByFingerprint(fingerprint string) (*model.Aler
 // GetAlertList - Alert 목록 조회
 func (db *Postgres) GetAlertList() ([]model.AlertListResponse, error) {
 	query := `
-		SELECT alert_id, incident_id, alarm_title, labels->>'namespace' as namespace, severity, status, fired_at, resolved_at, analysis_summary, labels
+		SELECT alert_id, incident_id, alarm_title, labels->>'namespace' as namespace, severity, status, fired_at, resolved_at, analysis_summary, labels,
+			acknowledged_at IS NOT NULL, acknowledged_at, acknowledged_by, time_to_ack_seconds
 		FROM alerts
 		WHERE is_enabled = TRUE
 		ORDER BY fired_at DESC`
@@ -207,7 +419,8 @@ func (db *Postgres) GetAlertList() ([]model.AlertListResponse, error) {
 	var list []model.AlertListResponse
 	for rows.Next() {
 		var a model.AlertListResponse
-		if err := rows.Scan(&a.AlertID, &a.IncidentID, &a.AlarmTitle, &a.Namespace, &a.Severity, &a.Status, &a.FiredAt, &a.ResolvedAt, &a.AnalysisSummary, &a.Labels); err != nil {
+		if err := rows.Scan(&a.AlertID, &a.IncidentID, &a.AlarmTitle, &a.Namespace, &a.Severity, &a.Status, &a.FiredAt, &a.ResolvedAt, &a.AnalysisSummary, &a.Labels,
+			&a.Acknowledged, &a.AcknowledgedAt, &a.AcknowledgedBy, &a.TimeToAckSeconds); err != nil {
 			return nil, err
 		}
 		list = append(list, a)
@@ -222,7 +435,8 @@ func (db *Postgres) GetAlertList() ([]model.AlertListResponse, error) {
 // GetAlertsByIncidentID - 특정 Incident에 속한 Alert 목록 조회
 func (db *Postgres) GetAlertsByIncidentID(incidentID string) ([]model.AlertListResponse, error) {
 	query := `
-		SELECT alert_id, incident_id, alarm_title, severity, status, fired_at, resolved_at, analysis_summary
+		SELECT alert_id, incident_id, alarm_title, severity, status, fired_at, resolved_at, analysis_summary,
+			acknowledged_at IS NOT NULL, acknowledged_at, acknowledged_by, time_to_ack_seconds
 		FROM alerts
 		WHERE incident_id = $1 AND is_enabled = TRUE
 		ORDER BY fired_at DESC`
@@ -236,7 +450,8 @@ func (db *Postgres) GetAlertsByIncidentID(incidentID string) ([]model.AlertListR
 	var list []model.AlertListResponse
 	for rows.Next() {
 		var a model.AlertListResponse
-		if err := rows.Scan(&a.AlertID, &a.IncidentID, &a.AlarmTitle, &a.Severity, &a.Status, &a.FiredAt, &a.ResolvedAt, &a.AnalysisSummary); err != nil {
+		if err := rows.Scan(&a.AlertID, &a.IncidentID, &a.AlarmTitle, &a.Severity, &a.Status, &a.FiredAt, &a.ResolvedAt, &a.AnalysisSummary,
+			&a.Acknowledged, &a.AcknowledgedAt, &a.AcknowledgedBy, &a.TimeToAckSeconds); err != nil {
 			return nil, err
 		}
 		list = append(list, a)
@@ -293,7 +508,13 @@ func (db *Postgres) GetAlertDetail(alertID string) (*model.AlertDetailResponse,
 			alert_id, incident_id, alarm_title, severity, status,
 			fired_at, resolved_at, analysis_summary, analysis_detail,
 			fingerprint, thread_ts, labels, annotations,
-			is_flapping, flap_cycle_count, flap_window_start
+			is_flapping, flap_cycle_count, flap_window_start,
+			correlation_key, correlation_reason, correlation_score, correlation_detail,
+			source_credential, source, silenced_by,
+			in_maintenance, maintenance_window_id,
+			inhibited_by, inhibition_rule_id, external_url, enrichments, service_id,
+			flapping_policy_id, last_reminder_at, reminder_count,
+			acknowledged_at IS NOT NULL, acknowledged_at, acknowledged_by, time_to_ack_seconds
 		FROM alerts
 		WHERE alert_id = $1
 	`
@@ -316,6 +537,27 @@ func (db *Postgres) GetAlertDetail(alertID string) (*model.AlertDetailResponse,
 		&a.IsFlapping,
 		&a.FlapCycleCount,
 		&a.FlapWindowStart,
+		&a.CorrelationKey,
+		&a.CorrelationReason,
+		&a.CorrelationScore,
+		&a.CorrelationDetail,
+		&a.SourceCredential,
+		&a.Source,
+		&a.SilencedBy,
+		&a.InMaintenance,
+		&a.MaintenanceWindowID,
+		&a.InhibitedBy,
+		&a.InhibitionRuleID,
+		&a.ExternalURL,
+		&a.Enrichments,
+		&a.ServiceID,
+		&a.FlappingPolicyID,
+		&a.LastReminderAt,
+		&a.ReminderCount,
+		&a.Acknowledged,
+		&a.AcknowledgedAt,
+		&a.AcknowledgedBy,
+		&a.TimeToAckSeconds,
 	)
 
 	if err != nil {
@@ -331,7 +573,13 @@ func (db *Postgres) GetAlertDetailInsensitive(alertID string) (*model.AlertDetai
 			alert_id, incident_id, alarm_title, severity, status,
 			fired_at, resolved_at, analysis_summary, analysis_detail,
 			fingerprint, thread_ts, labels, annotations,
-			is_flapping, flap_cycle_count, flap_window_start
+			is_flapping, flap_cycle_count, flap_window_start,
+			correlation_key, correlation_reason, correlation_score, correlation_detail,
+			source_credential, source, silenced_by,
+			in_maintenance, maintenance_window_id,
+			inhibited_by, inhibition_rule_id, external_url, enrichments, service_id,
+			flapping_policy_id, last_reminder_at, reminder_count,
+			acknowledged_at IS NOT NULL, acknowledged_at, acknowledged_by, time_to_ack_seconds
 		FROM alerts
 		WHERE lower(alert_id) = lower($1)
 		LIMIT 1
@@ -355,6 +603,27 @@ func (db *Postgres) GetAlertDetailInsensitive(alertID string) (*model.AlertDetai
 		&a.IsFlapping,
 		&a.FlapCycleCount,
 		&a.FlapWindowStart,
+		&a.CorrelationKey,
+		&a.CorrelationReason,
+		&a.CorrelationScore,
+		&a.CorrelationDetail,
+		&a.SourceCredential,
+		&a.Source,
+		&a.SilencedBy,
+		&a.InMaintenance,
+		&a.MaintenanceWindowID,
+		&a.InhibitedBy,
+		&a.InhibitionRuleID,
+		&a.ExternalURL,
+		&a.Enrichments,
+		&a.ServiceID,
+		&a.FlappingPolicyID,
+		&a.LastReminderAt,
+		&a.ReminderCount,
+		&a.Acknowledged,
+		&a.AcknowledgedAt,
+		&a.AcknowledgedBy,
+		&a.TimeToAckSeconds,
 	)
 	if err != nil {
 		return nil, err
@@ -553,6 +822,39 @@ func (db *Postgres) MarkAlertAsFlapping(fingerprint string, isFlapping bool, cyc
 	return err
 }
 
+// ListFlappingClearanceCandidates - 최신 alert가 resolved인데 flapping 상태이고 pending clearance 작업이 없는 fingerprint 목록
+func (db *Postgres) ListFlappingClearanceCandidates() ([]model.FlappingClearancePayload, error) {
+	rows, err := db.Pool.Query(context.Background(), `
+		SELECT latest.fingerprint, latest.resolved_at
+		FROM (
+			SELECT DISTINCT ON (fingerprint) fingerprint, status, is_flapping, resolved_at
+			FROM alerts
+			ORDER BY fingerprint, fired_at DESC
+		) latest
+		WHERE latest.is_flapping = TRUE
+		  AND latest.status = 'resolved'
+		  AND latest.resolved_at IS NOT NULL
+		  AND NOT EXISTS (
+		      SELECT 1 FROM scheduled_jobs j
+		      WHERE j.job_type = $1 AND j.job_key = latest.fingerprint AND j.status IN ('pending', 'running')
+		  )
+	`, model.ScheduledJobTypeFlappingClearance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list flapping clearance candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var list []model.FlappingClearancePayload
+	for rows.Next() {
+		var c model.FlappingClearancePayload
+		if err := rows.Scan(&c.Fingerprint, &c.ResolvedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan flapping clearance candidate: %w", err)
+		}
+		list = append(list, c)
+	}
+	return list, rows.Err()
+}
+
 // UpdateFlappingCycleCount - fingerprint 기준 firing alert의 Flapping cycle 수 업데이트
 func (db *Postgres) UpdateFlappingCycleCount(fingerprint string, cycleCount int) error {
 	query := `
@@ -597,3 +899,192 @@ func (db *Postgres) ManualResolveAlert(alertID string) error {
 	}
 	return nil
 }
+
+// TouchFiringAlert - firing 중인 alert의 last_seen_at과 annotations 갱신 (반복 수신 중복 제거용)
+// firing alert가 없으면 false
+func (db *Postgres) TouchFiringAlert(fingerprint string, annotations map[string]string) (bool, error) {
+	query := `
+		UPDATE alerts
+		SET annotations = annotations || $2::jsonb, last_seen_at = NOW(), resolve_claimed_at = NULL, updated_at = NOW()
+		WHERE fingerprint = $1 AND status = 'firing'
+	`
+	tag, err := db.Pool.Exec(context.Background(), query, fingerprint, annotations)
+	if err != nil {
+		return false, fmt.Errorf("failed to touch firing alert: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// ListFiringFingerprints - 현재 firing 중인 alert의 fingerprint 목록 (Alertmanager 동기화 비교용)
+func (db *Postgres) ListFiringFingerprints() ([]string, error) {
+	rows, err := db.Pool.Query(context.Background(), `SELECT DISTINCT fingerprint FROM alerts WHERE status = 'firing'`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list firing fingerprints: %w", err)
+	}
+	defer rows.Close()
+
+	var list []string
+	for rows.Next() {
+		var fp string
+		if err := rows.Scan(&fp); err != nil {
+			return nil, fmt.Errorf("failed to scan firing fingerprint: %w", err)
+		}
+		list = append(list, fp)
+	}
+	return list, rows.Err()
+}
+
+// ListQuietFiringAlerts - 지정한 source의 firing alert 중 quietSince 이후 다시 수신되지 않은 alert 조회
+func (db *Postgres) ListQuietFiringAlerts(source string, quietSince time.Time) ([]model.Alert, error) {
+	query := `
+		SELECT fingerprint, labels, annotations, fired_at, source_credential, external_url
+		FROM alerts
+		WHERE source = $1 AND status = 'firing' AND COALESCE(last_seen_at, updated_at) < $2
+		ORDER BY fired_at
+	`
+	rows, err := db.Pool.Query(context.Background(), query, source, quietSince)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quiet firing alerts: %w", err)
+	}
+	defer rows.Close()
+
+	var list []model.Alert
+	for rows.Next() {
+		a := model.Alert{Status: "firing", Source: source}
+		if err := rows.Scan(&a.Fingerprint, &a.Labels, &a.Annotations, &a.StartsAt, &a.Credential, &a.ExternalURL); err != nil {
+			return nil, fmt.Errorf("failed to scan quiet firing alert: %w", err)
+		}
+		list = append(list, a)
+	}
+	return list, rows.Err()
+}
+
+// ClaimQuietFiringAlerts - quietSince 이후 수신되지 않은 firing alert를 자동 해결 대상으로 점유
+// 조회와 같은 UPDATE에서 resolve_claimed_at을 기록하므로 여러 replica가 동시에 실행해도 alert당 한 곳만 가져간다.
+// claimStaleBefore 이전에 점유된 alert는 resolved가 반영되지 않은 것으로 보고 다시 점유한다.
+func (db *Postgres) ClaimQuietFiringAlerts(source string, quietSince, claimStaleBefore time.Time) ([]model.Alert, error) {
+	query := `
+		UPDATE alerts
+		SET resolve_claimed_at = NOW()
+		WHERE alert_id IN (
+			SELECT alert_id FROM alerts
+			WHERE source = $1 AND status = 'firing' AND COALESCE(last_seen_at, updated_at) < $2
+				AND (resolve_claimed_at IS NULL OR resolve_claimed_at < $3)
+			ORDER BY fired_at
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING fingerprint, labels, annotations, fired_at, source_credential
+	`
+	rows, err := db.Pool.Query(context.Background(), query, source, quietSince, claimStaleBefore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim quiet firing alerts: %w", err)
+	}
+	defer rows.Close()
+
+	var list []model.Alert
+	for rows.Next() {
+		a := model.Alert{Status: "firing", Source: source}
+		if err := rows.Scan(&a.Fingerprint, &a.Labels, &a.Annotations, &a.StartsAt, &a.Credential); err != nil {
+			return nil, fmt.Errorf("failed to scan quiet firing alert: %w", err)
+		}
+		list = append(list, a)
+	}
+	return list, rows.Err()
+}
+
+// ListAlertReminderCandidates - 재알림 대상 firing alert 조회
+// 알림 전송 이력(active delivery)이 있고 확인(acknowledge)되지 않았으며 silence/점검/억제/flapping/kube-rca에서 생성한 활성 Alertmanager silence에 해당하지 않는 alert
+func (db *Postgres) ListAlertReminderCandidates(at time.Time) ([]model.AlertReminderCandidate, error) {
+	query := `
+		SELECT a.alert_id, a.fingerprint, COALESCE(a.incident_id, ''), a.alarm_title, a.severity,
+			a.labels, a.fired_at, a.last_reminder_at, a.reminder_count
+		FROM alerts a
+		WHERE a.status = 'firing'
+			AND a.fired_at IS NOT NULL
+			AND a.silenced_by IS NULL
+			AND a.in_maintenance = FALSE
+			AND a.inhibited_by IS NULL
+			AND a.is_flapping = FALSE
+			AND a.acknowledged_at IS NULL
+			AND EXISTS (
+				SELECT 1 FROM alert_notification_deliveries d
+				WHERE d.alert_id = a.alert_id AND d.is_active = TRUE
+			)
+			AND NOT EXISTS (
+				SELECT 1 FROM alertmanager_silences s
+				WHERE s.alert_id = a.alert_id AND s.expired_at IS NULL AND s.ends_at > $1
+			)
+		ORDER BY a.fired_at
+	`
+	rows, err := db.Pool.Query(context.Background(), query, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list alert reminder candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var list []model.AlertReminderCandidate
+	for rows.Next() {
+		var c model.AlertReminderCandidate
+		if err := rows.Scan(&c.AlertID, &c.Fingerprint, &c.IncidentID, &c.AlarmTitle, &c.Severity,
+			&c.Labels, &c.FiredAt, &c.LastReminderAt, &c.ReminderCount); err != nil {
+			return nil, fmt.Errorf("failed to scan alert reminder candidate: %w", err)
+		}
+		list = append(list, c)
+	}
+	return list, rows.Err()
+}
+
+// ClaimAlertReminder - 재알림 전송 권한 점유 (마지막 재알림(없으면 fired_at)이 dueBefore 이전인 firing alert만)
+// UPDATE 한 문장으로 처리하므로 여러 replica가 동시에 같은 alert를 재알림하지 않는다.
+func (db *Postgres) ClaimAlertReminder(alertID string, at, dueBefore time.Time) (bool, error) {
+	tag, err := db.Pool.Exec(context.Background(), `
+		UPDATE alerts
+		SET last_reminder_at = $2, reminder_count = reminder_count + 1
+		WHERE alert_id = $1 AND status = 'firing' AND COALESCE(last_reminder_at, fired_at) <= $3
+	`, alertID, at, dueBefore)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim alert reminder: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// AcknowledgeAlert - firing alert 확인 처리 (이미 확인되었거나 firing이 아니면 false)
+func (db *Postgres) AcknowledgeAlert(alertID, acknowledgedBy string, at time.Time) (bool, error) {
+	tag, err := db.Pool.Exec(context.Background(), `
+		UPDATE alerts
+		SET acknowledged_at = $3, acknowledged_by = $2,
+			time_to_ack_seconds = GREATEST(0, FLOOR(EXTRACT(EPOCH FROM $3::TIMESTAMPTZ - COALESCE(fired_at, $3::TIMESTAMPTZ))))::BIGINT,
+			updated_at = NOW()
+		WHERE alert_id = $1 AND status = 'firing' AND acknowledged_at IS NULL
+	`, alertID, acknowledgedBy, at)
+	if err != nil {
+		return false, fmt.Errorf("failed to acknowledge alert: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// AcknowledgeIncidentAlerts - Incident에 속한 확인되지 않은 firing alert를 모두 확인 처리 (반환: 확인된 alert ID)
+func (db *Postgres) AcknowledgeIncidentAlerts(incidentID, acknowledgedBy string, at time.Time) ([]string, error) {
+	rows, err := db.Pool.Query(context.Background(), `
+		UPDATE alerts
+		SET acknowledged_at = $3, acknowledged_by = $2,
+			time_to_ack_seconds = GREATEST(0, FLOOR(EXTRACT(EPOCH FROM $3::TIMESTAMPTZ - COALESCE(fired_at, $3::TIMESTAMPTZ))))::BIGINT,
+			updated_at = NOW()
+		WHERE incident_id = $1 AND status = 'firing' AND is_enabled = TRUE AND acknowledged_at IS NULL
+		RETURNING alert_id
+	`, incidentID, acknowledgedBy, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acknowledge incident alerts: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan acknowledged alert: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
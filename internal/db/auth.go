@@ -38,6 +38,8 @@ func (db *Postgres) EnsureAuthSchema(ctx context.Context) error {
 		`ALTER TABLE users ALTER COLUMN password_hash SET DEFAULT ''`,
 		`CREATE UNIQUE INDEX IF NOT EXISTS users_oidc_provider_sub_idx ON users(auth_provider, oidc_sub) WHERE oidc_sub IS NOT NULL`,
 		`CREATE INDEX IF NOT EXISTS users_email_idx ON users(email) WHERE email IS NOT NULL`,
+		// on-call 알림 멘션용 Slack 사용자 ID
+		`ALTER TABLE users ADD COLUMN IF NOT EXISTS slack_user_id TEXT NOT NULL DEFAULT ''`,
 	}
 
 	for _, query := range queries {
@@ -52,7 +54,7 @@ func (db *Postgres) CreateUser(ctx context.Context, loginID, passwordHash string
 	query := `
 		INSERT INTO users (login_id, password_hash, created_at, updated_at)
 		VALUES ($1, $2, NOW(), NOW())
-		RETURNING id, login_id, password_hash, auth_provider, oidc_sub, email, display_name, picture_url, created_at, updated_at
+		RETURNING id, login_id, password_hash, auth_provider, oidc_sub, email, display_name, picture_url, slack_user_id, created_at, updated_at
 	`
 	var user model.User
 	err := db.Pool.QueryRow(ctx, query, loginID, passwordHash).Scan(
@@ -64,6 +66,7 @@ func (db *Postgres) CreateUser(ctx context.Context, loginID, passwordHash string
 		&user.Email,
 		&user.DisplayName,
 		&user.PictureURL,
+		&user.SlackUserID,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -75,7 +78,7 @@ func (db *Postgres) CreateUser(ctx context.Context, loginID, passwordHash string
 
 func (db *Postgres) GetUserByLoginID(ctx context.Context, loginID string) (*model.User, error) {
 	query := `
-		SELECT id, login_id, password_hash, auth_provider, oidc_sub, email, display_name, picture_url, created_at, updated_at
+		SELECT id, login_id, password_hash, auth_provider, oidc_sub, email, display_name, picture_url, slack_user_id, created_at, updated_at
 		FROM users
 		WHERE login_id = $1
 	`
@@ -89,6 +92,7 @@ func (db *Postgres) GetUserByLoginID(ctx context.Context, loginID string) (*mode
 		&user.Email,
 		&user.DisplayName,
 		&user.PictureURL,
+		&user.SlackUserID,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -100,7 +104,7 @@ func (db *Postgres) GetUserByLoginID(ctx context.Context, loginID string) (*mode
 
 func (db *Postgres) GetUserByID(ctx context.Context, userID int64) (*model.User, error) {
 	query := `
-		SELECT id, login_id, password_hash, auth_provider, oidc_sub, email, display_name, picture_url, created_at, updated_at
+		SELECT id, login_id, password_hash, auth_provider, oidc_sub, email, display_name, picture_url, slack_user_id, created_at, updated_at
 		FROM users
 		WHERE id = $1
 	`
@@ -114,6 +118,7 @@ func (db *Postgres) GetUserByID(ctx context.Context, userID int64) (*model.User,
 		&user.Email,
 		&user.DisplayName,
 		&user.PictureURL,
+		&user.SlackUserID,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -192,7 +197,7 @@ func (db *Postgres) RotateRefreshToken(ctx context.Context, oldTokenID int64, us
 
 func (db *Postgres) GetUserByOIDCSub(ctx context.Context, provider, sub string) (*model.User, error) {
 	query := `
-		SELECT id, login_id, password_hash, auth_provider, oidc_sub, email, display_name, picture_url, created_at, updated_at
+		SELECT id, login_id, password_hash, auth_provider, oidc_sub, email, display_name, picture_url, slack_user_id, created_at, updated_at
 		FROM users
 		WHERE auth_provider = $1 AND oidc_sub = $2
 	`
@@ -206,6 +211,7 @@ func (db *Postgres) GetUserByOIDCSub(ctx context.Context, provider, sub string)
 		&user.Email,
 		&user.DisplayName,
 		&user.PictureURL,
+		&user.SlackUserID,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -217,7 +223,7 @@ func (db *Postgres) GetUserByOIDCSub(ctx context.Context, provider, sub string)
 
 func (db *Postgres) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
 	query := `
-		SELECT id, login_id, password_hash, auth_provider, oidc_sub, email, display_name, picture_url, created_at, updated_at
+		SELECT id, login_id, password_hash, auth_provider, oidc_sub, email, display_name, picture_url, slack_user_id, created_at, updated_at
 		FROM users
 		WHERE email = $1
 	`
@@ -231,6 +237,7 @@ func (db *Postgres) GetUserByEmail(ctx context.Context, email string) (*model.Us
 		&user.Email,
 		&user.DisplayName,
 		&user.PictureURL,
+		&user.SlackUserID,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -244,7 +251,7 @@ func (db *Postgres) CreateOIDCUser(ctx context.Context, loginID, provider, sub,
 	query := `
 		INSERT INTO users (login_id, password_hash, auth_provider, oidc_sub, email, display_name, picture_url, created_at, updated_at)
 		VALUES ($1, '', $2, $3, $4, $5, $6, NOW(), NOW())
-		RETURNING id, login_id, password_hash, auth_provider, oidc_sub, email, display_name, picture_url, created_at, updated_at
+		RETURNING id, login_id, password_hash, auth_provider, oidc_sub, email, display_name, picture_url, slack_user_id, created_at, updated_at
 	`
 	var user model.User
 	err := db.Pool.QueryRow(ctx, query, loginID, provider, sub, email, displayName, pictureURL).Scan(
@@ -256,6 +263,7 @@ func (db *Postgres) CreateOIDCUser(ctx context.Context, loginID, provider, sub,
 		&user.Email,
 		&user.DisplayName,
 		&user.PictureURL,
+		&user.SlackUserID,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -0,0 +1,254 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// EnsureEscalationSchema - escalation_policies(다단계 에스컬레이션 정책), incident_escalations(Incident별 진행 상태) 테이블 생성
+func (p *Postgres) EnsureEscalationSchema() error {
+	queries := []string{
+		`
+		CREATE TABLE IF NOT EXISTS escalation_policies (
+			id BIGSERIAL PRIMARY KEY,
+			name TEXT NOT NULL DEFAULT '',
+			matchers JSONB NOT NULL DEFAULT '[]',
+			levels JSONB NOT NULL DEFAULT '[]',
+			enabled BOOLEAN NOT NULL DEFAULT TRUE,
+			comment TEXT NOT NULL DEFAULT '',
+			created_by TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)
+		`,
+		`
+		CREATE TABLE IF NOT EXISTS incident_escalations (
+			incident_id TEXT PRIMARY KEY,
+			policy_id BIGINT NOT NULL,
+			policy_name TEXT NOT NULL DEFAULT '',
+			current_level INT NOT NULL DEFAULT 0,
+			status TEXT NOT NULL DEFAULT 'active',
+			started_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			last_escalated_at TIMESTAMPTZ,
+			next_escalation_at TIMESTAMPTZ,
+			stopped_reason TEXT NOT NULL DEFAULT '',
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)
+		`,
+		`CREATE INDEX IF NOT EXISTS incident_escalations_active_idx ON incident_escalations(next_escalation_at) WHERE status = 'active'`,
+	}
+
+	for _, query := range queries {
+		if _, err := p.Pool.Exec(context.Background(), query); err != nil {
+			return fmt.Errorf("failed to ensure escalation schema: %w", err)
+		}
+	}
+	return nil
+}
+
+const escalationPolicyColumns = `id, name, matchers, levels, enabled, comment, created_by, created_at, updated_at`
+
+func scanEscalationPolicy(row pgx.Row) (model.EscalationPolicy, error) {
+	var (
+		ep       model.EscalationPolicy
+		matchers []byte
+		levels   []byte
+	)
+	if err := row.Scan(&ep.ID, &ep.Name, &matchers, &levels, &ep.Enabled, &ep.Comment, &ep.CreatedBy, &ep.CreatedAt, &ep.UpdatedAt); err != nil {
+		return ep, err
+	}
+	if err := json.Unmarshal(matchers, &ep.Matchers); err != nil {
+		return ep, fmt.Errorf("failed to decode escalation policy matchers (id=%d): %w", ep.ID, err)
+	}
+	if err := json.Unmarshal(levels, &ep.Levels); err != nil {
+		return ep, fmt.Errorf("failed to decode escalation policy levels (id=%d): %w", ep.ID, err)
+	}
+	return ep, nil
+}
+
+func (p *Postgres) queryEscalationPolicies(ctx context.Context, query string, args ...any) ([]model.EscalationPolicy, error) {
+	rows, err := p.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query escalation policies: %w", err)
+	}
+	defer rows.Close()
+
+	policies := []model.EscalationPolicy{}
+	for rows.Next() {
+		ep, err := scanEscalationPolicy(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan escalation policy: %w", err)
+		}
+		policies = append(policies, ep)
+	}
+	return policies, rows.Err()
+}
+
+// ListEscalationPolicies - 에스컬레이션 정책 전체 목록 (이름순)
+func (p *Postgres) ListEscalationPolicies(ctx context.Context) ([]model.EscalationPolicy, error) {
+	return p.queryEscalationPolicies(ctx, `SELECT `+escalationPolicyColumns+` FROM escalation_policies ORDER BY name, id`)
+}
+
+// ListEnabledEscalationPolicies - 활성화된 에스컬레이션 정책 (alert 처리 시 평가용, id순)
+func (p *Postgres) ListEnabledEscalationPolicies() ([]model.EscalationPolicy, error) {
+	return p.queryEscalationPolicies(context.Background(), `SELECT `+escalationPolicyColumns+` FROM escalation_policies WHERE enabled = TRUE ORDER BY id`)
+}
+
+// GetEscalationPolicy - ID로 단건 조회 (없으면 nil)
+func (p *Postgres) GetEscalationPolicy(ctx context.Context, id int64) (*model.EscalationPolicy, error) {
+	ep, err := scanEscalationPolicy(p.Pool.QueryRow(ctx, `SELECT `+escalationPolicyColumns+` FROM escalation_policies WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get escalation policy: %w", err)
+	}
+	return &ep, nil
+}
+
+func encodeEscalationPolicy(ep model.EscalationPolicy) ([]byte, []byte, error) {
+	matchers, err := json.Marshal(ep.Matchers)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode escalation policy matchers: %w", err)
+	}
+	levels, err := json.Marshal(ep.Levels)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode escalation policy levels: %w", err)
+	}
+	return matchers, levels, nil
+}
+
+// CreateEscalationPolicy - 에스컬레이션 정책 저장
+func (p *Postgres) CreateEscalationPolicy(ctx context.Context, ep model.EscalationPolicy) (int64, error) {
+	matchers, levels, err := encodeEscalationPolicy(ep)
+	if err != nil {
+		return 0, err
+	}
+	var id int64
+	err = p.Pool.QueryRow(ctx, `
+		INSERT INTO escalation_policies (name, matchers, levels, enabled, comment, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`, ep.Name, matchers, levels, ep.Enabled, ep.Comment, ep.CreatedBy).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert escalation policy: %w", err)
+	}
+	return id, nil
+}
+
+// UpdateEscalationPolicy - 에스컬레이션 정책 수정 (created_by는 유지, 진행 중인 에스컬레이션은 다음 레벨부터 새 설정 적용)
+func (p *Postgres) UpdateEscalationPolicy(ctx context.Context, id int64, ep model.EscalationPolicy) error {
+	matchers, levels, err := encodeEscalationPolicy(ep)
+	if err != nil {
+		return err
+	}
+	tag, err := p.Pool.Exec(ctx, `
+		UPDATE escalation_policies
+		SET name = $2, matchers = $3, levels = $4, enabled = $5, comment = $6, updated_at = NOW()
+		WHERE id = $1
+	`, id, ep.Name, matchers, levels, ep.Enabled, ep.Comment)
+	if err != nil {
+		return fmt.Errorf("failed to update escalation policy: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("escalation policy not found: id=%d", id)
+	}
+	return nil
+}
+
+// DeleteEscalationPolicy - 에스컬레이션 정책 삭제 (진행 중인 에스컬레이션은 다음 레벨 시점에 cancelled 처리)
+func (p *Postgres) DeleteEscalationPolicy(ctx context.Context, id int64) error {
+	tag, err := p.Pool.Exec(ctx, `DELETE FROM escalation_policies WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete escalation policy: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("escalation policy not found: id=%d", id)
+	}
+	return nil
+}
+
+const incidentEscalationColumns = `incident_id, policy_id, policy_name, current_level, status, started_at, last_escalated_at, next_escalation_at, stopped_reason, updated_at`
+
+func scanIncidentEscalation(row pgx.Row) (model.IncidentEscalation, error) {
+	var e model.IncidentEscalation
+	err := row.Scan(&e.IncidentID, &e.PolicyID, &e.PolicyName, &e.CurrentLevel, &e.Status, &e.StartedAt,
+		&e.LastEscalatedAt, &e.NextEscalationAt, &e.StoppedReason, &e.UpdatedAt)
+	return e, err
+}
+
+// GetIncidentEscalation - Incident 에스컬레이션 상태 조회 (없으면 nil)
+func (p *Postgres) GetIncidentEscalation(ctx context.Context, incidentID string) (*model.IncidentEscalation, error) {
+	e, err := scanIncidentEscalation(p.Pool.QueryRow(ctx, `SELECT `+incidentEscalationColumns+` FROM incident_escalations WHERE incident_id = $1`, incidentID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get incident escalation: %w", err)
+	}
+	return &e, nil
+}
+
+// ListActiveIncidentEscalations - 진행 중인 에스컬레이션 (시작 시 누락된 예약 작업 복구용)
+func (p *Postgres) ListActiveIncidentEscalations(ctx context.Context) ([]model.IncidentEscalation, error) {
+	rows, err := p.Pool.Query(ctx, `SELECT `+incidentEscalationColumns+` FROM incident_escalations WHERE status = 'active' ORDER BY started_at`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active incident escalations: %w", err)
+	}
+	defer rows.Close()
+
+	var escalations []model.IncidentEscalation
+	for rows.Next() {
+		e, err := scanIncidentEscalation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan incident escalation: %w", err)
+		}
+		escalations = append(escalations, e)
+	}
+	return escalations, rows.Err()
+}
+
+// CreateIncidentEscalation - Incident 에스컬레이션 시작 (이미 있으면 false, Incident당 한 번)
+func (p *Postgres) CreateIncidentEscalation(ctx context.Context, incidentID string, policyID int64, policyName string, at time.Time) (bool, error) {
+	tag, err := p.Pool.Exec(ctx, `
+		INSERT INTO incident_escalations (incident_id, policy_id, policy_name, status, started_at, next_escalation_at, updated_at)
+		VALUES ($1, $2, $3, 'active', $4, $4, NOW())
+		ON CONFLICT (incident_id) DO NOTHING
+	`, incidentID, policyID, policyName, at)
+	if err != nil {
+		return false, fmt.Errorf("failed to create incident escalation: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// AdvanceIncidentEscalation - 현재 레벨이 fromLevel인 active 에스컬레이션을 toLevel로 진행 (점유 실패 시 false)
+func (p *Postgres) AdvanceIncidentEscalation(ctx context.Context, incidentID string, fromLevel, toLevel int, at, nextAt time.Time) (bool, error) {
+	tag, err := p.Pool.Exec(ctx, `
+		UPDATE incident_escalations
+		SET current_level = $3, last_escalated_at = $4, next_escalation_at = $5, updated_at = NOW()
+		WHERE incident_id = $1 AND status = 'active' AND current_level = $2
+	`, incidentID, fromLevel, toLevel, at, nextAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to advance incident escalation: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// StopIncidentEscalation - active 에스컬레이션 중단 (status: acknowledged, resolved, exhausted, cancelled)
+func (p *Postgres) StopIncidentEscalation(ctx context.Context, incidentID, status, reason string) (bool, error) {
+	tag, err := p.Pool.Exec(ctx, `
+		UPDATE incident_escalations
+		SET status = $2, stopped_reason = $3, next_escalation_at = NULL, updated_at = NOW()
+		WHERE incident_id = $1 AND status = 'active'
+	`, incidentID, status, reason)
+	if err != nil {
+		return false, fmt.Errorf("failed to stop incident escalation: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
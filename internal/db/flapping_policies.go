@@ -0,0 +1,158 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// EnsureFlappingPolicySchema - flapping_policies 테이블 생성 (라벨 매처별 flapping 감지 정책)
+func (p *Postgres) EnsureFlappingPolicySchema() error {
+	queries := []string{
+		`
+		CREATE TABLE IF NOT EXISTS flapping_policies (
+			id BIGSERIAL PRIMARY KEY,
+			name TEXT NOT NULL DEFAULT '',
+			matchers JSONB NOT NULL DEFAULT '[]',
+			never_flapping BOOLEAN NOT NULL DEFAULT FALSE,
+			detection_window_minutes INT NOT NULL DEFAULT 0,
+			cycle_threshold INT NOT NULL DEFAULT 0,
+			clearance_window_minutes INT NOT NULL DEFAULT 0,
+			enabled BOOLEAN NOT NULL DEFAULT TRUE,
+			comment TEXT NOT NULL DEFAULT '',
+			created_by TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)
+		`,
+	}
+
+	for _, query := range queries {
+		if _, err := p.Pool.Exec(context.Background(), query); err != nil {
+			return fmt.Errorf("failed to ensure flapping_policies schema: %w", err)
+		}
+	}
+	return nil
+}
+
+const flappingPolicyColumns = `id, name, matchers, never_flapping, detection_window_minutes, cycle_threshold, clearance_window_minutes, enabled, comment, created_by, created_at, updated_at`
+
+func scanFlappingPolicy(row pgx.Row) (model.FlappingPolicy, error) {
+	var (
+		fp       model.FlappingPolicy
+		matchers []byte
+	)
+	if err := row.Scan(&fp.ID, &fp.Name, &matchers, &fp.NeverFlapping, &fp.DetectionWindowMinutes, &fp.CycleThreshold,
+		&fp.ClearanceWindowMinutes, &fp.Enabled, &fp.Comment, &fp.CreatedBy, &fp.CreatedAt, &fp.UpdatedAt); err != nil {
+		return fp, err
+	}
+	if err := json.Unmarshal(matchers, &fp.Matchers); err != nil {
+		return fp, fmt.Errorf("failed to decode flapping policy matchers (id=%d): %w", fp.ID, err)
+	}
+	return fp, nil
+}
+
+func (p *Postgres) queryFlappingPolicies(ctx context.Context, query string, args ...any) ([]model.FlappingPolicy, error) {
+	rows, err := p.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query flapping policies: %w", err)
+	}
+	defer rows.Close()
+
+	policies := []model.FlappingPolicy{}
+	for rows.Next() {
+		fp, err := scanFlappingPolicy(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan flapping policy: %w", err)
+		}
+		policies = append(policies, fp)
+	}
+	return policies, rows.Err()
+}
+
+// ListFlappingPolicies - flapping 정책 전체 목록 (이름순)
+func (p *Postgres) ListFlappingPolicies(ctx context.Context) ([]model.FlappingPolicy, error) {
+	return p.queryFlappingPolicies(ctx, `SELECT `+flappingPolicyColumns+` FROM flapping_policies ORDER BY name, id`)
+}
+
+// ListEnabledFlappingPolicies - 활성화된 flapping 정책 (alert 처리 시 평가용, id순)
+func (p *Postgres) ListEnabledFlappingPolicies() ([]model.FlappingPolicy, error) {
+	return p.queryFlappingPolicies(context.Background(), `SELECT `+flappingPolicyColumns+` FROM flapping_policies WHERE enabled = TRUE ORDER BY id`)
+}
+
+// GetFlappingPolicy - ID로 단건 조회 (없으면 nil)
+func (p *Postgres) GetFlappingPolicy(ctx context.Context, id int64) (*model.FlappingPolicy, error) {
+	fp, err := scanFlappingPolicy(p.Pool.QueryRow(ctx, `SELECT `+flappingPolicyColumns+` FROM flapping_policies WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get flapping policy: %w", err)
+	}
+	return &fp, nil
+}
+
+// CreateFlappingPolicy - flapping 정책 저장
+func (p *Postgres) CreateFlappingPolicy(ctx context.Context, fp model.FlappingPolicy) (int64, error) {
+	matchers, err := json.Marshal(fp.Matchers)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode flapping policy matchers: %w", err)
+	}
+	var id int64
+	err = p.Pool.QueryRow(ctx, `
+		INSERT INTO flapping_policies (name, matchers, never_flapping, detection_window_minutes, cycle_threshold, clearance_window_minutes, enabled, comment, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id
+	`, fp.Name, matchers, fp.NeverFlapping, fp.DetectionWindowMinutes, fp.CycleThreshold, fp.ClearanceWindowMinutes, fp.Enabled, fp.Comment, fp.CreatedBy).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert flapping policy: %w", err)
+	}
+	return id, nil
+}
+
+// UpdateFlappingPolicy - flapping 정책 수정 (created_by는 유지)
+func (p *Postgres) UpdateFlappingPolicy(ctx context.Context, id int64, fp model.FlappingPolicy) error {
+	matchers, err := json.Marshal(fp.Matchers)
+	if err != nil {
+		return fmt.Errorf("failed to encode flapping policy matchers: %w", err)
+	}
+	tag, err := p.Pool.Exec(ctx, `
+		UPDATE flapping_policies
+		SET name = $2, matchers = $3, never_flapping = $4, detection_window_minutes = $5, cycle_threshold = $6,
+		    clearance_window_minutes = $7, enabled = $8, comment = $9, updated_at = NOW()
+		WHERE id = $1
+	`, id, fp.Name, matchers, fp.NeverFlapping, fp.DetectionWindowMinutes, fp.CycleThreshold, fp.ClearanceWindowMinutes, fp.Enabled, fp.Comment)
+	if err != nil {
+		return fmt.Errorf("failed to update flapping policy: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("flapping policy not found: id=%d", id)
+	}
+	return nil
+}
+
+// DeleteFlappingPolicy - flapping 정책 삭제 (alerts.flapping_policy_id 기록은 유지)
+func (p *Postgres) DeleteFlappingPolicy(ctx context.Context, id int64) error {
+	tag, err := p.Pool.Exec(ctx, `DELETE FROM flapping_policies WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete flapping policy: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("flapping policy not found: id=%d", id)
+	}
+	return nil
+}
+
+// UpdateAlertFlappingPolicy - alert의 flapping 판정에 적용된 정책 기록 (nil = 전역 설정)
+func (p *Postgres) UpdateAlertFlappingPolicy(alertID string, policyID *int64) error {
+	_, err := p.Pool.Exec(context.Background(), `
+		UPDATE alerts
+		SET flapping_policy_id = $2, updated_at = NOW()
+		WHERE alert_id = $1 AND flapping_policy_id IS DISTINCT FROM $2
+	`, alertID, policyID)
+	return err
+}
@@ -0,0 +1,73 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// EnsureIncidentAuditSchema - incident_audit_events 테이블 생성 (확인/에스컬레이션 등 Incident 감사 기록)
+func (p *Postgres) EnsureIncidentAuditSchema() error {
+	queries := []string{
+		`
+		CREATE TABLE IF NOT EXISTS incident_audit_events (
+			id BIGSERIAL PRIMARY KEY,
+			incident_id TEXT NOT NULL,
+			event_type TEXT NOT NULL,
+			actor TEXT NOT NULL DEFAULT '',
+			message TEXT NOT NULL DEFAULT '',
+			detail JSONB NOT NULL DEFAULT '{}'::jsonb,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)
+		`,
+		`CREATE INDEX IF NOT EXISTS incident_audit_events_incident_idx ON incident_audit_events(incident_id, created_at)`,
+	}
+
+	for _, query := range queries {
+		if _, err := p.Pool.Exec(context.Background(), query); err != nil {
+			return fmt.Errorf("failed to ensure incident_audit_events schema: %w", err)
+		}
+	}
+	return nil
+}
+
+// InsertIncidentAuditEvent - Incident 감사 기록 추가 (detail이 비어 있으면 {})
+func (p *Postgres) InsertIncidentAuditEvent(ctx context.Context, event model.IncidentAuditEvent) error {
+	detail := event.Detail
+	if len(detail) == 0 {
+		detail = []byte("{}")
+	}
+	_, err := p.Pool.Exec(ctx, `
+		INSERT INTO incident_audit_events (incident_id, event_type, actor, message, detail)
+		VALUES ($1, $2, $3, $4, $5)
+	`, event.IncidentID, event.EventType, event.Actor, event.Message, detail)
+	if err != nil {
+		return fmt.Errorf("failed to insert incident audit event: %w", err)
+	}
+	return nil
+}
+
+// ListIncidentAuditEvents - Incident 감사 기록 (시간순)
+func (p *Postgres) ListIncidentAuditEvents(ctx context.Context, incidentID string) ([]model.IncidentAuditEvent, error) {
+	rows, err := p.Pool.Query(ctx, `
+		SELECT id, incident_id, event_type, actor, message, detail, created_at
+		FROM incident_audit_events
+		WHERE incident_id = $1
+		ORDER BY created_at, id
+	`, incidentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list incident audit events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []model.IncidentAuditEvent{}
+	for rows.Next() {
+		var e model.IncidentAuditEvent
+		if err := rows.Scan(&e.ID, &e.IncidentID, &e.EventType, &e.Actor, &e.Message, &e.Detail, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan incident audit event: %w", err)
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/kube-rca/backend/internal/model"
 )
@@ -15,6 +16,11 @@ type Postgres struct {
 	Pool *pgxpool.Pool
 }
 
+// rowQuerier - 커넥션 풀과 트랜잭션에서 같은 쿼리를 실행하기 위한 인터페이스 (pgxpool.Pool, pgx.Tx)
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
 // EnsureIncidentSchema - incidents 테이블 생성 (장애 단위)
 func (db *Postgres) EnsureIncidentSchema() error {
 	queries := []string{
@@ -38,7 +44,40 @@ func (db *Postgres) EnsureIncidentSchema() error {
 		`,
 		`CREATE INDEX IF NOT EXISTS incidents_status_idx ON incidents(status)`,
 		`CREATE INDEX IF NOT EXISTS incidents_fired_at_idx ON incidents(fired_at DESC)`,
-		`CREATE UNIQUE INDEX IF NOT EXISTS incidents_firing_uniq ON incidents(status) WHERE status = 'firing' AND is_enabled = TRUE`,
+		// 상관관계 그룹핑: 그룹핑 키별로 firing Incident가 공존할 수 있도록 전역 unique index 제거
+		`ALTER TABLE incidents ADD COLUMN IF NOT EXISTS correlation_key TEXT NOT NULL DEFAULT ''`,
+		`ALTER TABLE incidents ADD COLUMN IF NOT EXISTS correlation_labels JSONB NOT NULL DEFAULT '{}'`,
+		`ALTER TABLE incidents ADD COLUMN IF NOT EXISTS last_alert_at TIMESTAMPTZ`,
+		// 상관관계 도입 전 생성된 열린 Incident(빈 그룹핑 키)는 마지막 alert 시각을 채워 시간 창이 적용되도록 마이그레이션
+		// (incident 스키마가 alert 스키마보다 먼저 생성되므로 alerts 테이블이 없으면 fired_at으로 채움)
+		`DO $$
+		BEGIN
+			IF to_regclass('alerts') IS NOT NULL THEN
+				UPDATE incidents i SET last_alert_at = COALESCE((SELECT MAX(a.fired_at) FROM alerts a WHERE a.incident_id = i.incident_id), i.fired_at)
+				WHERE i.last_alert_at IS NULL AND i.status = 'firing';
+			ELSE
+				UPDATE incidents SET last_alert_at = fired_at
+				WHERE last_alert_at IS NULL AND status = 'firing';
+			END IF;
+		END
+		$$`,
+		`ALTER TABLE incidents ADD COLUMN IF NOT EXISTS correlation_closed_at TIMESTAMPTZ`,
+		`DROP INDEX IF EXISTS incidents_firing_uniq`,
+		// Partial unique index: 그룹핑 키당 매칭 가능한 firing Incident는 1건만 허용
+		`CREATE UNIQUE INDEX IF NOT EXISTS incidents_firing_correlation_uniq ON incidents(correlation_key) WHERE status = 'firing' AND is_enabled = TRUE AND correlation_closed_at IS NULL`,
+		// severity escalation: 분류 체계 rank 저장 (기존 데이터는 기본 분류 체계 rank로 채움)
+		`ALTER TABLE incidents ADD COLUMN IF NOT EXISTS severity_rank INT`,
+		`UPDATE incidents SET severity_rank = CASE severity WHEN 'critical' THEN 30 WHEN 'warning' THEN 20 WHEN 'info' THEN 10 ELSE 0 END WHERE severity_rank IS NULL AND severity <> 'TBD'`,
+		// 서비스 카탈로그(services.id): Incident에 처음 연결된 alert의 소유 서비스
+		`ALTER TABLE incidents ADD COLUMN IF NOT EXISTS service_id BIGINT`,
+		`CREATE INDEX IF NOT EXISTS incidents_service_idx ON incidents(service_id, fired_at DESC) WHERE service_id IS NOT NULL`,
+		// 확인(acknowledge) 시각/사용자와 fired_at → 확인까지 걸린 시간 (MTTA)
+		`ALTER TABLE incidents ADD COLUMN IF NOT EXISTS acknowledged_at TIMESTAMPTZ`,
+		`ALTER TABLE incidents ADD COLUMN IF NOT EXISTS acknowledged_by TEXT NOT NULL DEFAULT ''`,
+		`ALTER TABLE incidents ADD COLUMN IF NOT EXISTS time_to_ack_seconds BIGINT`,
+		// 발생 시점의 on-call 사용자 (oncall_schedules.id 기준, 최초 기록 유지)
+		`ALTER TABLE incidents ADD COLUMN IF NOT EXISTS oncall_user TEXT NOT NULL DEFAULT ''`,
+		`ALTER TABLE incidents ADD COLUMN IF NOT EXISTS oncall_schedule_id BIGINT`,
 	}
 
 	for _, query := range queries {
@@ -59,11 +98,14 @@ func (db *Postgres) GetIncidentList() ([]model.IncidentListResponse, error) {
 			i.status,
 			i.fired_at,
 			i.resolved_at,
-			COUNT(a.alert_id) as alert_count
+			COUNT(a.alert_id) as alert_count,
+			i.correlation_key,
+			COALESCE(BOOL_AND(a.in_maintenance), FALSE) as in_maintenance,
+			i.acknowledged_at IS NOT NULL, i.acknowledged_at, i.acknowledged_by, i.time_to_ack_seconds
 		FROM incidents i
 		LEFT JOIN alerts a ON i.incident_id = a.incident_id AND a.is_enabled = TRUE
 		WHERE i.is_enabled = TRUE
-		GROUP BY i.incident_id, i.title, i.severity, i.status, i.fired_at, i.resolved_at
+		GROUP BY i.incident_id
 		ORDER BY i.fired_at DESC`
 
 	rows, err := db.Pool.Query(context.Background(), query)
@@ -75,7 +117,8 @@ func (db *Postgres) GetIncidentList() ([]model.IncidentListResponse, error) {
 	var list []model.IncidentListResponse
 	for rows.Next() {
 		var i model.IncidentListResponse
-		if err := rows.Scan(&i.IncidentID, &i.Title, &i.Severity, &i.Status, &i.FiredAt, &i.ResolvedAt, &i.AlertCount); err != nil {
+		if err := rows.Scan(&i.IncidentID, &i.Title, &i.Severity, &i.Status, &i.FiredAt, &i.ResolvedAt, &i.AlertCount, &i.CorrelationKey, &i.InMaintenance,
+			&i.Acknowledged, &i.AcknowledgedAt, &i.AcknowledgedBy, &i.TimeToAckSeconds); err != nil {
 			return nil, err
 		}
 		list = append(list, i)
@@ -97,11 +140,14 @@ func (db *Postgres) GetHiddenIncidentList() ([]model.IncidentListResponse, error
             i.status,
             i.fired_at,
             i.resolved_at,
-            COUNT(a.alert_id) as alert_count
+            COUNT(a.alert_id) as alert_count,
+            i.correlation_key,
+            COALESCE(BOOL_AND(a.in_maintenance), FALSE) as in_maintenance,
+            i.acknowledged_at IS NOT NULL, i.acknowledged_at, i.acknowledged_by, i.time_to_ack_seconds
         FROM incidents i
         LEFT JOIN alerts a ON i.incident_id = a.incident_id AND a.is_enabled = TRUE
         WHERE i.is_enabled = FALSE
-        GROUP BY i.incident_id, i.title, i.severity, i.status, i.fired_at, i.resolved_at
+        GROUP BY i.incident_id
         ORDER BY i.fired_at DESC`
 
 	rows, err := db.Pool.Query(context.Background(), query)
@@ -113,7 +159,8 @@ func (db *Postgres) GetHiddenIncidentList() ([]model.IncidentListResponse, error
 	var list []model.IncidentListResponse
 	for rows.Next() {
 		var i model.IncidentListResponse
-		if err := rows.Scan(&i.IncidentID, &i.Title, &i.Severity, &i.Status, &i.FiredAt, &i.ResolvedAt, &i.AlertCount); err != nil {
+		if err := rows.Scan(&i.IncidentID, &i.Title, &i.Severity, &i.Status, &i.FiredAt, &i.ResolvedAt, &i.AlertCount, &i.CorrelationKey, &i.InMaintenance,
+			&i.Acknowledged, &i.AcknowledgedAt, &i.AcknowledgedBy, &i.TimeToAckSeconds); err != nil {
 			return nil, err
 		}
 		list = append(list, i)
@@ -131,7 +178,9 @@ func (db *Postgres) GetIncidentDetail(id string) (*model.IncidentDetailResponse,
 		SELECT
 			incident_id, title, severity, status,
 			fired_at, resolved_at, analysis_summary, analysis_detail, similar_incidents,
-			created_by, resolved_by
+			created_by, resolved_by, correlation_key,
+			acknowledged_at IS NOT NULL, acknowledged_at, acknowledged_by, time_to_ack_seconds,
+			oncall_user, oncall_schedule_id
 		FROM incidents
 		WHERE incident_id = $1
 	`
@@ -149,6 +198,13 @@ func (db *Postgres) GetIncidentDetail(id string) (*model.IncidentDetailResponse,
 		&i.SimilarIncidents,
 		&i.CreatedBy,
 		&i.ResolvedBy,
+		&i.CorrelationKey,
+		&i.Acknowledged,
+		&i.AcknowledgedAt,
+		&i.AcknowledgedBy,
+		&i.TimeToAckSeconds,
+		&i.OncallUser,
+		&i.OncallScheduleID,
 	)
 
 	if err != nil {
@@ -164,7 +220,9 @@ func (db *Postgres) GetIncidentDetailInsensitive(id string) (*model.IncidentDeta
 		SELECT
 			incident_id, title, severity, status,
 			fired_at, resolved_at, analysis_summary, analysis_detail, similar_incidents,
-			created_by, resolved_by
+			created_by, resolved_by, correlation_key,
+			acknowledged_at IS NOT NULL, acknowledged_at, acknowledged_by, time_to_ack_seconds,
+			oncall_user, oncall_schedule_id
 		FROM incidents
 		WHERE lower(incident_id) = lower($1)
 		LIMIT 1
@@ -183,6 +241,13 @@ func (db *Postgres) GetIncidentDetailInsensitive(id string) (*model.IncidentDeta
 		&i.SimilarIncidents,
 		&i.CreatedBy,
 		&i.ResolvedBy,
+		&i.CorrelationKey,
+		&i.Acknowledged,
+		&i.AcknowledgedAt,
+		&i.AcknowledgedBy,
+		&i.TimeToAckSeconds,
+		&i.OncallUser,
+		&i.OncallScheduleID,
 	)
 	if err != nil {
 		return nil, err
@@ -278,13 +343,30 @@ func (db *Postgres) ResolveIncident(id string, resolvedBy string) error {
 	return nil
 }
 
+// AcknowledgeIncident - firing Incident 확인 처리 (이미 확인되었거나 firing이 아니면 false)
+func (db *Postgres) AcknowledgeIncident(incidentID, acknowledgedBy string, at time.Time) (bool, error) {
+	tag, err := db.Pool.Exec(context.Background(), `
+		UPDATE incidents
+		SET acknowledged_at = $3, acknowledged_by = $2,
+			time_to_ack_seconds = GREATEST(0, FLOOR(EXTRACT(EPOCH FROM $3::TIMESTAMPTZ - fired_at)))::BIGINT,
+			updated_at = NOW()
+		WHERE incident_id = $1 AND status = 'firing' AND acknowledged_at IS NULL
+	`, incidentID, acknowledgedBy, at)
+	if err != nil {
+		return false, fmt.Errorf("failed to acknowledge incident: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
 // GetFiringIncident - 현재 firing 상태인 Incident 조회
 func (db *Postgres) GetFiringIncident() (*model.IncidentDetailResponse, error) {
 	query := `
 		SELECT
 			incident_id, title, severity, status,
 			fired_at, resolved_at, analysis_summary, analysis_detail, similar_incidents,
-			created_by, resolved_by
+			created_by, resolved_by, correlation_key,
+			acknowledged_at IS NOT NULL, acknowledged_at, acknowledged_by, time_to_ack_seconds,
+			oncall_user, oncall_schedule_id
 		FROM incidents
 		WHERE status = 'firing' AND is_enabled = TRUE
 		ORDER BY fired_at DESC
@@ -304,6 +386,13 @@ func (db *Postgres) GetFiringIncident() (*model.IncidentDetailResponse, error) {
 		&i.SimilarIncidents,
 		&i.CreatedBy,
 		&i.ResolvedBy,
+		&i.CorrelationKey,
+		&i.Acknowledged,
+		&i.AcknowledgedAt,
+		&i.AcknowledgedBy,
+		&i.TimeToAckSeconds,
+		&i.OncallUser,
+		&i.OncallScheduleID,
 	)
 
 	if err != nil {
@@ -330,48 +419,105 @@ func (db *Postgres) CreateIncident(title, severity string, firedAt time.Time) (s
 	return incidentID, nil
 }
 
-// GetOrCreateFiringIncident - firing Incident를 원자적으로 조회하거나 생성 (TOCTOU race condition 방지)
-func (db *Postgres) GetOrCreateFiringIncident(title, severity string, firedAt time.Time) (string, bool, error) {
+// ListCorrelationCandidates - 새 Alert를 붙일 수 있는 열린(firing, 매칭 가능) Incident 목록 조회
+func (db *Postgres) ListCorrelationCandidates() ([]model.IncidentCorrelationCandidate, error) {
+	query := `
+		SELECT incident_id, correlation_key, correlation_labels, COALESCE(last_alert_at, fired_at)
+		FROM incidents
+		WHERE status = 'firing' AND is_enabled = TRUE AND correlation_closed_at IS NULL
+		ORDER BY COALESCE(last_alert_at, fired_at) DESC
+	`
+
+	rows, err := db.Pool.Query(context.Background(), query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list correlation candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var list []model.IncidentCorrelationCandidate
+	for rows.Next() {
+		var c model.IncidentCorrelationCandidate
+		if err := rows.Scan(&c.IncidentID, &c.CorrelationKey, &c.CorrelationLabels, &c.LastAlertAt); err != nil {
+			return nil, fmt.Errorf("failed to scan correlation candidate: %w", err)
+		}
+		list = append(list, c)
+	}
+	return list, rows.Err()
+}
+
+// GetOrCreateCorrelatedIncident - 그룹핑 키 기준 firing Incident를 원자적으로 조회하거나 생성 (TOCTOU race condition 방지)
+func (db *Postgres) GetOrCreateCorrelatedIncident(correlationKey, title, severity string, labels map[string]string, firedAt time.Time) (string, bool, error) {
 	incidentID := "INC-" + uuid.New().String()[:8]
+	if labels == nil {
+		labels = map[string]string{}
+	}
 
-	// INSERT 시도 — unique index 덕분에 firing incident가 이미 있으면 ON CONFLICT로 무시
+	// INSERT 시도 — partial unique index 덕분에 같은 키의 firing incident가 이미 있으면 ON CONFLICT로 무시
 	insertQuery := `
-		INSERT INTO incidents (incident_id, title, severity, status, fired_at, created_at, updated_at)
-		VALUES ($1, $2, $3, 'firing', $4, NOW(), NOW())
+		INSERT INTO incidents (
+			incident_id, title, severity, status, fired_at,
+			correlation_key, correlation_labels, last_alert_at, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, 'firing', $4, $5, $6, NOW(), NOW(), NOW())
 		ON CONFLICT DO NOTHING
 		RETURNING incident_id
 	`
 	var insertedID string
-	err := db.Pool.QueryRow(context.Background(), insertQuery, incidentID, title, severity, firedAt).Scan(&insertedID)
+	err := db.Pool.QueryRow(context.Background(), insertQuery, incidentID, title, severity, firedAt, correlationKey, labels).Scan(&insertedID)
 	if err == nil {
 		return insertedID, true, nil // 새로 생성됨
 	}
 
-	// INSERT가 conflict로 무시됨 (pgx.ErrNoRows) → 기존 firing incident 조회
-	if err.Error() == "no rows in result set" {
-		existing, getErr := db.GetFiringIncident()
-		if getErr != nil {
+	// INSERT가 conflict로 무시됨 (pgx.ErrNoRows) → 같은 키의 firing incident 조회
+	if IsNoRows(err) {
+		selectQuery := `
+			SELECT incident_id
+			FROM incidents
+			WHERE correlation_key = $1 AND status = 'firing' AND is_enabled = TRUE AND correlation_closed_at IS NULL
+			LIMIT 1
+		`
+		var existingID string
+		if getErr := db.Pool.QueryRow(context.Background(), selectQuery, correlationKey).Scan(&existingID); getErr != nil {
 			return "", false, getErr
 		}
-		return existing.IncidentID, false, nil
+		return existingID, false, nil
 	}
 
 	return "", false, err
 }
 
-// UpdateIncidentSeverity - Incident severity 업데이트 (가장 높은 severity로)
-func (db *Postgres) UpdateIncidentSeverity(incidentID, severity string) error {
-	// critical > warning > info 순으로 높은 severity만 업데이트
+// TouchIncidentCorrelation - Incident에 Alert가 연결된 시각 갱신 (상관관계 시간 창 계산용)
+func (db *Postgres) TouchIncidentCorrelation(incidentID string, at time.Time) error {
 	query := `
 		UPDATE incidents
-		SET severity = $2, updated_at = NOW()
+		SET last_alert_at = GREATEST(COALESCE(last_alert_at, fired_at), $2), updated_at = NOW()
 		WHERE incident_id = $1
-		AND (
-			(severity = 'info') OR
-			(severity = 'warning' AND $2 = 'critical')
-		)
 	`
-	_, err := db.Pool.Exec(context.Background(), query, incidentID, severity)
+	_, err := db.Pool.Exec(context.Background(), query, incidentID, at)
+	return err
+}
+
+// CloseIncidentCorrelation - 시간 창이 지난 Incident를 매칭 대상에서 제외 (Incident 상태는 유지)
+func (db *Postgres) CloseIncidentCorrelation(incidentID string) error {
+	query := `
+		UPDATE incidents
+		SET correlation_closed_at = NOW(), updated_at = NOW()
+		WHERE incident_id = $1 AND correlation_closed_at IS NULL
+	`
+	_, err := db.Pool.Exec(context.Background(), query, incidentID)
+	return err
+}
+
+// EscalateIncidentSeverity - Incident severity를 rank가 더 높을 때만 업데이트
+// severity 분류 체계(app_settings "severity")의 rank를 기준으로 비교하며, rank가 없는 Incident는 항상 갱신
+func (db *Postgres) EscalateIncidentSeverity(incidentID, severity string, rank int) error {
+	query := `
+		UPDATE incidents
+		SET severity = $2, severity_rank = $3, updated_at = NOW()
+		WHERE incident_id = $1
+		AND COALESCE(severity_rank, -1) < $3
+	`
+	_, err := db.Pool.Exec(context.Background(), query, incidentID, severity, rank)
 	return err
 }
 
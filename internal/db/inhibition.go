@@ -0,0 +1,191 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// EnsureInhibitionSchema - inhibition_rules 테이블 생성 (관련 alert 간 억제 규칙)
+func (p *Postgres) EnsureInhibitionSchema() error {
+	queries := []string{
+		`
+		CREATE TABLE IF NOT EXISTS inhibition_rules (
+			id BIGSERIAL PRIMARY KEY,
+			name TEXT NOT NULL DEFAULT '',
+			source_matchers JSONB NOT NULL DEFAULT '[]',
+			target_matchers JSONB NOT NULL DEFAULT '[]',
+			equal_labels TEXT[] NOT NULL DEFAULT '{}',
+			enabled BOOLEAN NOT NULL DEFAULT TRUE,
+			comment TEXT NOT NULL DEFAULT '',
+			created_by TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)
+		`,
+	}
+
+	for _, query := range queries {
+		if _, err := p.Pool.Exec(context.Background(), query); err != nil {
+			return fmt.Errorf("failed to ensure inhibition_rules schema: %w", err)
+		}
+	}
+	return nil
+}
+
+const inhibitionRuleColumns = `id, name, source_matchers, target_matchers, equal_labels, enabled, comment, created_by, created_at, updated_at`
+
+func scanInhibitionRule(row pgx.Row) (model.InhibitionRule, error) {
+	var (
+		r              model.InhibitionRule
+		source, target []byte
+	)
+	if err := row.Scan(&r.ID, &r.Name, &source, &target, &r.Equal, &r.Enabled, &r.Comment, &r.CreatedBy, &r.CreatedAt, &r.UpdatedAt); err != nil {
+		return r, err
+	}
+	if err := json.Unmarshal(source, &r.SourceMatchers); err != nil {
+		return r, fmt.Errorf("failed to decode inhibition source matchers (id=%d): %w", r.ID, err)
+	}
+	if err := json.Unmarshal(target, &r.TargetMatchers); err != nil {
+		return r, fmt.Errorf("failed to decode inhibition target matchers (id=%d): %w", r.ID, err)
+	}
+	if r.Equal == nil {
+		r.Equal = []string{}
+	}
+	return r, nil
+}
+
+func (p *Postgres) queryInhibitionRules(ctx context.Context, query string, args ...any) ([]model.InhibitionRule, error) {
+	rows, err := p.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query inhibition rules: %w", err)
+	}
+	defer rows.Close()
+
+	rules := []model.InhibitionRule{}
+	for rows.Next() {
+		r, err := scanInhibitionRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan inhibition rule: %w", err)
+		}
+		rules = append(rules, r)
+	}
+	return rules, rows.Err()
+}
+
+// ListInhibitionRules - 억제 규칙 전체 목록 (이름순)
+func (p *Postgres) ListInhibitionRules(ctx context.Context) ([]model.InhibitionRule, error) {
+	return p.queryInhibitionRules(ctx, `SELECT `+inhibitionRuleColumns+` FROM inhibition_rules ORDER BY name, id`)
+}
+
+// ListEnabledInhibitionRules - 활성화된 억제 규칙 (alert 처리 시 평가용)
+func (p *Postgres) ListEnabledInhibitionRules() ([]model.InhibitionRule, error) {
+	return p.queryInhibitionRules(context.Background(), `SELECT `+inhibitionRuleColumns+` FROM inhibition_rules WHERE enabled = TRUE ORDER BY id`)
+}
+
+// GetInhibitionRule - ID로 단건 조회 (없으면 nil)
+func (p *Postgres) GetInhibitionRule(ctx context.Context, id int64) (*model.InhibitionRule, error) {
+	r, err := scanInhibitionRule(p.Pool.QueryRow(ctx, `SELECT `+inhibitionRuleColumns+` FROM inhibition_rules WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inhibition rule: %w", err)
+	}
+	return &r, nil
+}
+
+// CreateInhibitionRule - 억제 규칙 저장
+func (p *Postgres) CreateInhibitionRule(ctx context.Context, r model.InhibitionRule) (int64, error) {
+	source, target, err := encodeInhibitionMatchers(r)
+	if err != nil {
+		return 0, err
+	}
+	var id int64
+	err = p.Pool.QueryRow(ctx, `
+		INSERT INTO inhibition_rules (name, source_matchers, target_matchers, equal_labels, enabled, comment, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`, r.Name, source, target, r.Equal, r.Enabled, r.Comment, r.CreatedBy).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert inhibition rule: %w", err)
+	}
+	return id, nil
+}
+
+// UpdateInhibitionRule - 억제 규칙 수정 (created_by는 유지)
+func (p *Postgres) UpdateInhibitionRule(ctx context.Context, id int64, r model.InhibitionRule) error {
+	source, target, err := encodeInhibitionMatchers(r)
+	if err != nil {
+		return err
+	}
+	tag, err := p.Pool.Exec(ctx, `
+		UPDATE inhibition_rules
+		SET name = $2, source_matchers = $3, target_matchers = $4, equal_labels = $5, enabled = $6, comment = $7, updated_at = NOW()
+		WHERE id = $1
+	`, id, r.Name, source, target, r.Equal, r.Enabled, r.Comment)
+	if err != nil {
+		return fmt.Errorf("failed to update inhibition rule: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("inhibition rule not found: id=%d", id)
+	}
+	return nil
+}
+
+// DeleteInhibitionRule - 억제 규칙 삭제 (alerts.inhibition_rule_id 기록은 유지)
+func (p *Postgres) DeleteInhibitionRule(ctx context.Context, id int64) error {
+	tag, err := p.Pool.Exec(ctx, `DELETE FROM inhibition_rules WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete inhibition rule: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("inhibition rule not found: id=%d", id)
+	}
+	return nil
+}
+
+func encodeInhibitionMatchers(r model.InhibitionRule) ([]byte, []byte, error) {
+	source, err := json.Marshal(r.SourceMatchers)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode inhibition source matchers: %w", err)
+	}
+	target, err := json.Marshal(r.TargetMatchers)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode inhibition target matchers: %w", err)
+	}
+	return source, target, nil
+}
+
+// ListFiringInhibitionSources - 억제 source 후보인 firing alert 목록 (alert_id, fingerprint, labels)
+func (p *Postgres) ListFiringInhibitionSources() ([]model.InhibitionSource, error) {
+	rows, err := p.Pool.Query(context.Background(), `
+		SELECT alert_id, fingerprint, labels
+		FROM alerts
+		WHERE status = 'firing' AND is_enabled = TRUE
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query firing alerts: %w", err)
+	}
+	defer rows.Close()
+
+	var sources []model.InhibitionSource
+	for rows.Next() {
+		var (
+			src    model.InhibitionSource
+			labels []byte
+		)
+		if err := rows.Scan(&src.AlertID, &src.Fingerprint, &labels); err != nil {
+			return nil, fmt.Errorf("failed to scan firing alert: %w", err)
+		}
+		if err := json.Unmarshal(labels, &src.Labels); err != nil {
+			continue
+		}
+		sources = append(sources, src)
+	}
+	return sources, rows.Err()
+}
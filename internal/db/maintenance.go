@@ -0,0 +1,145 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// EnsureMaintenanceSchema - maintenance_windows 테이블 생성 (cron 기반 반복 점검 시간대)
+func (p *Postgres) EnsureMaintenanceSchema() error {
+	queries := []string{
+		`
+		CREATE TABLE IF NOT EXISTS maintenance_windows (
+			id BIGSERIAL PRIMARY KEY,
+			name TEXT NOT NULL DEFAULT '',
+			schedule TEXT NOT NULL,
+			time_zone TEXT NOT NULL DEFAULT 'UTC',
+			duration_minutes INT NOT NULL,
+			matchers JSONB NOT NULL DEFAULT '[]',
+			enabled BOOLEAN NOT NULL DEFAULT TRUE,
+			comment TEXT NOT NULL DEFAULT '',
+			created_by TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)
+		`,
+	}
+
+	for _, query := range queries {
+		if _, err := p.Pool.Exec(context.Background(), query); err != nil {
+			return fmt.Errorf("failed to ensure maintenance_windows schema: %w", err)
+		}
+	}
+	return nil
+}
+
+const maintenanceWindowColumns = `id, name, schedule, time_zone, duration_minutes, matchers, enabled, comment, created_by, created_at, updated_at`
+
+func scanMaintenanceWindow(row pgx.Row) (model.MaintenanceWindow, error) {
+	var (
+		w        model.MaintenanceWindow
+		matchers []byte
+	)
+	if err := row.Scan(&w.ID, &w.Name, &w.Schedule, &w.TimeZone, &w.DurationMinutes, &matchers, &w.Enabled, &w.Comment, &w.CreatedBy, &w.CreatedAt, &w.UpdatedAt); err != nil {
+		return w, err
+	}
+	if err := json.Unmarshal(matchers, &w.Matchers); err != nil {
+		return w, fmt.Errorf("failed to decode maintenance matchers (id=%d): %w", w.ID, err)
+	}
+	return w, nil
+}
+
+func (p *Postgres) queryMaintenanceWindows(ctx context.Context, query string, args ...any) ([]model.MaintenanceWindow, error) {
+	rows, err := p.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query maintenance windows: %w", err)
+	}
+	defer rows.Close()
+
+	windows := []model.MaintenanceWindow{}
+	for rows.Next() {
+		w, err := scanMaintenanceWindow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan maintenance window: %w", err)
+		}
+		windows = append(windows, w)
+	}
+	return windows, rows.Err()
+}
+
+// ListMaintenanceWindows - 점검 시간대 전체 목록 (이름순)
+func (p *Postgres) ListMaintenanceWindows(ctx context.Context) ([]model.MaintenanceWindow, error) {
+	return p.queryMaintenanceWindows(ctx, `SELECT `+maintenanceWindowColumns+` FROM maintenance_windows ORDER BY name, id`)
+}
+
+// ListEnabledMaintenanceWindows - 활성화된 점검 시간대 (alert 처리 시 매칭용)
+func (p *Postgres) ListEnabledMaintenanceWindows() ([]model.MaintenanceWindow, error) {
+	return p.queryMaintenanceWindows(context.Background(), `SELECT `+maintenanceWindowColumns+` FROM maintenance_windows WHERE enabled = TRUE ORDER BY id`)
+}
+
+// GetMaintenanceWindow - ID로 단건 조회 (없으면 nil)
+func (p *Postgres) GetMaintenanceWindow(ctx context.Context, id int64) (*model.MaintenanceWindow, error) {
+	w, err := scanMaintenanceWindow(p.Pool.QueryRow(ctx, `SELECT `+maintenanceWindowColumns+` FROM maintenance_windows WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get maintenance window: %w", err)
+	}
+	return &w, nil
+}
+
+// CreateMaintenanceWindow - 점검 시간대 저장
+func (p *Postgres) CreateMaintenanceWindow(ctx context.Context, w model.MaintenanceWindow) (int64, error) {
+	matchers, err := json.Marshal(w.Matchers)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode maintenance matchers: %w", err)
+	}
+	var id int64
+	err = p.Pool.QueryRow(ctx, `
+		INSERT INTO maintenance_windows (name, schedule, time_zone, duration_minutes, matchers, enabled, comment, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`, w.Name, w.Schedule, w.TimeZone, w.DurationMinutes, matchers, w.Enabled, w.Comment, w.CreatedBy).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert maintenance window: %w", err)
+	}
+	return id, nil
+}
+
+// UpdateMaintenanceWindow - 점검 시간대 수정 (created_by는 유지)
+func (p *Postgres) UpdateMaintenanceWindow(ctx context.Context, id int64, w model.MaintenanceWindow) error {
+	matchers, err := json.Marshal(w.Matchers)
+	if err != nil {
+		return fmt.Errorf("failed to encode maintenance matchers: %w", err)
+	}
+	tag, err := p.Pool.Exec(ctx, `
+		UPDATE maintenance_windows
+		SET name = $2, schedule = $3, time_zone = $4, duration_minutes = $5, matchers = $6, enabled = $7, comment = $8, updated_at = NOW()
+		WHERE id = $1
+	`, id, w.Name, w.Schedule, w.TimeZone, w.DurationMinutes, matchers, w.Enabled, w.Comment)
+	if err != nil {
+		return fmt.Errorf("failed to update maintenance window: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("maintenance window not found: id=%d", id)
+	}
+	return nil
+}
+
+// DeleteMaintenanceWindow - 점검 시간대 삭제 (alerts.maintenance_window_id 기록은 유지)
+func (p *Postgres) DeleteMaintenanceWindow(ctx context.Context, id int64) error {
+	tag, err := p.Pool.Exec(ctx, `DELETE FROM maintenance_windows WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete maintenance window: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("maintenance window not found: id=%d", id)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// EnsureNotificationAttemptSchema - notification_attempts 테이블 생성 (대상별 알림 전송 시도 기록)
+func (p *Postgres) EnsureNotificationAttemptSchema() error {
+	queries := []string{
+		`
+		CREATE TABLE IF NOT EXISTS notification_attempts (
+			id BIGSERIAL PRIMARY KEY,
+			outbox_id BIGINT,
+			alert_id TEXT NOT NULL DEFAULT '',
+			fingerprint TEXT NOT NULL DEFAULT '',
+			incident_id TEXT NOT NULL DEFAULT '',
+			event_type TEXT NOT NULL,
+			mode TEXT NOT NULL DEFAULT 'notify',
+			webhook_config_id INT,
+			channel TEXT NOT NULL DEFAULT '',
+			status TEXT NOT NULL,
+			status_code INT,
+			latency_ms BIGINT NOT NULL DEFAULT 0,
+			error TEXT NOT NULL DEFAULT '',
+			payload_hash TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)
+		`,
+		`CREATE INDEX IF NOT EXISTS notification_attempts_alert_idx ON notification_attempts(alert_id, id DESC) WHERE alert_id <> ''`,
+		`CREATE INDEX IF NOT EXISTS notification_attempts_webhook_idx ON notification_attempts(webhook_config_id, id DESC) WHERE webhook_config_id IS NOT NULL`,
+		`CREATE INDEX IF NOT EXISTS notification_attempts_created_idx ON notification_attempts(created_at)`,
+	}
+
+	for _, query := range queries {
+		if _, err := p.Pool.Exec(context.Background(), query); err != nil {
+			return fmt.Errorf("failed to ensure notification_attempts schema: %w", err)
+		}
+	}
+	return nil
+}
+
+// InsertNotificationAttempt - 전송 시도 1회 저장
+func (p *Postgres) InsertNotificationAttempt(ctx context.Context, a model.NotificationAttempt) error {
+	_, err := p.Pool.Exec(ctx, `
+		INSERT INTO notification_attempts (
+			outbox_id, alert_id, fingerprint, incident_id, event_type, mode, webhook_config_id, channel,
+			status, status_code, latency_ms, error, payload_hash
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`, a.OutboxID, a.AlertID, a.Fingerprint, a.IncidentID, a.EventType, a.Mode, a.WebhookConfigID, a.Channel,
+		a.Status, a.StatusCode, a.LatencyMs, a.Error, a.PayloadHash)
+	if err != nil {
+		return fmt.Errorf("failed to insert notification attempt: %w", err)
+	}
+	return nil
+}
+
+// ListNotificationAttemptsByAlert - alert의 전송 시도 목록 (최신순)
+func (p *Postgres) ListNotificationAttemptsByAlert(ctx context.Context, alertID string, limit int) ([]model.NotificationAttempt, error) {
+	list, err := p.queryNotificationAttempts(ctx, `WHERE a.alert_id = $1`, alertID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification attempts by alert: %w", err)
+	}
+	return list, nil
+}
+
+// ListNotificationAttemptsByWebhookConfig - 웹훅 설정의 전송 시도 목록 (최신순)
+func (p *Postgres) ListNotificationAttemptsByWebhookConfig(ctx context.Context, configID int, limit int) ([]model.NotificationAttempt, error) {
+	list, err := p.queryNotificationAttempts(ctx, `WHERE a.webhook_config_id = $1`, configID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification attempts by webhook config: %w", err)
+	}
+	return list, nil
+}
+
+// queryNotificationAttempts - 조건($1)과 limit($2)으로 전송 시도 조회 (웹훅 설정 이름 포함)
+func (p *Postgres) queryNotificationAttempts(ctx context.Context, where string, arg any, limit int) ([]model.NotificationAttempt, error) {
+	rows, err := p.Pool.Query(ctx, `
+		SELECT
+			a.id, a.outbox_id, a.alert_id, a.fingerprint, a.incident_id, a.event_type, a.mode,
+			a.webhook_config_id, COALESCE(w.name, ''), a.channel, a.status, a.status_code,
+			a.latency_ms, a.error, a.payload_hash, a.created_at
+		FROM notification_attempts a
+		LEFT JOIN webhook_configs w ON w.id = a.webhook_config_id
+		`+where+`
+		ORDER BY a.id DESC
+		LIMIT $2
+	`, arg, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []model.NotificationAttempt{}
+	for rows.Next() {
+		var a model.NotificationAttempt
+		if err := rows.Scan(
+			&a.ID, &a.OutboxID, &a.AlertID, &a.Fingerprint, &a.IncidentID, &a.EventType, &a.Mode,
+			&a.WebhookConfigID, &a.WebhookConfigName, &a.Channel, &a.Status, &a.StatusCode,
+			&a.LatencyMs, &a.Error, &a.PayloadHash, &a.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, a)
+	}
+	return list, rows.Err()
+}
+
+// PurgeNotificationAttempts - before 이전의 전송 시도 기록 삭제 (삭제 수 반환)
+func (p *Postgres) PurgeNotificationAttempts(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := p.Pool.Exec(ctx, `DELETE FROM notification_attempts WHERE created_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge notification attempts: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,291 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// EnsureNotificationOutboxSchema - notification_outbox 테이블 생성 (알림 전송 대상별 기록 + 재시도 큐)
+func (p *Postgres) EnsureNotificationOutboxSchema() error {
+	queries := []string{
+		`
+		CREATE TABLE IF NOT EXISTS notification_outbox (
+			id BIGSERIAL PRIMARY KEY,
+			event_type TEXT NOT NULL,
+			mode TEXT NOT NULL DEFAULT 'notify',
+			webhook_config_id INT,
+			channel TEXT NOT NULL DEFAULT '',
+			delivery JSONB,
+			alert_id TEXT NOT NULL DEFAULT '',
+			fingerprint TEXT NOT NULL DEFAULT '',
+			incident_id TEXT NOT NULL DEFAULT '',
+			payload JSONB NOT NULL,
+			status TEXT NOT NULL DEFAULT 'pending',
+			attempts INT NOT NULL DEFAULT 0,
+			last_error TEXT NOT NULL DEFAULT '',
+			next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			locked_at TIMESTAMPTZ,
+			delivered_at TIMESTAMPTZ,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)
+		`,
+		`CREATE INDEX IF NOT EXISTS notification_outbox_due_idx ON notification_outbox(next_attempt_at) WHERE status IN ('pending', 'sending')`,
+		`CREATE INDEX IF NOT EXISTS notification_outbox_status_idx ON notification_outbox(status, id DESC)`,
+	}
+
+	for _, query := range queries {
+		if _, err := p.Pool.Exec(context.Background(), query); err != nil {
+			return fmt.Errorf("failed to ensure notification_outbox schema: %w", err)
+		}
+	}
+	return nil
+}
+
+const notificationOutboxColumns = `
+	id, event_type, mode, webhook_config_id, channel, delivery, alert_id, fingerprint, incident_id,
+	status, attempts, last_error, next_attempt_at, created_at, updated_at, delivered_at`
+
+func scanNotificationOutboxEntry(row pgx.Row, withPayload bool) (model.NotificationOutboxEntry, error) {
+	var e model.NotificationOutboxEntry
+	var delivery []byte
+	dest := []any{
+		&e.ID, &e.EventType, &e.Mode, &e.WebhookConfigID, &e.Channel, &delivery, &e.AlertID, &e.Fingerprint, &e.IncidentID,
+		&e.Status, &e.Attempts, &e.LastError, &e.NextAttemptAt, &e.CreatedAt, &e.UpdatedAt, &e.DeliveredAt,
+	}
+	if withPayload {
+		dest = append(dest, &e.Payload)
+	}
+	if err := row.Scan(dest...); err != nil {
+		return e, err
+	}
+	if len(delivery) > 0 {
+		var d model.AlertNotificationDelivery
+		if err := json.Unmarshal(delivery, &d); err != nil {
+			return e, fmt.Errorf("failed to decode outbox delivery: %w", err)
+		}
+		e.Delivery = &d
+	}
+	return e, nil
+}
+
+func queryNotificationOutboxEntries(ctx context.Context, p *Postgres, withPayload bool, query string, args ...any) ([]model.NotificationOutboxEntry, error) {
+	rows, err := p.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []model.NotificationOutboxEntry{}
+	for rows.Next() {
+		e, err := scanNotificationOutboxEntry(rows, withPayload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan notification outbox entry: %w", err)
+		}
+		list = append(list, e)
+	}
+	return list, rows.Err()
+}
+
+// InsertNotificationOutboxEntry - 전송할 대상을 pending 상태로 저장 (worker가 next_attempt_at 이후 첫 전송)
+func (p *Postgres) InsertNotificationOutboxEntry(ctx context.Context, entry model.NotificationOutboxEntry) (int64, error) {
+	return insertNotificationOutboxEntry(ctx, p.Pool, entry)
+}
+
+// insertNotificationOutboxEntry - 커넥션 풀 또는 alert 저장 트랜잭션에서 outbox entry 저장
+// next_attempt_at이 비어 있으면 즉시 전송 대상
+func insertNotificationOutboxEntry(ctx context.Context, q rowQuerier, entry model.NotificationOutboxEntry) (int64, error) {
+	var delivery []byte
+	if entry.Delivery != nil {
+		encoded, err := json.Marshal(entry.Delivery)
+		if err != nil {
+			return 0, fmt.Errorf("failed to encode outbox delivery: %w", err)
+		}
+		delivery = encoded
+	}
+	var nextAttemptAt *time.Time
+	if !entry.NextAttemptAt.IsZero() {
+		nextAttemptAt = &entry.NextAttemptAt
+	}
+
+	var id int64
+	err := q.QueryRow(ctx, `
+		INSERT INTO notification_outbox (
+			event_type, mode, webhook_config_id, channel, delivery, alert_id, fingerprint, incident_id, payload,
+			status, attempts, next_attempt_at, created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, 'pending', 0, COALESCE($10, NOW()), NOW(), NOW())
+		RETURNING id
+	`, entry.EventType, entry.Mode, entry.WebhookConfigID, entry.Channel, delivery,
+		entry.AlertID, entry.Fingerprint, entry.IncidentID, entry.Payload, nextAttemptAt).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert notification outbox entry: %w", err)
+	}
+	return id, nil
+}
+
+// ReleaseNotificationOutboxEntries - 보류 중인 pending entry를 즉시 전송 대상으로 변경
+func (p *Postgres) ReleaseNotificationOutboxEntries(ctx context.Context, ids []int64) error {
+	_, err := p.Pool.Exec(ctx, `
+		UPDATE notification_outbox
+		SET next_attempt_at = NOW(), updated_at = NOW()
+		WHERE id = ANY($1) AND status = 'pending'
+	`, ids)
+	if err != nil {
+		return fmt.Errorf("failed to release notification outbox entries: %w", err)
+	}
+	return nil
+}
+
+// DiscardPendingNotificationOutboxEntries - 아직 전송되지 않은 pending entry 폐기 (reason은 last_error에 기록)
+func (p *Postgres) DiscardPendingNotificationOutboxEntries(ctx context.Context, ids []int64, reason string) error {
+	_, err := p.Pool.Exec(ctx, `
+		UPDATE notification_outbox
+		SET status = 'discarded', last_error = $2, updated_at = NOW()
+		WHERE id = ANY($1) AND status = 'pending'
+	`, ids, reason)
+	if err != nil {
+		return fmt.Errorf("failed to discard notification outbox entries: %w", err)
+	}
+	return nil
+}
+
+// ClaimNotificationOutboxEntries - 재시도 시각이 지난 entry를 원자적으로 점유 (FOR UPDATE SKIP LOCKED)
+// staleAfter보다 오래 sending 상태인 entry(전송 중 Pod 종료)도 다시 점유한다.
+func (p *Postgres) ClaimNotificationOutboxEntries(ctx context.Context, limit int, staleAfter time.Duration) ([]model.NotificationOutboxEntry, error) {
+	list, err := queryNotificationOutboxEntries(ctx, p, true, `
+		UPDATE notification_outbox
+		SET status = 'sending', attempts = attempts + 1, locked_at = NOW(), updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM notification_outbox
+			WHERE (status = 'pending' AND next_attempt_at <= NOW())
+			   OR (status = 'sending' AND locked_at < NOW() - make_interval(secs => $2))
+			ORDER BY next_attempt_at, id
+			FOR UPDATE SKIP LOCKED
+			LIMIT $1
+		)
+		RETURNING `+notificationOutboxColumns+`, payload
+	`, limit, staleAfter.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim notification outbox entries: %w", err)
+	}
+	return list, nil
+}
+
+// CompleteNotificationOutboxEntry - 전송 완료 기록
+func (p *Postgres) CompleteNotificationOutboxEntry(ctx context.Context, id int64) error {
+	_, err := p.Pool.Exec(ctx, `
+		UPDATE notification_outbox
+		SET status = 'delivered', last_error = '', locked_at = NULL, delivered_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+	`, id)
+	if err != nil {
+		return fmt.Errorf("failed to complete notification outbox entry: %w", err)
+	}
+	return nil
+}
+
+// FailNotificationOutboxEntry - 전송 실패 기록 (dead=true면 dead-letter, 아니면 nextAttemptAt에 재시도)
+func (p *Postgres) FailNotificationOutboxEntry(ctx context.Context, id int64, errMsg string, nextAttemptAt time.Time, dead bool) error {
+	status := model.NotificationOutboxStatusPending
+	if dead {
+		status = model.NotificationOutboxStatusDead
+	}
+	_, err := p.Pool.Exec(ctx, `
+		UPDATE notification_outbox
+		SET status = $2, last_error = $3, next_attempt_at = $4, locked_at = NULL, updated_at = NOW()
+		WHERE id = $1
+	`, id, status, errMsg, nextAttemptAt)
+	if err != nil {
+		return fmt.Errorf("failed to record notification outbox failure: %w", err)
+	}
+	return nil
+}
+
+// ListNotificationOutboxEntries - outbox 목록 조회 (최신순, status 빈 문자열이면 전체, payload 제외)
+func (p *Postgres) ListNotificationOutboxEntries(ctx context.Context, status string, limit int) ([]model.NotificationOutboxEntry, error) {
+	list, err := queryNotificationOutboxEntries(ctx, p, false, `
+		SELECT `+notificationOutboxColumns+`
+		FROM notification_outbox
+		WHERE ($1 = '' OR status = $1)
+		ORDER BY id DESC
+		LIMIT $2
+	`, status, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification outbox entries: %w", err)
+	}
+	return list, nil
+}
+
+// GetNotificationOutboxEntry - outbox 단건 조회 (payload 포함, 없으면 nil)
+func (p *Postgres) GetNotificationOutboxEntry(ctx context.Context, id int64) (*model.NotificationOutboxEntry, error) {
+	row := p.Pool.QueryRow(ctx, `
+		SELECT `+notificationOutboxColumns+`, payload
+		FROM notification_outbox
+		WHERE id = $1
+	`, id)
+	e, err := scanNotificationOutboxEntry(row, true)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get notification outbox entry: %w", err)
+	}
+	return &e, nil
+}
+
+// RequeueNotificationOutboxEntry - dead/discarded/pending entry를 시도 횟수를 초기화하여 즉시 재시도 (변경 여부 반환)
+func (p *Postgres) RequeueNotificationOutboxEntry(ctx context.Context, id int64) (bool, error) {
+	tag, err := p.Pool.Exec(ctx, `
+		UPDATE notification_outbox
+		SET status = 'pending', attempts = 0, last_error = '', next_attempt_at = NOW(), locked_at = NULL, updated_at = NOW()
+		WHERE id = $1 AND status IN ('pending', 'dead', 'discarded')
+	`, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to requeue notification outbox entry: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// DiscardNotificationOutboxEntry - pending/dead entry 폐기 (변경 여부 반환)
+func (p *Postgres) DiscardNotificationOutboxEntry(ctx context.Context, id int64) (bool, error) {
+	tag, err := p.Pool.Exec(ctx, `
+		UPDATE notification_outbox
+		SET status = 'discarded', locked_at = NULL, updated_at = NOW()
+		WHERE id = $1 AND status IN ('pending', 'dead')
+	`, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to discard notification outbox entry: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// DiscardClaimedNotificationOutboxEntry - worker가 점유한 entry를 더 이상 보낼 필요가 없어 폐기 (reason은 last_error에 기록)
+func (p *Postgres) DiscardClaimedNotificationOutboxEntry(ctx context.Context, id int64, reason string) error {
+	_, err := p.Pool.Exec(ctx, `
+		UPDATE notification_outbox
+		SET status = 'discarded', last_error = $2, locked_at = NULL, updated_at = NOW()
+		WHERE id = $1 AND status = 'sending'
+	`, id, reason)
+	if err != nil {
+		return fmt.Errorf("failed to discard notification outbox entry: %w", err)
+	}
+	return nil
+}
+
+// PurgeDeliveredNotificationOutbox - before 이전에 전송 완료된 entry 삭제 (삭제 수 반환)
+func (p *Postgres) PurgeDeliveredNotificationOutbox(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := p.Pool.Exec(ctx, `
+		DELETE FROM notification_outbox
+		WHERE status = 'delivered' AND delivered_at < $1
+	`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge notification outbox: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,291 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// EnsureOncallSchema - oncall_schedules(레이어별 로테이션), oncall_overrides(기간별 대체 근무) 테이블 생성
+// users.slack_user_id / incidents.oncall_user는 각 테이블 스키마에서 관리
+func (p *Postgres) EnsureOncallSchema() error {
+	queries := []string{
+		`
+		CREATE TABLE IF NOT EXISTS oncall_schedules (
+			id BIGSERIAL PRIMARY KEY,
+			name TEXT NOT NULL DEFAULT '',
+			time_zone TEXT NOT NULL DEFAULT 'UTC',
+			matchers JSONB NOT NULL DEFAULT '[]',
+			layers JSONB NOT NULL DEFAULT '[]',
+			mention_in_slack BOOLEAN NOT NULL DEFAULT FALSE,
+			enabled BOOLEAN NOT NULL DEFAULT TRUE,
+			comment TEXT NOT NULL DEFAULT '',
+			created_by TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)
+		`,
+		`
+		CREATE TABLE IF NOT EXISTS oncall_overrides (
+			id BIGSERIAL PRIMARY KEY,
+			schedule_id BIGINT NOT NULL REFERENCES oncall_schedules(id) ON DELETE CASCADE,
+			user_login_id TEXT NOT NULL,
+			start_at TIMESTAMPTZ NOT NULL,
+			end_at TIMESTAMPTZ NOT NULL,
+			reason TEXT NOT NULL DEFAULT '',
+			created_by TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)
+		`,
+		`CREATE INDEX IF NOT EXISTS oncall_overrides_schedule_idx ON oncall_overrides(schedule_id, end_at)`,
+	}
+
+	for _, query := range queries {
+		if _, err := p.Pool.Exec(context.Background(), query); err != nil {
+			return fmt.Errorf("failed to ensure oncall schema: %w", err)
+		}
+	}
+	return nil
+}
+
+const oncallScheduleColumns = `id, name, time_zone, matchers, layers, mention_in_slack, enabled, comment, created_by, created_at, updated_at`
+
+func scanOncallSchedule(row pgx.Row) (model.OncallSchedule, error) {
+	var (
+		s        model.OncallSchedule
+		matchers []byte
+		layers   []byte
+	)
+	if err := row.Scan(&s.ID, &s.Name, &s.TimeZone, &matchers, &layers, &s.MentionInSlack, &s.Enabled, &s.Comment, &s.CreatedBy, &s.CreatedAt, &s.UpdatedAt); err != nil {
+		return s, err
+	}
+	if err := json.Unmarshal(matchers, &s.Matchers); err != nil {
+		return s, fmt.Errorf("failed to decode oncall schedule matchers (id=%d): %w", s.ID, err)
+	}
+	if err := json.Unmarshal(layers, &s.Layers); err != nil {
+		return s, fmt.Errorf("failed to decode oncall schedule layers (id=%d): %w", s.ID, err)
+	}
+	return s, nil
+}
+
+func (p *Postgres) queryOncallSchedules(ctx context.Context, query string, args ...any) ([]model.OncallSchedule, error) {
+	rows, err := p.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query oncall schedules: %w", err)
+	}
+	defer rows.Close()
+
+	schedules := []model.OncallSchedule{}
+	for rows.Next() {
+		s, err := scanOncallSchedule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan oncall schedule: %w", err)
+		}
+		schedules = append(schedules, s)
+	}
+	return schedules, rows.Err()
+}
+
+// ListOncallSchedules - on-call 스케줄 전체 목록 (이름순)
+func (p *Postgres) ListOncallSchedules(ctx context.Context) ([]model.OncallSchedule, error) {
+	return p.queryOncallSchedules(ctx, `SELECT `+oncallScheduleColumns+` FROM oncall_schedules ORDER BY name, id`)
+}
+
+// ListEnabledOncallSchedules - 활성화된 on-call 스케줄 (alert 처리 시 평가용, id순)
+func (p *Postgres) ListEnabledOncallSchedules() ([]model.OncallSchedule, error) {
+	return p.queryOncallSchedules(context.Background(), `SELECT `+oncallScheduleColumns+` FROM oncall_schedules WHERE enabled = TRUE ORDER BY id`)
+}
+
+// GetOncallSchedule - ID로 단건 조회 (없으면 nil)
+func (p *Postgres) GetOncallSchedule(ctx context.Context, id int64) (*model.OncallSchedule, error) {
+	s, err := scanOncallSchedule(p.Pool.QueryRow(ctx, `SELECT `+oncallScheduleColumns+` FROM oncall_schedules WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get oncall schedule: %w", err)
+	}
+	return &s, nil
+}
+
+func encodeOncallSchedule(s model.OncallSchedule) ([]byte, []byte, error) {
+	matchers, err := json.Marshal(s.Matchers)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode oncall schedule matchers: %w", err)
+	}
+	layers, err := json.Marshal(s.Layers)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode oncall schedule layers: %w", err)
+	}
+	return matchers, layers, nil
+}
+
+// CreateOncallSchedule - on-call 스케줄 저장
+func (p *Postgres) CreateOncallSchedule(ctx context.Context, s model.OncallSchedule) (int64, error) {
+	matchers, layers, err := encodeOncallSchedule(s)
+	if err != nil {
+		return 0, err
+	}
+	var id int64
+	err = p.Pool.QueryRow(ctx, `
+		INSERT INTO oncall_schedules (name, time_zone, matchers, layers, mention_in_slack, enabled, comment, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`, s.Name, s.TimeZone, matchers, layers, s.MentionInSlack, s.Enabled, s.Comment, s.CreatedBy).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert oncall schedule: %w", err)
+	}
+	return id, nil
+}
+
+// UpdateOncallSchedule - on-call 스케줄 수정 (created_by는 유지)
+func (p *Postgres) UpdateOncallSchedule(ctx context.Context, id int64, s model.OncallSchedule) error {
+	matchers, layers, err := encodeOncallSchedule(s)
+	if err != nil {
+		return err
+	}
+	tag, err := p.Pool.Exec(ctx, `
+		UPDATE oncall_schedules
+		SET name = $2, time_zone = $3, matchers = $4, layers = $5, mention_in_slack = $6, enabled = $7, comment = $8, updated_at = NOW()
+		WHERE id = $1
+	`, id, s.Name, s.TimeZone, matchers, layers, s.MentionInSlack, s.Enabled, s.Comment)
+	if err != nil {
+		return fmt.Errorf("failed to update oncall schedule: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("oncall schedule not found: id=%d", id)
+	}
+	return nil
+}
+
+// DeleteOncallSchedule - on-call 스케줄 삭제 (override는 cascade 삭제, incidents.oncall_user 기록은 유지)
+func (p *Postgres) DeleteOncallSchedule(ctx context.Context, id int64) error {
+	tag, err := p.Pool.Exec(ctx, `DELETE FROM oncall_schedules WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete oncall schedule: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("oncall schedule not found: id=%d", id)
+	}
+	return nil
+}
+
+func (p *Postgres) queryOncallOverrides(ctx context.Context, query string, args ...any) ([]model.OncallOverride, error) {
+	rows, err := p.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query oncall overrides: %w", err)
+	}
+	defer rows.Close()
+
+	overrides := []model.OncallOverride{}
+	for rows.Next() {
+		var o model.OncallOverride
+		if err := rows.Scan(&o.ID, &o.ScheduleID, &o.User, &o.StartAt, &o.EndAt, &o.Reason, &o.CreatedBy, &o.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan oncall override: %w", err)
+		}
+		overrides = append(overrides, o)
+	}
+	return overrides, rows.Err()
+}
+
+// ListOncallOverrides - 스케줄의 진행 중/예정 override (since 이후 종료, 시작순)
+func (p *Postgres) ListOncallOverrides(ctx context.Context, scheduleID int64, since time.Time) ([]model.OncallOverride, error) {
+	return p.queryOncallOverrides(ctx, `
+		SELECT id, schedule_id, user_login_id, start_at, end_at, reason, created_by, created_at
+		FROM oncall_overrides
+		WHERE schedule_id = $1 AND end_at > $2
+		ORDER BY start_at, id
+	`, scheduleID, since)
+}
+
+// ListActiveOncallOverrides - 모든 스케줄에서 지정 시각에 진행 중인 override
+func (p *Postgres) ListActiveOncallOverrides(at time.Time) ([]model.OncallOverride, error) {
+	return p.queryOncallOverrides(context.Background(), `
+		SELECT id, schedule_id, user_login_id, start_at, end_at, reason, created_by, created_at
+		FROM oncall_overrides
+		WHERE start_at <= $1 AND end_at > $1
+		ORDER BY created_at, id
+	`, at)
+}
+
+// CreateOncallOverride - override 저장
+func (p *Postgres) CreateOncallOverride(ctx context.Context, o model.OncallOverride) (int64, error) {
+	var id int64
+	err := p.Pool.QueryRow(ctx, `
+		INSERT INTO oncall_overrides (schedule_id, user_login_id, start_at, end_at, reason, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`, o.ScheduleID, o.User, o.StartAt, o.EndAt, o.Reason, o.CreatedBy).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert oncall override: %w", err)
+	}
+	return id, nil
+}
+
+// DeleteOncallOverride - override 삭제 (스케줄에 속하지 않으면 false)
+func (p *Postgres) DeleteOncallOverride(ctx context.Context, scheduleID, id int64) (bool, error) {
+	tag, err := p.Pool.Exec(ctx, `DELETE FROM oncall_overrides WHERE id = $1 AND schedule_id = $2`, id, scheduleID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete oncall override: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// ListOncallUsers - 사용자 목록과 Slack 사용자 ID 매핑 (login ID순)
+func (p *Postgres) ListOncallUsers(ctx context.Context) ([]model.OncallUser, error) {
+	rows, err := p.Pool.Query(ctx, `SELECT login_id, display_name, slack_user_id FROM users ORDER BY login_id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list oncall users: %w", err)
+	}
+	defer rows.Close()
+
+	users := []model.OncallUser{}
+	for rows.Next() {
+		var u model.OncallUser
+		if err := rows.Scan(&u.LoginID, &u.DisplayName, &u.SlackUserID); err != nil {
+			return nil, fmt.Errorf("failed to scan oncall user: %w", err)
+		}
+		users = append(users, u)
+	}
+	return users, rows.Err()
+}
+
+// ListUserSlackIDs - login ID → Slack 사용자 ID (매핑되지 않은 사용자는 빈 값)
+func (p *Postgres) ListUserSlackIDs() (map[string]string, error) {
+	users, err := p.ListOncallUsers(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]string, len(users))
+	for _, u := range users {
+		ids[u.LoginID] = u.SlackUserID
+	}
+	return ids, nil
+}
+
+// UpdateUserSlackID - 사용자 Slack ID 설정 (사용자가 없으면 false)
+func (p *Postgres) UpdateUserSlackID(ctx context.Context, loginID, slackUserID string) (bool, error) {
+	tag, err := p.Pool.Exec(ctx, `UPDATE users SET slack_user_id = $2, updated_at = NOW() WHERE login_id = $1`, loginID, slackUserID)
+	if err != nil {
+		return false, fmt.Errorf("failed to update user slack id: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// SetIncidentOncall - Incident 발생 시점의 on-call 사용자 기록 (이미 기록되어 있으면 유지)
+func (p *Postgres) SetIncidentOncall(incidentID string, scheduleID int64, user string) error {
+	_, err := p.Pool.Exec(context.Background(), `
+		UPDATE incidents
+		SET oncall_user = $2, oncall_schedule_id = $3, updated_at = NOW()
+		WHERE incident_id = $1 AND oncall_schedule_id IS NULL
+	`, incidentID, user, scheduleID)
+	if err != nil {
+		return fmt.Errorf("failed to set incident oncall: %w", err)
+	}
+	return nil
+}
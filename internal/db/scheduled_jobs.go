@@ -0,0 +1,192 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// EnsureScheduledJobSchema - scheduled_jobs 테이블 생성 (flapping clearance 등 지연 실행 작업 큐)
+func (p *Postgres) EnsureScheduledJobSchema() error {
+	queries := []string{
+		`
+		CREATE TABLE IF NOT EXISTS scheduled_jobs (
+			id BIGSERIAL PRIMARY KEY,
+			job_type TEXT NOT NULL,
+			job_key TEXT NOT NULL,
+			payload JSONB NOT NULL DEFAULT '{}'::jsonb,
+			status TEXT NOT NULL DEFAULT 'pending',
+			run_at TIMESTAMPTZ NOT NULL,
+			attempts INT NOT NULL DEFAULT 0,
+			last_error TEXT NOT NULL DEFAULT '',
+			locked_at TIMESTAMPTZ,
+			completed_at TIMESTAMPTZ,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)
+		`,
+		// 같은 종류/key의 pending 작업은 하나만 유지 (재예약 시 run_at/payload 갱신)
+		`CREATE UNIQUE INDEX IF NOT EXISTS scheduled_jobs_pending_key_idx ON scheduled_jobs(job_type, job_key) WHERE status = 'pending'`,
+		`CREATE INDEX IF NOT EXISTS scheduled_jobs_due_idx ON scheduled_jobs(run_at) WHERE status IN ('pending', 'running')`,
+		`CREATE INDEX IF NOT EXISTS scheduled_jobs_status_idx ON scheduled_jobs(status, run_at DESC)`,
+		`CREATE INDEX IF NOT EXISTS scheduled_jobs_completed_idx ON scheduled_jobs(completed_at) WHERE status IN ('done', 'cancelled')`,
+	}
+
+	for _, query := range queries {
+		if _, err := p.Pool.Exec(context.Background(), query); err != nil {
+			return fmt.Errorf("failed to ensure scheduled_jobs schema: %w", err)
+		}
+	}
+	return nil
+}
+
+const scheduledJobColumns = `
+	id, job_type, job_key, status, run_at, attempts, last_error, payload, created_at, updated_at, completed_at`
+
+func scanScheduledJob(row pgx.Row) (model.ScheduledJob, error) {
+	var j model.ScheduledJob
+	err := row.Scan(
+		&j.ID, &j.JobType, &j.JobKey, &j.Status, &j.RunAt, &j.Attempts, &j.LastError,
+		&j.Payload, &j.CreatedAt, &j.UpdatedAt, &j.CompletedAt,
+	)
+	return j, err
+}
+
+func queryScheduledJobs(ctx context.Context, p *Postgres, query string, args ...any) ([]model.ScheduledJob, error) {
+	rows, err := p.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []model.ScheduledJob{}
+	for rows.Next() {
+		j, err := scanScheduledJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan scheduled job: %w", err)
+		}
+		list = append(list, j)
+	}
+	return list, rows.Err()
+}
+
+// ScheduleJob - 예약 작업 등록 (같은 종류/key의 pending 작업이 있으면 run_at/payload 갱신)
+func (p *Postgres) ScheduleJob(ctx context.Context, jobType, jobKey string, payload json.RawMessage, runAt time.Time) (int64, error) {
+	var id int64
+	err := p.Pool.QueryRow(ctx, `
+		INSERT INTO scheduled_jobs (job_type, job_key, payload, status, run_at, created_at, updated_at)
+		VALUES ($1, $2, $3, 'pending', $4, NOW(), NOW())
+		ON CONFLICT (job_type, job_key) WHERE status = 'pending'
+		DO UPDATE SET payload = EXCLUDED.payload, run_at = EXCLUDED.run_at, attempts = 0, last_error = '', updated_at = NOW()
+		RETURNING id
+	`, jobType, jobKey, payload, runAt).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to schedule job: %w", err)
+	}
+	return id, nil
+}
+
+// ClaimScheduledJobs - 실행 시각이 지난 작업을 원자적으로 점유 (FOR UPDATE SKIP LOCKED)
+// staleAfter보다 오래 running 상태인 작업(실행 중 Pod 종료)도 다시 점유한다.
+func (p *Postgres) ClaimScheduledJobs(ctx context.Context, limit int, staleAfter time.Duration) ([]model.ScheduledJob, error) {
+	list, err := queryScheduledJobs(ctx, p, `
+		UPDATE scheduled_jobs
+		SET status = 'running', attempts = attempts + 1, locked_at = NOW(), updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM scheduled_jobs
+			WHERE (status = 'pending' AND run_at <= NOW())
+			   OR (status = 'running' AND locked_at < NOW() - make_interval(secs => $2))
+			ORDER BY run_at
+			FOR UPDATE SKIP LOCKED
+			LIMIT $1
+		)
+		RETURNING `+scheduledJobColumns, limit, staleAfter.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim scheduled jobs: %w", err)
+	}
+	return list, nil
+}
+
+// CompleteScheduledJob - 작업 완료 기록
+func (p *Postgres) CompleteScheduledJob(ctx context.Context, id int64) error {
+	_, err := p.Pool.Exec(ctx, `
+		UPDATE scheduled_jobs
+		SET status = 'done', last_error = '', locked_at = NULL, completed_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+	`, id)
+	if err != nil {
+		return fmt.Errorf("failed to complete scheduled job: %w", err)
+	}
+	return nil
+}
+
+// FailScheduledJob - 작업 실패 기록 (dead=true면 failed, 아니면 nextRunAt에 재시도)
+// 재시도 대기 중 같은 key로 새 작업이 예약되었으면 이 작업은 cancelled로 정리한다.
+func (p *Postgres) FailScheduledJob(ctx context.Context, id int64, errMsg string, nextRunAt time.Time, dead bool) error {
+	status := model.ScheduledJobStatusPending
+	if dead {
+		status = model.ScheduledJobStatusFailed
+	}
+	_, err := p.Pool.Exec(ctx, `
+		UPDATE scheduled_jobs j
+		SET status = CASE
+		        WHEN $2 = 'pending' AND EXISTS (
+		            SELECT 1 FROM scheduled_jobs o
+		            WHERE o.job_type = j.job_type AND o.job_key = j.job_key AND o.status = 'pending' AND o.id <> j.id
+		        ) THEN 'cancelled'
+		        ELSE $2
+		    END,
+		    last_error = $3, run_at = $4, locked_at = NULL, updated_at = NOW()
+		WHERE id = $1
+	`, id, status, errMsg, nextRunAt)
+	if err != nil {
+		return fmt.Errorf("failed to record scheduled job failure: %w", err)
+	}
+	return nil
+}
+
+// CancelScheduledJobs - 종류/key가 같은 pending 작업 취소 (취소된 수 반환)
+func (p *Postgres) CancelScheduledJobs(ctx context.Context, jobType, jobKey string) (int64, error) {
+	tag, err := p.Pool.Exec(ctx, `
+		UPDATE scheduled_jobs
+		SET status = 'cancelled', completed_at = NOW(), updated_at = NOW()
+		WHERE job_type = $1 AND job_key = $2 AND status = 'pending'
+	`, jobType, jobKey)
+	if err != nil {
+		return 0, fmt.Errorf("failed to cancel scheduled jobs: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// PurgeCompletedScheduledJobs - before 이전에 완료/취소된 작업 삭제 (삭제 수 반환)
+// 재시도 대기 중 cancelled로 정리된 작업은 completed_at이 없으므로 updated_at 기준.
+func (p *Postgres) PurgeCompletedScheduledJobs(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := p.Pool.Exec(ctx, `
+		DELETE FROM scheduled_jobs
+		WHERE status IN ('done', 'cancelled') AND COALESCE(completed_at, updated_at) < $1
+	`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge scheduled jobs: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// ListScheduledJobs - 예약 작업 목록 (실행 예정 시각순, jobType/status 빈 문자열이면 전체)
+func (p *Postgres) ListScheduledJobs(ctx context.Context, jobType, status string, limit int) ([]model.ScheduledJob, error) {
+	list, err := queryScheduledJobs(ctx, p, `
+		SELECT `+scheduledJobColumns+`
+		FROM scheduled_jobs
+		WHERE ($1 = '' OR job_type = $1)
+		  AND ($2 = '' OR status = $2)
+		ORDER BY run_at, id
+		LIMIT $3
+	`, jobType, status, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduled jobs: %w", err)
+	}
+	return list, nil
+}
@@ -0,0 +1,256 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// EnsureServiceSchema - services 테이블 생성 (서비스 소유권 카탈로그)
+// alerts.service_id / incidents.service_id는 각 테이블 스키마에서 관리
+func (p *Postgres) EnsureServiceSchema() error {
+	queries := []string{
+		`
+		CREATE TABLE IF NOT EXISTS services (
+			id BIGSERIAL PRIMARY KEY,
+			name TEXT NOT NULL,
+			team TEXT NOT NULL DEFAULT '',
+			namespaces TEXT[] NOT NULL DEFAULT '{}',
+			selectors JSONB NOT NULL DEFAULT '[]',
+			slack_channel TEXT NOT NULL DEFAULT '',
+			escalation_contact TEXT NOT NULL DEFAULT '',
+			tier TEXT NOT NULL DEFAULT '',
+			description TEXT NOT NULL DEFAULT '',
+			created_by TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)
+		`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS services_name_uniq ON services(lower(name))`,
+	}
+
+	for _, query := range queries {
+		if _, err := p.Pool.Exec(context.Background(), query); err != nil {
+			return fmt.Errorf("failed to ensure services schema: %w", err)
+		}
+	}
+	return nil
+}
+
+const serviceColumns = `id, name, team, namespaces, selectors, slack_channel, escalation_contact, tier, description, created_by, created_at, updated_at`
+
+func scanService(row pgx.Row) (model.Service, error) {
+	var (
+		s         model.Service
+		selectors []byte
+	)
+	if err := row.Scan(&s.ID, &s.Name, &s.Team, &s.Namespaces, &selectors, &s.SlackChannel, &s.EscalationContact, &s.Tier, &s.Description, &s.CreatedBy, &s.CreatedAt, &s.UpdatedAt); err != nil {
+		return s, err
+	}
+	if err := json.Unmarshal(selectors, &s.Selectors); err != nil {
+		return s, fmt.Errorf("failed to decode service selectors (id=%d): %w", s.ID, err)
+	}
+	if s.Namespaces == nil {
+		s.Namespaces = []string{}
+	}
+	if s.Selectors == nil {
+		s.Selectors = model.LabelMatchers{}
+	}
+	return s, nil
+}
+
+func (p *Postgres) queryServices(ctx context.Context, query string, args ...any) ([]model.Service, error) {
+	rows, err := p.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query services: %w", err)
+	}
+	defer rows.Close()
+
+	services := []model.Service{}
+	for rows.Next() {
+		s, err := scanService(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan service: %w", err)
+		}
+		services = append(services, s)
+	}
+	return services, rows.Err()
+}
+
+// ListServices - 서비스 전체 목록 (이름순)
+func (p *Postgres) ListServices(ctx context.Context) ([]model.Service, error) {
+	return p.queryServices(ctx, `SELECT `+serviceColumns+` FROM services ORDER BY name, id`)
+}
+
+// ListServicesForMatching - alert 처리 시 서비스 매칭용 목록 (ID순)
+func (p *Postgres) ListServicesForMatching() ([]model.Service, error) {
+	return p.queryServices(context.Background(), `SELECT `+serviceColumns+` FROM services ORDER BY id`)
+}
+
+// GetService - ID로 단건 조회 (없으면 nil)
+func (p *Postgres) GetService(ctx context.Context, id int64) (*model.Service, error) {
+	s, err := scanService(p.Pool.QueryRow(ctx, `SELECT `+serviceColumns+` FROM services WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service: %w", err)
+	}
+	return &s, nil
+}
+
+// CreateService - 서비스 저장 (이름 중복 시 unique violation)
+func (p *Postgres) CreateService(ctx context.Context, s model.Service) (int64, error) {
+	selectors, err := json.Marshal(s.Selectors)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode service selectors: %w", err)
+	}
+	var id int64
+	err = p.Pool.QueryRow(ctx, `
+		INSERT INTO services (name, team, namespaces, selectors, slack_channel, escalation_contact, tier, description, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id
+	`, s.Name, s.Team, s.Namespaces, selectors, s.SlackChannel, s.EscalationContact, s.Tier, s.Description, s.CreatedBy).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert service: %w", err)
+	}
+	return id, nil
+}
+
+// UpdateService - 서비스 수정 (created_by는 유지)
+func (p *Postgres) UpdateService(ctx context.Context, id int64, s model.Service) error {
+	selectors, err := json.Marshal(s.Selectors)
+	if err != nil {
+		return fmt.Errorf("failed to encode service selectors: %w", err)
+	}
+	tag, err := p.Pool.Exec(ctx, `
+		UPDATE services
+		SET name = $2, team = $3, namespaces = $4, selectors = $5, slack_channel = $6,
+			escalation_contact = $7, tier = $8, description = $9, updated_at = NOW()
+		WHERE id = $1
+	`, id, s.Name, s.Team, s.Namespaces, selectors, s.SlackChannel, s.EscalationContact, s.Tier, s.Description)
+	if err != nil {
+		return fmt.Errorf("failed to update service: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("service not found: id=%d", id)
+	}
+	return nil
+}
+
+// DeleteService - 서비스 삭제 (alerts/incidents의 service_id 기록은 유지)
+func (p *Postgres) DeleteService(ctx context.Context, id int64) error {
+	tag, err := p.Pool.Exec(ctx, `DELETE FROM services WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete service: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("service not found: id=%d", id)
+	}
+	return nil
+}
+
+// UpdateAlertService - Alert가 속한 서비스 기록 (nil이면 해제)
+func (p *Postgres) UpdateAlertService(alertID string, serviceID *int64) error {
+	_, err := p.Pool.Exec(context.Background(), `
+		UPDATE alerts
+		SET service_id = $2, updated_at = NOW()
+		WHERE alert_id = $1 AND service_id IS DISTINCT FROM $2
+	`, alertID, serviceID)
+	return err
+}
+
+// AssignIncidentService - Incident에 서비스가 아직 없으면 기록 (처음 매칭된 서비스 유지)
+func (p *Postgres) AssignIncidentService(incidentID string, serviceID int64) error {
+	_, err := p.Pool.Exec(context.Background(), `
+		UPDATE incidents
+		SET service_id = $2, updated_at = NOW()
+		WHERE incident_id = $1 AND service_id IS NULL
+	`, incidentID, serviceID)
+	return err
+}
+
+// ListServiceIncidents - 서비스에 속한 Incident 목록 (status가 비어 있으면 전체, 최신순)
+func (p *Postgres) ListServiceIncidents(ctx context.Context, serviceID int64, status string) ([]model.IncidentListResponse, error) {
+	rows, err := p.Pool.Query(ctx, `
+		SELECT
+			i.incident_id,
+			i.title,
+			i.severity,
+			i.status,
+			i.fired_at,
+			i.resolved_at,
+			COUNT(a.alert_id) as alert_count,
+			i.correlation_key,
+			COALESCE(BOOL_AND(a.in_maintenance), FALSE) as in_maintenance,
+			i.acknowledged_at IS NOT NULL, i.acknowledged_at, i.acknowledged_by, i.time_to_ack_seconds
+		FROM incidents i
+		LEFT JOIN alerts a ON i.incident_id = a.incident_id AND a.is_enabled = TRUE
+		WHERE i.is_enabled = TRUE AND i.service_id = $1 AND ($2 = '' OR i.status = $2)
+		GROUP BY i.incident_id
+		ORDER BY i.fired_at DESC
+	`, serviceID, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query service incidents: %w", err)
+	}
+	defer rows.Close()
+
+	list := []model.IncidentListResponse{}
+	for rows.Next() {
+		var i model.IncidentListResponse
+		if err := rows.Scan(&i.IncidentID, &i.Title, &i.Severity, &i.Status, &i.FiredAt, &i.ResolvedAt, &i.AlertCount, &i.CorrelationKey, &i.InMaintenance,
+			&i.Acknowledged, &i.AcknowledgedAt, &i.AcknowledgedBy, &i.TimeToAckSeconds); err != nil {
+			return nil, fmt.Errorf("failed to scan service incident: %w", err)
+		}
+		list = append(list, i)
+	}
+	return list, rows.Err()
+}
+
+// GetServiceMetrics - 서비스별 열린 Incident 수, since 이후 발생한 Incident 수/해결 수/MTTR
+// 연결된 alert가 모두 점검 시간대에 수신된 Incident는 MTTR에서 제외 (analytics와 동일)
+func (p *Postgres) GetServiceMetrics(ctx context.Context, since time.Time) ([]model.ServiceMetrics, error) {
+	rows, err := p.Pool.Query(ctx, `
+		WITH incident_stats AS (
+			SELECT
+				i.incident_id, i.service_id, i.status, i.fired_at, i.resolved_at,
+				COALESCE((
+					SELECT BOOL_AND(a.in_maintenance) FROM alerts a
+					WHERE a.incident_id = i.incident_id AND a.is_enabled = TRUE
+				), FALSE) AS in_maintenance
+			FROM incidents i
+			WHERE i.is_enabled = TRUE AND i.service_id IS NOT NULL
+		)
+		SELECT
+			s.id, s.name, s.team, s.tier,
+			COUNT(st.incident_id) FILTER (WHERE st.status = 'firing'),
+			COUNT(st.incident_id) FILTER (WHERE st.fired_at >= $1),
+			COUNT(st.incident_id) FILTER (WHERE st.fired_at >= $1 AND st.resolved_at IS NOT NULL),
+			COALESCE(AVG(EXTRACT(EPOCH FROM st.resolved_at - st.fired_at) / 60) FILTER (
+				WHERE st.fired_at >= $1 AND st.resolved_at > st.fired_at AND NOT st.in_maintenance
+			), 0)
+		FROM services s
+		LEFT JOIN incident_stats st ON st.service_id = s.id
+		GROUP BY s.id, s.name, s.team, s.tier
+		ORDER BY s.name, s.id
+	`, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query service metrics: %w", err)
+	}
+	defer rows.Close()
+
+	metrics := []model.ServiceMetrics{}
+	for rows.Next() {
+		var m model.ServiceMetrics
+		if err := rows.Scan(&m.ServiceID, &m.Name, &m.Team, &m.Tier, &m.OpenIncidents, &m.TotalIncidents, &m.ResolvedIncidents, &m.MTTRMinutes); err != nil {
+			return nil, fmt.Errorf("failed to scan service metrics: %w", err)
+		}
+		metrics = append(metrics, m)
+	}
+	return metrics, rows.Err()
+}
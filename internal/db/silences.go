@@ -0,0 +1,149 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// EnsureSilenceSchema - silence_rules 테이블 생성 (라벨 매처 기반 알림 음소거 규칙)
+func (p *Postgres) EnsureSilenceSchema() error {
+	queries := []string{
+		`
+		CREATE TABLE IF NOT EXISTS silence_rules (
+			id BIGSERIAL PRIMARY KEY,
+			matchers JSONB NOT NULL DEFAULT '[]',
+			starts_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			ends_at TIMESTAMPTZ NOT NULL,
+			created_by TEXT NOT NULL DEFAULT '',
+			comment TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)
+		`,
+		`CREATE INDEX IF NOT EXISTS silence_rules_active_idx ON silence_rules(ends_at, starts_at)`,
+	}
+
+	for _, query := range queries {
+		if _, err := p.Pool.Exec(context.Background(), query); err != nil {
+			return fmt.Errorf("failed to ensure silence_rules schema: %w", err)
+		}
+	}
+	return nil
+}
+
+const silenceRuleColumns = `id, matchers, starts_at, ends_at, created_by, comment, created_at, updated_at`
+
+func scanSilenceRule(row pgx.Row) (model.SilenceRule, error) {
+	var (
+		r        model.SilenceRule
+		matchers []byte
+	)
+	if err := row.Scan(&r.ID, &matchers, &r.StartsAt, &r.EndsAt, &r.CreatedBy, &r.Comment, &r.CreatedAt, &r.UpdatedAt); err != nil {
+		return r, err
+	}
+	if err := json.Unmarshal(matchers, &r.Matchers); err != nil {
+		return r, fmt.Errorf("failed to decode silence matchers (id=%d): %w", r.ID, err)
+	}
+	return r, nil
+}
+
+func (p *Postgres) querySilenceRules(ctx context.Context, query string, args ...any) ([]model.SilenceRule, error) {
+	rows, err := p.Pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query silence rules: %w", err)
+	}
+	defer rows.Close()
+
+	rules := []model.SilenceRule{}
+	for rows.Next() {
+		r, err := scanSilenceRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan silence rule: %w", err)
+		}
+		rules = append(rules, r)
+	}
+	return rules, rows.Err()
+}
+
+// ListSilenceRules - Silence 규칙 전체 목록 (최신순)
+func (p *Postgres) ListSilenceRules(ctx context.Context) ([]model.SilenceRule, error) {
+	return p.querySilenceRules(ctx, `SELECT `+silenceRuleColumns+` FROM silence_rules ORDER BY created_at DESC`)
+}
+
+// ListActiveSilenceRules - 주어진 시각에 유효한 Silence 규칙 (starts_at <= at < ends_at)
+func (p *Postgres) ListActiveSilenceRules(at time.Time) ([]model.SilenceRule, error) {
+	return p.querySilenceRules(context.Background(), `
+		SELECT `+silenceRuleColumns+`
+		FROM silence_rules
+		WHERE starts_at <= $1 AND ends_at > $1
+		ORDER BY created_at ASC
+	`, at)
+}
+
+// GetSilenceRule - ID로 단건 조회 (없으면 nil)
+func (p *Postgres) GetSilenceRule(ctx context.Context, id int64) (*model.SilenceRule, error) {
+	r, err := scanSilenceRule(p.Pool.QueryRow(ctx, `SELECT `+silenceRuleColumns+` FROM silence_rules WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get silence rule: %w", err)
+	}
+	return &r, nil
+}
+
+// CreateSilenceRule - Silence 규칙 저장
+func (p *Postgres) CreateSilenceRule(ctx context.Context, rule model.SilenceRule) (int64, error) {
+	matchers, err := json.Marshal(rule.Matchers)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode silence matchers: %w", err)
+	}
+	var id int64
+	err = p.Pool.QueryRow(ctx, `
+		INSERT INTO silence_rules (matchers, starts_at, ends_at, created_by, comment)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`, matchers, rule.StartsAt, rule.EndsAt, rule.CreatedBy, rule.Comment).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert silence rule: %w", err)
+	}
+	return id, nil
+}
+
+// UpdateSilenceRule - Silence 규칙 수정 (created_by는 유지)
+func (p *Postgres) UpdateSilenceRule(ctx context.Context, id int64, rule model.SilenceRule) error {
+	matchers, err := json.Marshal(rule.Matchers)
+	if err != nil {
+		return fmt.Errorf("failed to encode silence matchers: %w", err)
+	}
+	tag, err := p.Pool.Exec(ctx, `
+		UPDATE silence_rules
+		SET matchers = $2, starts_at = $3, ends_at = $4, comment = $5, updated_at = NOW()
+		WHERE id = $1
+	`, id, matchers, rule.StartsAt, rule.EndsAt, rule.Comment)
+	if err != nil {
+		return fmt.Errorf("failed to update silence rule: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("silence rule not found: id=%d", id)
+	}
+	return nil
+}
+
+// DeleteSilenceRule - Silence 규칙 삭제 (alerts.silenced_by 기록은 유지)
+func (p *Postgres) DeleteSilenceRule(ctx context.Context, id int64) error {
+	tag, err := p.Pool.Exec(ctx, `DELETE FROM silence_rules WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete silence rule: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("silence rule not found: id=%d", id)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/kube-rca/backend/internal/model"
@@ -31,12 +32,42 @@ func (p *Postgres) EnsureWebhookSchema() error {
 		ADD COLUMN IF NOT EXISTS type TEXT NOT NULL DEFAULT 'http',
 		ADD COLUMN IF NOT EXISTS token TEXT NOT NULL DEFAULT '',
 		ADD COLUMN IF NOT EXISTS channel TEXT NOT NULL DEFAULT '',
-		ADD COLUMN IF NOT EXISTS severities TEXT[] NOT NULL DEFAULT '{}';
+		ADD COLUMN IF NOT EXISTS severities TEXT[] NOT NULL DEFAULT '{}',
+		ADD COLUMN IF NOT EXISTS use_service_channel BOOLEAN NOT NULL DEFAULT FALSE,
+		ADD COLUMN IF NOT EXISTS matchers JSONB NOT NULL DEFAULT '[]',
+		ADD COLUMN IF NOT EXISTS enabled BOOLEAN NOT NULL DEFAULT TRUE,
+		ADD COLUMN IF NOT EXISTS priority INTEGER NOT NULL DEFAULT 100,
+		ADD COLUMN IF NOT EXISTS title_template TEXT NOT NULL DEFAULT '',
+		ADD COLUMN IF NOT EXISTS body_template TEXT NOT NULL DEFAULT '',
+		ADD COLUMN IF NOT EXISTS payload_template TEXT NOT NULL DEFAULT '',
+		ADD COLUMN IF NOT EXISTS http_method TEXT NOT NULL DEFAULT 'POST',
+		ADD COLUMN IF NOT EXISTS custom_headers JSONB NOT NULL DEFAULT '{}',
+		ADD COLUMN IF NOT EXISTS signing_secret TEXT NOT NULL DEFAULT '',
+		ADD COLUMN IF NOT EXISTS basic_auth_username TEXT NOT NULL DEFAULT '',
+		ADD COLUMN IF NOT EXISTS basic_auth_password TEXT NOT NULL DEFAULT '',
+		ADD COLUMN IF NOT EXISTS tls_client_cert TEXT NOT NULL DEFAULT '',
+		ADD COLUMN IF NOT EXISTS tls_client_key TEXT NOT NULL DEFAULT '',
+		ADD COLUMN IF NOT EXISTS tls_ca_cert TEXT NOT NULL DEFAULT '';
 	`)
 	if err != nil {
 		return fmt.Errorf("failed to alter webhook_configs table(add columns): %w", err)
 	}
 
+	// 기존 설정은 매칭되는 모든 설정으로 전송되던 동작을 유지하도록 continue=TRUE로 추가하고,
+	// 이후 생성되는 설정의 기본값은 FALSE(첫 매칭에서 중단)로 바꾼다.
+	for _, query := range []string{
+		`ALTER TABLE webhook_configs ADD COLUMN IF NOT EXISTS continue_matching BOOLEAN NOT NULL DEFAULT TRUE`,
+		`ALTER TABLE webhook_configs ALTER COLUMN continue_matching SET DEFAULT FALSE`,
+	} {
+		if _, err := p.Pool.Exec(ctx, query); err != nil {
+			return fmt.Errorf("failed to alter webhook_configs table(continue_matching): %w", err)
+		}
+	}
+
+	if err := p.migrateWebhookSeverities(ctx); err != nil {
+		return err
+	}
+
 	_, err = p.Pool.Exec(ctx, `
 		ALTER TABLE webhook_configs
 		DROP COLUMN IF EXISTS method,
@@ -71,12 +102,87 @@ func (p *Postgres) EnsureWebhookSchema() error {
 	return nil
 }
 
-// GetWebhookConfigs - 웹훅 설정 전체 목록 조회 (최신순)
+// migrateWebhookSeverities - 기존 severities 필터를 severity 라벨 매처로 옮기고 비운다 (한 번만 적용됨)
+func (p *Postgres) migrateWebhookSeverities(ctx context.Context) error {
+	rows, err := p.Pool.Query(ctx, `SELECT id, severities FROM webhook_configs WHERE cardinality(severities) > 0`)
+	if err != nil {
+		return fmt.Errorf("failed to query webhook severities: %w", err)
+	}
+	type legacy struct {
+		id         int
+		severities []string
+	}
+	var pending []legacy
+	for rows.Next() {
+		var l legacy
+		if err := rows.Scan(&l.id, &l.severities); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan webhook severities: %w", err)
+		}
+		pending = append(pending, l)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read webhook severities: %w", err)
+	}
+
+	for _, l := range pending {
+		matchers := model.LabelMatchers{}
+		if m, ok := model.SeverityMatcher(l.severities); ok {
+			matchers = append(matchers, m)
+		}
+		encoded, err := json.Marshal(matchers)
+		if err != nil {
+			return fmt.Errorf("failed to encode webhook matchers: %w", err)
+		}
+		if _, err := p.Pool.Exec(ctx, `
+			UPDATE webhook_configs
+			SET matchers = matchers || $2::jsonb, severities = '{}'
+			WHERE id = $1;
+		`, l.id, encoded); err != nil {
+			return fmt.Errorf("failed to migrate webhook severities (id=%d): %w", l.id, err)
+		}
+	}
+	return nil
+}
+
+const webhookConfigColumns = `id, name, url, type, token, channel, matchers, enabled, priority, continue_matching, use_service_channel,
+	title_template, body_template, payload_template, http_method, custom_headers, signing_secret,
+	basic_auth_username, basic_auth_password, tls_client_cert, tls_client_key, tls_ca_cert, updated_at`
+
+func scanWebhookConfig(row interface{ Scan(...any) error }) (model.WebhookConfig, error) {
+	var (
+		cfg      model.WebhookConfig
+		matchers []byte
+		headers  []byte
+	)
+	if err := row.Scan(&cfg.ID, &cfg.Name, &cfg.URL, &cfg.Type, &cfg.Token, &cfg.Channel, &matchers,
+		&cfg.Enabled, &cfg.Priority, &cfg.Continue, &cfg.UseServiceChannel,
+		&cfg.TitleTemplate, &cfg.BodyTemplate, &cfg.PayloadTemplate, &cfg.HTTPMethod, &headers, &cfg.SigningSecret,
+		&cfg.BasicAuthUsername, &cfg.BasicAuthPassword, &cfg.TLSClientCert, &cfg.TLSClientKey, &cfg.TLSCACert, &cfg.UpdatedAt); err != nil {
+		return cfg, err
+	}
+	if err := json.Unmarshal(matchers, &cfg.Matchers); err != nil {
+		return cfg, fmt.Errorf("failed to decode webhook config matchers (id=%d): %w", cfg.ID, err)
+	}
+	if cfg.Matchers == nil {
+		cfg.Matchers = model.LabelMatchers{}
+	}
+	if err := json.Unmarshal(headers, &cfg.CustomHeaders); err != nil {
+		return cfg, fmt.Errorf("failed to decode webhook config headers (id=%d): %w", cfg.ID, err)
+	}
+	if cfg.CustomHeaders == nil {
+		cfg.CustomHeaders = map[string]string{}
+	}
+	return cfg, nil
+}
+
+// GetWebhookConfigs - 웹훅 설정 전체 목록 조회 (라우팅 평가 순서: priority, id)
 func (p *Postgres) GetWebhookConfigs(ctx context.Context) ([]model.WebhookConfig, error) {
 	rows, err := p.Pool.Query(ctx, `
-		SELECT id, name, url, type, token, channel, severities, updated_at
+		SELECT `+webhookConfigColumns+`
 		FROM webhook_configs
-		ORDER BY updated_at DESC;
+		ORDER BY priority, id;
 	`)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query webhook configs: %w", err)
@@ -85,13 +191,10 @@ func (p *Postgres) GetWebhookConfigs(ctx context.Context) ([]model.WebhookConfig
 
 	var configs []model.WebhookConfig
 	for rows.Next() {
-		var cfg model.WebhookConfig
-		if err := rows.Scan(&cfg.ID, &cfg.Name, &cfg.URL, &cfg.Type, &cfg.Token, &cfg.Channel, &cfg.Severities, &cfg.UpdatedAt); err != nil {
+		cfg, err := scanWebhookConfig(rows)
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan webhook config: %w", err)
 		}
-		if cfg.Severities == nil {
-			cfg.Severities = []string{}
-		}
 		configs = append(configs, cfg)
 	}
 	if configs == nil {
@@ -103,33 +206,34 @@ func (p *Postgres) GetWebhookConfigs(ctx context.Context) ([]model.WebhookConfig
 // GetWebhookConfigByID - ID로 단건 조회
 func (p *Postgres) GetWebhookConfigByID(ctx context.Context, id int) (*model.WebhookConfig, error) {
 	row := p.Pool.QueryRow(ctx, `
-		SELECT id, name, url, type, token, channel, severities, updated_at
+		SELECT `+webhookConfigColumns+`
 		FROM webhook_configs
 		WHERE id = $1;
 	`, id)
 
-	var cfg model.WebhookConfig
-	if err := row.Scan(&cfg.ID, &cfg.Name, &cfg.URL, &cfg.Type, &cfg.Token, &cfg.Channel, &cfg.Severities, &cfg.UpdatedAt); err != nil {
+	cfg, err := scanWebhookConfig(row)
+	if err != nil {
 		return nil, fmt.Errorf("webhook config not found: %w", err)
 	}
-	if cfg.Severities == nil {
-		cfg.Severities = []string{}
-	}
 	return &cfg, nil
 }
 
 // CreateWebhookConfig - 신규 웹훅 설정 저장
 func (p *Postgres) CreateWebhookConfig(ctx context.Context, cfg model.WebhookConfig) (int, error) {
-	severities := cfg.Severities
-	if severities == nil {
-		severities = []string{}
+	matchers, headers, err := encodeWebhookConfigJSON(cfg)
+	if err != nil {
+		return 0, err
 	}
 	var id int
-	err := p.Pool.QueryRow(ctx, `
-		INSERT INTO webhook_configs (name, url, type, token, channel, severities, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+	err = p.Pool.QueryRow(ctx, `
+		INSERT INTO webhook_configs (name, url, type, token, channel, matchers, enabled, priority, continue_matching, use_service_channel,
+			title_template, body_template, payload_template, http_method, custom_headers, signing_secret,
+			basic_auth_username, basic_auth_password, tls_client_cert, tls_client_key, tls_ca_cert, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, NOW())
 		RETURNING id;
-	`, cfg.Name, cfg.URL, cfg.Type, cfg.Token, cfg.Channel, severities).Scan(&id)
+	`, cfg.Name, cfg.URL, cfg.Type, cfg.Token, cfg.Channel, matchers, cfg.Enabled, cfg.Priority, cfg.Continue, cfg.UseServiceChannel,
+		cfg.TitleTemplate, cfg.BodyTemplate, cfg.PayloadTemplate, cfg.HTTPMethod, headers, cfg.SigningSecret,
+		cfg.BasicAuthUsername, cfg.BasicAuthPassword, cfg.TLSClientCert, cfg.TLSClientKey, cfg.TLSCACert).Scan(&id)
 	if err != nil {
 		return 0, fmt.Errorf("failed to insert webhook config: %w", err)
 	}
@@ -138,15 +242,20 @@ func (p *Postgres) CreateWebhookConfig(ctx context.Context, cfg model.WebhookCon
 
 // UpdateWebhookConfig - ID로 웹훅 설정 수정
 func (p *Postgres) UpdateWebhookConfig(ctx context.Context, id int, cfg model.WebhookConfig) error {
-	severities := cfg.Severities
-	if severities == nil {
-		severities = []string{}
+	matchers, headers, err := encodeWebhookConfigJSON(cfg)
+	if err != nil {
+		return err
 	}
 	tag, err := p.Pool.Exec(ctx, `
 		UPDATE webhook_configs
-		SET name = $1, url = $2, type = $3, token = $4, channel = $5, severities = $6, updated_at = NOW()
-		WHERE id = $7;
-	`, cfg.Name, cfg.URL, cfg.Type, cfg.Token, cfg.Channel, severities, id)
+		SET name = $1, url = $2, type = $3, token = $4, channel = $5, matchers = $6, enabled = $7, priority = $8,
+			continue_matching = $9, use_service_channel = $10, title_template = $11, body_template = $12, payload_template = $13,
+			http_method = $14, custom_headers = $15, signing_secret = $16, basic_auth_username = $17, basic_auth_password = $18,
+			tls_client_cert = $19, tls_client_key = $20, tls_ca_cert = $21, updated_at = NOW()
+		WHERE id = $22;
+	`, cfg.Name, cfg.URL, cfg.Type, cfg.Token, cfg.Channel, matchers, cfg.Enabled, cfg.Priority, cfg.Continue, cfg.UseServiceChannel,
+		cfg.TitleTemplate, cfg.BodyTemplate, cfg.PayloadTemplate, cfg.HTTPMethod, headers, cfg.SigningSecret,
+		cfg.BasicAuthUsername, cfg.BasicAuthPassword, cfg.TLSClientCert, cfg.TLSClientKey, cfg.TLSCACert, id)
 	if err != nil {
 		return fmt.Errorf("failed to update webhook config: %w", err)
 	}
@@ -167,3 +276,24 @@ func (p *Postgres) DeleteWebhookConfig(ctx context.Context, id int) error {
 	}
 	return nil
 }
+
+// encodeWebhookConfigJSON - JSONB 컬럼(matchers, custom_headers) 인코딩
+func encodeWebhookConfigJSON(cfg model.WebhookConfig) ([]byte, []byte, error) {
+	matchers := cfg.Matchers
+	if matchers == nil {
+		matchers = model.LabelMatchers{}
+	}
+	encodedMatchers, err := json.Marshal(matchers)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode webhook config matchers: %w", err)
+	}
+	headers := cfg.CustomHeaders
+	if headers == nil {
+		headers = map[string]string{}
+	}
+	encodedHeaders, err := json.Marshal(headers)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode webhook config headers: %w", err)
+	}
+	return encodedMatchers, encodedHeaders, nil
+}
@@ -0,0 +1,252 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// ErrWebhookInboxEntryNotOwned - 점유가 만료되어 다른 worker가 다시 점유한 entry (처리 결과를 기록하지 않음)
+var ErrWebhookInboxEntryNotOwned = errors.New("webhook inbox entry is no longer owned by this worker")
+
+// EnsureWebhookInboxSchema - webhook_inbox 테이블 생성 (수신 웹훅 원본 보관 + 비동기 처리 큐)
+func (p *Postgres) EnsureWebhookInboxSchema() error {
+	queries := []string{
+		`
+		CREATE TABLE IF NOT EXISTS webhook_inbox (
+			id BIGSERIAL PRIMARY KEY,
+			source TEXT NOT NULL DEFAULT 'alertmanager',
+			credential TEXT NOT NULL DEFAULT '',
+			payload JSONB NOT NULL,
+			alert_count INT NOT NULL DEFAULT 0,
+			status TEXT NOT NULL DEFAULT 'pending',
+			attempts INT NOT NULL DEFAULT 0,
+			last_error TEXT NOT NULL DEFAULT '',
+			notifications_sent INT NOT NULL DEFAULT 0,
+			notifications_failed INT NOT NULL DEFAULT 0,
+			received_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			locked_at TIMESTAMPTZ,
+			processed_at TIMESTAMPTZ,
+			updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)
+		`,
+		`CREATE INDEX IF NOT EXISTS webhook_inbox_pending_idx ON webhook_inbox(next_attempt_at) WHERE status IN ('pending', 'processing')`,
+		`CREATE INDEX IF NOT EXISTS webhook_inbox_status_idx ON webhook_inbox(status, received_at DESC)`,
+		// Alertmanager max_alerts 설정으로 웹훅에서 생략된 alert 수 (Alertmanager API 동기화로 복구)
+		`ALTER TABLE webhook_inbox ADD COLUMN IF NOT EXISTS truncated_alerts INT NOT NULL DEFAULT 0`,
+		// 처리 순서를 보장할 단위 (수신 경로 + Alertmanager groupKey), 같은 키의 entry는 수신 순서대로 하나씩 처리
+		`ALTER TABLE webhook_inbox ADD COLUMN IF NOT EXISTS group_key TEXT NOT NULL DEFAULT ''`,
+		`CREATE INDEX IF NOT EXISTS webhook_inbox_group_idx ON webhook_inbox(group_key, id) WHERE status IN ('pending', 'processing')`,
+		`CREATE INDEX IF NOT EXISTS webhook_inbox_processed_idx ON webhook_inbox(processed_at) WHERE status = 'done'`,
+	}
+
+	for _, query := range queries {
+		if _, err := p.Pool.Exec(context.Background(), query); err != nil {
+			return fmt.Errorf("failed to ensure webhook_inbox schema: %w", err)
+		}
+	}
+	return nil
+}
+
+const webhookInboxColumns = `
+	id, source, credential, group_key, status, alert_count, truncated_alerts, attempts, last_error,
+	notifications_sent, notifications_failed, received_at, next_attempt_at, processed_at`
+
+func scanWebhookInboxEntry(row pgx.Row, withPayload bool) (model.WebhookInboxEntry, error) {
+	var e model.WebhookInboxEntry
+	dest := []any{
+		&e.ID, &e.Source, &e.Credential, &e.GroupKey, &e.Status, &e.AlertCount, &e.TruncatedAlerts, &e.Attempts, &e.LastError,
+		&e.NotificationsSent, &e.NotificationsFailed, &e.ReceivedAt, &e.NextAttemptAt, &e.ProcessedAt,
+	}
+	if withPayload {
+		dest = append(dest, &e.Payload)
+	}
+	err := row.Scan(dest...)
+	return e, err
+}
+
+// InsertWebhookInboxEntry - 수신 웹훅 원본 저장 (pending 상태)
+func (p *Postgres) InsertWebhookInboxEntry(ctx context.Context, source, credential, groupKey string, payload json.RawMessage, alertCount, truncatedAlerts int) (int64, error) {
+	var id int64
+	err := p.Pool.QueryRow(ctx, `
+		INSERT INTO webhook_inbox (source, credential, group_key, payload, alert_count, truncated_alerts, status, received_at, next_attempt_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, 'pending', NOW(), NOW(), NOW())
+		RETURNING id
+	`, source, credential, groupKey, payload, alertCount, truncatedAlerts).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert webhook inbox entry: %w", err)
+	}
+	return id, nil
+}
+
+// ClaimWebhookInboxEntries - 처리할 entry를 원자적으로 점유 (FOR UPDATE SKIP LOCKED)
+// staleAfter보다 오래 processing 상태인 entry(처리 중 Pod 종료)도 다시 점유한다.
+// group_key별로 가장 오래된 미완료 entry만 점유하므로 같은 그룹은 수신 순서대로 하나씩 처리된다
+// (앞선 entry가 재시도 대기 중이면 뒤 entry도 done/dead가 될 때까지 기다린다).
+func (p *Postgres) ClaimWebhookInboxEntries(ctx context.Context, limit int, staleAfter time.Duration) ([]model.WebhookInboxEntry, error) {
+	rows, err := p.Pool.Query(ctx, `
+		UPDATE webhook_inbox
+		SET status = 'processing', attempts = attempts + 1, locked_at = NOW(), updated_at = NOW()
+		WHERE id IN (
+			SELECT w.id FROM webhook_inbox w
+			WHERE ((w.status = 'pending' AND w.next_attempt_at <= NOW())
+			   OR (w.status = 'processing' AND w.locked_at < NOW() - make_interval(secs => $2)))
+			  AND NOT EXISTS (
+				SELECT 1 FROM webhook_inbox o
+				WHERE o.group_key = w.group_key AND o.id < w.id AND o.status IN ('pending', 'processing')
+			  )
+			ORDER BY w.id
+			FOR UPDATE SKIP LOCKED
+			LIMIT $1
+		)
+		RETURNING `+webhookInboxColumns+`, payload
+	`, limit, staleAfter.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook inbox entries: %w", err)
+	}
+	defer rows.Close()
+
+	var list []model.WebhookInboxEntry
+	for rows.Next() {
+		e, err := scanWebhookInboxEntry(rows, true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook inbox entry: %w", err)
+		}
+		list = append(list, e)
+	}
+	return list, rows.Err()
+}
+
+// CompleteWebhookInboxEntry - 처리 완료 기록
+// attempts는 점유 시 받은 값으로, 그 사이 다른 worker가 다시 점유했으면 ErrWebhookInboxEntryNotOwned를 반환한다.
+func (p *Postgres) CompleteWebhookInboxEntry(ctx context.Context, id int64, attempts, sent, failed int) error {
+	tag, err := p.Pool.Exec(ctx, `
+		UPDATE webhook_inbox
+		SET status = 'done', last_error = '', notifications_sent = $3, notifications_failed = $4,
+		    locked_at = NULL, processed_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND status = 'processing' AND attempts = $2
+	`, id, attempts, sent, failed)
+	if err != nil {
+		return fmt.Errorf("failed to complete webhook inbox entry: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrWebhookInboxEntryNotOwned
+	}
+	return nil
+}
+
+// FailWebhookInboxEntry - 처리 실패 기록 (dead=true면 dead-letter, 아니면 nextAttemptAt에 재시도)
+// attempts는 점유 시 받은 값으로, 그 사이 다른 worker가 다시 점유했으면 ErrWebhookInboxEntryNotOwned를 반환한다.
+func (p *Postgres) FailWebhookInboxEntry(ctx context.Context, id int64, attempts int, errMsg string, nextAttemptAt time.Time, dead bool) error {
+	status := model.WebhookInboxStatusPending
+	if dead {
+		status = model.WebhookInboxStatusDead
+	}
+	tag, err := p.Pool.Exec(ctx, `
+		UPDATE webhook_inbox
+		SET status = $3, last_error = $4, next_attempt_at = $5, locked_at = NULL, updated_at = NOW()
+		WHERE id = $1 AND status = 'processing' AND attempts = $2
+	`, id, attempts, status, errMsg, nextAttemptAt)
+	if err != nil {
+		return fmt.Errorf("failed to record webhook inbox failure: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrWebhookInboxEntryNotOwned
+	}
+	return nil
+}
+
+// RetainWebhookInboxAlerts - 재시도할 alert만 남긴 payload로 교체 (처리된 alert는 다시 처리하지 않음)
+// attempts는 점유 시 받은 값으로, 그 사이 다른 worker가 다시 점유했으면 ErrWebhookInboxEntryNotOwned를 반환한다.
+func (p *Postgres) RetainWebhookInboxAlerts(ctx context.Context, id int64, attempts int, payload json.RawMessage, alertCount int) error {
+	tag, err := p.Pool.Exec(ctx, `
+		UPDATE webhook_inbox
+		SET payload = $3, alert_count = $4, updated_at = NOW()
+		WHERE id = $1 AND status = 'processing' AND attempts = $2
+	`, id, attempts, payload, alertCount)
+	if err != nil {
+		return fmt.Errorf("failed to narrow webhook inbox payload: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrWebhookInboxEntryNotOwned
+	}
+	return nil
+}
+
+// PurgeDoneWebhookInbox - before 이전에 처리 완료된 entry 삭제 (삭제 수 반환)
+func (p *Postgres) PurgeDoneWebhookInbox(ctx context.Context, before time.Time) (int64, error) {
+	tag, err := p.Pool.Exec(ctx, `
+		DELETE FROM webhook_inbox
+		WHERE status = 'done' AND processed_at < $1
+	`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge webhook inbox: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// ListWebhookInboxEntries - inbox 목록 조회 (최신순, status 빈 문자열이면 전체)
+func (p *Postgres) ListWebhookInboxEntries(ctx context.Context, status string, limit int) ([]model.WebhookInboxEntry, error) {
+	rows, err := p.Pool.Query(ctx, `
+		SELECT `+webhookInboxColumns+`
+		FROM webhook_inbox
+		WHERE ($1 = '' OR status = $1)
+		ORDER BY id DESC
+		LIMIT $2
+	`, status, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook inbox entries: %w", err)
+	}
+	defer rows.Close()
+
+	list := []model.WebhookInboxEntry{}
+	for rows.Next() {
+		e, err := scanWebhookInboxEntry(rows, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook inbox entry: %w", err)
+		}
+		list = append(list, e)
+	}
+	return list, rows.Err()
+}
+
+// GetWebhookInboxEntry - inbox 단건 조회 (payload 포함, 없으면 nil)
+func (p *Postgres) GetWebhookInboxEntry(ctx context.Context, id int64) (*model.WebhookInboxEntry, error) {
+	row := p.Pool.QueryRow(ctx, `
+		SELECT `+webhookInboxColumns+`, payload
+		FROM webhook_inbox
+		WHERE id = $1
+	`, id)
+	e, err := scanWebhookInboxEntry(row, true)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get webhook inbox entry: %w", err)
+	}
+	return &e, nil
+}
+
+// RequeueWebhookInboxEntry - 저장된 payload를 다시 처리하도록 pending으로 되돌림
+// 처리 중(processing)인 entry는 재처리하지 않는다.
+func (p *Postgres) RequeueWebhookInboxEntry(ctx context.Context, id int64) error {
+	tag, err := p.Pool.Exec(ctx, `
+		UPDATE webhook_inbox
+		SET status = 'pending', attempts = 0, last_error = '', next_attempt_at = NOW(),
+		    locked_at = NULL, processed_at = NULL, updated_at = NOW()
+		WHERE id = $1 AND status <> 'processing'
+	`, id)
+	if err != nil {
+		return fmt.Errorf("failed to requeue webhook inbox entry: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("no replayable webhook inbox entry found with id: %d", id)
+	}
+	return nil
+}
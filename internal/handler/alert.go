@@ -3,39 +3,57 @@
 // 요청 흐름:
 //  1. Alertmanager가 POST /webhook/alertmanager로 알림 전송
 //  2. JSON 페이로드를 AlertmanagerWebhook 구조체로 파싱
-//  3. 각 알림을 로깅하고 service 레이어로 전달
+//  3. 원본을 webhook inbox에 저장하고 202 응답 (처리는 inbox worker가 비동기로 수행)
 
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/gin-gonic/gin"
 	"github.com/kube-rca/backend/internal/model"
-	"github.com/kube-rca/backend/internal/service"
 	"log"
 	"net/http"
 )
 
+// webhookEnqueuer - 수신 웹훅을 inbox에 저장하는 인터페이스
+type webhookEnqueuer interface {
+	Enqueue(ctx context.Context, webhook model.AlertmanagerWebhook, source, credential string) (int64, error)
+}
+
+// webhookNormalizer - Alertmanager 외 소스 페이로드를 AlertmanagerWebhook으로 정규화하는 인터페이스
+type webhookNormalizer interface {
+	NormalizeGrafana(payload model.GrafanaWebhook) (model.AlertmanagerWebhook, error)
+	NormalizePagerDuty(event model.PagerDutyEvent) (model.AlertmanagerWebhook, error)
+	NormalizeGeneric(body []byte) (model.AlertmanagerWebhook, error)
+}
+
 // Alert 핸들러 구조체 정의
 type AlertHandler struct {
-	alertService *service.AlertService
+	inbox      webhookEnqueuer
+	normalizer webhookNormalizer
 }
 
 // Alert 핸들러 객체 생성
-func NewAlertHandler(alertService *service.AlertService) *AlertHandler {
+func NewAlertHandler(inbox webhookEnqueuer, normalizer webhookNormalizer) *AlertHandler {
 	return &AlertHandler{
-		alertService: alertService,
+		inbox:      inbox,
+		normalizer: normalizer,
 	}
 }
 
 // Webhook godoc
 // @Summary Receive Alertmanager webhook
+// @Description Stores the payload in the webhook inbox and returns 202; alerts are processed asynchronously
 // @Tags webhook
 // @Accept json
 // @Produce json
 // @Param payload body model.AlertmanagerWebhook true "Alertmanager webhook payload"
-// @Success 200 {object} model.AlertWebhookResponse
+// @Success 202 {object} model.WebhookAcceptedResponse
 // @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 413 {object} model.ErrorResponse
+// @Failure 503 {object} model.ErrorResponse
 // @Router /webhook/alertmanager [post]
 func (h *AlertHandler) Webhook(c *gin.Context) {
 	var webhook model.AlertmanagerWebhook
@@ -59,15 +77,29 @@ func (h *AlertHandler) Webhook(c *gin.Context) {
 	log.Printf("Received alert webhook: status=%s, alertCount=%d, receiver=%s",
 		webhook.Status, len(webhook.Alerts), webhook.Receiver)
 
-	// 4. 서비스 레이어에서 Slack 전송 처리
-	// 비즈니스 로직(필터링)은 service 레이어에 위임
-	sent, failed := h.alertService.ProcessWebhook(webhook)
+	// 4. inbox 저장 후 202 응답
+	h.accept(c, webhook, model.AlertSourceAlertmanager)
+}
+
+// accept - 정규화된 웹훅을 inbox에 저장하고 202 응답 (인증에 사용된 credential 이름 포함)
+// 처리(DB 저장, 알림 전송, 분석 요청)는 inbox worker가 비동기로 수행
+// 저장 실패 시 5xx를 반환하여 송신 측이 재전송하도록 한다.
+func (h *AlertHandler) accept(c *gin.Context, webhook model.AlertmanagerWebhook, source string) {
+	// 처리할 alert가 없는 이벤트(PagerDuty acknowledge 등)는 inbox에 저장하지 않음
+	if len(webhook.Alerts) == 0 {
+		c.JSON(http.StatusAccepted, model.WebhookAcceptedResponse{Status: "ignored"})
+		return
+	}
+	inboxID, err := h.inbox.Enqueue(c.Request.Context(), webhook, source, GetWebhookCredential(c))
+	if err != nil {
+		log.Printf("Failed to store %s webhook in inbox: %v", source, err)
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "failed to store webhook"})
+		return
+	}
 
-	// 5. 응답 반환
-	c.JSON(http.StatusOK, model.AlertWebhookResponse{
-		Status:      "received",          // 수신 상태
-		AlertCount:  len(webhook.Alerts), // 수신한 알림 수
-		SlackSent:   sent,                // Slack 전송 성공 수
-		SlackFailed: failed,              // Slack 전송 실패 수
+	c.JSON(http.StatusAccepted, model.WebhookAcceptedResponse{
+		Status:     "accepted",          // 수신 상태
+		InboxID:    inboxID,             // inbox entry ID
+		AlertCount: len(webhook.Alerts), // 수신한 알림 수
 	})
 }
@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kube-rca/backend/internal/model"
+	"github.com/kube-rca/backend/internal/service"
+)
+
+// alertmanagerSyncService - 서비스 인터페이스
+type alertmanagerSyncService interface {
+	ReconcileNow(ctx context.Context) (model.AlertmanagerReconcileResult, error)
+}
+
+// alertmanagerSilenceService - 서비스 인터페이스
+type alertmanagerSilenceService interface {
+	SilenceAlert(ctx context.Context, alertID string, req model.AlertSilenceRequest, createdBy string) (*model.AlertmanagerSilence, error)
+	ListForAlert(ctx context.Context, alertID string) ([]model.AlertmanagerSilence, error)
+	ListForIncident(ctx context.Context, incidentID string) ([]model.AlertmanagerSilence, error)
+	Expire(ctx context.Context, id int64) (*model.AlertmanagerSilence, error)
+}
+
+// AlertmanagerHandler - Alertmanager 연동 관련 핸들러
+type AlertmanagerHandler struct {
+	sync     alertmanagerSyncService
+	silences alertmanagerSilenceService
+}
+
+func NewAlertmanagerHandler(sync alertmanagerSyncService, silences alertmanagerSilenceService) *AlertmanagerHandler {
+	return &AlertmanagerHandler{sync: sync, silences: silences}
+}
+
+// alertmanagerErrorStatus - 서비스 에러를 HTTP 상태 코드로 변환
+func alertmanagerErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidAlertmanagerSilence):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrAlertNotFound), errors.Is(err, service.ErrAlertmanagerSilenceNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrAlertmanagerNotConfigured):
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// ReconcileAlertmanager godoc
+// @Summary Reconcile alerts with Alertmanager
+// @Description Fetches active alerts routed to ALERTMANAGER_RECEIVER from the Alertmanager v2 API and enqueues missing firing alerts and stale resolved alerts into the webhook inbox (503 when ALERTMANAGER_URL or ALERTMANAGER_RECEIVER is empty)
+// @Tags alertmanager
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} model.AlertmanagerReconcileResponse
+// @Failure 500,503 {object} model.ErrorResponse
+// @Router /api/v1/alertmanager/reconcile [post]
+func (h *AlertmanagerHandler) ReconcileAlertmanager(c *gin.Context) {
+	result, err := h.sync.ReconcileNow(c.Request.Context())
+	if err != nil {
+		c.JSON(alertmanagerErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.AlertmanagerReconcileResponse{Status: "success", Data: result})
+}
+
+// SilenceAlert godoc
+// @Summary Silence an alert in Alertmanager
+// @Description Creates an Alertmanager silence from the stored alert labels. The Alertmanager is chosen from the alert's webhook externalURL (mapped through ALERTMANAGER_URL_MAP). Defaults to 120 minutes when neither ends_at nor duration_minutes is given.
+// @Tags alertmanager
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Alert ID"
+// @Param request body model.AlertSilenceRequest false "Silence options"
+// @Success 201 {object} model.AlertmanagerSilenceResponse
+// @Failure 400,404,500,503 {object} model.ErrorResponse
+// @Router /api/v1/alerts/{id}/silence [post]
+func (h *AlertmanagerHandler) SilenceAlert(c *gin.Context) {
+	var req model.AlertSilenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	createdBy := ""
+	if user := GetAuthUser(c); user != nil {
+		createdBy = user.LoginID
+	}
+	silence, err := h.silences.SilenceAlert(c.Request.Context(), c.Param("id"), req, createdBy)
+	if err != nil {
+		c.JSON(alertmanagerErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, model.AlertmanagerSilenceResponse{Status: "success", Data: silence})
+}
+
+// ListAlertSilences godoc
+// @Summary List active Alertmanager silences for an alert
+// @Tags alertmanager
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Alert ID"
+// @Success 200 {object} model.AlertmanagerSilenceListResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /api/v1/alerts/{id}/silences [get]
+func (h *AlertmanagerHandler) ListAlertSilences(c *gin.Context) {
+	silences, err := h.silences.ListForAlert(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.AlertmanagerSilenceListResponse{Status: "success", Data: silences})
+}
+
+// ListIncidentSilences godoc
+// @Summary List active Alertmanager silences for an incident
+// @Tags alertmanager
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Incident ID"
+// @Success 200 {object} model.AlertmanagerSilenceListResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /api/v1/incidents/{id}/silences [get]
+func (h *AlertmanagerHandler) ListIncidentSilences(c *gin.Context) {
+	silences, err := h.silences.ListForIncident(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.AlertmanagerSilenceListResponse{Status: "success", Data: silences})
+}
+
+// ExpireAlertmanagerSilence godoc
+// @Summary Expire an Alertmanager silence early
+// @Tags alertmanager
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Silence record ID"
+// @Success 200 {object} model.AlertmanagerSilenceResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/alertmanager/silences/{id} [delete]
+func (h *AlertmanagerHandler) ExpireAlertmanagerSilence(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	silence, err := h.silences.Expire(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(alertmanagerErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.AlertmanagerSilenceResponse{Status: "success", Data: silence})
+}
@@ -173,6 +173,7 @@ func (h *AuthHandler) Me(c *gin.Context) {
 		Email:        fullUser.Email,
 		DisplayName:  fullUser.DisplayName,
 		AuthProvider: fullUser.AuthProvider,
+		SlackUserID:  fullUser.SlackUserID,
 	})
 }
 
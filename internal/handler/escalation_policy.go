@@ -0,0 +1,223 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kube-rca/backend/internal/model"
+	"github.com/kube-rca/backend/internal/service"
+)
+
+// escalationService - 서비스 인터페이스
+type escalationService interface {
+	List(ctx context.Context) ([]model.EscalationPolicy, error)
+	Get(ctx context.Context, id int64) (*model.EscalationPolicy, error)
+	Create(ctx context.Context, req model.EscalationPolicyRequest, createdBy string) (int64, error)
+	Update(ctx context.Context, id int64, req model.EscalationPolicyRequest) error
+	Delete(ctx context.Context, id int64) error
+	GetIncidentEscalation(ctx context.Context, incidentID string) (*model.IncidentEscalation, error)
+	ListAuditEvents(ctx context.Context, incidentID string) ([]model.IncidentAuditEvent, error)
+}
+
+// EscalationPolicyHandler - 에스컬레이션 정책 및 Incident 에스컬레이션/감사 기록 핸들러
+type EscalationPolicyHandler struct {
+	svc escalationService
+}
+
+func NewEscalationPolicyHandler(svc escalationService) *EscalationPolicyHandler {
+	return &EscalationPolicyHandler{svc: svc}
+}
+
+// escalationPolicyErrorStatus - 서비스 에러를 HTTP 상태 코드로 변환
+func escalationPolicyErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidEscalationPolicy):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrEscalationPolicyNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// ListEscalationPolicies godoc
+// @Summary List escalation policies
+// @Tags escalation-policies
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} model.EscalationPolicyListResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /api/v1/escalation-policies [get]
+func (h *EscalationPolicyHandler) ListEscalationPolicies(c *gin.Context) {
+	policies, err := h.svc.List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.EscalationPolicyListResponse{Status: "success", Data: policies})
+}
+
+// GetEscalationPolicy godoc
+// @Summary Get an escalation policy by ID
+// @Tags escalation-policies
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Escalation policy ID"
+// @Success 200 {object} model.EscalationPolicyResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/escalation-policies/{id} [get]
+func (h *EscalationPolicyHandler) GetEscalationPolicy(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	policy, err := h.svc.Get(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	if policy == nil {
+		c.JSON(http.StatusNotFound, gin.H{"status": "error", "error": "escalation policy not found"})
+		return
+	}
+	c.JSON(http.StatusOK, model.EscalationPolicyResponse{Status: "success", Data: policy})
+}
+
+// CreateEscalationPolicy godoc
+// @Summary Create an escalation policy
+// @Description Firing incidents whose alert labels match the matchers escalate through the ordered levels until acknowledged. Level 1 targets are notified immediately and each following level after the previous level's timeout_minutes. The most specific matching policy wins.
+// @Tags escalation-policies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body model.EscalationPolicyRequest true "Escalation policy"
+// @Success 201 {object} model.EscalationPolicyMutationResponse
+// @Failure 400,500 {object} model.ErrorResponse
+// @Router /api/v1/escalation-policies [post]
+func (h *EscalationPolicyHandler) CreateEscalationPolicy(c *gin.Context) {
+	var req model.EscalationPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	createdBy := ""
+	if user := GetAuthUser(c); user != nil {
+		createdBy = user.LoginID
+	}
+	id, err := h.svc.Create(c.Request.Context(), req, createdBy)
+	if err != nil {
+		c.JSON(escalationPolicyErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, model.EscalationPolicyMutationResponse{
+		Status:  "success",
+		Message: "에스컬레이션 정책이 생성되었습니다.",
+		ID:      id,
+	})
+}
+
+// UpdateEscalationPolicy godoc
+// @Summary Update an escalation policy
+// @Description In-flight escalations use the updated levels from their next level onward
+// @Tags escalation-policies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Escalation policy ID"
+// @Param request body model.EscalationPolicyRequest true "Escalation policy"
+// @Success 200 {object} model.EscalationPolicyMutationResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/escalation-policies/{id} [put]
+func (h *EscalationPolicyHandler) UpdateEscalationPolicy(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	var req model.EscalationPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	if err := h.svc.Update(c.Request.Context(), id, req); err != nil {
+		c.JSON(escalationPolicyErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.EscalationPolicyMutationResponse{
+		Status:  "success",
+		Message: "에스컬레이션 정책이 수정되었습니다.",
+		ID:      id,
+	})
+}
+
+// DeleteEscalationPolicy godoc
+// @Summary Delete an escalation policy
+// @Description In-flight escalations using the policy are cancelled when their next level is due
+// @Tags escalation-policies
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Escalation policy ID"
+// @Success 200 {object} model.EscalationPolicyMutationResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/escalation-policies/{id} [delete]
+func (h *EscalationPolicyHandler) DeleteEscalationPolicy(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	if err := h.svc.Delete(c.Request.Context(), id); err != nil {
+		c.JSON(escalationPolicyErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.EscalationPolicyMutationResponse{
+		Status:  "success",
+		Message: "에스컬레이션 정책이 삭제되었습니다.",
+		ID:      id,
+	})
+}
+
+// GetIncidentEscalation godoc
+// @Summary Get the escalation state of an incident
+// @Tags incidents
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Incident ID"
+// @Success 200 {object} model.IncidentEscalationResponse
+// @Failure 404,500 {object} model.ErrorResponse
+// @Router /api/v1/incidents/{id}/escalation [get]
+func (h *EscalationPolicyHandler) GetIncidentEscalation(c *gin.Context) {
+	escalation, err := h.svc.GetIncidentEscalation(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	if escalation == nil {
+		c.JSON(http.StatusNotFound, gin.H{"status": "error", "error": "incident escalation not found"})
+		return
+	}
+	c.JSON(http.StatusOK, model.IncidentEscalationResponse{Status: "success", Data: escalation})
+}
+
+// ListIncidentAuditEvents godoc
+// @Summary List the audit trail of an incident
+// @Description Acknowledgements and escalation steps (started, escalated, stopped, exhausted) in chronological order
+// @Tags incidents
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Incident ID"
+// @Success 200 {object} model.IncidentAuditListResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /api/v1/incidents/{id}/audit [get]
+func (h *EscalationPolicyHandler) ListIncidentAuditEvents(c *gin.Context) {
+	events, err := h.svc.ListAuditEvents(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.IncidentAuditListResponse{Status: "success", Data: events})
+}
@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kube-rca/backend/internal/model"
+	"github.com/kube-rca/backend/internal/service"
+)
+
+// flappingPolicyService - 서비스 인터페이스
+type flappingPolicyService interface {
+	List(ctx context.Context) ([]model.FlappingPolicy, error)
+	Get(ctx context.Context, id int64) (*model.FlappingPolicy, error)
+	Create(ctx context.Context, req model.FlappingPolicyRequest, createdBy string) (int64, error)
+	Update(ctx context.Context, id int64, req model.FlappingPolicyRequest) error
+	Delete(ctx context.Context, id int64) error
+	Match(ctx context.Context, labels map[string]string) (model.EffectiveFlappingPolicy, error)
+}
+
+// FlappingPolicyHandler - flapping 정책 관련 핸들러
+type FlappingPolicyHandler struct {
+	svc flappingPolicyService
+}
+
+func NewFlappingPolicyHandler(svc flappingPolicyService) *FlappingPolicyHandler {
+	return &FlappingPolicyHandler{svc: svc}
+}
+
+// flappingPolicyErrorStatus - 서비스 에러를 HTTP 상태 코드로 변환
+func flappingPolicyErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidFlappingPolicy):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrFlappingPolicyNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// ListFlappingPolicies godoc
+// @Summary List flapping policies
+// @Tags flapping-policies
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} model.FlappingPolicyListResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /api/v1/flapping-policies [get]
+func (h *FlappingPolicyHandler) ListFlappingPolicies(c *gin.Context) {
+	policies, err := h.svc.List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.FlappingPolicyListResponse{Status: "success", Data: policies})
+}
+
+// GetFlappingPolicy godoc
+// @Summary Get a flapping policy by ID
+// @Tags flapping-policies
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Flapping policy ID"
+// @Success 200 {object} model.FlappingPolicyResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/flapping-policies/{id} [get]
+func (h *FlappingPolicyHandler) GetFlappingPolicy(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	policy, err := h.svc.Get(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	if policy == nil {
+		c.JSON(http.StatusNotFound, gin.H{"status": "error", "error": "flapping policy not found"})
+		return
+	}
+	c.JSON(http.StatusOK, model.FlappingPolicyResponse{Status: "success", Data: policy})
+}
+
+// CreateFlappingPolicy godoc
+// @Summary Create a flapping policy
+// @Description Alerts matching the matchers use the policy thresholds (0 falls back to the global flapping setting) or skip flapping detection when never_flapping is set. The most specific matching policy wins.
+// @Tags flapping-policies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body model.FlappingPolicyRequest true "Flapping policy"
+// @Success 201 {object} model.FlappingPolicyMutationResponse
+// @Failure 400,500 {object} model.ErrorResponse
+// @Router /api/v1/flapping-policies [post]
+func (h *FlappingPolicyHandler) CreateFlappingPolicy(c *gin.Context) {
+	var req model.FlappingPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	createdBy := ""
+	if user := GetAuthUser(c); user != nil {
+		createdBy = user.LoginID
+	}
+	id, err := h.svc.Create(c.Request.Context(), req, createdBy)
+	if err != nil {
+		c.JSON(flappingPolicyErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, model.FlappingPolicyMutationResponse{
+		Status:  "success",
+		Message: "Flapping 정책이 생성되었습니다.",
+		ID:      id,
+	})
+}
+
+// UpdateFlappingPolicy godoc
+// @Summary Update a flapping policy
+// @Tags flapping-policies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Flapping policy ID"
+// @Param request body model.FlappingPolicyRequest true "Flapping policy"
+// @Success 200 {object} model.FlappingPolicyMutationResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/flapping-policies/{id} [put]
+func (h *FlappingPolicyHandler) UpdateFlappingPolicy(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	var req model.FlappingPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	if err := h.svc.Update(c.Request.Context(), id, req); err != nil {
+		c.JSON(flappingPolicyErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.FlappingPolicyMutationResponse{
+		Status:  "success",
+		Message: "Flapping 정책이 수정되었습니다.",
+		ID:      id,
+	})
+}
+
+// DeleteFlappingPolicy godoc
+// @Summary Delete a flapping policy
+// @Tags flapping-policies
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Flapping policy ID"
+// @Success 200 {object} model.FlappingPolicyMutationResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/flapping-policies/{id} [delete]
+func (h *FlappingPolicyHandler) DeleteFlappingPolicy(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	if err := h.svc.Delete(c.Request.Context(), id); err != nil {
+		c.JSON(flappingPolicyErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.FlappingPolicyMutationResponse{
+		Status:  "success",
+		Message: "Flapping 정책이 삭제되었습니다.",
+		ID:      id,
+	})
+}
+
+// MatchFlappingPolicy godoc
+// @Summary Preview which flapping policy applies to a label set
+// @Description Returns the most specific enabled policy for the labels and the effective detection window, cycle threshold and clearance window
+// @Tags flapping-policies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body model.FlappingPolicyMatchRequest true "Alert labels"
+// @Success 200 {object} model.FlappingPolicyMatchResponse
+// @Failure 400,500 {object} model.ErrorResponse
+// @Router /api/v1/flapping-policies/match [post]
+func (h *FlappingPolicyHandler) MatchFlappingPolicy(c *gin.Context) {
+	var req model.FlappingPolicyMatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	effective, err := h.svc.Match(c.Request.Context(), req.Labels)
+	if err != nil {
+		c.JSON(flappingPolicyErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.FlappingPolicyMatchResponse{Status: "success", Data: effective})
+}
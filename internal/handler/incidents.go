@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -687,6 +688,108 @@ func (h *RcaHandler) ResolveAlert(c *gin.Context) {
 	})
 }
 
+// ClearAlertFlapping godoc
+// @Summary Clear flapping status manually
+// @Description Clears is_flapping for the alert's fingerprint, cancels its pending flapping clearance job and posts the flapping cleared message to the alert thread
+// @Tags alerts
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Alert ID"
+// @Success 200 {object} model.FlappingClearResponse
+// @Failure 404,409,500 {object} model.ErrorResponse
+// @Router /api/v1/alerts/{id}/flapping/clear [post]
+func (h *RcaHandler) ClearAlertFlapping(c *gin.Context) {
+	id := c.Param("id")
+
+	alert, cancelled, err := h.alertService.ClearFlapping(c.Request.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrAlertNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"status": "error", "error": err.Error()})
+		case errors.Is(err, service.ErrAlertNotFlapping):
+			c.JSON(http.StatusConflict, gin.H{"status": "error", "error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, model.FlappingClearResponse{
+		Status:        "success",
+		Message:       "Flapping 상태가 해제되었습니다.",
+		AlertID:       id,
+		Fingerprint:   alert.Fingerprint,
+		CancelledJobs: cancelled,
+	})
+}
+
+// AcknowledgeAlert godoc
+// @Summary Acknowledge firing alert
+// @Description Records who acknowledged the alert and the time to acknowledge, acknowledges its incident if not yet acknowledged, stops re-notification reminders and posts a note to the alert thread
+// @Tags alerts
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Alert ID"
+// @Success 200 {object} model.AcknowledgeResponse
+// @Failure 404,409,500 {object} model.ErrorResponse
+// @Router /api/v1/alerts/{id}/ack [post]
+func (h *RcaHandler) AcknowledgeAlert(c *gin.Context) {
+	ack, err := h.alertService.AcknowledgeAlert(c.Param("id"), acknowledgedBy(c))
+	if err != nil {
+		c.JSON(acknowledgeErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.AcknowledgeResponse{
+		Status:  "success",
+		Message: "Alert가 확인되었습니다.",
+		Data:    *ack,
+	})
+}
+
+// AcknowledgeIncident godoc
+// @Summary Acknowledge firing incident
+// @Description Records who acknowledged the incident and the time to acknowledge, and acknowledges all of its unacknowledged firing alerts
+// @Tags incidents
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Incident ID"
+// @Success 200 {object} model.AcknowledgeResponse
+// @Failure 404,409,500 {object} model.ErrorResponse
+// @Router /api/v1/incidents/{id}/ack [post]
+func (h *RcaHandler) AcknowledgeIncident(c *gin.Context) {
+	ack, err := h.alertService.AcknowledgeIncident(c.Param("id"), acknowledgedBy(c))
+	if err != nil {
+		c.JSON(acknowledgeErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, model.AcknowledgeResponse{
+		Status:  "success",
+		Message: "Incident가 확인되었습니다.",
+		Data:    *ack,
+	})
+}
+
+// acknowledgedBy - 확인자 (인증 사용자 login ID)
+func acknowledgedBy(c *gin.Context) string {
+	if user := GetAuthUser(c); user != nil {
+		return user.LoginID
+	}
+	return ""
+}
+
+func acknowledgeErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrAlertNotFound), errors.Is(err, service.ErrIncidentNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrAlreadyAcknowledged), errors.Is(err, service.ErrAlertNotFiring), errors.Is(err, service.ErrIncidentNotFiring):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 // BulkResolveAlerts godoc
 // @Summary Bulk resolve alerts (다건 수동 알림 종료)
 // @Tags alerts
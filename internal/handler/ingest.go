@@ -0,0 +1,112 @@
+// Alertmanager 외 알림 소스(Grafana, PagerDuty Events v2, 일반 JSON) 웹훅 핸들러
+//
+// 요청 흐름:
+//  1. 소스별 페이로드 파싱
+//  2. service 레이어 adapter로 AlertmanagerWebhook 정규화
+//  3. webhook inbox에 저장하고 202 응답 (이후 Alertmanager 웹훅과 동일한 파이프라인으로 처리)
+
+package handler
+
+import (
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// GrafanaWebhook godoc
+// @Summary Receive Grafana unified alerting webhook
+// @Description Normalizes Grafana alerts, stores them in the webhook inbox and returns 202
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Param payload body model.GrafanaWebhook true "Grafana webhook payload"
+// @Success 202 {object} model.WebhookAcceptedResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 413 {object} model.ErrorResponse
+// @Failure 503 {object} model.ErrorResponse
+// @Router /webhook/grafana [post]
+func (h *AlertHandler) GrafanaWebhook(c *gin.Context) {
+	var payload model.GrafanaWebhook
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		log.Printf("Failed to parse grafana webhook: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid payload"})
+		return
+	}
+
+	webhook, err := h.normalizer.NormalizeGrafana(payload)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	log.Printf("Received grafana webhook: status=%s, alertCount=%d, receiver=%s",
+		webhook.Status, len(webhook.Alerts), webhook.Receiver)
+
+	h.accept(c, webhook, model.AlertSourceGrafana)
+}
+
+// PagerDutyWebhook godoc
+// @Summary Receive PagerDuty Events API v2 event
+// @Description Normalizes a trigger/resolve event, stores it in the webhook inbox and returns 202. Acknowledge events and resolves for unknown dedup keys return 202 with status "ignored" and are not stored
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Param payload body model.PagerDutyEvent true "PagerDuty Events v2 event"
+// @Success 202 {object} model.WebhookAcceptedResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 413 {object} model.ErrorResponse
+// @Failure 503 {object} model.ErrorResponse
+// @Router /webhook/pagerduty [post]
+func (h *AlertHandler) PagerDutyWebhook(c *gin.Context) {
+	var event model.PagerDutyEvent
+	if err := c.ShouldBindJSON(&event); err != nil {
+		log.Printf("Failed to parse pagerduty event: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid payload"})
+		return
+	}
+
+	webhook, err := h.normalizer.NormalizePagerDuty(event)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	log.Printf("Received pagerduty event: action=%s, alertCount=%d", event.EventAction, len(webhook.Alerts))
+
+	h.accept(c, webhook, model.AlertSourcePagerDuty)
+}
+
+// GenericWebhook godoc
+// @Summary Receive generic JSON alerts
+// @Description Accepts {"source","alerts":[...]}, a JSON array of alerts or a single alert object; normalizes, stores in the webhook inbox and returns 202
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Param payload body model.GenericWebhook true "Generic alert payload"
+// @Success 202 {object} model.WebhookAcceptedResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 413 {object} model.ErrorResponse
+// @Failure 503 {object} model.ErrorResponse
+// @Router /webhook/generic [post]
+func (h *AlertHandler) GenericWebhook(c *gin.Context) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid payload"})
+		return
+	}
+
+	webhook, err := h.normalizer.NormalizeGeneric(body)
+	if err != nil {
+		log.Printf("Failed to parse generic webhook: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	log.Printf("Received generic webhook: status=%s, alertCount=%d, receiver=%s",
+		webhook.Status, len(webhook.Alerts), webhook.GroupKey)
+
+	h.accept(c, webhook, model.AlertSourceGeneric)
+}
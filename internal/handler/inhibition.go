@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kube-rca/backend/internal/model"
+	"github.com/kube-rca/backend/internal/service"
+)
+
+// inhibitionService - 서비스 인터페이스
+type inhibitionService interface {
+	List(ctx context.Context) ([]model.InhibitionRule, error)
+	Get(ctx context.Context, id int64) (*model.InhibitionRule, error)
+	Create(ctx context.Context, req model.InhibitionRuleRequest, createdBy string) (int64, error)
+	Update(ctx context.Context, id int64, req model.InhibitionRuleRequest) error
+	Delete(ctx context.Context, id int64) error
+}
+
+// InhibitionHandler - 억제 규칙 관련 핸들러
+type InhibitionHandler struct {
+	svc inhibitionService
+}
+
+func NewInhibitionHandler(svc inhibitionService) *InhibitionHandler {
+	return &InhibitionHandler{svc: svc}
+}
+
+// inhibitionErrorStatus - 서비스 에러를 HTTP 상태 코드로 변환
+func inhibitionErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidInhibitionRule):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrInhibitionRuleNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// ListInhibitionRules godoc
+// @Summary List inhibition rules
+// @Tags inhibition
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} model.InhibitionRuleListResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /api/v1/inhibition-rules [get]
+func (h *InhibitionHandler) ListInhibitionRules(c *gin.Context) {
+	rules, err := h.svc.List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.InhibitionRuleListResponse{Status: "success", Data: rules})
+}
+
+// GetInhibitionRule godoc
+// @Summary Get an inhibition rule by ID
+// @Tags inhibition
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Inhibition rule ID"
+// @Success 200 {object} model.InhibitionRuleResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/inhibition-rules/{id} [get]
+func (h *InhibitionHandler) GetInhibitionRule(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	rule, err := h.svc.Get(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	if rule == nil {
+		c.JSON(http.StatusNotFound, gin.H{"status": "error", "error": "inhibition rule not found"})
+		return
+	}
+	c.JSON(http.StatusOK, model.InhibitionRuleResponse{Status: "success", Data: rule})
+}
+
+// CreateInhibitionRule godoc
+// @Summary Create an inhibition rule
+// @Description While an alert matching source_matchers is firing, alerts matching target_matchers with the same equal labels are stored with inhibited_by and skip notification and auto-analysis
+// @Tags inhibition
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body model.InhibitionRuleRequest true "Inhibition rule"
+// @Success 201 {object} model.InhibitionRuleMutationResponse
+// @Failure 400,500 {object} model.ErrorResponse
+// @Router /api/v1/inhibition-rules [post]
+func (h *InhibitionHandler) CreateInhibitionRule(c *gin.Context) {
+	var req model.InhibitionRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	createdBy := ""
+	if user := GetAuthUser(c); user != nil {
+		createdBy = user.LoginID
+	}
+	id, err := h.svc.Create(c.Request.Context(), req, createdBy)
+	if err != nil {
+		c.JSON(inhibitionErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, model.InhibitionRuleMutationResponse{
+		Status:  "success",
+		Message: "억제 규칙이 생성되었습니다.",
+		ID:      id,
+	})
+}
+
+// UpdateInhibitionRule godoc
+// @Summary Update an inhibition rule
+// @Tags inhibition
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Inhibition rule ID"
+// @Param request body model.InhibitionRuleRequest true "Inhibition rule"
+// @Success 200 {object} model.InhibitionRuleMutationResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/inhibition-rules/{id} [put]
+func (h *InhibitionHandler) UpdateInhibitionRule(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	var req model.InhibitionRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	if err := h.svc.Update(c.Request.Context(), id, req); err != nil {
+		c.JSON(inhibitionErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.InhibitionRuleMutationResponse{
+		Status:  "success",
+		Message: "억제 규칙이 수정되었습니다.",
+		ID:      id,
+	})
+}
+
+// DeleteInhibitionRule godoc
+// @Summary Delete an inhibition rule
+// @Tags inhibition
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Inhibition rule ID"
+// @Success 200 {object} model.InhibitionRuleMutationResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/inhibition-rules/{id} [delete]
+func (h *InhibitionHandler) DeleteInhibitionRule(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	if err := h.svc.Delete(c.Request.Context(), id); err != nil {
+		c.JSON(inhibitionErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.InhibitionRuleMutationResponse{
+		Status:  "success",
+		Message: "억제 규칙이 삭제되었습니다.",
+		ID:      id,
+	})
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kube-rca/backend/internal/model"
+	"github.com/kube-rca/backend/internal/service"
+)
+
+// kubeEventIngester - Kubernetes Event 수집 서비스 인터페이스
+type kubeEventIngester interface {
+	Ingest(ctx context.Context, events []model.KubernetesEvent, cluster, credential string) (model.KubernetesEventIngestResponse, error)
+}
+
+// KubeEventHandler - Kubernetes Event 수신 핸들러
+type KubeEventHandler struct {
+	svc kubeEventIngester
+}
+
+func NewKubeEventHandler(svc kubeEventIngester) *KubeEventHandler {
+	return &KubeEventHandler{svc: svc}
+}
+
+// Webhook godoc
+// @Summary Receive Kubernetes Events
+// @Description Accepts a core/v1 Event or EventList (e.g. from an event-exporter webhook sink). Warning events become alerts deduplicated on involvedObject + reason; Normal events are ignored
+// @Tags webhook
+// @Accept json
+// @Produce json
+// @Param cluster query string false "Cluster name label (overrides KUBE_EVENT_CLUSTER_NAME when the event has no clusterName)"
+// @Param payload body model.KubernetesEvent true "core/v1 Event (EventList is also accepted)"
+// @Success 202 {object} model.KubernetesEventIngestResponse
+// @Failure 400 {object} model.ErrorResponse
+// @Failure 401 {object} model.ErrorResponse
+// @Failure 413 {object} model.ErrorResponse
+// @Failure 503 {object} model.ErrorResponse
+// @Router /webhook/kubernetes-events [post]
+func (h *KubeEventHandler) Webhook(c *gin.Context) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid payload"})
+		return
+	}
+	events, err := service.DecodeKubernetesEvents(body)
+	if err != nil {
+		log.Printf("Failed to parse kubernetes events: %v", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid payload"})
+		return
+	}
+
+	resp, err := h.svc.Ingest(c.Request.Context(), events, c.Query("cluster"), GetWebhookCredential(c))
+	if err != nil {
+		log.Printf("Failed to ingest kubernetes events: %v", err)
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "failed to store events"})
+		return
+	}
+	log.Printf("Received kubernetes events: total=%d, new=%d, deduplicated=%d, ignored=%d",
+		len(events), resp.AlertCount, resp.Deduplicated, resp.Ignored)
+
+	c.JSON(http.StatusAccepted, resp)
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kube-rca/backend/internal/model"
+	"github.com/kube-rca/backend/internal/service"
+)
+
+// maintenanceService - 서비스 인터페이스
+type maintenanceService interface {
+	List(ctx context.Context) ([]model.MaintenanceWindow, error)
+	Get(ctx context.Context, id int64) (*model.MaintenanceWindow, error)
+	Create(ctx context.Context, req model.MaintenanceWindowRequest, createdBy string) (int64, error)
+	Update(ctx context.Context, id int64, req model.MaintenanceWindowRequest) error
+	Delete(ctx context.Context, id int64) error
+}
+
+// MaintenanceHandler - 점검 시간대 관련 핸들러
+type MaintenanceHandler struct {
+	svc maintenanceService
+}
+
+func NewMaintenanceHandler(svc maintenanceService) *MaintenanceHandler {
+	return &MaintenanceHandler{svc: svc}
+}
+
+// maintenanceErrorStatus - 서비스 에러를 HTTP 상태 코드로 변환
+func maintenanceErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidMaintenanceWindow):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrMaintenanceWindowNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// ListMaintenanceWindows godoc
+// @Summary List maintenance windows
+// @Tags maintenance
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} model.MaintenanceWindowListResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /api/v1/maintenance-windows [get]
+func (h *MaintenanceHandler) ListMaintenanceWindows(c *gin.Context) {
+	windows, err := h.svc.List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.MaintenanceWindowListResponse{Status: "success", Data: windows})
+}
+
+// GetMaintenanceWindow godoc
+// @Summary Get a maintenance window by ID
+// @Tags maintenance
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Maintenance window ID"
+// @Success 200 {object} model.MaintenanceWindowResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/maintenance-windows/{id} [get]
+func (h *MaintenanceHandler) GetMaintenanceWindow(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	window, err := h.svc.Get(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	if window == nil {
+		c.JSON(http.StatusNotFound, gin.H{"status": "error", "error": "maintenance window not found"})
+		return
+	}
+	c.JSON(http.StatusOK, model.MaintenanceWindowResponse{Status: "success", Data: window})
+}
+
+// CreateMaintenanceWindow godoc
+// @Summary Create a maintenance window
+// @Description Alerts matching the scope during a window are stored as in_maintenance and skip notification and auto-analysis
+// @Tags maintenance
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body model.MaintenanceWindowRequest true "Maintenance window"
+// @Success 201 {object} model.MaintenanceWindowMutationResponse
+// @Failure 400,500 {object} model.ErrorResponse
+// @Router /api/v1/maintenance-windows [post]
+func (h *MaintenanceHandler) CreateMaintenanceWindow(c *gin.Context) {
+	var req model.MaintenanceWindowRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	createdBy := ""
+	if user := GetAuthUser(c); user != nil {
+		createdBy = user.LoginID
+	}
+	id, err := h.svc.Create(c.Request.Context(), req, createdBy)
+	if err != nil {
+		c.JSON(maintenanceErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, model.MaintenanceWindowMutationResponse{
+		Status:  "success",
+		Message: "점검 시간대가 생성되었습니다.",
+		ID:      id,
+	})
+}
+
+// UpdateMaintenanceWindow godoc
+// @Summary Update a maintenance window
+// @Tags maintenance
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Maintenance window ID"
+// @Param request body model.MaintenanceWindowRequest true "Maintenance window"
+// @Success 200 {object} model.MaintenanceWindowMutationResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/maintenance-windows/{id} [put]
+func (h *MaintenanceHandler) UpdateMaintenanceWindow(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	var req model.MaintenanceWindowRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	if err := h.svc.Update(c.Request.Context(), id, req); err != nil {
+		c.JSON(maintenanceErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.MaintenanceWindowMutationResponse{
+		Status:  "success",
+		Message: "점검 시간대가 수정되었습니다.",
+		ID:      id,
+	})
+}
+
+// DeleteMaintenanceWindow godoc
+// @Summary Delete a maintenance window
+// @Tags maintenance
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Maintenance window ID"
+// @Success 200 {object} model.MaintenanceWindowMutationResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/maintenance-windows/{id} [delete]
+func (h *MaintenanceHandler) DeleteMaintenanceWindow(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	if err := h.svc.Delete(c.Request.Context(), id); err != nil {
+		c.JSON(maintenanceErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.MaintenanceWindowMutationResponse{
+		Status:  "success",
+		Message: "점검 시간대가 삭제되었습니다.",
+		ID:      id,
+	})
+}
@@ -1,8 +1,13 @@
 package handler
 
 import (
+	"bytes"
+	"errors"
+	"io"
+	"log"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/kube-rca/backend/internal/model"
@@ -11,6 +16,11 @@ import (
 
 const authUserKey = "auth_user"
 
+const webhookCredentialKey = "webhook_credential"
+
+// webhookMaxBodyBytes - 인바운드 웹훅 본문 최대 크기 (초과 시 413, 서명 검증을 위해 본문 전체를 읽음)
+const webhookMaxBodyBytes = 10 << 20
+
 func AuthMiddleware(authService *service.AuthService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		if c.Request.Method == http.MethodOptions {
@@ -44,6 +54,54 @@ func AuthMiddleware(authService *service.AuthService) gin.HandlerFunc {
 	}
 }
 
+// WebhookAuthMiddleware authenticates inbound webhooks (/webhook/*) with the
+// configured named credentials (bearer, basic or HMAC signature).
+// Bodies larger than webhookMaxBodyBytes are rejected with 413 whether or not
+// authentication is enabled.
+// Rejected requests are logged and counted; the matched credential name is
+// stored in the context for the handler.
+func WebhookAuthMiddleware(authenticator *service.WebhookAuthenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// HMAC 서명 검증을 위해 본문을 읽고 핸들러가 다시 읽을 수 있도록 복원 (잘린 본문으로 처리하지 않도록 초과 시 거부)
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, webhookMaxBodyBytes))
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				log.Printf("Rejected oversized webhook request (path=%s, remote=%s, limit=%d bytes)", c.Request.URL.Path, c.ClientIP(), tooLarge.Limit)
+				c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "payload too large"})
+			} else {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid payload"})
+			}
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		if !authenticator.Enabled() {
+			c.Next()
+			return
+		}
+
+		now := time.Now()
+		credential, err := authenticator.Authenticate(c.Request.Header, body, now)
+		if err != nil {
+			authenticator.RecordRejection(err, now)
+			log.Printf("Rejected webhook request (path=%s, remote=%s): %v", c.Request.URL.Path, c.ClientIP(), err)
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+			c.Abort()
+			return
+		}
+
+		c.Set(webhookCredentialKey, credential)
+		c.Next()
+	}
+}
+
+// GetWebhookCredential returns the credential name matched by WebhookAuthMiddleware.
+func GetWebhookCredential(c *gin.Context) string {
+	return c.GetString(webhookCredentialKey)
+}
+
 func GetAuthUser(c *gin.Context) *model.AuthUser {
 	if value, ok := c.Get(authUserKey); ok {
 		if user, ok := value.(*model.AuthUser); ok {
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kube-rca/backend/internal/model"
+	"github.com/kube-rca/backend/internal/service"
+)
+
+// notificationAttemptService - 알림 전송 기록 조회 서비스 인터페이스
+type notificationAttemptService interface {
+	ListByAlert(ctx context.Context, alertID string, limit int) ([]model.NotificationAttempt, error)
+	ListByWebhookConfig(ctx context.Context, configID int, limit int) ([]model.NotificationAttempt, error)
+}
+
+// NotificationAttemptHandler - 알림 전송 기록(delivery log) 조회 핸들러
+type NotificationAttemptHandler struct {
+	svc notificationAttemptService
+}
+
+func NewNotificationAttemptHandler(svc notificationAttemptService) *NotificationAttemptHandler {
+	return &NotificationAttemptHandler{svc: svc}
+}
+
+// parseAttemptLimit - limit 쿼리 파라미터 (없으면 0 = 기본값)
+func parseAttemptLimit(c *gin.Context) (int, bool) {
+	raw := c.Query("limit")
+	if raw == "" {
+		return 0, true
+	}
+	limit, err := strconv.Atoi(raw)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid limit"})
+		return 0, false
+	}
+	return limit, true
+}
+
+// ListAlertDeliveries godoc
+// @Summary List notification attempts for an alert
+// @Description Returns every delivery attempt (all targets, including failures and thread replies), newest first
+// @Tags alerts
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Alert ID"
+// @Param limit query int false "Max entries (default 100, max 500)"
+// @Success 200 {object} model.NotificationAttemptListResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/alerts/{id}/deliveries [get]
+func (h *NotificationAttemptHandler) ListAlertDeliveries(c *gin.Context) {
+	limit, ok := parseAttemptLimit(c)
+	if !ok {
+		return
+	}
+	attempts, err := h.svc.ListByAlert(c.Request.Context(), c.Param("id"), limit)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, service.ErrAlertNotFound) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.NotificationAttemptListResponse{Status: "success", Data: attempts})
+}
+
+// ListWebhookDeliveries godoc
+// @Summary List notification attempts for a webhook config
+// @Description Returns delivery attempts sent through the webhook config, newest first
+// @Tags settings
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Webhook config ID"
+// @Param limit query int false "Max entries (default 100, max 500)"
+// @Success 200 {object} model.NotificationAttemptListResponse
+// @Failure 400,500 {object} model.ErrorResponse
+// @Router /api/v1/settings/webhooks/{id}/deliveries [get]
+func (h *NotificationAttemptHandler) ListWebhookDeliveries(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	limit, ok := parseAttemptLimit(c)
+	if !ok {
+		return
+	}
+	attempts, err := h.svc.ListByWebhookConfig(c.Request.Context(), id, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.NotificationAttemptListResponse{Status: "success", Data: attempts})
+}
@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kube-rca/backend/internal/model"
+	"github.com/kube-rca/backend/internal/service"
+)
+
+// notificationOutboxService - 알림 outbox 관리 서비스 인터페이스
+type notificationOutboxService interface {
+	List(ctx context.Context, status string, limit int) ([]model.NotificationOutboxEntry, error)
+	Get(ctx context.Context, id int64) (*model.NotificationOutboxEntry, error)
+	Retry(ctx context.Context, id int64) error
+	Discard(ctx context.Context, id int64) error
+}
+
+// NotificationOutboxHandler - 알림 outbox(재시도 큐/dead-letter) 관리 핸들러
+type NotificationOutboxHandler struct {
+	svc notificationOutboxService
+}
+
+func NewNotificationOutboxHandler(svc notificationOutboxService) *NotificationOutboxHandler {
+	return &NotificationOutboxHandler{svc: svc}
+}
+
+// notificationOutboxErrorStatus - 서비스 에러를 HTTP 상태 코드로 변환
+func notificationOutboxErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrNotificationOutboxNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrNotificationOutboxConflict):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// ListNotificationOutbox godoc
+// @Summary List notification outbox entries
+// @Description Returns outbound notification entries (newest first) without payloads
+// @Tags notification-outbox
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Filter by status (pending, sending, delivered, dead, discarded)"
+// @Param limit query int false "Max entries (default 100, max 500)"
+// @Success 200 {object} model.NotificationOutboxListResponse
+// @Failure 400,500 {object} model.ErrorResponse
+// @Router /api/v1/notification-outbox [get]
+func (h *NotificationOutboxHandler) ListNotificationOutbox(c *gin.Context) {
+	limit := 0
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid limit"})
+			return
+		}
+		limit = parsed
+	}
+	entries, err := h.svc.List(c.Request.Context(), c.Query("status"), limit)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.NotificationOutboxListResponse{Status: "success", Data: entries})
+}
+
+// GetNotificationOutboxEntry godoc
+// @Summary Get a notification outbox entry
+// @Description Returns an outbox entry including the stored event payload
+// @Tags notification-outbox
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Outbox entry ID"
+// @Success 200 {object} model.NotificationOutboxEntryResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/notification-outbox/{id} [get]
+func (h *NotificationOutboxHandler) GetNotificationOutboxEntry(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	entry, err := h.svc.Get(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(notificationOutboxErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.NotificationOutboxEntryResponse{Status: "success", Data: entry})
+}
+
+// RetryNotificationOutboxEntry godoc
+// @Summary Retry a notification outbox entry
+// @Description Resets attempts and schedules a pending, dead or discarded entry for immediate redelivery
+// @Tags notification-outbox
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Outbox entry ID"
+// @Success 202 {object} model.NotificationOutboxActionResponse
+// @Failure 400,404,409,500 {object} model.ErrorResponse
+// @Router /api/v1/notification-outbox/{id}/retry [post]
+func (h *NotificationOutboxHandler) RetryNotificationOutboxEntry(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	if err := h.svc.Retry(c.Request.Context(), id); err != nil {
+		c.JSON(notificationOutboxErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusAccepted, model.NotificationOutboxActionResponse{
+		Status:  "success",
+		Message: "알림 재전송이 요청되었습니다.",
+		ID:      id,
+	})
+}
+
+// DiscardNotificationOutboxEntry godoc
+// @Summary Discard a notification outbox entry
+// @Description Stops retrying a pending or dead entry
+// @Tags notification-outbox
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Outbox entry ID"
+// @Success 200 {object} model.NotificationOutboxActionResponse
+// @Failure 400,404,409,500 {object} model.ErrorResponse
+// @Router /api/v1/notification-outbox/{id}/discard [post]
+func (h *NotificationOutboxHandler) DiscardNotificationOutboxEntry(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	if err := h.svc.Discard(c.Request.Context(), id); err != nil {
+		c.JSON(notificationOutboxErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.NotificationOutboxActionResponse{
+		Status:  "success",
+		Message: "알림이 폐기되었습니다.",
+		ID:      id,
+	})
+}
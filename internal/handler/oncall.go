@@ -0,0 +1,346 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kube-rca/backend/internal/model"
+	"github.com/kube-rca/backend/internal/service"
+)
+
+// oncallService - 서비스 인터페이스
+type oncallService interface {
+	List(ctx context.Context) ([]model.OncallSchedule, error)
+	Get(ctx context.Context, id int64) (*model.OncallSchedule, error)
+	Create(ctx context.Context, req model.OncallScheduleRequest, createdBy string) (int64, error)
+	Update(ctx context.Context, id int64, req model.OncallScheduleRequest) error
+	Delete(ctx context.Context, id int64) error
+	ListOverrides(ctx context.Context, scheduleID int64) ([]model.OncallOverride, error)
+	CreateOverride(ctx context.Context, scheduleID int64, req model.OncallOverrideRequest, createdBy string) (int64, error)
+	DeleteOverride(ctx context.Context, scheduleID, id int64) error
+	Now(ctx context.Context) ([]model.OncallShift, error)
+	ListUsers(ctx context.Context) ([]model.OncallUser, error)
+	SetUserSlackID(ctx context.Context, loginID, slackUserID string) error
+}
+
+// OncallHandler - on-call 스케줄/override/사용자 Slack 매핑 핸들러
+type OncallHandler struct {
+	svc oncallService
+}
+
+func NewOncallHandler(svc oncallService) *OncallHandler {
+	return &OncallHandler{svc: svc}
+}
+
+// oncallErrorStatus - 서비스 에러를 HTTP 상태 코드로 변환
+func oncallErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidOncallSchedule),
+		errors.Is(err, service.ErrInvalidOncallOverride),
+		errors.Is(err, service.ErrInvalidSlackUserID):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrOncallScheduleNotFound),
+		errors.Is(err, service.ErrOncallOverrideNotFound),
+		errors.Is(err, service.ErrOncallUserNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// GetOncallNow godoc
+// @Summary Who is on call now
+// @Description Returns the current on-call user of every enabled schedule (override first, then the last active layer) with the next hand-off time
+// @Tags oncall
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} model.OncallNowResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /api/v1/oncall/now [get]
+func (h *OncallHandler) GetOncallNow(c *gin.Context) {
+	shifts, err := h.svc.Now(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.OncallNowResponse{Status: "success", Data: shifts})
+}
+
+// ListOncallSchedules godoc
+// @Summary List on-call schedules
+// @Tags oncall
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} model.OncallScheduleListResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /api/v1/oncall/schedules [get]
+func (h *OncallHandler) ListOncallSchedules(c *gin.Context) {
+	schedules, err := h.svc.List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.OncallScheduleListResponse{Status: "success", Data: schedules})
+}
+
+// GetOncallSchedule godoc
+// @Summary Get an on-call schedule by ID
+// @Tags oncall
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Schedule ID"
+// @Success 200 {object} model.OncallScheduleResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/oncall/schedules/{id} [get]
+func (h *OncallHandler) GetOncallSchedule(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	schedule, err := h.svc.Get(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	if schedule == nil {
+		c.JSON(http.StatusNotFound, gin.H{"status": "error", "error": "oncall schedule not found"})
+		return
+	}
+	c.JSON(http.StatusOK, model.OncallScheduleResponse{Status: "success", Data: schedule})
+}
+
+// CreateOncallSchedule godoc
+// @Summary Create an on-call schedule
+// @Description Layers rotate their users daily or weekly at handoff_time (schedule time zone), starting with the first user on start_date. Later layers take precedence while their weekdays/active hours apply. Alerts matching the matchers use the schedule; the most specific schedule wins.
+// @Tags oncall
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body model.OncallScheduleRequest true "On-call schedule"
+// @Success 201 {object} model.OncallMutationResponse
+// @Failure 400,500 {object} model.ErrorResponse
+// @Router /api/v1/oncall/schedules [post]
+func (h *OncallHandler) CreateOncallSchedule(c *gin.Context) {
+	var req model.OncallScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	createdBy := ""
+	if user := GetAuthUser(c); user != nil {
+		createdBy = user.LoginID
+	}
+	id, err := h.svc.Create(c.Request.Context(), req, createdBy)
+	if err != nil {
+		c.JSON(oncallErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, model.OncallMutationResponse{
+		Status:  "success",
+		Message: "On-call 스케줄이 생성되었습니다.",
+		ID:      id,
+	})
+}
+
+// UpdateOncallSchedule godoc
+// @Summary Update an on-call schedule
+// @Tags oncall
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Schedule ID"
+// @Param request body model.OncallScheduleRequest true "On-call schedule"
+// @Success 200 {object} model.OncallMutationResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/oncall/schedules/{id} [put]
+func (h *OncallHandler) UpdateOncallSchedule(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	var req model.OncallScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	if err := h.svc.Update(c.Request.Context(), id, req); err != nil {
+		c.JSON(oncallErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.OncallMutationResponse{
+		Status:  "success",
+		Message: "On-call 스케줄이 수정되었습니다.",
+		ID:      id,
+	})
+}
+
+// DeleteOncallSchedule godoc
+// @Summary Delete an on-call schedule
+// @Description Also deletes its overrides. On-call users already recorded on incidents are kept.
+// @Tags oncall
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Schedule ID"
+// @Success 200 {object} model.OncallMutationResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/oncall/schedules/{id} [delete]
+func (h *OncallHandler) DeleteOncallSchedule(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	if err := h.svc.Delete(c.Request.Context(), id); err != nil {
+		c.JSON(oncallErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.OncallMutationResponse{
+		Status:  "success",
+		Message: "On-call 스케줄이 삭제되었습니다.",
+		ID:      id,
+	})
+}
+
+// ListOncallOverrides godoc
+// @Summary List current and upcoming overrides of a schedule
+// @Tags oncall
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Schedule ID"
+// @Success 200 {object} model.OncallOverrideListResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/oncall/schedules/{id}/overrides [get]
+func (h *OncallHandler) ListOncallOverrides(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	overrides, err := h.svc.ListOverrides(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(oncallErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.OncallOverrideListResponse{Status: "success", Data: overrides})
+}
+
+// CreateOncallOverride godoc
+// @Summary Create an on-call override
+// @Description The user is on call for the schedule from start_at (default now) to end_at, ahead of every layer. When overrides overlap, the latest one wins.
+// @Tags oncall
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Schedule ID"
+// @Param request body model.OncallOverrideRequest true "Override"
+// @Success 201 {object} model.OncallMutationResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/oncall/schedules/{id}/overrides [post]
+func (h *OncallHandler) CreateOncallOverride(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	var req model.OncallOverrideRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	createdBy := ""
+	if user := GetAuthUser(c); user != nil {
+		createdBy = user.LoginID
+	}
+	overrideID, err := h.svc.CreateOverride(c.Request.Context(), id, req, createdBy)
+	if err != nil {
+		c.JSON(oncallErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, model.OncallMutationResponse{
+		Status:  "success",
+		Message: "On-call override가 생성되었습니다.",
+		ID:      overrideID,
+	})
+}
+
+// DeleteOncallOverride godoc
+// @Summary Delete an on-call override
+// @Tags oncall
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Schedule ID"
+// @Param overrideId path int true "Override ID"
+// @Success 200 {object} model.OncallMutationResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/oncall/schedules/{id}/overrides/{overrideId} [delete]
+func (h *OncallHandler) DeleteOncallOverride(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	overrideID, err := strconv.ParseInt(c.Param("overrideId"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid override id"})
+		return
+	}
+	if err := h.svc.DeleteOverride(c.Request.Context(), id, overrideID); err != nil {
+		c.JSON(oncallErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.OncallMutationResponse{
+		Status:  "success",
+		Message: "On-call override가 삭제되었습니다.",
+		ID:      overrideID,
+	})
+}
+
+// ListOncallUsers godoc
+// @Summary List users and their Slack user IDs
+// @Tags oncall
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} model.OncallUserListResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /api/v1/oncall/users [get]
+func (h *OncallHandler) ListOncallUsers(c *gin.Context) {
+	users, err := h.svc.ListUsers(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.OncallUserListResponse{Status: "success", Data: users})
+}
+
+// UpdateOncallUserSlack godoc
+// @Summary Map a user to a Slack user ID
+// @Description Used to mention the on-call user in Slack. An empty slack_user_id removes the mapping.
+// @Tags oncall
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param loginId path string true "User login ID"
+// @Param request body model.OncallUserSlackRequest true "Slack user ID"
+// @Success 200 {object} model.OncallMutationResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/oncall/users/{loginId}/slack [put]
+func (h *OncallHandler) UpdateOncallUserSlack(c *gin.Context) {
+	var req model.OncallUserSlackRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	if err := h.svc.SetUserSlackID(c.Request.Context(), c.Param("loginId"), req.SlackUserID); err != nil {
+		c.JSON(oncallErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.OncallMutationResponse{
+		Status:  "success",
+		Message: "Slack 사용자 ID가 저장되었습니다.",
+	})
+}
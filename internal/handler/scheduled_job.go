@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// scheduledJobService - 예약 작업 조회 서비스 인터페이스
+type scheduledJobService interface {
+	List(ctx context.Context, jobType, status string, limit int) ([]model.ScheduledJob, error)
+}
+
+// ScheduledJobHandler - 예약 작업(scheduled_jobs) 조회 핸들러
+type ScheduledJobHandler struct {
+	svc scheduledJobService
+}
+
+func NewScheduledJobHandler(svc scheduledJobService) *ScheduledJobHandler {
+	return &ScheduledJobHandler{svc: svc}
+}
+
+// ListScheduledJobs godoc
+// @Summary List scheduled jobs
+// @Description Returns scheduled jobs ordered by run_at. Defaults to pending jobs; use type=flapping_clearance for pending flapping clearance checks.
+// @Tags scheduled-jobs
+// @Produce json
+// @Security BearerAuth
+// @Param type query string false "Filter by job type (e.g. flapping_clearance)"
+// @Param status query string false "pending (default), running, done, failed, cancelled, all"
+// @Param limit query int false "Max jobs (default 100, max 500)"
+// @Success 200 {object} model.ScheduledJobListResponse
+// @Failure 400,500 {object} model.ErrorResponse
+// @Router /api/v1/scheduled-jobs [get]
+func (h *ScheduledJobHandler) ListScheduledJobs(c *gin.Context) {
+	limit := 0
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid limit"})
+			return
+		}
+		limit = parsed
+	}
+	jobs, err := h.svc.List(c.Request.Context(), c.Query("type"), c.Query("status"), limit)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.ScheduledJobListResponse{Status: "success", Data: jobs})
+}
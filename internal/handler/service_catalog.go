@@ -0,0 +1,223 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kube-rca/backend/internal/model"
+	"github.com/kube-rca/backend/internal/service"
+)
+
+// serviceCatalogService - 서비스 인터페이스
+type serviceCatalogService interface {
+	List(ctx context.Context) ([]model.Service, error)
+	Get(ctx context.Context, id int64) (*model.Service, error)
+	Create(ctx context.Context, req model.ServiceRequest, createdBy string) (int64, error)
+	Update(ctx context.Context, id int64, req model.ServiceRequest) error
+	Delete(ctx context.Context, id int64) error
+	ListIncidents(ctx context.Context, id int64, status string) ([]model.IncidentListResponse, error)
+	Metrics(ctx context.Context, window string) ([]model.ServiceMetrics, string, error)
+}
+
+// ServiceCatalogHandler - 서비스 소유권 카탈로그 관련 핸들러
+type ServiceCatalogHandler struct {
+	svc serviceCatalogService
+}
+
+func NewServiceCatalogHandler(svc serviceCatalogService) *ServiceCatalogHandler {
+	return &ServiceCatalogHandler{svc: svc}
+}
+
+// serviceErrorStatus - 서비스 에러를 HTTP 상태 코드로 변환
+func serviceErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidService):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrServiceNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// ListServices godoc
+// @Summary List services in the ownership catalog
+// @Tags services
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} model.ServiceListResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /api/v1/services [get]
+func (h *ServiceCatalogHandler) ListServices(c *gin.Context) {
+	services, err := h.svc.List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.ServiceListResponse{Status: "success", Data: services})
+}
+
+// GetService godoc
+// @Summary Get a service by ID
+// @Tags services
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Service ID"
+// @Success 200 {object} model.ServiceResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/services/{id} [get]
+func (h *ServiceCatalogHandler) GetService(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	svc, err := h.svc.Get(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	if svc == nil {
+		c.JSON(http.StatusNotFound, gin.H{"status": "error", "error": "service not found"})
+		return
+	}
+	c.JSON(http.StatusOK, model.ServiceResponse{Status: "success", Data: svc})
+}
+
+// CreateService godoc
+// @Summary Create a service
+// @Description Alerts whose namespace is listed in namespaces or whose labels match all selectors are resolved to this service at ingestion
+// @Tags services
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body model.ServiceRequest true "Service"
+// @Success 201 {object} model.ServiceMutationResponse
+// @Failure 400,500 {object} model.ErrorResponse
+// @Router /api/v1/services [post]
+func (h *ServiceCatalogHandler) CreateService(c *gin.Context) {
+	var req model.ServiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	createdBy := ""
+	if user := GetAuthUser(c); user != nil {
+		createdBy = user.LoginID
+	}
+	id, err := h.svc.Create(c.Request.Context(), req, createdBy)
+	if err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, model.ServiceMutationResponse{
+		Status:  "success",
+		Message: "서비스가 생성되었습니다.",
+		ID:      id,
+	})
+}
+
+// UpdateService godoc
+// @Summary Update a service
+// @Tags services
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Service ID"
+// @Param request body model.ServiceRequest true "Service"
+// @Success 200 {object} model.ServiceMutationResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/services/{id} [put]
+func (h *ServiceCatalogHandler) UpdateService(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	var req model.ServiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	if err := h.svc.Update(c.Request.Context(), id, req); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.ServiceMutationResponse{
+		Status:  "success",
+		Message: "서비스가 수정되었습니다.",
+		ID:      id,
+	})
+}
+
+// DeleteService godoc
+// @Summary Delete a service
+// @Tags services
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Service ID"
+// @Success 200 {object} model.ServiceMutationResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/services/{id} [delete]
+func (h *ServiceCatalogHandler) DeleteService(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	if err := h.svc.Delete(c.Request.Context(), id); err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.ServiceMutationResponse{
+		Status:  "success",
+		Message: "서비스가 삭제되었습니다.",
+		ID:      id,
+	})
+}
+
+// ListServiceIncidents godoc
+// @Summary List incidents owned by a service
+// @Tags services
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Service ID"
+// @Param status query string false "firing (default), resolved, all"
+// @Success 200 {object} model.ServiceIncidentListResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/services/{id}/incidents [get]
+func (h *ServiceCatalogHandler) ListServiceIncidents(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	incidents, err := h.svc.ListIncidents(c.Request.Context(), id, c.Query("status"))
+	if err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.ServiceIncidentListResponse{Status: "success", Data: incidents})
+}
+
+// GetServiceMetrics godoc
+// @Summary Per-service open incidents and MTTR
+// @Description Open incident count per service plus incidents and MTTR within the window. Maintenance-only incidents are excluded from MTTR.
+// @Tags services
+// @Produce json
+// @Security BearerAuth
+// @Param window query string false "Window (e.g. 24h, 7d, 30d)" default(30d)
+// @Success 200 {object} model.ServiceMetricsListResponse
+// @Failure 400,500 {object} model.ErrorResponse
+// @Router /api/v1/services/metrics [get]
+func (h *ServiceCatalogHandler) GetServiceMetrics(c *gin.Context) {
+	metrics, window, err := h.svc.Metrics(c.Request.Context(), c.DefaultQuery("window", "30d"))
+	if err != nil {
+		c.JSON(serviceErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.ServiceMetricsListResponse{Status: "success", Window: window, Data: metrics})
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kube-rca/backend/internal/model"
+	"github.com/kube-rca/backend/internal/service"
+)
+
+// silenceService - 서비스 인터페이스
+type silenceService interface {
+	List(ctx context.Context) ([]model.SilenceRule, error)
+	Get(ctx context.Context, id int64) (*model.SilenceRule, error)
+	Create(ctx context.Context, req model.SilenceRuleRequest, createdBy string) (int64, error)
+	Update(ctx context.Context, id int64, req model.SilenceRuleRequest) error
+	Delete(ctx context.Context, id int64) error
+}
+
+// SilenceHandler - Silence 규칙 관련 핸들러
+type SilenceHandler struct {
+	svc silenceService
+}
+
+func NewSilenceHandler(svc silenceService) *SilenceHandler {
+	return &SilenceHandler{svc: svc}
+}
+
+// silenceErrorStatus - 서비스 에러를 HTTP 상태 코드로 변환
+func silenceErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidSilenceRule):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrSilenceRuleNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// ListSilences godoc
+// @Summary List silence rules
+// @Tags silences
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} model.SilenceRuleListResponse
+// @Failure 500 {object} model.ErrorResponse
+// @Router /api/v1/silences [get]
+func (h *SilenceHandler) ListSilences(c *gin.Context) {
+	rules, err := h.svc.List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.SilenceRuleListResponse{Status: "success", Data: rules})
+}
+
+// GetSilence godoc
+// @Summary Get a silence rule by ID
+// @Tags silences
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Silence rule ID"
+// @Success 200 {object} model.SilenceRuleResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/silences/{id} [get]
+func (h *SilenceHandler) GetSilence(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	rule, err := h.svc.Get(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	if rule == nil {
+		c.JSON(http.StatusNotFound, gin.H{"status": "error", "error": "silence rule not found"})
+		return
+	}
+	c.JSON(http.StatusOK, model.SilenceRuleResponse{Status: "success", Data: rule})
+}
+
+// CreateSilence godoc
+// @Summary Create a silence rule
+// @Description Matching alerts are still stored but skip notification and auto-analysis
+// @Tags silences
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body model.SilenceRuleRequest true "Silence rule"
+// @Success 201 {object} model.SilenceRuleMutationResponse
+// @Failure 400,500 {object} model.ErrorResponse
+// @Router /api/v1/silences [post]
+func (h *SilenceHandler) CreateSilence(c *gin.Context) {
+	var req model.SilenceRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	createdBy := ""
+	if user := GetAuthUser(c); user != nil {
+		createdBy = user.LoginID
+	}
+	id, err := h.svc.Create(c.Request.Context(), req, createdBy)
+	if err != nil {
+		c.JSON(silenceErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, model.SilenceRuleMutationResponse{
+		Status:  "success",
+		Message: "Silence 규칙이 생성되었습니다.",
+		ID:      id,
+	})
+}
+
+// UpdateSilence godoc
+// @Summary Update a silence rule
+// @Tags silences
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Silence rule ID"
+// @Param request body model.SilenceRuleRequest true "Silence rule"
+// @Success 200 {object} model.SilenceRuleMutationResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/silences/{id} [put]
+func (h *SilenceHandler) UpdateSilence(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	var req model.SilenceRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	if err := h.svc.Update(c.Request.Context(), id, req); err != nil {
+		c.JSON(silenceErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.SilenceRuleMutationResponse{
+		Status:  "success",
+		Message: "Silence 규칙이 수정되었습니다.",
+		ID:      id,
+	})
+}
+
+// DeleteSilence godoc
+// @Summary Delete a silence rule
+// @Tags silences
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Silence rule ID"
+// @Success 200 {object} model.SilenceRuleMutationResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/silences/{id} [delete]
+func (h *SilenceHandler) DeleteSilence(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	if err := h.svc.Delete(c.Request.Context(), id); err != nil {
+		c.JSON(silenceErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.SilenceRuleMutationResponse{
+		Status:  "success",
+		Message: "Silence 규칙이 삭제되었습니다.",
+		ID:      id,
+	})
+}
@@ -2,12 +2,14 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/kube-rca/backend/internal/model"
+	"github.com/kube-rca/backend/internal/service"
 )
 
 // webhookService - 서비스 인터페이스
@@ -17,6 +19,7 @@ type webhookService interface {
 	CreateWebhookConfig(ctx context.Context, req model.WebhookConfigRequest) (int, error)
 	UpdateWebhookConfig(ctx context.Context, id int, req model.WebhookConfigRequest) error
 	DeleteWebhookConfig(ctx context.Context, id int) error
+	PreviewTemplates(ctx context.Context, req model.WebhookTemplatePreviewRequest) (*model.WebhookTemplatePreview, error)
 }
 
 // WebhookSettingsHandler - 웹훅 설정 관련 핸들러
@@ -90,7 +93,7 @@ func (h *WebhookSettingsHandler) CreateWebhookConfig(c *gin.Context) {
 	}
 	id, err := h.svc.CreateWebhookConfig(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		c.JSON(webhookConfigErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
 		return
 	}
 	c.JSON(http.StatusCreated, model.WebhookConfigMutationResponse{
@@ -127,7 +130,7 @@ func (h *WebhookSettingsHandler) UpdateWebhookConfig(c *gin.Context) {
 		return
 	}
 	if err := h.svc.UpdateWebhookConfig(c.Request.Context(), id, req); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		c.JSON(webhookConfigErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
 		return
 	}
 	c.JSON(http.StatusOK, model.WebhookConfigMutationResponse{
@@ -162,3 +165,39 @@ func (h *WebhookSettingsHandler) DeleteWebhookConfig(c *gin.Context) {
 		ID:      id,
 	})
 }
+
+// PreviewWebhookTemplates godoc
+// @Summary Preview webhook message templates
+// @Description Renders title/body/payload templates against a stored alert. Empty template fields fall back to the templates of webhook_config_id when given.
+// @Tags settings
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body model.WebhookTemplatePreviewRequest true "Templates and alert"
+// @Success 200 {object} model.WebhookTemplatePreviewResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/settings/webhooks/preview [post]
+func (h *WebhookSettingsHandler) PreviewWebhookTemplates(c *gin.Context) {
+	var req model.WebhookTemplatePreviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	preview, err := h.svc.PreviewTemplates(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(webhookConfigErrorStatus(err), gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.WebhookTemplatePreviewResponse{Status: "success", Data: *preview})
+}
+
+func webhookConfigErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrInvalidWebhookConfig):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrWebhookPreviewNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
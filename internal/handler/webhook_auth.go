@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// webhookAuthStatusProvider - 인바운드 웹훅 인증 상태 조회 인터페이스
+type webhookAuthStatusProvider interface {
+	Status() model.WebhookAuthStatus
+}
+
+// WebhookAuthHandler - 인바운드 웹훅 인증 상태 핸들러
+type WebhookAuthHandler struct {
+	auth webhookAuthStatusProvider
+}
+
+func NewWebhookAuthHandler(auth webhookAuthStatusProvider) *WebhookAuthHandler {
+	return &WebhookAuthHandler{auth: auth}
+}
+
+// GetWebhookAuthStatus godoc
+// @Summary Get inbound webhook authentication status
+// @Description Returns configured credential names (without secrets) and rejected request counters
+// @Tags settings
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} model.WebhookAuthStatusResponse
+// @Router /api/v1/settings/webhook-auth [get]
+func (h *WebhookAuthHandler) GetWebhookAuthStatus(c *gin.Context) {
+	c.JSON(http.StatusOK, model.WebhookAuthStatusResponse{
+		Status: "success",
+		Data:   h.auth.Status(),
+	})
+}
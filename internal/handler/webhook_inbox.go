@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// webhookInboxService - inbox 관리 서비스 인터페이스
+type webhookInboxService interface {
+	List(ctx context.Context, status string, limit int) ([]model.WebhookInboxEntry, error)
+	Get(ctx context.Context, id int64) (*model.WebhookInboxEntry, error)
+	Replay(ctx context.Context, id int64) error
+}
+
+// WebhookInboxHandler - 수신 웹훅 inbox 관리 핸들러
+type WebhookInboxHandler struct {
+	svc webhookInboxService
+}
+
+func NewWebhookInboxHandler(svc webhookInboxService) *WebhookInboxHandler {
+	return &WebhookInboxHandler{svc: svc}
+}
+
+// ListWebhookInbox godoc
+// @Summary List stored inbound webhooks
+// @Description Returns inbox entries (newest first) without payloads
+// @Tags webhook-inbox
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Filter by status (pending, processing, done, dead)"
+// @Param limit query int false "Max entries (default 100, max 500)"
+// @Success 200 {object} model.WebhookInboxListResponse
+// @Failure 400,500 {object} model.ErrorResponse
+// @Router /api/v1/webhook-inbox [get]
+func (h *WebhookInboxHandler) ListWebhookInbox(c *gin.Context) {
+	limit := 0
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid limit"})
+			return
+		}
+		limit = parsed
+	}
+	entries, err := h.svc.List(c.Request.Context(), c.Query("status"), limit)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, model.WebhookInboxListResponse{Status: "success", Data: entries})
+}
+
+// GetWebhookInboxEntry godoc
+// @Summary Get a stored inbound webhook
+// @Description Returns an inbox entry including the raw payload
+// @Tags webhook-inbox
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Inbox entry ID"
+// @Success 200 {object} model.WebhookInboxEntryResponse
+// @Failure 400,404,500 {object} model.ErrorResponse
+// @Router /api/v1/webhook-inbox/{id} [get]
+func (h *WebhookInboxHandler) GetWebhookInboxEntry(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	entry, err := h.svc.Get(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	if entry == nil {
+		c.JSON(http.StatusNotFound, gin.H{"status": "error", "error": "inbox entry not found"})
+		return
+	}
+	c.JSON(http.StatusOK, model.WebhookInboxEntryResponse{Status: "success", Data: entry})
+}
+
+// ReplayWebhookInboxEntry godoc
+// @Summary Re-process a stored inbound webhook
+// @Description Resets the entry to pending so workers process the stored payload again
+// @Tags webhook-inbox
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Inbox entry ID"
+// @Success 202 {object} model.WebhookInboxReplayResponse
+// @Failure 400,404,409,500 {object} model.ErrorResponse
+// @Router /api/v1/webhook-inbox/{id}/replay [post]
+func (h *WebhookInboxHandler) ReplayWebhookInboxEntry(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"status": "error", "error": "invalid id"})
+		return
+	}
+	entry, err := h.svc.Get(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	if entry == nil {
+		c.JSON(http.StatusNotFound, gin.H{"status": "error", "error": "inbox entry not found"})
+		return
+	}
+	if entry.Status == model.WebhookInboxStatusProcessing {
+		c.JSON(http.StatusConflict, gin.H{"status": "error", "error": "inbox entry is being processed"})
+		return
+	}
+	if err := h.svc.Replay(c.Request.Context(), id); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"status": "error", "error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusAccepted, model.WebhookInboxReplayResponse{
+		Status:  "success",
+		Message: "웹훅 재처리가 요청되었습니다.",
+		ID:      id,
+	})
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// Acknowledgement - alert/incident 확인(acknowledge) 결과
+type Acknowledgement struct {
+	AlertID            string    `json:"alert_id,omitempty"`
+	IncidentID         string    `json:"incident_id,omitempty"`
+	AcknowledgedBy     string    `json:"acknowledged_by"`
+	AcknowledgedAt     time.Time `json:"acknowledged_at"`
+	TimeToAckSeconds   int64     `json:"time_to_ack_seconds"`
+	AcknowledgedAlerts []string  `json:"acknowledged_alerts,omitempty"` // 함께 확인 처리된 alert ID
+	IncidentAcked      bool      `json:"incident_acknowledged"`         // alert 확인 시 연결된 incident도 처음 확인되었는지
+}
+
+// AcknowledgeResponse - 확인 응답
+type AcknowledgeResponse struct {
+	Status  string          `json:"status"`
+	Message string          `json:"message"`
+	Data    Acknowledgement `json:"data"`
+}
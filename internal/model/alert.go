@@ -5,6 +5,15 @@ package model
 
 import "time"
 
+// 알림 수신 경로 (alerts.source, webhook_inbox.source)
+const (
+	AlertSourceAlertmanager = "alertmanager"
+	AlertSourceGrafana      = "grafana"
+	AlertSourcePagerDuty    = "pagerduty"
+	AlertSourceGeneric      = "generic"
+	AlertSourceKubernetes   = "kubernetes"
+)
+
 // AlertmanagerWebhook - Alertmanager 웹훅 페이로드
 // 여러 개의 알림이 그룹으로 묶여서 전송 가능
 type AlertmanagerWebhook struct {
@@ -62,6 +71,39 @@ type Alert struct {
 	// Fingerprint: 알림 고유 식별자 (Labels의 조합으로 생성되는 해시값)
 	// thread_ts 매핑에 사용하여 같은 스레드로 메시지 전송이 가능하게함
 	Fingerprint string `json:"fingerprint"`
+
+	// Credential: 웹훅 수신 시 인증에 사용된 credential 이름 (페이로드에는 없음, DB 저장용)
+	Credential string `json:"-"`
+
+	// Source: 알림 수신 경로 (alertmanager, grafana, pagerduty, generic, kubernetes) - inbox source에서 설정, DB 저장용
+	Source string `json:"-"`
+
+	// ExternalURL: 웹훅을 보낸 Alertmanager의 externalURL - IngestWebhook에서 설정, DB 저장용 (silence 생성 대상 식별)
+	ExternalURL string `json:"-"`
+
+	// Enrichments: enrichment 파이프라인이 추가한 라벨/annotation 출처 - IngestWebhook에서 설정, DB 저장용
+	Enrichments []AlertEnrichment `json:"-"`
+
+	// SourceLabels: severity 정규화/enrichment 전 수신 라벨 원본 - IngestWebhook에서 설정, DB 저장용 (Alertmanager silence 매처)
+	SourceLabels map[string]string `json:"-"`
+
+	// ServiceID/ServiceChannel: 서비스 카탈로그에서 매칭된 소유 서비스와 팀 Slack 채널 - IngestWebhook에서 설정 (알림 라우팅용)
+	ServiceID      *int64 `json:"-"`
+	ServiceChannel string `json:"-"`
+
+	// OncallSlackUserID: 매칭된 on-call 스케줄의 현재 사용자 Slack ID - 멘션이 설정된 경우 IngestWebhook에서 설정 (firing 알림 멘션용)
+	OncallSlackUserID string `json:"-"`
+}
+
+// AlertEnrichment - enricher가 기록한 값과 그 출처 (alerts.enrichments)
+type AlertEnrichment struct {
+	Target   string `json:"target"`             // label, annotation
+	Key      string `json:"key"`                // 기록된 라벨/annotation 이름
+	Value    string `json:"value"`              // 기록된 값
+	Replaced string `json:"replaced,omitempty"` // overwrite로 덮어쓴 기존 값
+	Enricher string `json:"enricher"`           // enricher 이름
+	Type     string `json:"type"`               // enricher 종류
+	Source   string `json:"source"`             // 조회에 사용된 라벨 (예: namespace=payments), static은 "static"
 }
 
 // BulkResolveAlertsRequest - 다건 alert resolve 요청 (최대 50건)
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// AlertReminderCandidate - 장시간 firing 재알림 후보 alert
+type AlertReminderCandidate struct {
+	AlertID        string
+	Fingerprint    string
+	IncidentID     string
+	AlarmTitle     string
+	Severity       string
+	Labels         map[string]string
+	FiredAt        time.Time
+	LastReminderAt *time.Time
+	ReminderCount  int
+}
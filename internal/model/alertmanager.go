@@ -0,0 +1,98 @@
+package model
+
+import "time"
+
+// AlertmanagerAPIAlert - Alertmanager v2 API (/api/v2/alerts) 응답의 개별 알림 (gettableAlert)
+type AlertmanagerAPIAlert struct {
+	Fingerprint  string                     `json:"fingerprint"`
+	Labels       map[string]string          `json:"labels"`
+	Annotations  map[string]string          `json:"annotations"`
+	StartsAt     time.Time                  `json:"startsAt"`
+	EndsAt       time.Time                  `json:"endsAt"`
+	UpdatedAt    time.Time                  `json:"updatedAt"`
+	GeneratorURL string                     `json:"generatorURL"`
+	Status       AlertmanagerAPIAlertStatus `json:"status"`
+	Receivers    []AlertmanagerAPIReceiver  `json:"receivers"`
+}
+
+// AlertmanagerAPIAlertStatus - 알림 상태 (active, suppressed, unprocessed)
+type AlertmanagerAPIAlertStatus struct {
+	State       string   `json:"state"`
+	SilencedBy  []string `json:"silencedBy"`
+	InhibitedBy []string `json:"inhibitedBy"`
+}
+
+// AlertmanagerAPIReceiver - 알림이 라우팅된 receiver
+type AlertmanagerAPIReceiver struct {
+	Name string `json:"name"`
+}
+
+// AlertmanagerReconcileResult - Alertmanager 상태 동기화 결과
+type AlertmanagerReconcileResult struct {
+	Trigger   string    `json:"trigger"`   // startup, interval, truncated, manual
+	Fetched   int       `json:"fetched"`   // Alertmanager에서 조회한 active alert 수
+	Recovered int       `json:"recovered"` // DB에 firing으로 없어 새로 enqueue한 alert 수
+	Resolved  int       `json:"resolved"`  // Alertmanager에 없어 resolved로 enqueue한 alert 수
+	InboxID   int64     `json:"inbox_id,omitempty"`
+	RanAt     time.Time `json:"ran_at"`
+}
+
+// AlertmanagerReconcileResponse - 수동 동기화 응답
+type AlertmanagerReconcileResponse struct {
+	Status string                      `json:"status"`
+	Data   AlertmanagerReconcileResult `json:"data"`
+}
+
+// AlertmanagerAPIMatcher - Alertmanager v2 API silence 매처
+type AlertmanagerAPIMatcher struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	IsRegex bool   `json:"isRegex"`
+	IsEqual bool   `json:"isEqual"`
+}
+
+// AlertmanagerAPISilence - Alertmanager v2 API silence 생성 요청 (postableSilence)
+type AlertmanagerAPISilence struct {
+	Matchers  []AlertmanagerAPIMatcher `json:"matchers"`
+	StartsAt  time.Time                `json:"startsAt"`
+	EndsAt    time.Time                `json:"endsAt"`
+	CreatedBy string                   `json:"createdBy"`
+	Comment   string                   `json:"comment"`
+}
+
+// AlertmanagerSilence - kube-rca에서 생성한 Alertmanager silence 기록 (alertmanager_silences 테이블)
+type AlertmanagerSilence struct {
+	ID              int64         `json:"id"`
+	SilenceID       string        `json:"silence_id"`       // Alertmanager silence ID
+	AlertmanagerURL string        `json:"alertmanager_url"` // silence를 생성한 Alertmanager
+	AlertID         string        `json:"alert_id"`
+	IncidentID      string        `json:"incident_id"`
+	Matchers        LabelMatchers `json:"matchers"`
+	StartsAt        time.Time     `json:"starts_at"`
+	EndsAt          time.Time     `json:"ends_at"`
+	Comment         string        `json:"comment"`
+	CreatedBy       string        `json:"created_by"`
+	ExpiredAt       *time.Time    `json:"expired_at,omitempty"` // kube-rca에서 조기 만료한 시각
+	CreatedAt       time.Time     `json:"created_at"`
+}
+
+// AlertSilenceRequest - alert 라벨 기반 Alertmanager silence 생성 요청
+// ends_at 또는 duration_minutes 중 하나 (둘 다 없으면 120분). match_labels를 지정하면 해당 라벨만 매처로 사용.
+type AlertSilenceRequest struct {
+	DurationMinutes int        `json:"duration_minutes"`
+	EndsAt          *time.Time `json:"ends_at,omitempty"`
+	Comment         string     `json:"comment"`
+	MatchLabels     []string   `json:"match_labels"`
+}
+
+// AlertmanagerSilenceResponse - 단건 응답
+type AlertmanagerSilenceResponse struct {
+	Status string               `json:"status"`
+	Data   *AlertmanagerSilence `json:"data"`
+}
+
+// AlertmanagerSilenceListResponse - 목록 응답
+type AlertmanagerSilenceListResponse struct {
+	Status string                `json:"status"`
+	Data   []AlertmanagerSilence `json:"data"`
+}
@@ -36,6 +36,81 @@ type AnalysisSettings struct {
 	ManualAnalyzeSeverities string `json:"manualAnalyzeSeverities"` // comma-separated severities requiring manual analysis, empty = all auto
 }
 
+// CorrelationSettings - Alert → Incident 상관관계(그룹핑) 설정
+type CorrelationSettings struct {
+	// 그룹핑 키로 사용할 라벨 목록 (예: ["namespace","app"])
+	// "groupKey"는 Alertmanager 웹훅의 GroupKey를 의미하며, 빈 목록(기본값)이면 단일 Incident로 묶음
+	// alert에 없는 라벨은 키에서 제외
+	GroupBy []string `json:"groupBy"`
+	// 마지막 Alert 이후 이 시간(분)이 지난 Incident에는 새 Alert를 붙이지 않음 (0 = 무제한)
+	WindowMinutes int `json:"windowMinutes"`
+	// 그룹핑 키가 다를 때 라벨 유사도 비교에 사용할 라벨 목록
+	SimilarityLabels []string `json:"similarityLabels"`
+	// 유사도 임계값 (0~1, 0이면 유사도 매칭 비활성)
+	SimilarityThreshold float64 `json:"similarityThreshold"`
+}
+
+// SeveritySettings - severity 분류 체계 (라벨 값 → 정규 레벨 매핑 + 레벨별 처리 정책)
+type SeveritySettings struct {
+	// 정규 레벨 목록 (rank가 클수록 심각)
+	Levels []SeverityLevel `json:"levels"`
+	// 라벨 값 별칭 → 정규 레벨 이름 (대소문자 무시, 예: {"error":"critical","P1":"critical"})
+	Aliases map[string]string `json:"aliases"`
+	// severity 라벨이 없거나 알 수 없는 값일 때 사용할 레벨 (빈 값이면 해당 alert 무시)
+	Default string `json:"default"`
+}
+
+// SeverityLevel - 정규 severity 레벨
+type SeverityLevel struct {
+	Name        string `json:"name"`
+	Rank        int    `json:"rank"`
+	Store       bool   `json:"store"`       // DB 저장 및 처리 여부 (false면 완전 무시)
+	Notify      bool   `json:"notify"`      // 알림 채널 전송 여부
+	AutoAnalyze bool   `json:"autoAnalyze"` // Agent 자동 분석 여부 (analysis.manualAnalyzeSeverities와 함께 적용)
+	// firing이 계속되는 동안 기존 스레드에 재알림할 간격(분, 0 = 재알림 없음, notify가 true일 때만 적용)
+	ReminderMinutes int `json:"reminderMinutes"`
+}
+
+// EnrichmentSettings - alert 보강(enrichment) 파이프라인 설정
+// 웹훅 처리 시 저장 전에 enricher를 순서대로 적용해 라벨/annotation을 추가한다 (앞선 enricher가 추가한 라벨도 다음 enricher에 보임).
+type EnrichmentSettings struct {
+	Enabled   bool       `json:"enabled"`
+	Enrichers []Enricher `json:"enrichers"`
+}
+
+// Enricher 종류
+const (
+	EnricherTypeTeam        = "team"        // namespace → team 라벨
+	EnricherTypeRunbook     = "runbook"     // alertname → runbook_url annotation
+	EnricherTypeEnvironment = "environment" // cluster → environment 라벨
+	EnricherTypeLookup      = "lookup"      // 임의 라벨 값 → 라벨/annotation
+	EnricherTypeStatic      = "static"      // 매처에 맞는 alert에 고정 라벨/annotation
+)
+
+// Enricher 대상
+const (
+	EnrichmentTargetLabel      = "label"
+	EnrichmentTargetAnnotation = "annotation"
+)
+
+// Enricher - 개별 enricher
+//   - team/runbook/environment/lookup: sourceLabel 값으로 values 테이블을 조회해 target의 key에 기록 ("*" = 매핑 없는 값의 기본값)
+//     team/runbook/environment는 sourceLabel, target, key 생략 시 종류별 기본값 사용
+//   - static: matchers를 모두 만족하면(비어 있으면 모든 alert) labels/annotations 추가
+//   - overwrite가 false면 alert에 이미 있는 값은 유지
+type Enricher struct {
+	Name        string            `json:"name"`
+	Type        string            `json:"type"`
+	SourceLabel string            `json:"sourceLabel,omitempty"`
+	Target      string            `json:"target,omitempty"`
+	Key         string            `json:"key,omitempty"`
+	Values      map[string]string `json:"values,omitempty"`
+	Matchers    LabelMatchers     `json:"matchers,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Overwrite   bool              `json:"overwrite"`
+}
+
 // AppSettingResponse - 단건 조회 응답
 type AppSettingResponse struct {
 	Status string     `json:"status"`
@@ -33,6 +33,7 @@ type User struct {
 	Email        *string
 	DisplayName  *string
 	PictureURL   *string
+	SlackUserID  string
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
@@ -8,14 +8,16 @@ type AnalyticsCountItem struct {
 }
 
 type AnalyticsSummary struct {
-	TotalIncidents       int     `json:"total_incidents"`
-	FiringIncidents      int     `json:"firing_incidents"`
-	ResolvedIncidents    int     `json:"resolved_incidents"`
-	TotalAlerts          int     `json:"total_alerts"`
-	FiringAlerts         int     `json:"firing_alerts"`
-	ResolvedAlerts       int     `json:"resolved_alerts"`
-	AvgMTTRMinutes       float64 `json:"avg_mttr_minutes"`
-	AvgAlertsPerIncident float64 `json:"avg_alerts_per_incident"`
+	TotalIncidents        int     `json:"total_incidents"`
+	FiringIncidents       int     `json:"firing_incidents"`
+	ResolvedIncidents     int     `json:"resolved_incidents"`
+	TotalAlerts           int     `json:"total_alerts"`
+	FiringAlerts          int     `json:"firing_alerts"`
+	ResolvedAlerts        int     `json:"resolved_alerts"`
+	AvgMTTRMinutes        float64 `json:"avg_mttr_minutes"`
+	AvgMTTAMinutes        float64 `json:"avg_mtta_minutes"`
+	AcknowledgedIncidents int     `json:"acknowledged_incidents"`
+	AvgAlertsPerIncident  float64 `json:"avg_alerts_per_incident"`
 }
 
 type AnalyticsBreakdown struct {
@@ -0,0 +1,131 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// 에스컬레이션 대상 종류
+const (
+	EscalationTargetWebhook = "webhook" // 등록된 webhook_configs (Slack은 설정 채널, HTTP/Teams는 JSON POST)
+	EscalationTargetUser    = "user"    // Slack 사용자 ID (기본 Slack 봇 DM)
+	EscalationTargetChannel = "channel" // Slack 채널 ID (기본 Slack 봇)
+)
+
+// Incident 에스컬레이션 상태
+const (
+	EscalationStatusActive       = "active"       // 다음 레벨 대기 중
+	EscalationStatusAcknowledged = "acknowledged" // 확인되어 중단
+	EscalationStatusResolved     = "resolved"     // 종료되어 중단
+	EscalationStatusExhausted    = "exhausted"    // 마지막 레벨 timeout까지 확인되지 않음
+	EscalationStatusCancelled    = "cancelled"    // 정책 삭제/비활성 등으로 중단
+)
+
+// EscalationTarget - 에스컬레이션 레벨의 알림 대상
+type EscalationTarget struct {
+	Type            string `json:"type"`                        // webhook, user, channel
+	WebhookConfigID int    `json:"webhook_config_id,omitempty"` // type=webhook
+	User            string `json:"user,omitempty"`              // type=user (Slack 사용자 ID, U...)
+	Channel         string `json:"channel,omitempty"`           // type=channel (Slack 채널 ID, C...)
+}
+
+// EscalationLevel - 에스컬레이션 단계 (대상에 알린 뒤 timeout 동안 확인되지 않으면 다음 레벨)
+type EscalationLevel struct {
+	TimeoutMinutes int                `json:"timeout_minutes"`
+	Targets        []EscalationTarget `json:"targets"`
+}
+
+// EscalationPolicy - 라벨 매처별 다단계 에스컬레이션 정책 (escalation_policies 테이블)
+// 매처가 비어 있으면 모든 Incident에 매칭되며, 여러 정책이 매칭되면 가장 구체적인 정책을 사용한다.
+type EscalationPolicy struct {
+	ID        int64             `json:"id"`
+	Name      string            `json:"name"`
+	Matchers  LabelMatchers     `json:"matchers"`
+	Levels    []EscalationLevel `json:"levels"`
+	Enabled   bool              `json:"enabled"`
+	Comment   string            `json:"comment"`
+	CreatedBy string            `json:"created_by"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
+// EscalationPolicyRequest - 에스컬레이션 정책 생성/수정 요청 (enabled 생략 시 true)
+type EscalationPolicyRequest struct {
+	Name     string            `json:"name"`
+	Matchers LabelMatchers     `json:"matchers"`
+	Levels   []EscalationLevel `json:"levels"`
+	Enabled  *bool             `json:"enabled,omitempty"`
+	Comment  string            `json:"comment"`
+}
+
+// IncidentEscalation - Incident별 에스컬레이션 진행 상태 (incident_escalations 테이블)
+type IncidentEscalation struct {
+	IncidentID       string     `json:"incident_id"`
+	PolicyID         int64      `json:"policy_id"`
+	PolicyName       string     `json:"policy_name"`
+	CurrentLevel     int        `json:"current_level"` // 알림을 보낸 마지막 레벨 (1부터, 0 = 시작 전)
+	Status           string     `json:"status"`        // active, acknowledged, resolved, exhausted, cancelled
+	StartedAt        time.Time  `json:"started_at"`
+	LastEscalatedAt  *time.Time `json:"last_escalated_at"`
+	NextEscalationAt *time.Time `json:"next_escalation_at"` // active일 때 다음 레벨 예정 시각
+	StoppedReason    string     `json:"stopped_reason"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// IncidentEscalationPayload - 에스컬레이션 다음 레벨 예약 작업 payload
+type IncidentEscalationPayload struct {
+	IncidentID string `json:"incident_id"`
+	PolicyID   int64  `json:"policy_id"`
+	Level      int    `json:"level"` // 실행할 레벨 (1부터, 레벨 수를 넘으면 exhausted 처리)
+}
+
+// EscalationPolicyResponse - 단건 조회 응답
+type EscalationPolicyResponse struct {
+	Status string            `json:"status"`
+	Data   *EscalationPolicy `json:"data"`
+}
+
+// EscalationPolicyListResponse - 목록 조회 응답
+type EscalationPolicyListResponse struct {
+	Status string             `json:"status"`
+	Data   []EscalationPolicy `json:"data"`
+}
+
+// EscalationPolicyMutationResponse - 생성/수정/삭제 응답
+type EscalationPolicyMutationResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	ID      int64  `json:"id,omitempty"`
+}
+
+// IncidentEscalationResponse - Incident 에스컬레이션 상태 응답
+type IncidentEscalationResponse struct {
+	Status string              `json:"status"`
+	Data   *IncidentEscalation `json:"data"`
+}
+
+// Incident 감사 기록 종류
+const (
+	IncidentAuditAcknowledged        = "acknowledged"
+	IncidentAuditEscalationStarted   = "escalation_started"
+	IncidentAuditEscalated           = "escalated"
+	IncidentAuditEscalationStopped   = "escalation_stopped"
+	IncidentAuditEscalationExhausted = "escalation_exhausted"
+)
+
+// IncidentAuditEvent - Incident 감사 기록 (incident_audit_events 테이블)
+type IncidentAuditEvent struct {
+	ID         int64           `json:"id"`
+	IncidentID string          `json:"incident_id"`
+	EventType  string          `json:"event_type"`
+	Actor      string          `json:"actor"` // 사용자 login ID (시스템 동작이면 system)
+	Message    string          `json:"message"`
+	Detail     json.RawMessage `json:"detail" swaggertype:"object"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// IncidentAuditListResponse - Incident 감사 기록 목록 응답
+type IncidentAuditListResponse struct {
+	Status string               `json:"status"`
+	Data   []IncidentAuditEvent `json:"data"`
+}
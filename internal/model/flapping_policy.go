@@ -0,0 +1,73 @@
+package model
+
+import "time"
+
+// FlappingPolicy - 라벨 매처별 flapping 감지 정책 (flapping_policies 테이블)
+// 매칭되는 alert는 전역 flapping 설정 대신 정책의 window/threshold/clearance를 사용한다.
+// 0인 값은 전역 설정을 그대로 사용하고, NeverFlapping이면 flapping으로 판정하지 않는다.
+type FlappingPolicy struct {
+	ID                     int64         `json:"id"`
+	Name                   string        `json:"name"`
+	Matchers               LabelMatchers `json:"matchers"`
+	NeverFlapping          bool          `json:"never_flapping"`
+	DetectionWindowMinutes int           `json:"detection_window_minutes"` // 0 = 전역 설정
+	CycleThreshold         int           `json:"cycle_threshold"`          // 0 = 전역 설정
+	ClearanceWindowMinutes int           `json:"clearance_window_minutes"` // 0 = 전역 설정
+	Enabled                bool          `json:"enabled"`
+	Comment                string        `json:"comment"`
+	CreatedBy              string        `json:"created_by"`
+	CreatedAt              time.Time     `json:"created_at"`
+	UpdatedAt              time.Time     `json:"updated_at"`
+}
+
+// FlappingPolicyRequest - flapping 정책 생성/수정 요청 (enabled 생략 시 true)
+type FlappingPolicyRequest struct {
+	Name                   string        `json:"name"`
+	Matchers               LabelMatchers `json:"matchers"`
+	NeverFlapping          bool          `json:"never_flapping"`
+	DetectionWindowMinutes int           `json:"detection_window_minutes"`
+	CycleThreshold         int           `json:"cycle_threshold"`
+	ClearanceWindowMinutes int           `json:"clearance_window_minutes"`
+	Enabled                *bool         `json:"enabled,omitempty"`
+	Comment                string        `json:"comment"`
+}
+
+// EffectiveFlappingPolicy - alert에 실제 적용되는 flapping 설정 (정책 + 전역 설정 병합)
+type EffectiveFlappingPolicy struct {
+	PolicyID               *int64 `json:"policy_id"`   // null = 전역 설정
+	PolicyName             string `json:"policy_name"` // 빈 문자열 = 전역 설정
+	Enabled                bool   `json:"enabled"`     // 전역 비활성 또는 never_flapping이면 false
+	DetectionWindowMinutes int    `json:"detection_window_minutes"`
+	CycleThreshold         int    `json:"cycle_threshold"`
+	ClearanceWindowMinutes int    `json:"clearance_window_minutes"`
+}
+
+// FlappingPolicyMatchRequest - 라벨에 적용될 정책 조회 요청
+type FlappingPolicyMatchRequest struct {
+	Labels map[string]string `json:"labels"`
+}
+
+// FlappingPolicyResponse - 단건 조회 응답
+type FlappingPolicyResponse struct {
+	Status string          `json:"status"`
+	Data   *FlappingPolicy `json:"data"`
+}
+
+// FlappingPolicyListResponse - 목록 조회 응답
+type FlappingPolicyListResponse struct {
+	Status string           `json:"status"`
+	Data   []FlappingPolicy `json:"data"`
+}
+
+// FlappingPolicyMutationResponse - 생성/수정/삭제 응답
+type FlappingPolicyMutationResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	ID      int64  `json:"id,omitempty"`
+}
+
+// FlappingPolicyMatchResponse - 라벨에 적용될 정책 조회 응답
+type FlappingPolicyMatchResponse struct {
+	Status string                  `json:"status"`
+	Data   EffectiveFlappingPolicy `json:"data"`
+}
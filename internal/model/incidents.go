@@ -18,6 +18,16 @@ type IncidentListResponse struct {
 	FiredAt    time.Time  `json:"fired_at"`
 	ResolvedAt *time.Time `json:"resolved_at"`
 	AlertCount int        `json:"alert_count"` // 연결된 Alert 개수
+
+	CorrelationKey string `json:"correlation_key"` // Alert 그룹핑 키
+
+	InMaintenance bool `json:"in_maintenance"` // 연결된 Alert가 모두 점검 시간대에 수신됨 (MTTR 제외)
+
+	// 확인(acknowledge) 정보 (확인 전이면 acknowledged=false, 나머지 null/빈 값)
+	Acknowledged     bool       `json:"acknowledged"`
+	AcknowledgedAt   *time.Time `json:"acknowledged_at"`
+	AcknowledgedBy   string     `json:"acknowledged_by"`
+	TimeToAckSeconds *int64     `json:"time_to_ack_seconds"` // fired_at → acknowledged_at (MTTA 계산용)
 }
 
 // IncidentDetailResponse - Incident 상세 조회용 구조체
@@ -32,6 +42,17 @@ type IncidentDetailResponse struct {
 	AnalysisDetail  *string    `json:"analysis_detail"`
 	CreatedBy       *string    `json:"created_by"`
 	ResolvedBy      *string    `json:"resolved_by"`
+	CorrelationKey  string     `json:"correlation_key"`
+
+	// 확인(acknowledge) 정보
+	Acknowledged     bool       `json:"acknowledged"`
+	AcknowledgedAt   *time.Time `json:"acknowledged_at"`
+	AcknowledgedBy   string     `json:"acknowledged_by"`
+	TimeToAckSeconds *int64     `json:"time_to_ack_seconds"`
+
+	// 발생 시점의 on-call 사용자 (매칭된 on-call 스케줄 기준)
+	OncallUser       string `json:"oncall_user"`
+	OncallScheduleID *int64 `json:"oncall_schedule_id"`
 
 	// DB의 JSONB 컬럼을 그대로 바이트로 받아서 전달
 	SimilarIncidents json.RawMessage `json:"similar_incidents" swaggertype:"object"`
@@ -42,6 +63,24 @@ type IncidentDetailResponse struct {
 	Alerts []AlertListResponse `json:"alerts,omitempty"`
 }
 
+// IncidentCorrelationCandidate - 상관관계 매칭 대상이 되는 열린 Incident
+type IncidentCorrelationCandidate struct {
+	IncidentID        string
+	CorrelationKey    string
+	CorrelationLabels map[string]string
+	LastAlertAt       time.Time
+}
+
+// IncidentMatch - Alert가 Incident에 연결된 결과와 그 이유
+type IncidentMatch struct {
+	IncidentID     string
+	Created        bool    // 새 Incident 생성 여부
+	CorrelationKey string  // Alert의 그룹핑 키
+	Reason         string  // existing_alert, group_key, label_similarity, new_group, window_expired
+	Score          float64 // 매칭 점수 (group_key = 1, label_similarity = 유사도)
+	Detail         string  // 사람이 읽을 수 있는 매칭 설명
+}
+
 // UpdateIncidentRequest - Incident 수정 요청 구조체
 type UpdateIncidentRequest struct {
 	Title           string `json:"title"`
@@ -71,6 +110,12 @@ type AlertListResponse struct {
 	ResolvedAt      *time.Time      `json:"resolved_at"`
 	AnalysisSummary *string         `json:"analysis_summary"`
 	Labels          json.RawMessage `json:"labels" swaggertype:"object"`
+
+	// 확인(acknowledge) 정보
+	Acknowledged     bool       `json:"acknowledged"`
+	AcknowledgedAt   *time.Time `json:"acknowledged_at"`
+	AcknowledgedBy   string     `json:"acknowledged_by"`
+	TimeToAckSeconds *int64     `json:"time_to_ack_seconds"`
 }
 
 // AlertDetailResponse - Alert 상세 조회용 구조체
@@ -93,6 +138,41 @@ type AlertDetailResponse struct {
 	FlapWindowStart *time.Time          `json:"flap_window_start,omitempty"`
 	IsAnalyzing     bool                `json:"is_analyzing"`
 	Analyses        []AlertAnalysisItem `json:"analyses"`
+
+	// Incident 상관관계 매칭 정보 (어떤 이유로 Incident에 연결되었는지)
+	CorrelationKey    string   `json:"correlation_key"`
+	CorrelationReason string   `json:"correlation_reason"`
+	CorrelationScore  *float64 `json:"correlation_score,omitempty"`
+	CorrelationDetail string   `json:"correlation_detail"`
+
+	// 웹훅 수신 시 인증에 사용된 credential 이름 (인증 비활성 시 빈 문자열)
+	SourceCredential string `json:"source_credential"`
+	// 알림 수신 경로 (alertmanager, grafana, pagerduty, generic, kubernetes)
+	Source string `json:"source"`
+	// 알림/자동 분석을 음소거한 silence 규칙 ID (음소거되지 않았으면 null)
+	SilencedBy *int64 `json:"silenced_by"`
+	// 점검 시간대에 수신된 alert 여부 (알림/분석 스킵, MTTR 제외)
+	InMaintenance       bool   `json:"in_maintenance"`
+	MaintenanceWindowID *int64 `json:"maintenance_window_id"`
+	// 억제 규칙에 의해 알림/분석이 스킵된 경우 억제한 source alert ID와 규칙 ID (억제되지 않았으면 null)
+	InhibitedBy      *string `json:"inhibited_by"`
+	InhibitionRuleID *int64  `json:"inhibition_rule_id"`
+	// 웹훅을 보낸 Alertmanager의 externalURL (silence 생성 대상)
+	ExternalURL string `json:"external_url"`
+	// enrichment 파이프라인이 추가한 라벨/annotation과 출처 (AlertEnrichment 목록)
+	Enrichments json.RawMessage `json:"enrichments" swaggertype:"array,object"`
+	// 서비스 카탈로그에서 매칭된 소유 서비스 ID (매칭되지 않았으면 null)
+	ServiceID *int64 `json:"service_id"`
+	// flapping 판정에 적용된 정책 ID (전역 flapping 설정을 사용했으면 null)
+	FlappingPolicyID *int64 `json:"flapping_policy_id"`
+	// 장시간 firing 재알림 마지막 전송 시각과 횟수 (severity 레벨의 reminderMinutes)
+	LastReminderAt *time.Time `json:"last_reminder_at"`
+	ReminderCount  int        `json:"reminder_count"`
+	// 확인(acknowledge) 정보 (확인 후에는 재알림 중단)
+	Acknowledged     bool       `json:"acknowledged"`
+	AcknowledgedAt   *time.Time `json:"acknowledged_at"`
+	AcknowledgedBy   string     `json:"acknowledged_by"`
+	TimeToAckSeconds *int64     `json:"time_to_ack_seconds"`
 }
 
 // ============================================================================
@@ -0,0 +1,99 @@
+// Alertmanager 외 알림 소스의 웹훅 페이로드 구조체를 정의
+// service 레이어의 ingestion adapter가 AlertmanagerWebhook으로 정규화하여 동일한 파이프라인으로 처리
+
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// GrafanaWebhook - Grafana unified alerting 웹훅 페이로드
+// Alertmanager 페이로드와 호환되며 Grafana 전용 필드가 추가됨
+type GrafanaWebhook struct {
+	Version           string            `json:"version"`
+	GroupKey          string            `json:"groupKey"`
+	TruncatedAlerts   int               `json:"truncatedAlerts"`
+	Status            string            `json:"status"`
+	Receiver          string            `json:"receiver"`
+	GroupLabels       map[string]string `json:"groupLabels"`
+	CommonLabels      map[string]string `json:"commonLabels"`
+	CommonAnnotations map[string]string `json:"commonAnnotations"`
+	ExternalURL       string            `json:"externalURL"`
+	Alerts            []GrafanaAlert    `json:"alerts"`
+
+	OrgID   int64  `json:"orgId"`
+	Title   string `json:"title"`
+	State   string `json:"state"`
+	Message string `json:"message"`
+}
+
+// GrafanaAlert - Grafana 개별 알림
+type GrafanaAlert struct {
+	Status       string             `json:"status"`
+	Labels       map[string]string  `json:"labels"`
+	Annotations  map[string]string  `json:"annotations"`
+	StartsAt     time.Time          `json:"startsAt"`
+	EndsAt       time.Time          `json:"endsAt"`
+	GeneratorURL string             `json:"generatorURL"`
+	Fingerprint  string             `json:"fingerprint"`
+	SilenceURL   string             `json:"silenceURL"`
+	DashboardURL string             `json:"dashboardURL"`
+	PanelURL     string             `json:"panelURL"`
+	Values       map[string]float64 `json:"values"`
+	ValueString  string             `json:"valueString"`
+}
+
+// PagerDutyEvent - PagerDuty Events API v2 이벤트
+// event_action: trigger(발생), acknowledge(확인), resolve(해결)
+type PagerDutyEvent struct {
+	RoutingKey  string            `json:"routing_key"`
+	EventAction string            `json:"event_action"`
+	DedupKey    string            `json:"dedup_key"`
+	Payload     *PagerDutyPayload `json:"payload"`
+	Client      string            `json:"client"`
+	ClientURL   string            `json:"client_url"`
+	Links       []PagerDutyLink   `json:"links"`
+}
+
+// PagerDutyPayload - trigger 이벤트 본문
+// severity: critical, error, warning, info
+type PagerDutyPayload struct {
+	Summary       string          `json:"summary"`
+	Source        string          `json:"source"`
+	Severity      string          `json:"severity"`
+	Timestamp     *time.Time      `json:"timestamp"`
+	Component     string          `json:"component"`
+	Group         string          `json:"group"`
+	Class         string          `json:"class"`
+	CustomDetails json.RawMessage `json:"custom_details" swaggertype:"object"`
+}
+
+// PagerDutyLink - 이벤트에 첨부된 링크
+type PagerDutyLink struct {
+	Href string `json:"href"`
+	Text string `json:"text"`
+}
+
+// GenericAlert - 일반 JSON 소스용 알림 형식
+// fingerprint를 지정하지 않으면 id 또는 labels로 안정적인 fingerprint를 생성
+type GenericAlert struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Status      string            `json:"status"` // firing(기본값) 또는 resolved
+	Severity    string            `json:"severity"`
+	Summary     string            `json:"summary"`
+	Description string            `json:"description"`
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	StartsAt    *time.Time        `json:"startsAt"`
+	EndsAt      *time.Time        `json:"endsAt"`
+	URL         string            `json:"url"`
+	Fingerprint string            `json:"fingerprint"`
+}
+
+// GenericWebhook - 일반 JSON 소스 웹훅 페이로드 (단건 GenericAlert도 허용)
+type GenericWebhook struct {
+	Source string         `json:"source"` // 송신 시스템 이름 (labels.source_system으로 기록)
+	Alerts []GenericAlert `json:"alerts"`
+}
@@ -0,0 +1,55 @@
+package model
+
+import "time"
+
+// InhibitionRule - 관련 alert 간 억제 규칙 (inhibition_rules 테이블)
+// SourceMatchers에 매칭되는 firing alert가 있으면, TargetMatchers에 매칭되고 Equal 라벨 값이 같은 alert의
+// 알림/자동 분석을 억제한다 (Alertmanager inhibit_rules와 동일한 의미).
+type InhibitionRule struct {
+	ID             int64         `json:"id"`
+	Name           string        `json:"name"`
+	SourceMatchers LabelMatchers `json:"source_matchers"`
+	TargetMatchers LabelMatchers `json:"target_matchers"`
+	Equal          []string      `json:"equal"` // source/target 간 값이 같아야 하는 라벨 (예: ["node", "cluster"])
+	Enabled        bool          `json:"enabled"`
+	Comment        string        `json:"comment"`
+	CreatedBy      string        `json:"created_by"`
+	CreatedAt      time.Time     `json:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at"`
+}
+
+// InhibitionRuleRequest - 억제 규칙 생성/수정 요청 (enabled 생략 시 true)
+type InhibitionRuleRequest struct {
+	Name           string        `json:"name"`
+	SourceMatchers LabelMatchers `json:"source_matchers"`
+	TargetMatchers LabelMatchers `json:"target_matchers"`
+	Equal          []string      `json:"equal"`
+	Enabled        *bool         `json:"enabled,omitempty"`
+	Comment        string        `json:"comment"`
+}
+
+// InhibitionSource - 억제 판단에 사용하는 firing alert (source 후보)
+type InhibitionSource struct {
+	AlertID     string
+	Fingerprint string
+	Labels      map[string]string
+}
+
+// InhibitionRuleResponse - 단건 조회 응답
+type InhibitionRuleResponse struct {
+	Status string          `json:"status"`
+	Data   *InhibitionRule `json:"data"`
+}
+
+// InhibitionRuleListResponse - 목록 조회 응답
+type InhibitionRuleListResponse struct {
+	Status string           `json:"status"`
+	Data   []InhibitionRule `json:"data"`
+}
+
+// InhibitionRuleMutationResponse - 생성/수정/삭제 응답
+type InhibitionRuleMutationResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	ID      int64  `json:"id,omitempty"`
+}
@@ -0,0 +1,74 @@
+// Kubernetes core/v1 Event 구조체를 정의 (event-exporter 등 webhook sink에서 전송)
+// 알림 변환에 필요한 필드만 정의
+
+package model
+
+import "time"
+
+// KubernetesEventType - Event type (Normal, Warning)
+const (
+	KubernetesEventTypeNormal  = "Normal"
+	KubernetesEventTypeWarning = "Warning"
+)
+
+// KubernetesEvent - core/v1 Event
+type KubernetesEvent struct {
+	Kind       string                    `json:"kind"`
+	APIVersion string                    `json:"apiVersion"`
+	Metadata   KubernetesObjectMeta      `json:"metadata"`
+	Involved   KubernetesObjectReference `json:"involvedObject"`
+	Reason     string                    `json:"reason"`
+	Message    string                    `json:"message"`
+	Source     KubernetesEventSource     `json:"source"`
+	Type       string                    `json:"type"`
+	Count      int                       `json:"count"`
+
+	FirstTimestamp time.Time `json:"firstTimestamp"`
+	LastTimestamp  time.Time `json:"lastTimestamp"`
+	EventTime      time.Time `json:"eventTime"`
+
+	ReportingComponent string `json:"reportingComponent"`
+	ReportingInstance  string `json:"reportingInstance"`
+
+	// ClusterName - event-exporter가 채우는 클러스터 이름 (없으면 쿼리 파라미터 cluster 사용)
+	ClusterName string `json:"clusterName,omitempty"`
+}
+
+// KubernetesObjectMeta - Event metadata
+type KubernetesObjectMeta struct {
+	Name              string    `json:"name"`
+	Namespace         string    `json:"namespace"`
+	UID               string    `json:"uid"`
+	CreationTimestamp time.Time `json:"creationTimestamp"`
+}
+
+// KubernetesObjectReference - Event의 대상 오브젝트 (involvedObject)
+type KubernetesObjectReference struct {
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace"`
+	Name       string `json:"name"`
+	UID        string `json:"uid"`
+	APIVersion string `json:"apiVersion"`
+	FieldPath  string `json:"fieldPath"`
+}
+
+// KubernetesEventSource - Event를 생성한 컴포넌트
+type KubernetesEventSource struct {
+	Component string `json:"component"`
+	Host      string `json:"host"`
+}
+
+// KubernetesEventList - core/v1 EventList
+type KubernetesEventList struct {
+	Kind  string            `json:"kind"`
+	Items []KubernetesEvent `json:"items"`
+}
+
+// KubernetesEventIngestResponse - Kubernetes Event 수신 응답
+type KubernetesEventIngestResponse struct {
+	Status       string `json:"status"`
+	InboxID      int64  `json:"inboxId,omitempty"` // 새 alert가 있을 때만 inbox에 저장
+	AlertCount   int    `json:"alertCount"`        // 새로 firing 처리할 alert 수
+	Deduplicated int    `json:"deduplicated"`      // 이미 firing 중이라 갱신만 한 이벤트 수
+	Ignored      int    `json:"ignored"`           // Warning이 아니거나 대상 정보가 없는 이벤트 수
+}
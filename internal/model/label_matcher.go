@@ -0,0 +1,120 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// LabelMatcher - Alertmanager 스타일 라벨 매처 (=, !=, =~, !~)
+//   - is_regex: 값을 정규식으로 해석 (전체 일치, Alertmanager와 동일하게 ^(?:value)$로 앵커링)
+//   - is_equal: false면 부정 매칭 (생략 시 true)
+//   - 라벨이 없으면 빈 문자열로 간주
+type LabelMatcher struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	IsRegex bool   `json:"is_regex"`
+	IsEqual bool   `json:"is_equal"`
+}
+
+// UnmarshalJSON - is_equal 생략 시 true로 처리
+func (m *LabelMatcher) UnmarshalJSON(data []byte) error {
+	type raw LabelMatcher
+	v := raw{IsEqual: true}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*m = LabelMatcher(v)
+	return nil
+}
+
+// matcherRegexCache - 정규식 컴파일 결과 캐시 (매 alert마다 다시 컴파일하지 않도록)
+var matcherRegexCache sync.Map // pattern → *regexp.Regexp
+
+func compileMatcherRegex(value string) (*regexp.Regexp, error) {
+	if cached, ok := matcherRegexCache.Load(value); ok {
+		return cached.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile("^(?:" + value + ")$")
+	if err != nil {
+		return nil, err
+	}
+	matcherRegexCache.Store(value, re)
+	return re, nil
+}
+
+// Validate - 라벨 이름 필수, 정규식 컴파일 가능 여부 검증
+func (m LabelMatcher) Validate() error {
+	if strings.TrimSpace(m.Name) == "" {
+		return fmt.Errorf("matcher name is required")
+	}
+	if m.IsRegex {
+		if _, err := compileMatcherRegex(m.Value); err != nil {
+			return fmt.Errorf("invalid regex for matcher %s: %w", m.Name, err)
+		}
+	}
+	return nil
+}
+
+// Matches - 라벨이 매처 조건을 만족하는지 확인 (정규식이 잘못된 경우 false)
+func (m LabelMatcher) Matches(labels map[string]string) bool {
+	value := labels[m.Name]
+	var matched bool
+	if m.IsRegex {
+		re, err := compileMatcherRegex(m.Value)
+		if err != nil {
+			return false
+		}
+		matched = re.MatchString(value)
+	} else {
+		matched = value == m.Value
+	}
+	return matched == m.IsEqual
+}
+
+// String - Alertmanager 매처 표기 (예: namespace=~"prod-.*")
+func (m LabelMatcher) String() string {
+	var op string
+	switch {
+	case m.IsEqual && !m.IsRegex:
+		op = "="
+	case !m.IsEqual && !m.IsRegex:
+		op = "!="
+	case m.IsEqual && m.IsRegex:
+		op = "=~"
+	default:
+		op = "!~"
+	}
+	return fmt.Sprintf("%s%s%q", m.Name, op, m.Value)
+}
+
+// LabelMatchers - 모든 매처를 만족해야 매칭 (AND)
+type LabelMatchers []LabelMatcher
+
+// Matches - 모든 매처가 일치하면 true (매처가 없으면 false)
+func (ms LabelMatchers) Matches(labels map[string]string) bool {
+	if len(ms) == 0 {
+		return false
+	}
+	for _, m := range ms {
+		if !m.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate - 매처 목록 검증 (비어 있으면 오류)
+func (ms LabelMatchers) Validate() error {
+	if len(ms) == 0 {
+		return fmt.Errorf("at least one matcher is required")
+	}
+	for _, m := range ms {
+		if err := m.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
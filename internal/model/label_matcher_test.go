@@ -0,0 +1,69 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLabelMatcher_Matches(t *testing.T) {
+	labels := map[string]string{"alertname": "KubePodCrashLooping", "namespace": "prod-payments"}
+
+	tests := []struct {
+		matcher LabelMatcher
+		want    bool
+	}{
+		{LabelMatcher{Name: "alertname", Value: "KubePodCrashLooping", IsEqual: true}, true},
+		{LabelMatcher{Name: "alertname", Value: "KubePodCrashLooping", IsEqual: false}, false},
+		{LabelMatcher{Name: "namespace", Value: "prod-.*", IsRegex: true, IsEqual: true}, true},
+		{LabelMatcher{Name: "namespace", Value: "prod", IsRegex: true, IsEqual: true}, false}, // 전체 일치만 허용
+		{LabelMatcher{Name: "namespace", Value: "dev-.*", IsRegex: true, IsEqual: false}, true},
+		{LabelMatcher{Name: "team", Value: "", IsEqual: true}, true}, // 없는 라벨은 빈 문자열
+		{LabelMatcher{Name: "team", Value: "sre", IsEqual: false}, true},
+		{LabelMatcher{Name: "namespace", Value: "(", IsRegex: true, IsEqual: true}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.matcher.Matches(labels); got != tt.want {
+			t.Errorf("%s.Matches() = %v; want %v", tt.matcher, got, tt.want)
+		}
+	}
+
+	if (LabelMatchers{}).Matches(labels) {
+		t.Fatal("empty LabelMatchers should not match")
+	}
+}
+
+func TestLabelMatcher_UnmarshalDefaultsIsEqual(t *testing.T) {
+	var ms LabelMatchers
+	if err := json.Unmarshal([]byte(`[{"name":"a","value":"1"},{"name":"b","value":"2","is_equal":false}]`), &ms); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !ms[0].IsEqual || ms[1].IsEqual {
+		t.Fatalf("IsEqual = %v/%v; want true/false", ms[0].IsEqual, ms[1].IsEqual)
+	}
+}
+
+func TestLabelMatchers_Validate(t *testing.T) {
+	if err := (LabelMatchers{}).Validate(); err == nil {
+		t.Fatal("Validate(empty) = nil; want error")
+	}
+	if err := (LabelMatchers{{Name: "a", Value: "[", IsRegex: true}}).Validate(); err == nil {
+		t.Fatal("Validate(bad regex) = nil; want error")
+	}
+	if err := (LabelMatchers{{Value: "x"}}).Validate(); err == nil {
+		t.Fatal("Validate(no name) = nil; want error")
+	}
+}
+
+func TestLabelMatcher_String(t *testing.T) {
+	cases := map[string]LabelMatcher{
+		`a="1"`:  {Name: "a", Value: "1", IsEqual: true},
+		`a!="1"`: {Name: "a", Value: "1"},
+		`a=~"1"`: {Name: "a", Value: "1", IsRegex: true, IsEqual: true},
+		`a!~"1"`: {Name: "a", Value: "1", IsRegex: true},
+	}
+	for want, m := range cases {
+		if got := m.String(); got != want {
+			t.Errorf("String() = %s; want %s", got, want)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package model
+
+import "time"
+
+// MaintenanceWindow - 반복 점검 시간대 (maintenance_windows 테이블)
+// cron 표현식의 각 실행 시각부터 DurationMinutes 동안 Matchers에 매칭되는 alert를 점검 중으로 처리한다.
+// Matchers가 비어 있으면 모든 alert에 적용.
+type MaintenanceWindow struct {
+	ID              int64         `json:"id"`
+	Name            string        `json:"name"`
+	Schedule        string        `json:"schedule"`  // 5필드 cron 표현식 (예: "0 22 * * TUE")
+	TimeZone        string        `json:"time_zone"` // IANA 타임존 (예: Asia/Seoul)
+	DurationMinutes int           `json:"duration_minutes"`
+	Matchers        LabelMatchers `json:"matchers"` // 적용 범위 (예: namespace, cluster)
+	Enabled         bool          `json:"enabled"`
+	Comment         string        `json:"comment"`
+	CreatedBy       string        `json:"created_by"`
+	CreatedAt       time.Time     `json:"created_at"`
+	UpdatedAt       time.Time     `json:"updated_at"`
+
+	// 조회 시점 기준으로 계산
+	Active    bool       `json:"active"`
+	NextStart *time.Time `json:"next_start,omitempty"`
+}
+
+// MaintenanceWindowRequest - 점검 시간대 생성/수정 요청 (enabled 생략 시 true, time_zone 생략 시 UTC)
+type MaintenanceWindowRequest struct {
+	Name            string        `json:"name"`
+	Schedule        string        `json:"schedule"`
+	TimeZone        string        `json:"time_zone"`
+	DurationMinutes int           `json:"duration_minutes"`
+	Matchers        LabelMatchers `json:"matchers"`
+	Enabled         *bool         `json:"enabled,omitempty"`
+	Comment         string        `json:"comment"`
+}
+
+// MaintenanceWindowResponse - 단건 조회 응답
+type MaintenanceWindowResponse struct {
+	Status string             `json:"status"`
+	Data   *MaintenanceWindow `json:"data"`
+}
+
+// MaintenanceWindowListResponse - 목록 조회 응답
+type MaintenanceWindowListResponse struct {
+	Status string              `json:"status"`
+	Data   []MaintenanceWindow `json:"data"`
+}
+
+// MaintenanceWindowMutationResponse - 생성/수정/삭제 응답
+type MaintenanceWindowMutationResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	ID      int64  `json:"id,omitempty"`
+}
@@ -0,0 +1,38 @@
+package model
+
+import "time"
+
+// 알림 전송 시도 결과
+const (
+	NotificationAttemptStatusSuccess = "success"
+	NotificationAttemptStatusFailed  = "failed"
+)
+
+// NotificationAttemptModeEscalation - 에스컬레이션 대상 전송 (그 외 mode는 NotificationOutboxMode* 사용)
+const NotificationAttemptModeEscalation = "escalation"
+
+// NotificationAttempt - notification_attempts 테이블 구조체 (전송 대상 1곳에 대한 전송 시도 1회)
+type NotificationAttempt struct {
+	ID                int64     `json:"id"`
+	OutboxID          *int64    `json:"outbox_id,omitempty"` // outbox를 거친 전송이면 entry ID
+	AlertID           string    `json:"alert_id,omitempty"`
+	Fingerprint       string    `json:"fingerprint,omitempty"`
+	IncidentID        string    `json:"incident_id,omitempty"`
+	EventType         string    `json:"event_type"`
+	Mode              string    `json:"mode"`                        // notify, root, thread, escalation
+	WebhookConfigID   *int      `json:"webhook_config_id,omitempty"` // nil = 기본(환경변수) notifier
+	WebhookConfigName string    `json:"webhook_config_name,omitempty"`
+	Channel           string    `json:"channel,omitempty"` // Slack 채널 (root/thread/escalation 대상)
+	Status            string    `json:"status"`            // success, failed
+	StatusCode        *int      `json:"status_code,omitempty"`
+	LatencyMs         int64     `json:"latency_ms"`
+	Error             string    `json:"error,omitempty"`
+	PayloadHash       string    `json:"payload_hash"` // 이벤트 내용 sha256 (같으면 같은 알림)
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// NotificationAttemptListResponse - 알림 전송 기록 조회 응답
+type NotificationAttemptListResponse struct {
+	Status string                `json:"status"`
+	Data   []NotificationAttempt `json:"data"`
+}
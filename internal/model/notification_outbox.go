@@ -0,0 +1,62 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// 알림 outbox 상태
+const (
+	NotificationOutboxStatusPending   = "pending"   // 재시도 대기
+	NotificationOutboxStatusSending   = "sending"   // 전송 중 (즉시 전송 또는 worker 점유)
+	NotificationOutboxStatusDelivered = "delivered" // 전송 완료
+	NotificationOutboxStatusDead      = "dead"      // 최대 시도 횟수 초과 (dead-letter)
+	NotificationOutboxStatusDiscarded = "discarded" // 관리자가 폐기
+)
+
+// 알림 outbox 전송 방식
+const (
+	NotificationOutboxModeNotify = "notify" // 일반 알림 (대상 notifier의 Notify)
+	NotificationOutboxModeRoot   = "root"   // firing alert root 메시지 (Slack은 스레드 생성 후 delivery 기록)
+	NotificationOutboxModeThread = "thread" // 기존 Slack 스레드 답글 (resolved, flapping 등)
+)
+
+// NotificationOutboxEntry - notification_outbox 테이블 구조체 (이벤트 1건 × 전송 대상 1곳)
+type NotificationOutboxEntry struct {
+	ID              int64                      `json:"id"`
+	EventType       string                     `json:"event_type"`                  // alert.status_changed 등
+	Mode            string                     `json:"mode"`                        // notify, root, thread
+	WebhookConfigID *int                       `json:"webhook_config_id,omitempty"` // nil = 기본(환경변수) notifier
+	Channel         string                     `json:"channel,omitempty"`           // Slack root 메시지 채널 (서비스 채널 포함)
+	Delivery        *AlertNotificationDelivery `json:"delivery,omitempty"`          // thread 전송 대상 스레드
+	AlertID         string                     `json:"alert_id,omitempty"`
+	Fingerprint     string                     `json:"fingerprint,omitempty"`
+	IncidentID      string                     `json:"incident_id,omitempty"`
+	Status          string                     `json:"status"` // pending, sending, delivered, dead, discarded
+	Attempts        int                        `json:"attempts"`
+	LastError       string                     `json:"last_error,omitempty"`
+	NextAttemptAt   time.Time                  `json:"next_attempt_at"`
+	CreatedAt       time.Time                  `json:"created_at"`
+	UpdatedAt       time.Time                  `json:"updated_at"`
+	DeliveredAt     *time.Time                 `json:"delivered_at,omitempty"`
+	Payload         json.RawMessage            `json:"payload,omitempty" swaggertype:"object"` // 상세 조회 시에만 포함
+}
+
+// NotificationOutboxListResponse - outbox 목록 조회 응답
+type NotificationOutboxListResponse struct {
+	Status string                    `json:"status"`
+	Data   []NotificationOutboxEntry `json:"data"`
+}
+
+// NotificationOutboxEntryResponse - outbox 단건 조회 응답
+type NotificationOutboxEntryResponse struct {
+	Status string                   `json:"status"`
+	Data   *NotificationOutboxEntry `json:"data"`
+}
+
+// NotificationOutboxActionResponse - outbox 재시도/폐기 요청 응답
+type NotificationOutboxActionResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	ID      int64  `json:"id"`
+}
@@ -0,0 +1,138 @@
+package model
+
+import "time"
+
+// 로테이션 종류
+const (
+	OncallRotationDaily  = "daily"  // 매일 handoff_time에 다음 사용자로 교대
+	OncallRotationWeekly = "weekly" // start_date와 같은 요일의 handoff_time에 교대
+)
+
+// 현재 on-call 결정 출처
+const (
+	OncallSourceOverride = "override"
+	OncallSourceLayer    = "layer"
+	OncallSourceNone     = "none" // 적용되는 레이어/override 없음
+)
+
+// OncallLayer - 스케줄의 로테이션 레이어
+// 레이어 목록의 뒤쪽이 우선하며, 적용 요일/시간대 밖이면 앞쪽 레이어로 넘어간다.
+type OncallLayer struct {
+	Name        string   `json:"name"`
+	Rotation    string   `json:"rotation"`              // daily, weekly
+	Users       []string `json:"users"`                 // 교대 순서대로 사용자 login ID
+	StartDate   string   `json:"start_date"`            // 첫 사용자의 근무 시작일 (YYYY-MM-DD, 스케줄 타임존)
+	HandoffTime string   `json:"handoff_time"`          // 교대 시각 (HH:MM, 생략 시 09:00)
+	Weekdays    []string `json:"weekdays,omitempty"`    // 적용 요일 (mon..sun, 비어 있으면 매일)
+	ActiveFrom  string   `json:"active_from,omitempty"` // 적용 시간대 시작 (HH:MM, active_to와 함께 지정, 자정 넘김 가능)
+	ActiveTo    string   `json:"active_to,omitempty"`   // 적용 시간대 끝 (HH:MM)
+}
+
+// OncallSchedule - on-call 스케줄 (oncall_schedules 테이블)
+// Matchers가 비어 있으면 모든 alert에 적용되며, 여러 스케줄이 매칭되면 가장 구체적인 스케줄을 사용한다.
+type OncallSchedule struct {
+	ID             int64         `json:"id"`
+	Name           string        `json:"name"`
+	TimeZone       string        `json:"time_zone"` // IANA 타임존 (예: Asia/Seoul)
+	Matchers       LabelMatchers `json:"matchers"`
+	Layers         []OncallLayer `json:"layers"`
+	MentionInSlack bool          `json:"mention_in_slack"` // firing 알림에 현재 on-call 사용자 멘션
+	Enabled        bool          `json:"enabled"`
+	Comment        string        `json:"comment"`
+	CreatedBy      string        `json:"created_by"`
+	CreatedAt      time.Time     `json:"created_at"`
+	UpdatedAt      time.Time     `json:"updated_at"`
+}
+
+// OncallScheduleRequest - 스케줄 생성/수정 요청 (enabled 생략 시 true, time_zone 생략 시 UTC)
+type OncallScheduleRequest struct {
+	Name           string        `json:"name"`
+	TimeZone       string        `json:"time_zone"`
+	Matchers       LabelMatchers `json:"matchers"`
+	Layers         []OncallLayer `json:"layers"`
+	MentionInSlack bool          `json:"mention_in_slack"`
+	Enabled        *bool         `json:"enabled,omitempty"`
+	Comment        string        `json:"comment"`
+}
+
+// OncallOverride - 기간 동안 스케줄의 모든 레이어보다 우선하는 대체 근무 (oncall_overrides 테이블)
+type OncallOverride struct {
+	ID         int64     `json:"id"`
+	ScheduleID int64     `json:"schedule_id"`
+	User       string    `json:"user"` // login ID
+	StartAt    time.Time `json:"start_at"`
+	EndAt      time.Time `json:"end_at"`
+	Reason     string    `json:"reason"`
+	CreatedBy  string    `json:"created_by"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// OncallOverrideRequest - override 생성 요청
+type OncallOverrideRequest struct {
+	User    string    `json:"user"`
+	StartAt time.Time `json:"start_at"`
+	EndAt   time.Time `json:"end_at"`
+	Reason  string    `json:"reason"`
+}
+
+// OncallShift - 스케줄의 현재 on-call 사용자
+type OncallShift struct {
+	ScheduleID     int64      `json:"schedule_id"`
+	ScheduleName   string     `json:"schedule_name"`
+	User           string     `json:"user"` // 비어 있으면 on-call 없음
+	SlackUserID    string     `json:"slack_user_id,omitempty"`
+	Source         string     `json:"source"`               // override, layer, none
+	LayerName      string     `json:"layer_name,omitempty"` // source=layer
+	OverrideID     *int64     `json:"override_id,omitempty"`
+	Until          *time.Time `json:"until,omitempty"` // override 종료 또는 레이어의 다음 교대 시각
+	MentionInSlack bool       `json:"mention_in_slack"`
+}
+
+// OncallUser - on-call 대상 사용자와 Slack 사용자 ID 매핑
+type OncallUser struct {
+	LoginID     string  `json:"login_id"`
+	DisplayName *string `json:"display_name,omitempty"`
+	SlackUserID string  `json:"slack_user_id"`
+}
+
+// OncallUserSlackRequest - 사용자 Slack ID 설정 요청 (빈 값이면 매핑 해제)
+type OncallUserSlackRequest struct {
+	SlackUserID string `json:"slack_user_id"`
+}
+
+// OncallScheduleResponse - 단건 조회 응답
+type OncallScheduleResponse struct {
+	Status string          `json:"status"`
+	Data   *OncallSchedule `json:"data"`
+}
+
+// OncallScheduleListResponse - 목록 조회 응답
+type OncallScheduleListResponse struct {
+	Status string           `json:"status"`
+	Data   []OncallSchedule `json:"data"`
+}
+
+// OncallMutationResponse - 스케줄/override/사용자 매핑 생성·수정·삭제 응답
+type OncallMutationResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	ID      int64  `json:"id,omitempty"`
+}
+
+// OncallOverrideListResponse - override 목록 응답
+type OncallOverrideListResponse struct {
+	Status string           `json:"status"`
+	Data   []OncallOverride `json:"data"`
+}
+
+// OncallNowResponse - 스케줄별 현재 on-call 응답
+type OncallNowResponse struct {
+	Status string        `json:"status"`
+	Data   []OncallShift `json:"data"`
+}
+
+// OncallUserListResponse - 사용자 Slack 매핑 목록 응답
+type OncallUserListResponse struct {
+	Status string       `json:"status"`
+	Data   []OncallUser `json:"data"`
+}
@@ -27,11 +27,5 @@ type AuthMeResponse struct {
 	Email        *string `json:"email,omitempty"`
 	DisplayName  *string `json:"displayName,omitempty"`
 	AuthProvider string  `json:"authProvider"`
-}
-
-type AlertWebhookResponse struct {
-	Status      string `json:"status"`
-	AlertCount  int    `json:"alertCount"`
-	SlackSent   int    `json:"slackSent"`
-	SlackFailed int    `json:"slackFailed"`
+	SlackUserID  string  `json:"slackUserId,omitempty"`
 }
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// 예약 작업 상태
+const (
+	ScheduledJobStatusPending   = "pending"
+	ScheduledJobStatusRunning   = "running"
+	ScheduledJobStatusDone      = "done"
+	ScheduledJobStatusFailed    = "failed"
+	ScheduledJobStatusCancelled = "cancelled"
+)
+
+// 예약 작업 종류
+const (
+	ScheduledJobTypeFlappingClearance  = "flapping_clearance"
+	ScheduledJobTypeIncidentEscalation = "incident_escalation" // 에스컬레이션 다음 레벨 (job_key: incident_id)
+)
+
+// ScheduledJob - scheduled_jobs 테이블 구조체 (재시작/다중 replica에서도 유지되는 지연 작업)
+type ScheduledJob struct {
+	ID          int64           `json:"id"`
+	JobType     string          `json:"job_type"` // flapping_clearance 등
+	JobKey      string          `json:"job_key"`  // 같은 종류의 pending 작업은 key당 하나 (예: fingerprint)
+	Status      string          `json:"status"`   // pending, running, done, failed, cancelled
+	RunAt       time.Time       `json:"run_at"`
+	Attempts    int             `json:"attempts"`
+	LastError   string          `json:"last_error,omitempty"`
+	Payload     json.RawMessage `json:"payload" swaggertype:"object"`
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+	CompletedAt *time.Time      `json:"completed_at,omitempty"`
+}
+
+// FlappingClearancePayload - flapping 해제 체크 작업 payload
+type FlappingClearancePayload struct {
+	Fingerprint string    `json:"fingerprint"`
+	ResolvedAt  time.Time `json:"resolved_at"`         // clearance 기준 resolved 시각
+	PolicyID    *int64    `json:"policy_id,omitempty"` // clearance 시간을 정한 flapping 정책 (nil = 전역 설정)
+}
+
+// ScheduledJobListResponse - 예약 작업 목록 응답
+type ScheduledJobListResponse struct {
+	Status string         `json:"status"`
+	Data   []ScheduledJob `json:"data"`
+}
+
+// FlappingClearResponse - 수동 flapping 해제 응답
+type FlappingClearResponse struct {
+	Status        string `json:"status"`
+	Message       string `json:"message"`
+	AlertID       string `json:"alert_id"`
+	Fingerprint   string `json:"fingerprint"`
+	CancelledJobs int64  `json:"cancelled_jobs"` // 취소된 pending clearance 작업 수
+}
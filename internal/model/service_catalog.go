@@ -0,0 +1,76 @@
+package model
+
+import "time"
+
+// Service - 서비스 소유권 카탈로그 항목 (services 테이블)
+// Namespaces와 Selectors 중 지정된 조건을 모두 만족하는 alert가 이 서비스에 속한다.
+type Service struct {
+	ID                int64         `json:"id"`
+	Name              string        `json:"name"`
+	Team              string        `json:"team"`               // 소유 팀
+	Namespaces        []string      `json:"namespaces"`         // 서비스가 배포된 namespace (비어 있으면 namespace 무관)
+	Selectors         LabelMatchers `json:"selectors"`          // 라벨 셀렉터 (비어 있으면 셀렉터 무관)
+	SlackChannel      string        `json:"slack_channel"`      // 팀 Slack 채널 ID (use_service_channel 웹훅 설정에서 사용)
+	EscalationContact string        `json:"escalation_contact"` // 에스컬레이션 연락처 (사람/그룹/전화번호 등)
+	Tier              string        `json:"tier"`               // 서비스 등급 (예: tier-1)
+	Description       string        `json:"description"`
+	CreatedBy         string        `json:"created_by"`
+	CreatedAt         time.Time     `json:"created_at"`
+	UpdatedAt         time.Time     `json:"updated_at"`
+}
+
+// ServiceRequest - 서비스 생성/수정 요청
+type ServiceRequest struct {
+	Name              string        `json:"name"`
+	Team              string        `json:"team"`
+	Namespaces        []string      `json:"namespaces"`
+	Selectors         LabelMatchers `json:"selectors"`
+	SlackChannel      string        `json:"slack_channel"`
+	EscalationContact string        `json:"escalation_contact"`
+	Tier              string        `json:"tier"`
+	Description       string        `json:"description"`
+}
+
+// ServiceMetrics - 서비스별 Incident 지표
+type ServiceMetrics struct {
+	ServiceID         int64   `json:"service_id"`
+	Name              string  `json:"name"`
+	Team              string  `json:"team"`
+	Tier              string  `json:"tier"`
+	OpenIncidents     int     `json:"open_incidents"`     // 현재 firing Incident 수 (기간 무관)
+	TotalIncidents    int     `json:"total_incidents"`    // 기간 내 발생한 Incident 수
+	ResolvedIncidents int     `json:"resolved_incidents"` // 기간 내 발생해 해결된 Incident 수
+	MTTRMinutes       float64 `json:"mttr_minutes"`       // 기간 내 해결된 Incident 평균 해결 시간 (점검 시간대 Incident 제외)
+}
+
+// ServiceResponse - 단건 조회 응답
+type ServiceResponse struct {
+	Status string   `json:"status"`
+	Data   *Service `json:"data"`
+}
+
+// ServiceListResponse - 목록 조회 응답
+type ServiceListResponse struct {
+	Status string    `json:"status"`
+	Data   []Service `json:"data"`
+}
+
+// ServiceMutationResponse - 생성/수정/삭제 응답
+type ServiceMutationResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	ID      int64  `json:"id,omitempty"`
+}
+
+// ServiceIncidentListResponse - 서비스별 Incident 목록 응답
+type ServiceIncidentListResponse struct {
+	Status string                 `json:"status"`
+	Data   []IncidentListResponse `json:"data"`
+}
+
+// ServiceMetricsListResponse - 서비스별 지표 응답
+type ServiceMetricsListResponse struct {
+	Status string           `json:"status"`
+	Window string           `json:"window"`
+	Data   []ServiceMetrics `json:"data"`
+}
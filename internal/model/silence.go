@@ -0,0 +1,68 @@
+package model
+
+import "time"
+
+// Silence 규칙 상태 (조회 시점 기준으로 계산)
+const (
+	SilenceStatusPending = "pending"
+	SilenceStatusActive  = "active"
+	SilenceStatusExpired = "expired"
+)
+
+// SilenceRule - 라벨 매처로 알림/자동 분석을 음소거하는 규칙 (silence_rules 테이블)
+// 매칭된 alert도 DB에는 저장되며 alerts.silenced_by에 규칙 ID가 기록된다.
+type SilenceRule struct {
+	ID        int64         `json:"id"`
+	Matchers  LabelMatchers `json:"matchers"`
+	StartsAt  time.Time     `json:"starts_at"`
+	EndsAt    time.Time     `json:"ends_at"`
+	CreatedBy string        `json:"created_by"`
+	Comment   string        `json:"comment"`
+	Status    string        `json:"status"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// ActiveAt - 주어진 시각에 규칙이 유효한지 (starts_at <= at < ends_at)
+func (r SilenceRule) ActiveAt(at time.Time) bool {
+	return !at.Before(r.StartsAt) && at.Before(r.EndsAt)
+}
+
+// StatusAt - 주어진 시각 기준 상태 (pending, active, expired)
+func (r SilenceRule) StatusAt(at time.Time) string {
+	switch {
+	case at.Before(r.StartsAt):
+		return SilenceStatusPending
+	case at.Before(r.EndsAt):
+		return SilenceStatusActive
+	default:
+		return SilenceStatusExpired
+	}
+}
+
+// SilenceRuleRequest - Silence 규칙 생성/수정 요청 (starts_at 생략 시 현재 시각)
+type SilenceRuleRequest struct {
+	Matchers LabelMatchers `json:"matchers"`
+	StartsAt *time.Time    `json:"starts_at,omitempty"`
+	EndsAt   time.Time     `json:"ends_at"`
+	Comment  string        `json:"comment"`
+}
+
+// SilenceRuleResponse - 단건 조회 응답
+type SilenceRuleResponse struct {
+	Status string       `json:"status"`
+	Data   *SilenceRule `json:"data"`
+}
+
+// SilenceRuleListResponse - 목록 조회 응답
+type SilenceRuleListResponse struct {
+	Status string        `json:"status"`
+	Data   []SilenceRule `json:"data"`
+}
+
+// SilenceRuleMutationResponse - 생성/수정/삭제 응답
+type SilenceRuleMutationResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	ID      int64  `json:"id,omitempty"`
+}
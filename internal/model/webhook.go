@@ -1,27 +1,128 @@
 package model
 
-import "time"
+import (
+	"regexp"
+	"strings"
+	"time"
+)
 
-// WebhookConfig - DB에 저장되는 웹훅 설정 구조체
+// DefaultWebhookPriority - priority 생략 시 기본값 (작을수록 먼저 평가)
+const DefaultWebhookPriority = 100
+
+// WebhookConfig - DB에 저장되는 웹훅 설정 구조체 (라우팅 규칙 포함)
+// Matchers가 비어 있으면 catch-all로, 다른 규칙에 매칭되지 않은 이벤트만 수신한다.
 type WebhookConfig struct {
-	ID         int       `json:"id"`
-	Name       string    `json:"name"`
-	URL        string    `json:"url"`
-	Type       string    `json:"type"`
-	Token      string    `json:"token,omitempty"`
-	Channel    string    `json:"channel,omitempty"`
-	Severities []string  `json:"severities"` // 빈 배열 = 모든 severity 수신
-	UpdatedAt  time.Time `json:"updated_at"`
+	ID       int           `json:"id"`
+	Name     string        `json:"name"`
+	URL      string        `json:"url"`
+	Type     string        `json:"type"`
+	Token    string        `json:"token,omitempty"`
+	Channel  string        `json:"channel,omitempty"`
+	Matchers LabelMatchers `json:"matchers"` // 모든 매처가 alert 라벨과 일치해야 수신 (빈 배열 = catch-all)
+	Enabled  bool          `json:"enabled"`
+	Priority int           `json:"priority"` // 평가 순서 (오름차순, 같으면 ID순)
+	Continue bool          `json:"continue"` // 매칭 후에도 다음 규칙 계속 평가
+	// Slack 전용: alert가 서비스 카탈로그에 매칭되고 서비스에 Slack 채널이 있으면 root 메시지를 그 채널로 전송 (없으면 Channel)
+	UseServiceChannel bool `json:"use_service_channel"`
+	// HTTP/Teams 전송 옵션
+	HTTPMethod        string            `json:"http_method"`                   // POST(기본), PUT, PATCH
+	CustomHeaders     map[string]string `json:"custom_headers"`                // 추가 요청 헤더 (X-KubeRCA-* 등 예약 헤더 제외)
+	SigningSecret     string            `json:"-"`                             // HMAC-SHA256 서명 secret (빈 값 = 서명 안 함)
+	BasicAuthUsername string            `json:"basic_auth_username,omitempty"` // token(bearer)과 함께 사용할 수 없음
+	BasicAuthPassword string            `json:"-"`
+	TLSClientCert     string            `json:"tls_client_cert,omitempty"` // mTLS 클라이언트 인증서 (PEM)
+	TLSClientKey      string            `json:"-"`                         // mTLS 클라이언트 개인키 (PEM)
+	TLSCACert         string            `json:"tls_ca_cert,omitempty"`     // 서버 인증서 검증용 CA (PEM, 빈 값 = 시스템 CA)
+	// secret 필드는 응답에 포함하지 않고 설정 여부만 노출
+	HasSigningSecret     bool `json:"has_signing_secret"`
+	HasBasicAuthPassword bool `json:"has_basic_auth_password"`
+	HasTLSClientKey      bool `json:"has_tls_client_key"`
+	// 사용자 정의 메시지 템플릿 (Go text/template, 빈 문자열 = 기본 포맷)
+	TitleTemplate   string    `json:"title_template"`   // Slack: alert 메시지 제목
+	BodyTemplate    string    `json:"body_template"`    // Slack: alert 메시지 본문 (기본 필드/설명 대체)
+	PayloadTemplate string    `json:"payload_template"` // HTTP/Teams: 요청 본문 (기본 JSON envelope 대체)
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// IsCatchAll - 매처가 없는 설정 (다른 규칙에 매칭되지 않은 이벤트 수신)
+func (c WebhookConfig) IsCatchAll() bool {
+	return len(c.Matchers) == 0
+}
+
+// SeverityMatcher - 기존 severity 필터를 동등한 라벨 매처로 변환 (빈 목록이면 ok=false)
+func SeverityMatcher(severities []string) (LabelMatcher, bool) {
+	var values []string
+	for _, severity := range severities {
+		if severity = strings.TrimSpace(severity); severity != "" {
+			values = append(values, severity)
+		}
+	}
+	switch len(values) {
+	case 0:
+		return LabelMatcher{}, false
+	case 1:
+		return LabelMatcher{Name: "severity", Value: values[0], IsEqual: true}, true
+	}
+	for i, v := range values {
+		values[i] = regexp.QuoteMeta(v)
+	}
+	return LabelMatcher{Name: "severity", Value: strings.Join(values, "|"), IsRegex: true, IsEqual: true}, true
 }
 
 // WebhookConfigRequest - 웹훅 설정 생성/수정 요청 구조체
 type WebhookConfigRequest struct {
-	Name       string   `json:"name" binding:"required"`
-	URL        string   `json:"url"`
-	Type       string   `json:"type"`
-	Token      string   `json:"token,omitempty"`
-	Channel    string   `json:"channel,omitempty"`
-	Severities []string `json:"severities"` // 빈 배열 = 모든 severity 수신
+	Name     string        `json:"name" binding:"required"`
+	URL      string        `json:"url"`
+	Type     string        `json:"type"`
+	Token    string        `json:"token,omitempty"`
+	Channel  string        `json:"channel,omitempty"`
+	Matchers LabelMatchers `json:"matchers"`
+	// Deprecated: matchers의 severity 매처로 변환된다 (severity=~"critical|warning")
+	Severities []string `json:"severities,omitempty"`
+	Enabled    *bool    `json:"enabled,omitempty"`  // 생략 시 true
+	Priority   *int     `json:"priority,omitempty"` // 생략 시 100
+	Continue   bool     `json:"continue"`
+	// Slack 전용: 서비스 카탈로그의 팀 Slack 채널로 root 메시지 전송
+	UseServiceChannel bool   `json:"use_service_channel"`
+	TitleTemplate     string `json:"title_template"`
+	BodyTemplate      string `json:"body_template"`
+	PayloadTemplate   string `json:"payload_template"`
+	// HTTP/Teams 전송 옵션 (http_method 생략 시 POST)
+	// signing_secret, basic_auth_password, tls_client_key는 수정 시 빈 값이면 저장된 값을 유지
+	HTTPMethod        string            `json:"http_method"`
+	CustomHeaders     map[string]string `json:"custom_headers"`
+	SigningSecret     string            `json:"signing_secret,omitempty"`
+	BasicAuthUsername string            `json:"basic_auth_username,omitempty"`
+	BasicAuthPassword string            `json:"basic_auth_password,omitempty"`
+	TLSClientCert     string            `json:"tls_client_cert,omitempty"`
+	TLSClientKey      string            `json:"tls_client_key,omitempty"`
+	TLSCACert         string            `json:"tls_ca_cert,omitempty"`
+	// 수정 시 삭제할 secret 필드 이름 (signing_secret, basic_auth_password, tls_client_key)
+	ClearSecrets []string `json:"clear_secrets,omitempty"`
+}
+
+// WebhookTemplatePreviewRequest - 템플릿 미리보기 요청
+// webhook_config_id를 지정하면 비어 있는 템플릿 필드에 해당 설정의 템플릿을 사용한다.
+type WebhookTemplatePreviewRequest struct {
+	AlertID         string `json:"alert_id" binding:"required"`
+	WebhookConfigID *int   `json:"webhook_config_id,omitempty"`
+	TitleTemplate   string `json:"title_template"`
+	BodyTemplate    string `json:"body_template"`
+	PayloadTemplate string `json:"payload_template"`
+}
+
+// WebhookTemplatePreview - 템플릿 렌더링 결과 (템플릿이 비어 있으면 빈 문자열)
+type WebhookTemplatePreview struct {
+	AlertID string `json:"alert_id"`
+	Title   string `json:"title"`
+	Body    string `json:"body"`
+	Payload string `json:"payload"`
+}
+
+// WebhookTemplatePreviewResponse - 템플릿 미리보기 응답
+type WebhookTemplatePreviewResponse struct {
+	Status string                 `json:"status"`
+	Data   WebhookTemplatePreview `json:"data"`
 }
 
 // WebhookConfigResponse - 단건 조회 응답
@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// WebhookAuthCredentialInfo - 인바운드 웹훅 credential 정보 (secret 제외)
+type WebhookAuthCredentialInfo struct {
+	Name string `json:"name"`
+	Type string `json:"type"` // bearer, basic, hmac
+}
+
+// WebhookAuthStatus - 인바운드 웹훅 인증 상태 및 거부 통계
+type WebhookAuthStatus struct {
+	Enabled          bool                        `json:"enabled"`
+	Credentials      []WebhookAuthCredentialInfo `json:"credentials"`
+	RejectedTotal    int64                       `json:"rejected_total"`
+	RejectedByReason map[string]int64            `json:"rejected_by_reason"`
+	LastRejectedAt   *time.Time                  `json:"last_rejected_at,omitempty"`
+}
+
+// WebhookAuthStatusResponse - 인바운드 웹훅 인증 상태 응답
+type WebhookAuthStatusResponse struct {
+	Status string            `json:"status"`
+	Data   WebhookAuthStatus `json:"data"`
+}
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Webhook inbox 상태
+const (
+	WebhookInboxStatusPending    = "pending"
+	WebhookInboxStatusProcessing = "processing"
+	WebhookInboxStatusDone       = "done"
+	WebhookInboxStatusDead       = "dead"
+)
+
+// WebhookInboxEntry - webhook_inbox 테이블 구조체 (수신한 웹훅 원본 + 처리 상태)
+type WebhookInboxEntry struct {
+	ID                  int64           `json:"id"`
+	Source              string          `json:"source"`     // alertmanager 등 수신 엔드포인트
+	Credential          string          `json:"credential"` // 인증에 사용된 credential 이름
+	GroupKey            string          `json:"group_key"`  // 처리 순서 보장 단위 (수신 경로 + groupKey)
+	Status              string          `json:"status"`     // pending, processing, done, dead
+	AlertCount          int             `json:"alert_count"`
+	TruncatedAlerts     int             `json:"truncated_alerts"` // max_alerts로 생략된 alert 수
+	Attempts            int             `json:"attempts"`
+	LastError           string          `json:"last_error,omitempty"`
+	NotificationsSent   int             `json:"notifications_sent"`
+	NotificationsFailed int             `json:"notifications_failed"`
+	ReceivedAt          time.Time       `json:"received_at"`
+	NextAttemptAt       time.Time       `json:"next_attempt_at"`
+	ProcessedAt         *time.Time      `json:"processed_at,omitempty"`
+	Payload             json.RawMessage `json:"payload,omitempty" swaggertype:"object"` // 상세 조회 시에만 포함
+}
+
+// WebhookAcceptedResponse - 웹훅 수신 응답 (inbox 저장 후 비동기 처리)
+type WebhookAcceptedResponse struct {
+	Status     string `json:"status"` // accepted, ignored (처리할 alert가 없어 inbox에 저장하지 않음)
+	InboxID    int64  `json:"inboxId"`
+	AlertCount int    `json:"alertCount"`
+}
+
+// WebhookInboxListResponse - inbox 목록 조회 응답
+type WebhookInboxListResponse struct {
+	Status string              `json:"status"`
+	Data   []WebhookInboxEntry `json:"data"`
+}
+
+// WebhookInboxEntryResponse - inbox 단건 조회 응답
+type WebhookInboxEntryResponse struct {
+	Status string             `json:"status"`
+	Data   *WebhookInboxEntry `json:"data"`
+}
+
+// WebhookInboxReplayResponse - inbox 재처리 요청 응답
+type WebhookInboxReplayResponse struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	ID      int64  `json:"id"`
+}
@@ -0,0 +1,202 @@
+// Alert/Incident 확인(acknowledge) 로직
+//
+// 처리 흐름:
+//  1. alert 확인: firing + 미확인 alert만 대상 → 확인자/시각/time_to_ack_seconds 기록 (조건부 UPDATE로 중복 확인 방지)
+//     - 연결된 Incident가 아직 확인되지 않았다면 함께 확인 (Incident MTTA는 첫 확인 시각 기준)
+//  2. incident 확인: firing + 미확인 Incident 확인 → 소속된 미확인 firing alert를 모두 확인
+//  3. SSE로 alert_acknowledged / incident_acknowledged 브로드캐스트
+//  4. 확인된 alert의 기존 알림 스레드에 AlertAcknowledgedEvent 전송 (전송 실패는 무시)
+//  5. Incident가 확인되면 감사 기록을 남기고 진행 중인 에스컬레이션 중단 (escalation.go)
+//
+// 확인된 alert는 재알림 후보(alert_reminder.go)에서 제외된다.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/kube-rca/backend/internal/client"
+	"github.com/kube-rca/backend/internal/db"
+	"github.com/kube-rca/backend/internal/model"
+	"github.com/kube-rca/backend/internal/sse"
+)
+
+var (
+	ErrAlreadyAcknowledged = errors.New("already acknowledged")
+	ErrAlertNotFiring      = errors.New("alert is not firing")
+	ErrIncidentNotFound    = errors.New("incident not found")
+	ErrIncidentNotFiring   = errors.New("incident is not firing")
+)
+
+// AcknowledgeAlert - firing alert 확인 처리 (연결된 Incident가 미확인이면 함께 확인)
+func (s *AlertService) AcknowledgeAlert(alertID, acknowledgedBy string) (*model.Acknowledgement, error) {
+	alert, err := s.db.GetAlertDetail(alertID)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return nil, ErrAlertNotFound
+		}
+		return nil, fmt.Errorf("failed to load alert: %w", err)
+	}
+	if alert.Status != "firing" {
+		return nil, fmt.Errorf("%w: %s", ErrAlertNotFiring, alertID)
+	}
+	if alert.Acknowledged {
+		return nil, fmt.Errorf("%w: %s", ErrAlreadyAcknowledged, alertID)
+	}
+
+	now := time.Now().UTC()
+	claimed, err := s.db.AcknowledgeAlert(alertID, acknowledgedBy, now)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, fmt.Errorf("%w: %s", ErrAlreadyAcknowledged, alertID)
+	}
+
+	ack := &model.Acknowledgement{
+		AlertID:          alertID,
+		IncidentID:       ptrToString(alert.IncidentID),
+		AcknowledgedBy:   acknowledgedBy,
+		AcknowledgedAt:   now,
+		TimeToAckSeconds: timeToAckSeconds(alert.FiredAt, now),
+	}
+	log.Printf("Alert acknowledged (alert_id=%s, by=%s, time_to_ack=%ds)", alertID, acknowledgedBy, ack.TimeToAckSeconds)
+
+	if ack.IncidentID != "" {
+		incidentAcked, err := s.db.AcknowledgeIncident(ack.IncidentID, acknowledgedBy, now)
+		if err != nil {
+			log.Printf("Failed to acknowledge incident with alert (incident_id=%s): %v", ack.IncidentID, err)
+		} else if incidentAcked {
+			ack.IncidentAcked = true
+			s.broadcastAcknowledgement(sse.EventIncidentAcknowledged, "", ack.IncidentID, acknowledgedBy)
+			if s.escalator != nil {
+				s.escalator.IncidentAcknowledged(context.Background(), ack.IncidentID, acknowledgedBy, alertID)
+			}
+		}
+	}
+
+	s.broadcastAcknowledgement(sse.EventAlertAcknowledged, alertID, ack.IncidentID, acknowledgedBy)
+	s.notifyAcknowledged(alert, acknowledgedBy, now)
+	return ack, nil
+}
+
+// AcknowledgeIncident - firing Incident 확인 처리 + 소속된 미확인 firing alert 일괄 확인
+func (s *AlertService) AcknowledgeIncident(incidentID, acknowledgedBy string) (*model.Acknowledgement, error) {
+	incident, err := s.db.GetIncidentDetail(incidentID)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return nil, ErrIncidentNotFound
+		}
+		return nil, fmt.Errorf("failed to load incident: %w", err)
+	}
+	if incident.Status != "firing" {
+		return nil, fmt.Errorf("%w: %s", ErrIncidentNotFiring, incidentID)
+	}
+	if incident.Acknowledged {
+		return nil, fmt.Errorf("%w: %s", ErrAlreadyAcknowledged, incidentID)
+	}
+
+	now := time.Now().UTC()
+	claimed, err := s.db.AcknowledgeIncident(incidentID, acknowledgedBy, now)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, fmt.Errorf("%w: %s", ErrAlreadyAcknowledged, incidentID)
+	}
+
+	ack := &model.Acknowledgement{
+		IncidentID:       incidentID,
+		AcknowledgedBy:   acknowledgedBy,
+		AcknowledgedAt:   now,
+		TimeToAckSeconds: timeToAckSeconds(incident.FiredAt, now),
+		IncidentAcked:    true,
+	}
+	s.broadcastAcknowledgement(sse.EventIncidentAcknowledged, "", incidentID, acknowledgedBy)
+	if s.escalator != nil {
+		s.escalator.IncidentAcknowledged(context.Background(), incidentID, acknowledgedBy, "")
+	}
+
+	alertIDs, err := s.db.AcknowledgeIncidentAlerts(incidentID, acknowledgedBy, now)
+	if err != nil {
+		log.Printf("Failed to acknowledge incident alerts (incident_id=%s): %v", incidentID, err)
+	}
+	ack.AcknowledgedAlerts = alertIDs
+	log.Printf("Incident acknowledged (incident_id=%s, by=%s, time_to_ack=%ds, alerts=%d)", incidentID, acknowledgedBy, ack.TimeToAckSeconds, len(alertIDs))
+
+	for _, alertID := range alertIDs {
+		s.broadcastAcknowledgement(sse.EventAlertAcknowledged, alertID, incidentID, acknowledgedBy)
+		alert, err := s.db.GetAlertDetail(alertID)
+		if err != nil {
+			log.Printf("Failed to load acknowledged alert (alert_id=%s): %v", alertID, err)
+			continue
+		}
+		s.notifyAcknowledged(alert, acknowledgedBy, now)
+	}
+	return ack, nil
+}
+
+func (s *AlertService) broadcastAcknowledgement(eventType sse.EventType, alertID, incidentID, acknowledgedBy string) {
+	if s.sseHub == nil {
+		return
+	}
+	s.sseHub.Broadcast(sse.Event{
+		Type: eventType,
+		Data: sse.EventData{AlertID: alertID, IncidentID: incidentID, Message: acknowledgedBy},
+	})
+}
+
+// notifyAcknowledged - 확인된 alert의 알림 스레드에 확인 메시지 전송
+func (s *AlertService) notifyAcknowledged(alert *model.AlertDetailResponse, acknowledgedBy string, at time.Time) {
+	deliveries, err := s.db.GetAlertNotificationDeliveries(alert.AlertID)
+	if err != nil {
+		log.Printf("Failed to load deliveries for acknowledged notification: %v", err)
+		return
+	}
+	if len(deliveries) == 0 {
+		deliveries, err = s.recoverLegacyDeliveries(alert.AlertID, alert.Fingerprint, ptrToString(alert.IncidentID), alert.Status, alert.ThreadTS)
+		if err != nil {
+			log.Printf("Failed to recover legacy deliveries for acknowledged notification: %v", err)
+			return
+		}
+	}
+	if len(deliveries) == 0 {
+		return
+	}
+
+	var labels map[string]string
+	if len(alert.Labels) > 0 {
+		if err := json.Unmarshal(alert.Labels, &labels); err != nil {
+			log.Printf("Failed to parse alert labels for acknowledged notification (alert_id=%s): %v", alert.AlertID, err)
+		}
+	}
+	event := client.AlertAcknowledgedEvent{
+		Alert: model.Alert{
+			Status:      alert.Status,
+			Labels:      labels,
+			StartsAt:    alert.FiredAt,
+			Fingerprint: alert.Fingerprint,
+		},
+		AlertID:        alert.AlertID,
+		IncidentID:     ptrToString(alert.IncidentID),
+		AcknowledgedBy: acknowledgedBy,
+		TimeToAck:      at.Sub(alert.FiredAt),
+	}
+	if err := s.notifyThreadEvent(event, deliveries); err != nil {
+		log.Printf("Failed to send acknowledged notification (alert_id=%s): %v", alert.AlertID, err)
+	}
+}
+
+// timeToAckSeconds - 발생부터 확인까지 걸린 시간(초), 음수는 0
+func timeToAckSeconds(firedAt, at time.Time) int64 {
+	seconds := int64(at.Sub(firedAt) / time.Second)
+	if seconds < 0 {
+		return 0
+	}
+	return seconds
+}
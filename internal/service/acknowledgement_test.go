@@ -0,0 +1,105 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kube-rca/backend/internal/client"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+func strPtr(v string) *string { return &v }
+
+func TestAcknowledgeAlert_AcknowledgesIncidentAndNotifiesThread(t *testing.T) {
+	store := newAlertStoreMock()
+	notifier := newNotifierMock()
+	svc := newTestAlertService(store, notifier, &analyzerMock{})
+	escalator := &escalatorMock{}
+	svc.escalator = escalator
+
+	firedAt := time.Now().Add(-10 * time.Minute)
+	store.alertByID["ALR-1"] = &model.AlertDetailResponse{
+		AlertID: "ALR-1", IncidentID: strPtr("INC-1"), Fingerprint: "fp-1", Status: "firing", FiredAt: firedAt,
+		Labels: json.RawMessage(`{"alertname":"HighCPU","severity":"warning"}`),
+	}
+	store.incidentByID["INC-1"] = &model.IncidentDetailResponse{IncidentID: "INC-1", Status: "firing", FiredAt: firedAt}
+	store.deliveries["ALR-1"] = []model.AlertNotificationDelivery{{AlertID: "ALR-1", ChannelID: "C-test", ThreadTS: "ts-fp-1"}}
+
+	ack, err := svc.AcknowledgeAlert("ALR-1", "alice")
+	if err != nil {
+		t.Fatalf("AcknowledgeAlert() error = %v", err)
+	}
+	if !ack.IncidentAcked || ack.IncidentID != "INC-1" || ack.TimeToAckSeconds < 599 {
+		t.Fatalf("ack = %+v; want incident acknowledged with time_to_ack ~600s", ack)
+	}
+	if !store.alertByID["ALR-1"].Acknowledged || store.incidentByID["INC-1"].AcknowledgedBy != "alice" {
+		t.Fatal("alert/incident not recorded as acknowledged by alice")
+	}
+	if len(escalator.acknowledged) != 1 || escalator.acknowledged[0] != "INC-1" {
+		t.Fatalf("escalator acknowledged = %v; want INC-1 escalation stopped", escalator.acknowledged)
+	}
+
+	if len(notifier.events) != 1 {
+		t.Fatalf("events = %d; want 1 thread note", len(notifier.events))
+	}
+	event, ok := notifier.events[0].(client.AlertAcknowledgedEvent)
+	if !ok || event.AcknowledgedBy != "alice" || event.Alert.Labels["alertname"] != "HighCPU" {
+		t.Fatalf("event = %+v; want AlertAcknowledgedEvent by alice with labels", notifier.events[0])
+	}
+}
+
+func TestAcknowledgeAlert_RejectsAcknowledgedOrResolved(t *testing.T) {
+	store := newAlertStoreMock()
+	svc := newTestAlertService(store, newNotifierMock(), &analyzerMock{})
+
+	store.alertByID["ALR-1"] = &model.AlertDetailResponse{AlertID: "ALR-1", Status: "firing"}
+	store.alertByID["ALR-2"] = &model.AlertDetailResponse{AlertID: "ALR-2", Status: "resolved"}
+
+	if _, err := svc.AcknowledgeAlert("ALR-1", "alice"); err != nil {
+		t.Fatalf("AcknowledgeAlert() error = %v", err)
+	}
+	if _, err := svc.AcknowledgeAlert("ALR-1", "bob"); !errors.Is(err, ErrAlreadyAcknowledged) {
+		t.Fatalf("second AcknowledgeAlert() error = %v; want ErrAlreadyAcknowledged", err)
+	}
+	if store.alertByID["ALR-1"].AcknowledgedBy != "alice" {
+		t.Fatalf("acknowledged_by = %s; want alice", store.alertByID["ALR-1"].AcknowledgedBy)
+	}
+	if _, err := svc.AcknowledgeAlert("ALR-2", "alice"); !errors.Is(err, ErrAlertNotFiring) {
+		t.Fatalf("AcknowledgeAlert(resolved) error = %v; want ErrAlertNotFiring", err)
+	}
+}
+
+func TestAcknowledgeIncident_AcknowledgesFiringAlerts(t *testing.T) {
+	store := newAlertStoreMock()
+	notifier := newNotifierMock()
+	svc := newTestAlertService(store, notifier, &analyzerMock{})
+
+	store.incidentByID["INC-1"] = &model.IncidentDetailResponse{IncidentID: "INC-1", Status: "firing", FiredAt: time.Now().Add(-time.Hour)}
+	store.alertByID["ALR-1"] = &model.AlertDetailResponse{AlertID: "ALR-1", IncidentID: strPtr("INC-1"), Status: "firing"}
+	store.alertByID["ALR-2"] = &model.AlertDetailResponse{AlertID: "ALR-2", IncidentID: strPtr("INC-1"), Status: "resolved"}
+	store.alertByID["ALR-3"] = &model.AlertDetailResponse{AlertID: "ALR-3", IncidentID: strPtr("INC-2"), Status: "firing"}
+	store.deliveries["ALR-1"] = []model.AlertNotificationDelivery{{AlertID: "ALR-1", ChannelID: "C-test", ThreadTS: "ts-1"}}
+
+	ack, err := svc.AcknowledgeIncident("INC-1", "alice")
+	if err != nil {
+		t.Fatalf("AcknowledgeIncident() error = %v", err)
+	}
+	if len(ack.AcknowledgedAlerts) != 1 || ack.AcknowledgedAlerts[0] != "ALR-1" {
+		t.Fatalf("acknowledged alerts = %v; want [ALR-1]", ack.AcknowledgedAlerts)
+	}
+	if store.alertByID["ALR-3"].Acknowledged {
+		t.Fatal("alert of another incident was acknowledged")
+	}
+	if len(notifier.events) != 1 {
+		t.Fatalf("events = %d; want 1 thread note", len(notifier.events))
+	}
+
+	if _, err := svc.AcknowledgeIncident("INC-1", "bob"); !errors.Is(err, ErrAlreadyAcknowledged) {
+		t.Fatalf("second AcknowledgeIncident() error = %v; want ErrAlreadyAcknowledged", err)
+	}
+	if _, err := svc.AcknowledgeIncident("INC-404", "bob"); !errors.Is(err, ErrIncidentNotFound) {
+		t.Fatalf("AcknowledgeIncident(missing) error = %v; want ErrIncidentNotFound", err)
+	}
+}
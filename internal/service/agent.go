@@ -23,6 +23,10 @@ import (
 // and automatically evicted so that future requests are not permanently blocked.
 const inFlightStaleTTL = 5 * time.Minute
 
+// threadContextWaitTimeout is how long a firing analysis waits for the outbox
+// worker to post the root message and record its thread.
+const threadContextWaitTimeout = time.Minute
+
 // AgentService 구조체 정의
 type AgentService struct {
 	agentClient *client.AgentClient
@@ -46,6 +50,10 @@ func NewAgentService(agentClient *client.AgentClient, notifier client.Notifier,
 
 func (s *AgentService) RequestAnalysis(alert model.Alert, alertID, threadTS, incidentID string, skipThreadCheck bool) {
 	threadTS = s.analysisThreadContext(alertID, alert.Fingerprint, threadTS)
+	if !skipThreadCheck && threadTS == "" && alert.Status == "firing" && s.requiresThreadRef() {
+		// root 메시지는 outbox worker가 비동기로 전송하므로 스레드가 기록될 때까지 대기
+		threadTS = s.waitForThreadContext(alertID, alert.Fingerprint, threadContextWaitTimeout)
+	}
 	if !skipThreadCheck && threadTS == "" && s.requiresThreadRef() {
 		log.Printf("No thread_ref for alert (alert_id=%s, fingerprint=%s), skipping agent request", alertID, alert.Fingerprint)
 		return
@@ -264,6 +272,24 @@ func (s *AgentService) waitForAnalysisCompletion(key string, timeout time.Durati
 	}
 }
 
+// waitForThreadContext - root 메시지 전송 후 스레드 delivery/thread_ts가 기록될 때까지 대기 (timeout이면 빈 문자열)
+func (s *AgentService) waitForThreadContext(alertID, fingerprint string, timeout time.Duration) string {
+	deadline := time.After(timeout)
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-deadline:
+			return ""
+		case <-ticker.C:
+			if threadTS := s.analysisThreadContext(alertID, fingerprint, ""); threadTS != "" {
+				return threadTS
+			}
+		}
+	}
+}
+
 // loadPreviousFiringAnalysis - fingerprint 기준 최신 firing 분석 컨텍스트 조회
 func (s *AgentService) loadPreviousFiringAnalysis(fingerprint, incidentID string) *client.PreviousAnalysisContext {
 	if fingerprint == "" {
@@ -2,13 +2,19 @@
 // handler에서 받은 알림을 필터링하고 client를 통해 알림 채널로 전송
 //
 // 처리 흐름:
-//  1. 현재 firing 상태인 Incident가 있는지 확인
-//     - 없으면: 새 Incident 생성
-//  2. Alert를 DB에 저장 (alerts 테이블) + Incident 연결
-//  3. resolved 상태면 resolved_at 업데이트
-//  4. shouldSendNotification으로 필터링
-//  5. resolved 알림: DB에서 thread_ts 조회하여 메모리에 복원
-//  6. Notifier.Notify로 알림 채널 전송
+//  0. enrichment 파이프라인(enrichment.go)으로 팀/런북/환경 등 파생 라벨·annotation 추가
+//     - 서비스 카탈로그(service_catalog.go)로 소유 서비스 매칭
+//     - on-call 스케줄(oncall.go)로 현재 on-call 계산 (firing 알림 멘션, 새 Incident에 기록)
+//     - flapping 정책(flapping_policy.go) 중 가장 구체적인 정책으로 감지 window/threshold/clearance 결정
+//  1. 그룹핑 라벨/시간 창/라벨 유사도로 가장 잘 맞는 열린 Incident 선택 (correlation.go)
+//     - 없으면: 그룹핑 키로 새 Incident 생성
+//  2. Alert를 DB에 저장 (alerts 테이블) + Incident 연결 + 매칭 이유 기록
+//     - 알림 outbox가 설정되어 있으면 라우팅된 대상별 outbox entry를 같은 트랜잭션에 보류 상태로 기록
+//  3. resolved 상태면 resolved_at 업데이트 (flapping 중이면 clearance 체크를 scheduled_jobs에 예약)
+//  4. silence 규칙(silence.go), 진행 중인 점검 시간대(maintenance.go), 억제 규칙(inhibition.go)에 매칭되면 알림/분석 스킵, shouldSendNotification으로 필터링 (severity 분류 체계의 notify 플래그, severity.go)
+//  4.5. 알림 대상 firing alert의 Incident에 매칭되는 에스컬레이션 정책이 있으면 에스컬레이션 시작 (escalation.go)
+//  5. outbox entry를 기록했으면 전송 개시 (flapping이면 폐기), worker가 전송하고 Slack 스레드를 기록 (notification_outbox.go)
+//  6. 그 밖의 경우 Notifier로 알림 채널 전송 (resolved 알림은 DB의 스레드 delivery에 답글)
 //  7. firing 알림: thread_ts를 DB에 저장
 //  8. Agent에 비동기 분석 요청 (firing, resolved)
 //  9. 전송 성공/실패 카운트 반환
@@ -16,9 +22,12 @@
 package service
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"sync/atomic"
 	"time"
 
 	"github.com/kube-rca/backend/internal/client"
@@ -28,9 +37,14 @@ import (
 	"github.com/kube-rca/backend/internal/sse"
 )
 
+// ErrAlertNotFlapping - flapping 상태가 아닌 alert의 수동 해제 요청
+var ErrAlertNotFlapping = errors.New("alert is not flapping")
+
 // alertStore - AlertService가 사용하는 DB 인터페이스
 type alertStore interface {
-	SaveAlert(alert model.Alert, incidentID string) (string, error)
+	SaveAlertWithOutbox(alert model.Alert, incidentID string, entries []model.NotificationOutboxEntry) (string, []int64, error)
+	GetFiringAlertByFingerprint(fingerprint string) (string, error)
+	GetFiringAlertSuppression(fingerprint string) (string, error)
 	GetAlertCurrentStatus(fingerprint string) (string, error)
 	IsAlertFlapping(fingerprint string) bool
 	RecordStateTransition(fingerprint, fromStatus, toStatus string, timestamp time.Time) error
@@ -45,13 +59,43 @@ type alertStore interface {
 	HasTransitionsSince(fingerprint string, since time.Time) (bool, error)
 	GetFiringIncident() (*model.IncidentDetailResponse, error)
 	CreateIncident(title, severity string, firedAt time.Time) (string, error)
-	GetOrCreateFiringIncident(title, severity string, firedAt time.Time) (string, bool, error)
-	UpdateIncidentSeverity(incidentID, severity string) error
+	ListCorrelationCandidates() ([]model.IncidentCorrelationCandidate, error)
+	GetOrCreateCorrelatedIncident(correlationKey, title, severity string, labels map[string]string, firedAt time.Time) (string, bool, error)
+	TouchIncidentCorrelation(incidentID string, at time.Time) error
+	CloseIncidentCorrelation(incidentID string) error
+	GetFiringAlertIncidentID(fingerprint string) (string, error)
+	UpdateAlertCorrelation(alertID string, match model.IncidentMatch) error
+	ListActiveSilenceRules(at time.Time) ([]model.SilenceRule, error)
+	UpdateAlertSilence(alertID string, silenceID *int64) error
+	ListEnabledMaintenanceWindows() ([]model.MaintenanceWindow, error)
+	UpdateAlertMaintenance(alertID string, windowID *int64) error
+	EscalateIncidentSeverity(incidentID, severity string, rank int) error
 	GetAlertDetail(alertID string) (*model.AlertDetailResponse, error)
 	ManualResolveAlert(alertID string) error
 	UpsertAlertNotificationDeliveries(deliveries []model.AlertNotificationDelivery) error
 	GetAlertNotificationDeliveries(alertID string) ([]model.AlertNotificationDelivery, error)
 	TouchAlertNotificationDeliveries(alertID string, at time.Time) error
+	ListEnabledInhibitionRules() ([]model.InhibitionRule, error)
+	ListFiringInhibitionSources() ([]model.InhibitionSource, error)
+	UpdateAlertInhibition(alertID string, inhibitedBy *string, ruleID *int64) error
+	ListServicesForMatching() ([]model.Service, error)
+	UpdateAlertService(alertID string, serviceID *int64) error
+	AssignIncidentService(incidentID string, serviceID int64) error
+	ListFlappingClearanceCandidates() ([]model.FlappingClearancePayload, error)
+	ListEnabledFlappingPolicies() ([]model.FlappingPolicy, error)
+	ListEnabledEscalationPolicies() ([]model.EscalationPolicy, error)
+	ListEnabledOncallSchedules() ([]model.OncallSchedule, error)
+	ListActiveOncallOverrides(at time.Time) ([]model.OncallOverride, error)
+	ListUserSlackIDs() (map[string]string, error)
+	SetIncidentOncall(incidentID string, scheduleID int64, user string) error
+	UpdateAlertFlappingPolicy(alertID string, policyID *int64) error
+	GetIncidentDetail(incidentID string) (*model.IncidentDetailResponse, error)
+	AcknowledgeAlert(alertID, acknowledgedBy string, at time.Time) (bool, error)
+	AcknowledgeIncident(incidentID, acknowledgedBy string, at time.Time) (bool, error)
+	AcknowledgeIncidentAlerts(incidentID, acknowledgedBy string, at time.Time) ([]string, error)
+	ClaimAlertIngestKey(key string, window time.Duration) (bool, error)
+	ReleaseAlertIngestKey(key string) error
+	PurgeAlertIngestKeys(before time.Time) (int64, error)
 }
 
 // alertAnalyzer - AlertService가 사용하는 Agent 분석 인터페이스
@@ -59,6 +103,25 @@ type alertAnalyzer interface {
 	RequestAnalysis(alert model.Alert, alertID, threadTS, incidentID string, skipThreadCheck bool)
 }
 
+// jobScheduler - 지연 실행 작업 예약 인터페이스 (ScheduledJobService)
+type jobScheduler interface {
+	Schedule(ctx context.Context, jobType, jobKey string, runAt time.Time, payload any) (int64, error)
+	Cancel(ctx context.Context, jobType, jobKey string) (int64, error)
+}
+
+// alertNotificationOutbox - alert 저장 트랜잭션에 기록할 알림 entry 생성과 전송 개시/취소 (NotificationOutboxService)
+type alertNotificationOutbox interface {
+	PrepareEntries(event client.NotifierEvent, targets []client.OutboxTarget) ([]model.NotificationOutboxEntry, error)
+	Release(ctx context.Context, ids []int64)
+	Cancel(ctx context.Context, ids []int64, reason string)
+}
+
+// incidentEscalator - Incident 에스컬레이션 시작/확인 처리 인터페이스 (EscalationService)
+type incidentEscalator interface {
+	StartEscalation(ctx context.Context, incidentID string, policy model.EscalationPolicy)
+	IncidentAcknowledged(ctx context.Context, incidentID, acknowledgedBy, alertID string)
+}
+
 // AlertService 구조체 정의
 type AlertService struct {
 	notifier     client.Notifier
@@ -67,16 +130,27 @@ type AlertService struct {
 	appSettings  *AppSettingsService
 	envFlapping  config.FlappingConfig
 	sseHub       *sse.Hub
+	jobs         jobScheduler      // flapping clearance 등 예약 작업 (nil이면 예약 안 함)
+	escalator    incidentEscalator // Incident 에스컬레이션 (nil이면 에스컬레이션 안 함)
+
+	// alert 저장과 함께 알림을 기록하는 outbox와 전송 대상 계산기 (nil이면 저장 후 notifier로 바로 전송)
+	outbox  alertNotificationOutbox
+	planner client.OutboxPlanner
+
+	// HA 중복 수신 제거 (0이면 비활성)
+	dedupeWindow   time.Duration
+	lastKeysPurged atomic.Int64 // 마지막 멱등 키 정리 시각 (unix seconds)
 }
 
 // NewAlertService 객체 생성
-func NewAlertService(notifier client.Notifier, agentService *AgentService, database *db.Postgres, flappingConfig config.FlappingConfig, sseHub *sse.Hub, appSettings *AppSettingsService) *AlertService {
+func NewAlertService(notifier client.Notifier, agentService *AgentService, database *db.Postgres, flappingConfig config.FlappingConfig, dedupeConfig config.AlertDedupeConfig, sseHub *sse.Hub, appSettings *AppSettingsService) *AlertService {
 	svc := &AlertService{
-		notifier:    notifier,
-		db:          database,
-		appSettings: appSettings,
-		envFlapping: flappingConfig,
-		sseHub:      sseHub,
+		notifier:     notifier,
+		db:           database,
+		appSettings:  appSettings,
+		envFlapping:  flappingConfig,
+		sseHub:       sseHub,
+		dedupeWindow: time.Duration(dedupeConfig.WindowSeconds) * time.Second,
 	}
 	// nil *AgentService를 interface에 직접 할당하면 non-nil 인터페이스가 되므로 명시적 처리
 	if agentService != nil {
@@ -85,47 +159,211 @@ func NewAlertService(notifier client.Notifier, agentService *AgentService, datab
 	return svc
 }
 
+// SetJobScheduler - 예약 작업 스케줄러 설정 및 flapping clearance 작업 실행 함수 등록
+func (s *AlertService) SetJobScheduler(jobs *ScheduledJobService) {
+	s.jobs = jobs
+	jobs.Register(model.ScheduledJobTypeFlappingClearance, s.RunFlappingClearanceCheck)
+}
+
+// SetEscalator - Incident 에스컬레이션 처리기 설정
+func (s *AlertService) SetEscalator(escalator *EscalationService) {
+	// nil *EscalationService가 non-nil 인터페이스가 되지 않도록 명시적 처리
+	if escalator != nil {
+		s.escalator = escalator
+	}
+}
+
+// SetNotificationOutbox - alert 저장 트랜잭션에 라우팅된 대상별 알림 entry를 함께 기록하도록 설정
+// notifier가 전송 대상 계산(client.OutboxPlanner)을 지원하지 않으면 기존처럼 저장 후 바로 전송한다.
+func (s *AlertService) SetNotificationOutbox(outbox *NotificationOutboxService) {
+	planner, ok := s.notifier.(client.OutboxPlanner)
+	if outbox == nil || !ok {
+		return
+	}
+	s.outbox, s.planner = outbox, planner
+}
+
+// ProcessWebhook - 웹훅을 처리하고 알림 전송 성공/실패 수를 반환 (DB 저장 오류는 로그만 남김)
 func (s *AlertService) ProcessWebhook(webhook model.AlertmanagerWebhook) (sent, failed int) {
+	sent, failed, _ = s.IngestWebhook(webhook)
+	return sent, failed
+}
+
+// AlertSaveError - 저장에 실패한 alert 목록 (webhook inbox가 실패한 alert만 재시도하도록 사용)
+type AlertSaveError struct {
+	Alerts []model.Alert
+	Err    error
+}
+
+func (e *AlertSaveError) Error() string { return e.Err.Error() }
+
+func (e *AlertSaveError) Unwrap() error { return e.Err }
+
+// IngestWebhook - 웹훅을 처리하고 Alert 저장 실패를 *AlertSaveError로 반환 (webhook inbox 재시도 판단용)
+// 저장에 실패한 alert는 알림/분석 등 부작용 없이 건너뛰고, 재시도에서 처음부터 다시 처리한다.
+func (s *AlertService) IngestWebhook(webhook model.AlertmanagerWebhook) (sent, failed int, err error) {
 	// 알림 파이프라인 비활성화 시 전체 스킵 (점검 모드)
 	if s.appSettings != nil && !s.appSettings.IsNotificationEnabled() {
 		log.Printf("Notification disabled, skipping %d alerts", len(webhook.Alerts))
-		return 0, 0
+		return 0, 0, nil
 	}
 
+	var saveErrs []error
+	var failedAlerts []model.Alert
+	s.purgeIngestKeys()
+	taxonomy := s.severityTaxonomy()
+	enrichment := s.enrichmentPipeline()
+	silences := s.activeSilences()
+	maintenance := s.activeMaintenance()
+	inhibition := s.loadInhibition()
+	services := s.loadServices()
+	flappingPolicies := s.loadFlappingPolicies()
+	flappingGlobal := s.flappingConfig()
+	escalationPolicies := s.loadEscalationPolicies()
+	oncallRoster := s.loadOncallRoster()
+
+	prepared := make([]preparedAlert, 0, len(webhook.Alerts))
 	for _, alert := range webhook.Alerts {
-		// 0. severity 필터링 (info, none 등은 DB 저장도 하지 않음)
-		if !s.shouldProcess(alert) {
+		received := alert
+		// 0. severity 분류: 라벨 값을 정규 레벨로 매핑하고, 저장 대상이 아닌 레벨(none 등)은 DB 저장도 하지 않음
+		level, ok := resolveSeverity(taxonomy, alert.Labels["severity"])
+		if !s.shouldProcess(level, ok) {
 			log.Printf("Skipping alert with severity=%s (fingerprint=%s)", alert.Labels["severity"], alert.Fingerprint)
 			continue
 		}
+		alert.SourceLabels = alert.Labels
+		alert = canonicalizeSeverity(alert, level)
+		alert.ExternalURL = webhook.ExternalURL
+		// 0.2. enrichment: 팀/런북/환경 등 파생 라벨·annotation 추가 (silence/억제 매칭과 저장 전에 적용)
+		alert = enrichment.apply(alert)
+		prepared = append(prepared, preparedAlert{received: received, alert: alert, level: level})
+	}
+	// 0.3. 같은 웹훅의 firing alert를 억제 source 후보에 미리 반영 (target이 source보다 앞에 있어도 억제)
+	inhibition.prescan(prepared)
+	var pendingInhibitions []pendingInhibition
+
+	for _, p := range prepared {
+		received, alert, level := p.received, p.alert, p.level
+
+		// 0.5. HA 복제본 중복 제거: 같은 멱등 키가 window 내에 이미 처리되었으면 부작용 없이 스킵
+		ingestKey := alertIngestKey(alert)
+		if !s.claimIngestKey(ingestKey) {
+			log.Printf("Skipping duplicate alert delivery (fingerprint=%s, status=%s)", alert.Fingerprint, alert.Status)
+			continue
+		}
 
-		// 1. 현재 firing 상태인 Incident 확인/생성
-		incidentID, err := s.getOrCreateIncident(alert)
+		silence := matchSilence(silences, alert.Labels)
+		window := matchMaintenanceWindow(maintenance, alert.Labels)
+		inhibitRule, inhibitSource := inhibition.match(alert)
+		if owner := matchService(services, alert.Labels); owner != nil {
+			alert.ServiceID, alert.ServiceChannel = &owner.ID, owner.SlackChannel
+		}
+		oncall := oncallRoster.current(alert.Labels)
+		if oncall != nil && oncall.MentionInSlack && alert.Status == "firing" {
+			alert.OncallSlackUserID = oncall.SlackUserID
+		}
+		flapPolicy := effectiveFlappingPolicy(flappingGlobal, matchFlappingPolicy(flappingPolicies, alert.Labels))
+
+		// 1. 상관관계 규칙으로 열린 Incident 매칭/생성
+		match, err := s.getOrCreateIncident(webhook, alert, level)
 		if err != nil {
 			log.Printf("Failed to get or create incident: %v", err)
 			// Incident 처리 실패해도 Alert 저장 및 Slack 전송은 계속 진행
-			incidentID = ""
+			match = model.IncidentMatch{}
+		}
+		incidentID := match.IncidentID
+
+		// 이미 resolved된 알림인지 확인 (중복 웹훅 방지, 저장 전 상태 기준)
+		// firing 알림이 억제되었던 alert는 resolved 알림도 보내지 않음 (보낸 적 없는 알림의 해결 메시지 방지)
+		alreadyResolved := false
+		firingSuppressedBy := ""
+		if alert.Status == "resolved" {
+			alreadyResolved, _ = s.db.IsAlertAlreadyResolved(alert.Fingerprint, alert.EndsAt)
+			firingSuppressedBy = s.firingSuppression(alert.Fingerprint)
 		}
 
-		// 2. Alert를 DB에 저장 (alerts 테이블)
-		alertID, saveErr := s.db.SaveAlert(alert, incidentID)
+		// 2. Alert를 DB에 저장 (alerts 테이블) - 알림 대상이면 outbox entry를 같은 트랜잭션에 기록
+		var outboxEntries []model.NotificationOutboxEntry
+		if silence == nil && window == nil && inhibitRule == nil && !alreadyResolved && firingSuppressedBy == "" && s.shouldSendNotification(level) {
+			outboxEntries = s.planOutboxNotification(alert, incidentID)
+		}
+		alertID, outboxIDs, saveErr := s.db.SaveAlertWithOutbox(alert, incidentID, outboxEntries)
 		if saveErr != nil {
 			log.Printf("Failed to save alert to DB: %v", saveErr)
-			// DB 저장 실패해도 Slack 전송은 계속 진행
-		} else if s.sseHub != nil {
+			saveErrs = append(saveErrs, fmt.Errorf("failed to save alert %s: %w", alert.Fingerprint, saveErr))
+			failedAlerts = append(failedAlerts, received)
+			// 재시도(webhook inbox) 시 중복으로 버려지지 않도록 멱등 키 해제
+			s.releaseIngestKey(ingestKey)
+			// 알림/분석은 재시도에서 저장이 성공한 뒤 한 번만 수행
+			continue
+		}
+		// 새로 연결된 경우에만 매칭 이유 기록 (기존 firing alert는 최초 매칭 이유 유지)
+		if incidentID != "" && match.Reason != correlationReasonExistingAlert {
+			if err := s.db.UpdateAlertCorrelation(alertID, match); err != nil {
+				log.Printf("Failed to save alert correlation: %v", err)
+			}
+		}
+		// silence/점검/억제 여부는 firing 수신 시점 기준 (resolved는 해제 후 도착해도 firing 때의 기록 유지)
+		if alert.Status == "firing" {
+			var silenceID *int64
+			if silence != nil {
+				silenceID = &silence.ID
+			}
+			if err := s.db.UpdateAlertSilence(alertID, silenceID); err != nil {
+				log.Printf("Failed to save alert silence: %v", err)
+			}
+			var windowID *int64
+			if window != nil {
+				windowID = &window.ID
+			}
+			if err := s.db.UpdateAlertMaintenance(alertID, windowID); err != nil {
+				log.Printf("Failed to save alert maintenance state: %v", err)
+			}
+			var inhibitedBy *string
+			var inhibitRuleID *int64
+			if inhibitRule != nil {
+				inhibitedBy, inhibitRuleID = &inhibitSource.AlertID, &inhibitRule.ID
+			}
+			if inhibitRule != nil && inhibitSource.AlertID == "" {
+				// source가 같은 웹훅에서 아직 저장되지 않음: 루프 후 source alert_id로 기록
+				pendingInhibitions = append(pendingInhibitions, pendingInhibition{alertID: alertID, sourceFingerprint: inhibitSource.Fingerprint, ruleID: inhibitRule.ID})
+			} else if err := s.db.UpdateAlertInhibition(alertID, inhibitedBy, inhibitRuleID); err != nil {
+				log.Printf("Failed to save alert inhibition: %v", err)
+			}
+		}
+		if err := s.db.UpdateAlertService(alertID, alert.ServiceID); err != nil {
+			log.Printf("Failed to save alert service: %v", err)
+		}
+		if incidentID != "" && alert.ServiceID != nil {
+			if err := s.db.AssignIncidentService(incidentID, *alert.ServiceID); err != nil {
+				log.Printf("Failed to save incident service: %v", err)
+			}
+		}
+		// 새 Incident에는 발생 시점의 on-call 사용자 기록
+		if match.Created && oncall != nil && oncall.User != "" {
+			if err := s.db.SetIncidentOncall(incidentID, oncall.ScheduleID, oncall.User); err != nil {
+				log.Printf("Failed to save incident oncall: %v", err)
+			}
+		}
+		if err := s.db.UpdateAlertFlappingPolicy(alertID, flapPolicy.PolicyID); err != nil {
+			log.Printf("Failed to save alert flapping policy: %v", err)
+		}
+		if s.sseHub != nil {
 			s.sseHub.Broadcast(sse.Event{
 				Type: sse.EventAlertCreated,
 				Data: sse.EventData{AlertID: alertID, IncidentID: incidentID},
 			})
 		}
 
-		// 2.5. Flapping 감지 (resolved 상태 업데이트 전에 수행)
-		isFlapping, isNewFlapping := s.detectFlapping(alert)
+		// 같은 웹훅의 이후 alert를 위해 source 후보 갱신
+		inhibition.observe(alertID, alert)
+
+		// 2.5. Flapping 감지 (resolved 상태 업데이트 전에 수행, 매칭된 flapping 정책 적용)
+		isFlapping, isNewFlapping := s.detectFlapping(alert, flapPolicy)
 
 		// 3. resolved 상태면 중복 체크 후 resolved_at 업데이트
 		if alert.Status == "resolved" {
-			// 이미 resolved된 알림인지 확인 (중복 웹훅 방지)
-			if alreadyResolved, _ := s.db.IsAlertAlreadyResolved(alert.Fingerprint, alert.EndsAt); alreadyResolved {
+			if alreadyResolved {
 				log.Printf("Skipping duplicate resolved alert (fingerprint=%s)", alert.Fingerprint)
 				continue
 			}
@@ -140,17 +378,54 @@ func (s *AlertService) ProcessWebhook(webhook model.AlertmanagerWebhook) (sent,
 
 			// Flapping 상태라면 clearance 체크 스케줄링
 			if isFlapping {
-				go s.scheduleFlappingClearanceCheck(alert.Fingerprint, alert.EndsAt)
+				s.scheduleFlappingClearanceCheck(alert.Fingerprint, alert.EndsAt, flapPolicy)
 			}
 		}
 
-		// 4. 필터링: 알림 채널로 전송할 알림인지 확인
-		if !s.shouldSendNotification(alert) {
+		// 4. 필터링: silence 규칙에 매칭되면 알림 전송/자동 분석 스킵 (DB 저장은 유지)
+		if silence != nil {
+			log.Printf("Skipping notification and analysis for silenced alert (fingerprint=%s, silence_id=%d)", alert.Fingerprint, silence.ID)
+			continue
+		}
+		// 점검 시간대에 포함되면 알림 전송/자동 분석 스킵 (in_maintenance로 저장)
+		if window != nil {
+			log.Printf("Skipping notification and analysis for alert in maintenance (fingerprint=%s, maintenance_window_id=%d)", alert.Fingerprint, window.ID)
+			continue
+		}
+		// 억제 규칙에 매칭되면 알림 전송/자동 분석 스킵 (inhibited_by에 source alert 기록)
+		if inhibitRule != nil {
+			log.Printf("Skipping notification and analysis for inhibited alert (fingerprint=%s, source_fingerprint=%s, inhibition_rule_id=%d)", alert.Fingerprint, inhibitSource.Fingerprint, inhibitRule.ID)
+			continue
+		}
+		// firing 알림이 억제되었던 alert의 resolved는 알림/자동 분석 스킵
+		if firingSuppressedBy != "" {
+			log.Printf("Skipping notification and analysis for resolved alert whose firing was suppressed (fingerprint=%s, reason=%s)", alert.Fingerprint, firingSuppressedBy)
+			continue
+		}
+		// 알림 채널로 전송할 알림인지 확인
+		if !s.shouldSendNotification(level) {
 			continue
 		}
 
+		// 4.5. 확인되지 않은 Incident 에스컬레이션 시작 (Incident당 한 번, 이미 시작되었으면 스킵)
+		if s.escalator != nil && alert.Status == "firing" && incidentID != "" {
+			if policy := matchEscalationPolicy(escalationPolicies, alert.Labels); policy != nil {
+				s.escalator.StartEscalation(context.Background(), incidentID, *policy)
+			}
+		}
+
 		// 5. 알림 채널 전송 - firing root message와 thread reply를 분리한다.
 		notificationSent := false
+		if len(outboxIDs) > 0 {
+			// 저장과 함께 기록한 entry는 worker가 전송 (flapping이면 아래 flapping 처리로 대체)
+			if !isFlapping {
+				s.outbox.Release(context.Background(), outboxIDs)
+				log.Printf("Queued alert notification (fingerprint=%s, alert_id=%s, status=%s, incident_id=%s, targets=%d)", alert.Fingerprint, alertID, alert.Status, incidentID, len(outboxIDs))
+				sent++
+				goto skipSlack
+			}
+			s.outbox.Cancel(context.Background(), outboxIDs, "alert is flapping")
+		}
 		if isNewFlapping {
 			deliveries, deliveryErr := s.db.GetAlertNotificationDeliveries(alertID)
 			if deliveryErr != nil {
@@ -170,7 +445,7 @@ func (s *AlertService) ProcessWebhook(webhook model.AlertmanagerWebhook) (sent,
 				err = s.notifyThreadEvent(client.FlappingDetectedEvent{
 					Alert:      alert,
 					IncidentID: incidentID,
-					CycleCount: s.getFlappingCycleCount(alert.Fingerprint),
+					CycleCount: s.getFlappingCycleCount(alert.Fingerprint, flapPolicy.DetectionWindowMinutes),
 				}, deliveries)
 				notificationSent = err == nil
 			}
@@ -242,8 +517,8 @@ func (s *AlertService) ProcessWebhook(webhook model.AlertmanagerWebhook) (sent,
 
 	skipSlack:
 		// 6. Agent에 비동기 분석 요청 - Flapping 중이거나 자동 분석 대상이 아니면 스킵
-		severity := alert.Labels["severity"]
-		if !isFlapping && s.shouldAutoAnalyze(severity) {
+		severity := level.Name
+		if !isFlapping && s.shouldAutoAnalyze(level) {
 			threadTS := s.analysisThreadContext(alertID, alert.Fingerprint)
 			go s.agentService.RequestAnalysis(alert, alertID, threadTS, incidentID, false)
 		} else if isFlapping {
@@ -252,63 +527,230 @@ func (s *AlertService) ProcessWebhook(webhook model.AlertmanagerWebhook) (sent,
 			log.Printf("Skipping auto-analysis for alert (fingerprint=%s, severity=%s)", alert.Fingerprint, severity)
 		}
 	}
-	return sent, failed
+	s.savePendingInhibitions(inhibition, pendingInhibitions)
+	if len(saveErrs) > 0 {
+		return sent, failed, &AlertSaveError{Alerts: failedAlerts, Err: errors.Join(saveErrs...)}
+	}
+	return sent, failed, nil
 }
 
-// getOrCreateIncident - 현재 firing 상태인 Incident를 원자적으로 조회하거나 새로 생성
-func (s *AlertService) getOrCreateIncident(alert model.Alert) (string, error) {
-	severity := "TBD"
-	incidentID, created, err := s.db.GetOrCreateFiringIncident("Ongoing", severity, alert.StartsAt)
-	if err != nil {
-		return "", err
+// preparedAlert - severity 정규화/enrichment가 적용된 웹훅 alert (received는 수신 원본, 저장 실패 시 재시도용)
+type preparedAlert struct {
+	received model.Alert
+	alert    model.Alert
+	level    model.SeverityLevel
+}
+
+// pendingInhibition - 같은 웹훅에서 나중에 저장된 source에 억제된 alert (source alert_id를 루프 후 기록)
+type pendingInhibition struct {
+	alertID           string
+	sourceFingerprint string
+	ruleID            int64
+}
+
+// savePendingInhibitions - 웹훅 처리 후 source alert_id가 정해진 억제 관계 기록
+func (s *AlertService) savePendingInhibitions(inhibition *inhibitionState, pending []pendingInhibition) {
+	for _, p := range pending {
+		sourceID := inhibition.sourceAlertID(p.sourceFingerprint)
+		if sourceID == "" {
+			log.Printf("Skipping inhibition record, source alert was not saved (alert_id=%s, source_fingerprint=%s)", p.alertID, p.sourceFingerprint)
+			continue
+		}
+		ruleID := p.ruleID
+		if err := s.db.UpdateAlertInhibition(p.alertID, &sourceID, &ruleID); err != nil {
+			log.Printf("Failed to save alert inhibition: %v", err)
+		}
 	}
+}
 
-	if created {
-		log.Printf("Created new incident: %s (triggered by alert: %s)", incidentID, alert.Fingerprint)
-		if s.sseHub != nil {
-			s.sseHub.Broadcast(sse.Event{
-				Type: sse.EventIncidentCreated,
-				Data: sse.EventData{IncidentID: incidentID},
-			})
+// planOutboxNotification - alert 저장 트랜잭션에 함께 기록할 알림 entry 계산
+// outbox가 설정되지 않았거나 대상을 미리 정할 수 없으면 nil (저장 후 notifier로 바로 전송하는 경로)
+//   - firing: 라우팅된 대상별 root 메시지
+//   - resolved: 현재 firing alert의 스레드 delivery에 답글 (firing alert가 없으면 저장 후 legacy 스레드 복구 경로)
+func (s *AlertService) planOutboxNotification(alert model.Alert, incidentID string) []model.NotificationOutboxEntry {
+	if s.outbox == nil || s.planner == nil {
+		return nil
+	}
+	event := client.AlertStatusChangedEvent{Alert: alert, IncidentID: incidentID}
+
+	var targets []client.OutboxTarget
+	if alert.Status == "resolved" {
+		firingAlertID, err := s.db.GetFiringAlertByFingerprint(alert.Fingerprint)
+		if err != nil {
+			if !db.IsNoRows(err) {
+				log.Printf("Failed to load firing alert for notification outbox (fingerprint=%s): %v", alert.Fingerprint, err)
+			}
+			return nil
+		}
+		deliveries, err := s.db.GetAlertNotificationDeliveries(firingAlertID)
+		if err == nil && len(deliveries) == 0 {
+			deliveries, err = s.recoverLegacyDeliveries(firingAlertID, alert.Fingerprint, incidentID, alert.Status, s.analysisThreadContext(firingAlertID, alert.Fingerprint))
+		}
+		if err != nil {
+			log.Printf("Failed to load notification deliveries for outbox (alert_id=%s): %v", firingAlertID, err)
+			return nil
 		}
+		targets = s.planner.PlanThreadOutboxTargets(event, deliveries)
 	} else {
-		// 기존 incident severity 업데이트
-		alertSeverity := alert.Labels["severity"]
-		if alertSeverity != "" {
-			_ = s.db.UpdateIncidentSeverity(incidentID, alertSeverity)
+		var err error
+		targets, err = s.planner.PlanOutboxTargets(event)
+		if err != nil {
+			log.Printf("Failed to plan notification outbox targets (fingerprint=%s): %v", alert.Fingerprint, err)
+			return nil
 		}
 	}
+	if len(targets) == 0 {
+		return nil
+	}
 
-	return incidentID, nil
+	entries, err := s.outbox.PrepareEntries(event, targets)
+	if err != nil {
+		log.Printf("Failed to prepare notification outbox entries (fingerprint=%s): %v", alert.Fingerprint, err)
+		return nil
+	}
+	return entries
 }
 
-// shouldProcess - DB 저장 및 처리 여부 결정 (none 등은 완전 무시)
-func (s *AlertService) shouldProcess(alert model.Alert) bool {
-	severity := alert.Labels["severity"]
-	return severity == "warning" || severity == "critical" || severity == "info"
+// getOrCreateIncident - 상관관계 규칙으로 Incident를 매칭하거나 새로 생성하고 severity 갱신
+// Incident severity는 분류 체계 rank가 더 높은 firing alert가 들어올 때만 올라감 (새 Incident는 rank 기록)
+func (s *AlertService) getOrCreateIncident(webhook model.AlertmanagerWebhook, alert model.Alert, level model.SeverityLevel) (model.IncidentMatch, error) {
+	match, err := s.correlateIncident(webhook, alert)
+	if err != nil {
+		return match, err
+	}
+
+	if match.IncidentID != "" && alert.Status == "firing" {
+		if err := s.db.EscalateIncidentSeverity(match.IncidentID, level.Name, level.Rank); err != nil {
+			log.Printf("Failed to escalate incident severity (incident_id=%s): %v", match.IncidentID, err)
+		}
+	}
+
+	return match, nil
+}
+
+// enrichmentPipeline - alert 보강 파이프라인 (app_settings "enrichment", 없으면 비활성)
+func (s *AlertService) enrichmentPipeline() enrichmentPipeline {
+	if s.appSettings != nil {
+		return newEnrichmentPipeline(s.appSettings.GetEnrichmentSettings())
+	}
+	return enrichmentPipeline{}
 }
 
-// 필터링 로직 예시:
-//   - severity가 warning 이상만 전송
-//   - 특정 namespace 제외 (예: kube-system)
-//   - 특정 alertname만 전송
+// severityTaxonomy - severity 분류 체계 (app_settings "severity", 없으면 기본값)
+func (s *AlertService) severityTaxonomy() model.SeveritySettings {
+	if s.appSettings != nil {
+		return s.appSettings.GetSeveritySettings()
+	}
+	return DefaultSeveritySettings()
+}
+
+// activeSilences - 현재 유효한 silence 규칙 조회 (조회 실패 시 음소거 없이 처리)
+func (s *AlertService) activeSilences() []model.SilenceRule {
+	rules, err := s.db.ListActiveSilenceRules(time.Now())
+	if err != nil {
+		log.Printf("Failed to load silence rules: %v", err)
+		return nil
+	}
+	return rules
+}
+
+// activeMaintenance - 현재 진행 중인 점검 시간대 조회 (조회 실패 시 점검 없이 처리)
+func (s *AlertService) activeMaintenance() []model.MaintenanceWindow {
+	windows, err := s.db.ListEnabledMaintenanceWindows()
+	if err != nil {
+		log.Printf("Failed to load maintenance windows: %v", err)
+		return nil
+	}
+	return activeMaintenanceWindows(windows, time.Now())
+}
+
+// loadServices - 서비스 카탈로그 조회 (조회 실패 시 서비스 매칭 없이 처리)
+func (s *AlertService) loadServices() []model.Service {
+	services, err := s.db.ListServicesForMatching()
+	if err != nil {
+		log.Printf("Failed to load service catalog: %v", err)
+		return nil
+	}
+	return services
+}
+
+// loadEscalationPolicies - 활성화된 에스컬레이션 정책 (에스컬레이션 미설정 또는 조회 실패 시 nil)
+func (s *AlertService) loadEscalationPolicies() []model.EscalationPolicy {
+	if s.escalator == nil {
+		return nil
+	}
+	policies, err := s.db.ListEnabledEscalationPolicies()
+	if err != nil {
+		log.Printf("Failed to load escalation policies: %v", err)
+		return nil
+	}
+	return policies
+}
+
+// loadFlappingPolicies - 활성화된 flapping 정책 (조회 실패 시 전역 설정만 사용)
+func (s *AlertService) loadFlappingPolicies() []model.FlappingPolicy {
+	policies, err := s.db.ListEnabledFlappingPolicies()
+	if err != nil {
+		log.Printf("Failed to load flapping policies: %v", err)
+		return nil
+	}
+	return policies
+}
+
+// loadInhibition - 활성화된 억제 규칙과 firing alert 조회 (규칙이 없거나 조회 실패 시 억제 없이 처리)
+func (s *AlertService) loadInhibition() *inhibitionState {
+	rules, err := s.db.ListEnabledInhibitionRules()
+	if err != nil {
+		log.Printf("Failed to load inhibition rules: %v", err)
+		return nil
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+	sources, err := s.db.ListFiringInhibitionSources()
+	if err != nil {
+		log.Printf("Failed to load firing alerts for inhibition: %v", err)
+		return nil
+	}
+	return &inhibitionState{rules: rules, sources: sources}
+}
+
+// firingSuppression - 저장 전 firing alert의 알림 억제 이유 (firing alert가 없거나 조회 실패 시 빈 문자열)
+func (s *AlertService) firingSuppression(fingerprint string) string {
+	reason, err := s.db.GetFiringAlertSuppression(fingerprint)
+	if err != nil {
+		if !db.IsNoRows(err) {
+			log.Printf("Failed to load firing alert suppression (fingerprint=%s): %v", fingerprint, err)
+		}
+		return ""
+	}
+	return reason
+}
+
+// WouldIngest - 현재 severity 분류 기준으로 저장·처리 대상인 alert인지 (Alertmanager 동기화 복구 대상 판단)
+func (s *AlertService) WouldIngest(labels map[string]string) bool {
+	level, ok := resolveSeverity(s.severityTaxonomy(), labels["severity"])
+	return s.shouldProcess(level, ok)
+}
+
+// shouldProcess - DB 저장 및 처리 여부 결정 (매핑되지 않거나 store=false인 레벨은 완전 무시)
+func (s *AlertService) shouldProcess(level model.SeverityLevel, resolved bool) bool {
+	return resolved && level.Store
+}
+
+// shouldSendNotification - 알림 채널 전송 여부 (분류 체계의 notify 플래그)
 //
 // Returns:
 //   - bool: true면 알림 채널로 전송, false면 무시
-func (s *AlertService) shouldSendNotification(alert model.Alert) bool {
-	// warning, critical, info 전송 (none 등 필터링)
-	severity := alert.Labels["severity"]
-	if severity == "warning" || severity == "critical" || severity == "info" {
-		return true
-	}
-	return false
+func (s *AlertService) shouldSendNotification(level model.SeverityLevel) bool {
+	return level.Notify
 }
 
 // detectFlapping - Alert flapping 감지
 // Returns: (isFlapping, isNewFlapping)
-func (s *AlertService) detectFlapping(alert model.Alert) (bool, bool) {
-	// Flapping 기능이 비활성화된 경우 스킵
-	if !s.getFlappingEnabled() {
+func (s *AlertService) detectFlapping(alert model.Alert, policy model.EffectiveFlappingPolicy) (bool, bool) {
+	// Flapping 기능이 비활성화되었거나 never_flapping 정책이면 스킵
+	if !policy.Enabled {
 		return false, false
 	}
 
@@ -340,9 +782,9 @@ func (s *AlertService) detectFlapping(alert model.Alert) (bool, bool) {
 		return s.db.IsAlertFlapping(alert.Fingerprint), false
 	}
 
-	// Flapping 윈도우 및 threshold 조회
-	windowMinutes := s.getFlappingWindowMinutes()
-	threshold := s.getFlappingThreshold()
+	// Flapping 윈도우 및 threshold (정책 값, 없으면 전역 설정)
+	windowMinutes := policy.DetectionWindowMinutes
+	threshold := policy.CycleThreshold
 
 	// 윈도우 내 사이클 수 계산
 	cycleCount, windowStart, err := s.db.CountFlappingCycles(alert.Fingerprint, windowMinutes)
@@ -360,7 +802,7 @@ func (s *AlertService) detectFlapping(alert model.Alert) (bool, bool) {
 		if err := s.db.MarkAlertAsFlapping(alert.Fingerprint, true, cycleCount, windowStart); err != nil {
 			log.Printf("Failed to mark alert as flapping: %v", err)
 		}
-		log.Printf("Flapping detected for alert %s (cycles=%d, threshold=%d)", alert.Fingerprint, cycleCount, threshold)
+		log.Printf("Flapping detected for alert %s (cycles=%d, threshold=%d, policy=%s)", alert.Fingerprint, cycleCount, threshold, flappingPolicyLabel(policy))
 		return true, true
 	} else if isNowFlapping {
 		// 이미 Flapping 중, cycle count 업데이트
@@ -371,100 +813,156 @@ func (s *AlertService) detectFlapping(alert model.Alert) (bool, bool) {
 	return false, false
 }
 
-// scheduleFlappingClearanceCheck - Flapping 해제 체크 스케줄링
-func (s *AlertService) scheduleFlappingClearanceCheck(fingerprint string, resolvedAt time.Time) {
-	clearanceMinutes := s.getFlappingClearanceMinutes()
-	checkTime := resolvedAt.Add(time.Duration(clearanceMinutes) * time.Minute)
+// scheduleFlappingClearanceCheck - Flapping 해제 체크 예약 (scheduled_jobs에 저장되어 재시작 후에도 실행)
+// fingerprint당 pending 작업은 하나이므로 다시 resolved되면 실행 시각이 뒤로 밀린다.
+func (s *AlertService) scheduleFlappingClearanceCheck(fingerprint string, resolvedAt time.Time, policy model.EffectiveFlappingPolicy) {
+	if s.jobs == nil {
+		log.Printf("Skipping flapping clearance check, job scheduler not configured (fingerprint=%s)", fingerprint)
+		return
+	}
+	runAt := resolvedAt.Add(time.Duration(policy.ClearanceWindowMinutes) * time.Minute)
+	payload := model.FlappingClearancePayload{Fingerprint: fingerprint, ResolvedAt: resolvedAt, PolicyID: policy.PolicyID}
+	if _, err := s.jobs.Schedule(context.Background(), model.ScheduledJobTypeFlappingClearance, fingerprint, runAt, payload); err != nil {
+		log.Printf("Failed to schedule flapping clearance check (fingerprint=%s): %v", fingerprint, err)
+	}
+}
+
+// ScheduleMissingFlappingClearances - 예약 작업 없이 flapping 상태로 남은 resolved alert의 clearance 체크 예약
+// (scheduled_jobs 도입 전 메모리 타이머가 재시작으로 유실된 alert 복구용, 시작 시 1회 호출)
+func (s *AlertService) ScheduleMissingFlappingClearances() {
+	candidates, err := s.db.ListFlappingClearanceCandidates()
+	if err != nil {
+		log.Printf("Failed to list flapping clearance candidates: %v", err)
+		return
+	}
+	// 라벨 정보가 없으므로 전역 clearance 시간 사용
+	global := effectiveFlappingPolicy(s.flappingConfig(), nil)
+	for _, c := range candidates {
+		s.scheduleFlappingClearanceCheck(c.Fingerprint, c.ResolvedAt, global)
+	}
+	if len(candidates) > 0 {
+		log.Printf("Scheduled flapping clearance checks for %d resolved flapping alerts", len(candidates))
+	}
+}
 
-	// Clearance 시간까지 대기
-	time.Sleep(time.Until(checkTime))
+// RunFlappingClearanceCheck - 예약된 flapping 해제 체크 실행 (scheduled job handler)
+// DB 오류는 error로 반환하여 재시도하고, 해제 조건 불충족은 완료로 처리한다.
+func (s *AlertService) RunFlappingClearanceCheck(_ context.Context, job model.ScheduledJob) error {
+	var payload model.FlappingClearancePayload
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return fmt.Errorf("failed to decode flapping clearance payload: %w", err)
+	}
+	fingerprint := payload.Fingerprint
+	resolvedAt := payload.ResolvedAt
 
 	// Alert 상태 재확인 (fingerprint 기준 최신)
 	alert, err := s.db.GetLatestAlertByFingerprint(fingerprint)
 	if err != nil {
-		log.Printf("Failed to get alert for flapping clearance check: %v", err)
-		return
+		return fmt.Errorf("failed to get alert for flapping clearance check: %w", err)
 	}
 
 	// 이미 Flapping이 아니면 종료
-	if !alert.IsFlapping {
-		return
+	if alert == nil || !alert.IsFlapping {
+		return nil
 	}
 
 	// Alert가 다시 firing되었거나 resolved 시각이 변경되었으면 clearance 취소
 	if alert.Status == "firing" || (alert.ResolvedAt != nil && alert.ResolvedAt.Before(resolvedAt)) {
 		log.Printf("Alert re-fired, not clearing flapping status (fingerprint=%s)", fingerprint)
-		return
+		return nil
 	}
 
 	// resolvedAt 이후 새로운 전환이 있었는지 확인
 	hasNewTransitions, err := s.db.HasTransitionsSince(fingerprint, resolvedAt)
-	if err != nil || hasNewTransitions {
+	if err != nil {
+		return fmt.Errorf("failed to check transitions for flapping clearance: %w", err)
+	}
+	if hasNewTransitions {
 		log.Printf("New transitions detected, not clearing flapping status (fingerprint=%s)", fingerprint)
-		return
+		return nil
 	}
 
 	// Flapping 해제
-	log.Printf("Clearing flapping status after %d min stability (fingerprint=%s)", clearanceMinutes, fingerprint)
+	log.Printf("Clearing flapping status after stability window (fingerprint=%s, resolved_at=%s)", fingerprint, resolvedAt.Format(time.RFC3339))
+	return s.clearFlapping(alert)
+}
+
+// ClearFlapping - alert의 flapping 상태 수동 해제 (pending clearance 작업 취소 + 해제 알림)
+func (s *AlertService) ClearFlapping(ctx context.Context, alertID string) (*model.AlertDetailResponse, int64, error) {
+	alert, err := s.db.GetAlertDetail(alertID)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return nil, 0, ErrAlertNotFound
+		}
+		return nil, 0, fmt.Errorf("failed to load alert: %w", err)
+	}
+	if !alert.IsFlapping && !s.db.IsAlertFlapping(alert.Fingerprint) {
+		return nil, 0, fmt.Errorf("%w: %s", ErrAlertNotFlapping, alertID)
+	}
+
+	var cancelled int64
+	if s.jobs != nil {
+		cancelled, err = s.jobs.Cancel(ctx, model.ScheduledJobTypeFlappingClearance, alert.Fingerprint)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	log.Printf("Clearing flapping status manually (alert_id=%s, fingerprint=%s, cancelled_jobs=%d)", alertID, alert.Fingerprint, cancelled)
+	if err := s.clearFlapping(alert); err != nil {
+		return nil, 0, err
+	}
+	return alert, cancelled, nil
+}
+
+// clearFlapping - flapping 상태 해제 후 기존 알림 스레드에 해제 메시지 전송
+func (s *AlertService) clearFlapping(alert *model.AlertDetailResponse) error {
+	fingerprint := alert.Fingerprint
 	if err := s.db.MarkAlertAsFlapping(fingerprint, false, 0, time.Time{}); err != nil {
-		log.Printf("Failed to clear flapping status: %v", err)
-		return
+		return fmt.Errorf("failed to clear flapping status: %w", err)
 	}
 
-	// Slack에 Flapping 해제 메시지 전송
+	// Slack에 Flapping 해제 메시지 전송 (전송 실패는 재시도하지 않음)
 	deliveries, err := s.db.GetAlertNotificationDeliveries(alert.AlertID)
 	if err != nil {
 		log.Printf("Failed to load deliveries for flapping cleared notification: %v", err)
-		return
+		return nil
 	}
 	if len(deliveries) == 0 {
 		deliveries, err = s.recoverLegacyDeliveries(alert.AlertID, fingerprint, ptrToString(alert.IncidentID), alert.Status, alert.ThreadTS)
 		if err != nil {
 			log.Printf("Failed to recover legacy deliveries for flapping cleared notification: %v", err)
-			return
+			return nil
 		}
 	}
 	if len(deliveries) == 0 {
 		log.Printf("Skipping flapping cleared notification (alert_id=%s, fingerprint=%s, skip_reason=no_active_delivery)", alert.AlertID, fingerprint)
-		return
+		return nil
 	}
 	if err := s.notifyThreadEvent(client.FlappingClearedEvent{Fingerprint: fingerprint}, deliveries); err != nil {
 		log.Printf("Failed to send flapping cleared notification: %v", err)
 	}
+	return nil
 }
 
-// Configuration helper methods - DB 동적 조회 + ENV fallback
-func (s *AlertService) getFlappingEnabled() bool {
-	if s.appSettings != nil {
-		return s.appSettings.GetEffectiveFlappingConfig().Enabled
-	}
-	return s.envFlapping.Enabled
-}
-
-func (s *AlertService) getFlappingWindowMinutes() int {
+// flappingConfig - 전역 flapping 설정 (DB 동적 조회 + ENV fallback)
+func (s *AlertService) flappingConfig() config.FlappingConfig {
 	if s.appSettings != nil {
-		return s.appSettings.GetEffectiveFlappingConfig().DetectionWindowMinutes
+		return s.appSettings.GetEffectiveFlappingConfig()
 	}
-	return s.envFlapping.DetectionWindowMinutes
+	return s.envFlapping
 }
 
-func (s *AlertService) getFlappingThreshold() int {
-	if s.appSettings != nil {
-		return s.appSettings.GetEffectiveFlappingConfig().CycleThreshold
-	}
-	return s.envFlapping.CycleThreshold
+func (s *AlertService) getFlappingCycleCount(fingerprint string, windowMinutes int) int {
+	count, _, _ := s.db.CountFlappingCycles(fingerprint, windowMinutes)
+	return count
 }
 
-func (s *AlertService) getFlappingClearanceMinutes() int {
-	if s.appSettings != nil {
-		return s.appSettings.GetEffectiveFlappingConfig().ClearanceWindowMinutes
+// flappingPolicyLabel - 로그용 정책 이름 (전역 설정이면 global)
+func flappingPolicyLabel(policy model.EffectiveFlappingPolicy) string {
+	if policy.PolicyID == nil {
+		return "global"
 	}
-	return s.envFlapping.ClearanceWindowMinutes
-}
-
-func (s *AlertService) getFlappingCycleCount(fingerprint string) int {
-	count, _, _ := s.db.CountFlappingCycles(fingerprint, s.getFlappingWindowMinutes())
-	return count
+	return fmt.Sprintf("%s(id=%d)", policy.PolicyName, *policy.PolicyID)
 }
 
 func (s *AlertService) notifyRootWithReceipts(event client.AlertStatusChangedEvent) ([]client.NotificationDeliveryReceipt, error) {
@@ -498,7 +996,12 @@ func (s *AlertService) persistDeliveries(alertID, fingerprint, incidentID, statu
 	if len(receipts) == 0 {
 		return nil
 	}
+	return s.db.UpsertAlertNotificationDeliveries(notificationDeliveriesFromReceipts(alertID, fingerprint, incidentID, status, receipts))
+}
 
+// notificationDeliveriesFromReceipts - Slack root 전송 receipt를 delivery 행으로 변환 (스레드가 없는 receipt 제외)
+// AlertService와 NotificationOutboxService(root 재전송)가 함께 사용한다.
+func notificationDeliveriesFromReceipts(alertID, fingerprint, incidentID, status string, receipts []client.NotificationDeliveryReceipt) []model.AlertNotificationDelivery {
 	var incidentIDPtr *string
 	if incidentID != "" {
 		incidentIDPtr = &incidentID
@@ -523,8 +1026,7 @@ func (s *AlertService) persistDeliveries(alertID, fingerprint, incidentID, statu
 			IsActive:        true,
 		})
 	}
-
-	return s.db.UpsertAlertNotificationDeliveries(deliveries)
+	return deliveries
 }
 
 func (s *AlertService) analysisThreadContext(alertID, fingerprint string) string {
@@ -595,10 +1097,14 @@ func ptrToString(v *string) string {
 	return *v
 }
 
-// shouldAutoAnalyze - 주어진 severity의 alert를 자동 분석해야 하는지 판단
-func (s *AlertService) shouldAutoAnalyze(severity string) bool {
+// shouldAutoAnalyze - 주어진 severity 레벨의 alert를 자동 분석해야 하는지 판단
+// 분류 체계의 autoAnalyze와 analysis 설정을 모두 만족해야 자동 분석
+func (s *AlertService) shouldAutoAnalyze(level model.SeverityLevel) bool {
+	if !level.AutoAnalyze {
+		return false
+	}
 	if s.appSettings != nil {
-		return s.appSettings.ShouldAutoAnalyze(severity)
+		return s.appSettings.ShouldAutoAnalyze(level.Name)
 	}
 	return true
 }
@@ -0,0 +1,69 @@
+// Alertmanager HA 복제본 중복 수신 제거
+//
+// HA 구성의 Alertmanager는 같은 알림을 모든 peer에서 전송한다.
+// 동시에 도착한 firing 중복이 flapping 감지/RecordStateTransition을 두 번 타지 않도록
+// alert마다 멱등 키(fingerprint + status + startsAt/endsAt)를 DB에서 원자적으로 점유한다.
+//
+// 처리 흐름:
+//  1. IngestWebhook이 severity 분류 직후 alertIngestKey로 키 생성
+//  2. ClaimAlertIngestKey(INSERT ... ON CONFLICT)로 점유 - 실패하면 중복으로 보고 부작용 없이 스킵
+//  3. Alert 저장 실패 시 키를 해제하여 webhook inbox 재시도가 처리되도록 함
+//  4. dedupe window가 지난 키는 웹훅 처리 시 주기적으로 정리
+
+package service
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// alertIngestKey - 멱등 키 생성 (firing: startsAt, resolved: startsAt + endsAt)
+func alertIngestKey(alert model.Alert) string {
+	key := fmt.Sprintf("%s:%s:%d", alert.Fingerprint, alert.Status, alert.StartsAt.UTC().UnixNano())
+	if alert.Status == "resolved" {
+		key += fmt.Sprintf(":%d", alert.EndsAt.UTC().UnixNano())
+	}
+	return key
+}
+
+// claimIngestKey - 멱등 키 점유 (비활성이거나 DB 오류 시에는 처리 진행)
+func (s *AlertService) claimIngestKey(key string) bool {
+	if s.dedupeWindow <= 0 {
+		return true
+	}
+	claimed, err := s.db.ClaimAlertIngestKey(key, s.dedupeWindow)
+	if err != nil {
+		// 키 점유 실패로 알림이 유실되지 않도록 기존 중복 방지 로직(firing unique index 등)에 맡김
+		log.Printf("Failed to claim alert ingest key (key=%s): %v", key, err)
+		return true
+	}
+	return claimed
+}
+
+// releaseIngestKey - 멱등 키 해제
+func (s *AlertService) releaseIngestKey(key string) {
+	if s.dedupeWindow <= 0 {
+		return
+	}
+	if err := s.db.ReleaseAlertIngestKey(key); err != nil {
+		log.Printf("Failed to release alert ingest key (key=%s): %v", key, err)
+	}
+}
+
+// purgeIngestKeys - window가 지난 멱등 키 정리 (window 주기로 최대 1회)
+func (s *AlertService) purgeIngestKeys() {
+	if s.dedupeWindow <= 0 {
+		return
+	}
+	now := time.Now()
+	last := s.lastKeysPurged.Load()
+	if now.Unix()-last < int64(s.dedupeWindow.Seconds()) || !s.lastKeysPurged.CompareAndSwap(last, now.Unix()) {
+		return
+	}
+	if _, err := s.db.PurgeAlertIngestKeys(now.Add(-s.dedupeWindow)); err != nil {
+		log.Printf("Failed to purge alert ingest keys: %v", err)
+	}
+}
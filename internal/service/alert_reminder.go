@@ -0,0 +1,183 @@
+// 장시간 firing alert 재알림 로직
+//
+// 처리 흐름:
+//  1. ticker가 PollIntervalSecs마다 재알림 후보 조회 (firing + 알림 전송 이력 있음 + silence/점검/억제/flapping 아님 + 미확인)
+//  2. severity 분류 체계로 레벨을 찾아 notify가 true이고 reminderMinutes > 0인 레벨만 대상
+//  3. 마지막 재알림(없으면 fired_at) 이후 reminderMinutes가 지났는지 확인
+//  4. 이후에 생성된 silence 규칙에 매칭되면 스킵
+//  5. ClaimAlertReminder로 점유 (여러 replica 중 하나만 전송) → 저장된 delivery 스레드에 AlertReminderEvent 전송
+//
+// alert가 resolved되거나 확인(acknowledge)되면 후보에서 빠지므로 재알림이 멈춘다.
+
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/kube-rca/backend/internal/client"
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// alertReminderStore - AlertReminderService가 사용하는 DB 인터페이스
+type alertReminderStore interface {
+	ListAlertReminderCandidates(at time.Time) ([]model.AlertReminderCandidate, error)
+	ClaimAlertReminder(alertID string, at, dueBefore time.Time) (bool, error)
+	ListActiveSilenceRules(at time.Time) ([]model.SilenceRule, error)
+	GetAlertNotificationDeliveries(alertID string) ([]model.AlertNotificationDelivery, error)
+}
+
+// threadEventNotifier - 저장된 delivery 스레드로 이벤트를 전송하는 notifier
+type threadEventNotifier interface {
+	NotifyThreadEvent(event client.NotifierEvent, deliveries []model.AlertNotificationDelivery) error
+}
+
+// reminderSettings - 재알림 판단에 필요한 동적 설정 (AppSettingsService)
+type reminderSettings interface {
+	IsNotificationEnabled() bool
+	GetSeveritySettings() model.SeveritySettings
+}
+
+// AlertReminderService - 장시간 firing alert 재알림 서비스
+type AlertReminderService struct {
+	store    alertReminderStore
+	notifier threadEventNotifier
+	settings reminderSettings
+	cfg      config.AlertReminderConfig
+	now      func() time.Time
+}
+
+func NewAlertReminderService(store alertReminderStore, notifier threadEventNotifier, settings reminderSettings, cfg config.AlertReminderConfig) *AlertReminderService {
+	return &AlertReminderService{
+		store:    store,
+		notifier: notifier,
+		settings: settings,
+		cfg:      cfg,
+		now:      time.Now,
+	}
+}
+
+// Start - 재알림 ticker 시작 (PollIntervalSecs <= 0이면 비활성)
+func (s *AlertReminderService) Start(ctx context.Context) {
+	if s.cfg.PollIntervalSecs <= 0 {
+		log.Println("Alert reminders disabled")
+		return
+	}
+	interval := time.Duration(s.cfg.PollIntervalSecs) * time.Second
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := s.RunOnce(); err != nil {
+					log.Printf("Alert reminder run failed: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// RunOnce - 기한이 지난 재알림 전송 (반환: 전송 수)
+func (s *AlertReminderService) RunOnce() (int, error) {
+	if s.settings != nil && !s.settings.IsNotificationEnabled() {
+		return 0, nil
+	}
+	severity := DefaultSeveritySettings()
+	if s.settings != nil {
+		severity = s.settings.GetSeveritySettings()
+	}
+	if !hasReminderLevel(severity) {
+		return 0, nil
+	}
+
+	now := s.now()
+	candidates, err := s.store.ListAlertReminderCandidates(now)
+	if err != nil {
+		return 0, err
+	}
+	if len(candidates) == 0 {
+		return 0, nil
+	}
+	silences, err := s.store.ListActiveSilenceRules(now)
+	if err != nil {
+		log.Printf("Failed to load silence rules for reminders: %v", err)
+	}
+
+	sent := 0
+	for _, c := range candidates {
+		level, ok := resolveSeverity(severity, c.Severity)
+		if !ok || !level.Notify || level.ReminderMinutes <= 0 {
+			continue
+		}
+		interval := time.Duration(level.ReminderMinutes) * time.Minute
+		last := c.FiredAt
+		if c.LastReminderAt != nil {
+			last = *c.LastReminderAt
+		}
+		if now.Sub(last) < interval {
+			continue
+		}
+		if rule := matchSilence(silences, c.Labels); rule != nil {
+			continue
+		}
+
+		claimed, err := s.store.ClaimAlertReminder(c.AlertID, now, now.Add(-interval))
+		if err != nil {
+			log.Printf("Failed to claim alert reminder (alert_id=%s): %v", c.AlertID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		if s.sendReminder(c, now) {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+// sendReminder - delivery 스레드에 재알림 전송
+func (s *AlertReminderService) sendReminder(c model.AlertReminderCandidate, now time.Time) bool {
+	deliveries, err := s.store.GetAlertNotificationDeliveries(c.AlertID)
+	if err != nil {
+		log.Printf("Failed to load deliveries for reminder (alert_id=%s): %v", c.AlertID, err)
+		return false
+	}
+	if len(deliveries) == 0 {
+		return false
+	}
+
+	event := client.AlertReminderEvent{
+		Alert: model.Alert{
+			Status:      "firing",
+			Labels:      c.Labels,
+			StartsAt:    c.FiredAt,
+			Fingerprint: c.Fingerprint,
+		},
+		AlertID:       c.AlertID,
+		IncidentID:    c.IncidentID,
+		FiringFor:     now.Sub(c.FiredAt),
+		ReminderCount: c.ReminderCount + 1,
+	}
+	if err := s.notifier.NotifyThreadEvent(event, deliveries); err != nil {
+		log.Printf("Failed to send alert reminder (alert_id=%s): %v", c.AlertID, err)
+		return false
+	}
+	log.Printf("Sent alert reminder (alert_id=%s, severity=%s, firing_for=%s, count=%d)", c.AlertID, c.Severity, event.FiringFor.Round(time.Minute), event.ReminderCount)
+	return true
+}
+
+// hasReminderLevel - 재알림이 설정된 레벨이 하나라도 있는지
+func hasReminderLevel(settings model.SeveritySettings) bool {
+	for _, level := range settings.Levels {
+		if level.Notify && level.ReminderMinutes > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kube-rca/backend/internal/client"
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// ============================================================================
+// Mock: alertReminderStore / threadEventNotifier / reminderSettings
+// ============================================================================
+
+type alertReminderStoreMock struct {
+	candidates []model.AlertReminderCandidate
+	silences   []model.SilenceRule
+	claimed    map[string]int
+	refuse     map[string]bool // 다른 replica가 먼저 점유한 alert
+}
+
+func (m *alertReminderStoreMock) ListAlertReminderCandidates(_ time.Time) ([]model.AlertReminderCandidate, error) {
+	return m.candidates, nil
+}
+
+func (m *alertReminderStoreMock) ClaimAlertReminder(alertID string, _, _ time.Time) (bool, error) {
+	if m.refuse[alertID] {
+		return false, nil
+	}
+	if m.claimed == nil {
+		m.claimed = make(map[string]int)
+	}
+	m.claimed[alertID]++
+	return true, nil
+}
+
+func (m *alertReminderStoreMock) ListActiveSilenceRules(_ time.Time) ([]model.SilenceRule, error) {
+	return m.silences, nil
+}
+
+func (m *alertReminderStoreMock) GetAlertNotificationDeliveries(alertID string) ([]model.AlertNotificationDelivery, error) {
+	return []model.AlertNotificationDelivery{{AlertID: alertID, NotifierType: "slack", ChannelID: "C-test", ThreadTS: "ts-" + alertID}}, nil
+}
+
+type threadEventNotifierMock struct {
+	events []client.AlertReminderEvent
+}
+
+func (m *threadEventNotifierMock) NotifyThreadEvent(event client.NotifierEvent, _ []model.AlertNotificationDelivery) error {
+	m.events = append(m.events, event.(client.AlertReminderEvent))
+	return nil
+}
+
+type reminderSettingsStub struct {
+	disabled bool
+	severity model.SeveritySettings
+}
+
+func (s reminderSettingsStub) IsNotificationEnabled() bool { return !s.disabled }
+
+func (s reminderSettingsStub) GetSeveritySettings() model.SeveritySettings { return s.severity }
+
+func reminderSeveritySettings() model.SeveritySettings {
+	settings := DefaultSeveritySettings()
+	for i := range settings.Levels {
+		if settings.Levels[i].Name == "critical" {
+			settings.Levels[i].ReminderMinutes = 60
+		}
+	}
+	return settings
+}
+
+func newTestAlertReminderService(store *alertReminderStoreMock, notifier *threadEventNotifierMock, settings reminderSettingsStub, now time.Time) *AlertReminderService {
+	svc := NewAlertReminderService(store, notifier, settings, config.AlertReminderConfig{PollIntervalSecs: 60})
+	svc.now = func() time.Time { return now }
+	return svc
+}
+
+// ============================================================================
+// Tests
+// ============================================================================
+
+func TestAlertReminder_SendsDueRemindersPerSeverity(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	lastReminder := now.Add(-30 * time.Minute)
+	store := &alertReminderStoreMock{candidates: []model.AlertReminderCandidate{
+		{AlertID: "ALR-due", Severity: "critical", Labels: map[string]string{"alertname": "Down"}, FiredAt: now.Add(-90 * time.Minute)},
+		{AlertID: "ALR-recent", Severity: "critical", Labels: map[string]string{"alertname": "Down"}, FiredAt: now.Add(-3 * time.Hour), LastReminderAt: &lastReminder, ReminderCount: 2},
+		{AlertID: "ALR-warning", Severity: "warning", Labels: map[string]string{"alertname": "Slow"}, FiredAt: now.Add(-5 * time.Hour)},
+	}}
+	notifier := &threadEventNotifierMock{}
+	svc := newTestAlertReminderService(store, notifier, reminderSettingsStub{severity: reminderSeveritySettings()}, now)
+
+	sent, err := svc.RunOnce()
+	if err != nil {
+		t.Fatalf("RunOnce() error = %v", err)
+	}
+	if sent != 1 || len(notifier.events) != 1 {
+		t.Fatalf("sent = %d, events = %d; want only ALR-due reminded", sent, len(notifier.events))
+	}
+	event := notifier.events[0]
+	if event.AlertID != "ALR-due" || event.FiringFor != 90*time.Minute || event.ReminderCount != 1 {
+		t.Fatalf("event = %+v; want ALR-due firing for 90m, reminder 1", event)
+	}
+}
+
+func TestAlertReminder_SkipsSilencedAndClaimedAlerts(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := &alertReminderStoreMock{
+		candidates: []model.AlertReminderCandidate{
+			{AlertID: "ALR-silenced", Severity: "critical", Labels: map[string]string{"alertname": "Down", "namespace": "batch"}, FiredAt: now.Add(-2 * time.Hour)},
+			{AlertID: "ALR-other-replica", Severity: "critical", Labels: map[string]string{"alertname": "Down"}, FiredAt: now.Add(-2 * time.Hour)},
+		},
+		silences: []model.SilenceRule{{ID: 1, Matchers: model.LabelMatchers{{Name: "namespace", Value: "batch", IsEqual: true}}}},
+		refuse:   map[string]bool{"ALR-other-replica": true},
+	}
+	notifier := &threadEventNotifierMock{}
+	svc := newTestAlertReminderService(store, notifier, reminderSettingsStub{severity: reminderSeveritySettings()}, now)
+
+	if sent, _ := svc.RunOnce(); sent != 0 || len(notifier.events) != 0 {
+		t.Fatalf("sent = %d; want no reminders for silenced or already claimed alerts", sent)
+	}
+	if store.claimed["ALR-silenced"] != 0 {
+		t.Fatal("silenced alert was claimed; want skipped before claim")
+	}
+}
+
+func TestAlertReminder_DisabledWithoutReminderLevels(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := &alertReminderStoreMock{candidates: []model.AlertReminderCandidate{
+		{AlertID: "ALR-1", Severity: "critical", FiredAt: now.Add(-24 * time.Hour)},
+	}}
+	notifier := &threadEventNotifierMock{}
+
+	svc := newTestAlertReminderService(store, notifier, reminderSettingsStub{severity: DefaultSeveritySettings()}, now)
+	if sent, _ := svc.RunOnce(); sent != 0 {
+		t.Fatalf("sent = %d with default severity settings; want 0", sent)
+	}
+
+	svc = newTestAlertReminderService(store, notifier, reminderSettingsStub{disabled: true, severity: reminderSeveritySettings()}, now)
+	if sent, _ := svc.RunOnce(); sent != 0 {
+		t.Fatalf("sent = %d with notifications disabled; want 0", sent)
+	}
+}
@@ -1,11 +1,16 @@
 package service
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/kube-rca/backend/internal/client"
 	"github.com/kube-rca/backend/internal/config"
 	"github.com/kube-rca/backend/internal/model"
@@ -20,6 +25,7 @@ type alertStoreMock struct {
 	saveAlertCalls   []saveAlertCall
 	saveAlertResults []saveAlertResult
 	saveAlertIdx     int
+	outboxEntries    []model.NotificationOutboxEntry // SaveAlertWithOutbox로 함께 기록된 entry
 
 	// State tracking for dedup verification
 	firingAlerts   map[string]string // fingerprint → alertID (firing only)
@@ -29,15 +35,62 @@ type alertStoreMock struct {
 	firingIncidentID  string
 	firingIncidentErr error
 
+	// Correlation
+	correlationCandidates []model.IncidentCorrelationCandidate
+	createdIncidentKeys   []string
+	closedIncidents       []string
+	firingAlertIncident   map[string]string // fingerprint → incident_id
+	correlations          map[string]model.IncidentMatch
+	escalations           []severityEscalation
+
+	// Silence
+	silenceRules []model.SilenceRule
+	silencedBy   map[string]*int64 // alertID → silence ID
+
+	// Maintenance
+	maintenanceWindows []model.MaintenanceWindow
+	maintenanceBy      map[string]*int64 // alertID → maintenance window ID
+
+	// Inhibition
+	inhibitionRules   []model.InhibitionRule
+	inhibitionSources []model.InhibitionSource
+	inhibitedBy       map[string]*string // alertID → 억제한 source alert ID
+
+	// Service catalog
+	services         []model.Service
+	serviceBy        map[string]*int64 // alertID → service ID
+	incidentServices map[string]int64  // incidentID → service ID
+
+	// Idempotency keys
+	ingestKeys   map[string]bool
+	releasedKeys []string
+
 	// Flapping (default: no flapping)
 	currentStatus   map[string]string // fingerprint → status
 	isFlapping      map[string]bool
 	alreadyResolved map[string]bool
 	threadTS        map[string]string // fingerprint → thread_ts
 	deliveries      map[string][]model.AlertNotificationDelivery
+	latestAlerts    map[string]*model.AlertDetailResponse // fingerprint → 최신 alert
+	flappingCleared []string                              // MarkAlertAsFlapping(false) 호출된 fingerprint
+	flappingCycles  int                                   // CountFlappingCycles 반환값
+
+	// Flapping policies
+	flappingPolicies []model.FlappingPolicy
+	flappingPolicyBy map[string]*int64 // alertID → flapping policy ID
+
+	escalationPolicies []model.EscalationPolicy
+
+	oncallSchedules []model.OncallSchedule
+	oncallOverrides []model.OncallOverride
+	userSlackIDs    map[string]string
+	incidentOncall  map[string]string // incidentID → on-call user
 
 	// Manual resolve
 	alertByID map[string]*model.AlertDetailResponse
+
+	// Acknowledgement
+	incidentByID map[string]*model.IncidentDetailResponse
 }
 
 type saveAlertCall struct {
@@ -45,6 +98,12 @@ type saveAlertCall struct {
 	IncidentID string
 }
 
+type severityEscalation struct {
+	IncidentID string
+	Severity   string
+	Rank       int
+}
+
 type saveAlertResult struct {
 	AlertID string
 	Err     error
@@ -60,6 +119,17 @@ func newAlertStoreMock() *alertStoreMock {
 		threadTS:        make(map[string]string),
 		deliveries:      make(map[string][]model.AlertNotificationDelivery),
 		alertByID:       make(map[string]*model.AlertDetailResponse),
+		incidentByID:    make(map[string]*model.IncidentDetailResponse),
+
+		firingAlertIncident: make(map[string]string),
+		correlations:        make(map[string]model.IncidentMatch),
+		silencedBy:          make(map[string]*int64),
+		maintenanceBy:       make(map[string]*int64),
+		inhibitedBy:         make(map[string]*string),
+		serviceBy:           make(map[string]*int64),
+		incidentServices:    make(map[string]int64),
+		ingestKeys:          make(map[string]bool),
+		flappingPolicyBy:    make(map[string]*int64),
 	}
 }
 
@@ -89,6 +159,46 @@ func (m *alertStoreMock) SaveAlert(alert model.Alert, incidentID string) (string
 	return id, nil
 }
 
+func (m *alertStoreMock) SaveAlertWithOutbox(alert model.Alert, incidentID string, entries []model.NotificationOutboxEntry) (string, []int64, error) {
+	alertID, err := m.SaveAlert(alert, incidentID)
+	if err != nil || len(entries) == 0 {
+		return alertID, nil, err
+	}
+	var ids []int64
+	for _, entry := range entries {
+		if entry.AlertID == "" {
+			entry.AlertID = alertID
+		}
+		m.outboxEntries = append(m.outboxEntries, entry)
+		ids = append(ids, int64(len(m.outboxEntries)))
+	}
+	return alertID, ids, nil
+}
+
+func (m *alertStoreMock) GetFiringAlertByFingerprint(fingerprint string) (string, error) {
+	if id, ok := m.firingAlerts[fingerprint]; ok {
+		return id, nil
+	}
+	return "", pgx.ErrNoRows
+}
+
+func (m *alertStoreMock) GetFiringAlertSuppression(fingerprint string) (string, error) {
+	alertID, ok := m.firingAlerts[fingerprint]
+	if !ok {
+		return "", pgx.ErrNoRows
+	}
+	if m.silencedBy[alertID] != nil {
+		return "silence", nil
+	}
+	if m.maintenanceBy[alertID] != nil {
+		return "maintenance", nil
+	}
+	if m.inhibitedBy[alertID] != nil {
+		return "inhibition", nil
+	}
+	return "", nil
+}
+
 func (m *alertStoreMock) GetAlertCurrentStatus(fingerprint string) (string, error) {
 	if s, ok := m.currentStatus[fingerprint]; ok {
 		return s, nil
@@ -124,10 +234,16 @@ func (m *alertStoreMock) UpdateAlertThreadTS(fingerprint, threadTS string) error
 }
 
 func (m *alertStoreMock) CountFlappingCycles(_ string, _ int) (int, time.Time, error) {
-	return 0, time.Time{}, nil
+	return m.flappingCycles, time.Time{}, nil
 }
 
-func (m *alertStoreMock) MarkAlertAsFlapping(_ string, _ bool, _ int, _ time.Time) error {
+func (m *alertStoreMock) MarkAlertAsFlapping(fingerprint string, isFlapping bool, _ int, _ time.Time) error {
+	if isFlapping {
+		m.isFlapping[fingerprint] = true
+	} else {
+		m.isFlapping[fingerprint] = false
+		m.flappingCleared = append(m.flappingCleared, fingerprint)
+	}
 	return nil
 }
 
@@ -135,14 +251,53 @@ func (m *alertStoreMock) UpdateFlappingCycleCount(_ string, _ int) error {
 	return nil
 }
 
-func (m *alertStoreMock) GetLatestAlertByFingerprint(_ string) (*model.AlertDetailResponse, error) {
-	return nil, nil
+func (m *alertStoreMock) GetLatestAlertByFingerprint(fingerprint string) (*model.AlertDetailResponse, error) {
+	return m.latestAlerts[fingerprint], nil
 }
 
 func (m *alertStoreMock) HasTransitionsSince(_ string, _ time.Time) (bool, error) {
 	return false, nil
 }
 
+func (m *alertStoreMock) ListFlappingClearanceCandidates() ([]model.FlappingClearancePayload, error) {
+	return nil, nil
+}
+
+func (m *alertStoreMock) ListEnabledFlappingPolicies() ([]model.FlappingPolicy, error) {
+	return m.flappingPolicies, nil
+}
+
+func (m *alertStoreMock) ListEnabledEscalationPolicies() ([]model.EscalationPolicy, error) {
+	return m.escalationPolicies, nil
+}
+
+func (m *alertStoreMock) ListEnabledOncallSchedules() ([]model.OncallSchedule, error) {
+	return m.oncallSchedules, nil
+}
+
+func (m *alertStoreMock) ListActiveOncallOverrides(_ time.Time) ([]model.OncallOverride, error) {
+	return m.oncallOverrides, nil
+}
+
+func (m *alertStoreMock) ListUserSlackIDs() (map[string]string, error) {
+	return m.userSlackIDs, nil
+}
+
+func (m *alertStoreMock) SetIncidentOncall(incidentID string, _ int64, user string) error {
+	if m.incidentOncall == nil {
+		m.incidentOncall = make(map[string]string)
+	}
+	if _, ok := m.incidentOncall[incidentID]; !ok {
+		m.incidentOncall[incidentID] = user
+	}
+	return nil
+}
+
+func (m *alertStoreMock) UpdateAlertFlappingPolicy(alertID string, policyID *int64) error {
+	m.flappingPolicyBy[alertID] = policyID
+	return nil
+}
+
 func (m *alertStoreMock) GetFiringIncident() (*model.IncidentDetailResponse, error) {
 	if m.firingIncidentID != "" {
 		return &model.IncidentDetailResponse{IncidentID: m.firingIncidentID}, nil
@@ -154,14 +309,103 @@ func (m *alertStoreMock) CreateIncident(_, _ string, _ time.Time) (string, error
 	return "INC-test0001", nil
 }
 
-func (m *alertStoreMock) GetOrCreateFiringIncident(_, _ string, _ time.Time) (string, bool, error) {
+func (m *alertStoreMock) ListCorrelationCandidates() ([]model.IncidentCorrelationCandidate, error) {
+	return append([]model.IncidentCorrelationCandidate(nil), m.correlationCandidates...), nil
+}
+
+func (m *alertStoreMock) GetOrCreateCorrelatedIncident(key, _, _ string, _ map[string]string, _ time.Time) (string, bool, error) {
+	m.createdIncidentKeys = append(m.createdIncidentKeys, key)
 	if m.firingIncidentID != "" {
 		return m.firingIncidentID, false, nil
 	}
 	return "INC-test0001", true, nil
 }
 
-func (m *alertStoreMock) UpdateIncidentSeverity(_, _ string) error {
+func (m *alertStoreMock) TouchIncidentCorrelation(_ string, _ time.Time) error {
+	return nil
+}
+
+func (m *alertStoreMock) CloseIncidentCorrelation(incidentID string) error {
+	m.closedIncidents = append(m.closedIncidents, incidentID)
+	return nil
+}
+
+func (m *alertStoreMock) GetFiringAlertIncidentID(fingerprint string) (string, error) {
+	return m.firingAlertIncident[fingerprint], nil
+}
+
+func (m *alertStoreMock) UpdateAlertCorrelation(alertID string, match model.IncidentMatch) error {
+	m.correlations[alertID] = match
+	return nil
+}
+
+func (m *alertStoreMock) ListActiveSilenceRules(_ time.Time) ([]model.SilenceRule, error) {
+	return m.silenceRules, nil
+}
+
+func (m *alertStoreMock) UpdateAlertSilence(alertID string, silenceID *int64) error {
+	m.silencedBy[alertID] = silenceID
+	return nil
+}
+
+func (m *alertStoreMock) ListEnabledMaintenanceWindows() ([]model.MaintenanceWindow, error) {
+	return m.maintenanceWindows, nil
+}
+
+func (m *alertStoreMock) UpdateAlertMaintenance(alertID string, windowID *int64) error {
+	m.maintenanceBy[alertID] = windowID
+	return nil
+}
+
+func (m *alertStoreMock) ListEnabledInhibitionRules() ([]model.InhibitionRule, error) {
+	return m.inhibitionRules, nil
+}
+
+func (m *alertStoreMock) ListFiringInhibitionSources() ([]model.InhibitionSource, error) {
+	return m.inhibitionSources, nil
+}
+
+func (m *alertStoreMock) UpdateAlertInhibition(alertID string, inhibitedBy *string, _ *int64) error {
+	m.inhibitedBy[alertID] = inhibitedBy
+	return nil
+}
+
+func (m *alertStoreMock) ListServicesForMatching() ([]model.Service, error) {
+	return m.services, nil
+}
+
+func (m *alertStoreMock) UpdateAlertService(alertID string, serviceID *int64) error {
+	m.serviceBy[alertID] = serviceID
+	return nil
+}
+
+func (m *alertStoreMock) AssignIncidentService(incidentID string, serviceID int64) error {
+	if _, ok := m.incidentServices[incidentID]; !ok {
+		m.incidentServices[incidentID] = serviceID
+	}
+	return nil
+}
+
+func (m *alertStoreMock) ClaimAlertIngestKey(key string, _ time.Duration) (bool, error) {
+	if m.ingestKeys[key] {
+		return false, nil
+	}
+	m.ingestKeys[key] = true
+	return true, nil
+}
+
+func (m *alertStoreMock) ReleaseAlertIngestKey(key string) error {
+	delete(m.ingestKeys, key)
+	m.releasedKeys = append(m.releasedKeys, key)
+	return nil
+}
+
+func (m *alertStoreMock) PurgeAlertIngestKeys(_ time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (m *alertStoreMock) EscalateIncidentSeverity(incidentID, severity string, rank int) error {
+	m.escalations = append(m.escalations, severityEscalation{IncidentID: incidentID, Severity: severity, Rank: rank})
 	return nil
 }
 
@@ -172,6 +416,44 @@ func (m *alertStoreMock) GetAlertDetail(alertID string) (*model.AlertDetailRespo
 	return nil, fmt.Errorf("alert not found: %s", alertID)
 }
 
+func (m *alertStoreMock) GetIncidentDetail(incidentID string) (*model.IncidentDetailResponse, error) {
+	if i, ok := m.incidentByID[incidentID]; ok {
+		return i, nil
+	}
+	return nil, pgx.ErrNoRows
+}
+
+func (m *alertStoreMock) AcknowledgeAlert(alertID, acknowledgedBy string, at time.Time) (bool, error) {
+	a, ok := m.alertByID[alertID]
+	if !ok || a.Status != "firing" || a.Acknowledged {
+		return false, nil
+	}
+	a.Acknowledged, a.AcknowledgedAt, a.AcknowledgedBy = true, &at, acknowledgedBy
+	return true, nil
+}
+
+func (m *alertStoreMock) AcknowledgeIncident(incidentID, acknowledgedBy string, at time.Time) (bool, error) {
+	i, ok := m.incidentByID[incidentID]
+	if !ok || i.Status != "firing" || i.Acknowledged {
+		return false, nil
+	}
+	i.Acknowledged, i.AcknowledgedAt, i.AcknowledgedBy = true, &at, acknowledgedBy
+	return true, nil
+}
+
+func (m *alertStoreMock) AcknowledgeIncidentAlerts(incidentID, acknowledgedBy string, at time.Time) ([]string, error) {
+	var ids []string
+	for id, a := range m.alertByID {
+		if ptrToString(a.IncidentID) != incidentID || a.Status != "firing" || a.Acknowledged {
+			continue
+		}
+		a.Acknowledged, a.AcknowledgedAt, a.AcknowledgedBy = true, &at, acknowledgedBy
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
 func (m *alertStoreMock) ManualResolveAlert(alertID string) error {
 	if a, ok := m.alertByID[alertID]; ok {
 		if a.Status == "resolved" {
@@ -295,6 +577,32 @@ func (m *notifierMock) RequiresThreadRef() bool {
 	return false
 }
 
+// ============================================================================
+// Mock: jobScheduler
+// ============================================================================
+
+type jobSchedulerMock struct {
+	scheduled []scheduledJobCall
+	cancelled []string // jobType/jobKey
+}
+
+type scheduledJobCall struct {
+	JobType string
+	JobKey  string
+	RunAt   time.Time
+	Payload any
+}
+
+func (m *jobSchedulerMock) Schedule(_ context.Context, jobType, jobKey string, runAt time.Time, payload any) (int64, error) {
+	m.scheduled = append(m.scheduled, scheduledJobCall{JobType: jobType, JobKey: jobKey, RunAt: runAt, Payload: payload})
+	return int64(len(m.scheduled)), nil
+}
+
+func (m *jobSchedulerMock) Cancel(_ context.Context, jobType, jobKey string) (int64, error) {
+	m.cancelled = append(m.cancelled, jobType+"/"+jobKey)
+	return 1, nil
+}
+
 // ============================================================================
 // Mock: alertAnalyzer
 // ============================================================================
@@ -513,6 +821,364 @@ func TestProcessWebhook_SkipsNoneSeverity(t *testing.T) {
 	}
 }
 
+func TestProcessWebhook_MapsSeverityAliases(t *testing.T) {
+	store := newAlertStoreMock()
+	notif := newNotifierMock()
+	analyzer := &analyzerMock{}
+	svc := newTestAlertService(store, notif, analyzer)
+
+	webhook := makeWebhook(makeAlert("fp-p1", "firing", "P1"), makeAlert("fp-low", "firing", "low"))
+	svc.ProcessWebhook(webhook)
+
+	if len(store.saveAlertCalls) != 2 {
+		t.Fatalf("SaveAlert called %d times; want 2 (aliases must not be dropped)", len(store.saveAlertCalls))
+	}
+	first := store.saveAlertCalls[0].Alert
+	if first.Labels["severity"] != "critical" || first.Annotations["source_severity"] != "P1" {
+		t.Fatalf("saved alert severity=%q source_severity=%q; want critical/P1", first.Labels["severity"], first.Annotations["source_severity"])
+	}
+	if got := store.saveAlertCalls[1].Alert.Labels["severity"]; got != "info" {
+		t.Fatalf("second alert severity = %q; want info", got)
+	}
+
+	// 같은 Incident에 대해 escalation은 rank와 함께 요청 (실제 비교는 DB에서 수행)
+	if len(store.escalations) != 2 || store.escalations[0].Rank != 30 || store.escalations[1].Rank != 10 {
+		t.Fatalf("escalations = %+v; want ranks 30 then 10", store.escalations)
+	}
+}
+
+func TestProcessWebhook_ResolvedDoesNotEscalate(t *testing.T) {
+	store := newAlertStoreMock()
+	notif := newNotifierMock()
+	analyzer := &analyzerMock{}
+	svc := newTestAlertService(store, notif, analyzer)
+
+	svc.ProcessWebhook(makeWebhook(makeAlert("fp-r", "resolved", "critical")))
+
+	if len(store.escalations) != 0 {
+		t.Fatalf("escalations = %+v; want none for resolved alert", store.escalations)
+	}
+}
+
+func TestProcessWebhook_SilencedAlertSavedWithoutNotification(t *testing.T) {
+	store := newAlertStoreMock()
+	store.saveAlertResults = []saveAlertResult{{AlertID: "ALR-silenced"}, {AlertID: "ALR-loud"}}
+	store.silenceRules = []model.SilenceRule{{
+		ID:       7,
+		Matchers: model.LabelMatchers{{Name: "alertname", Value: "Noisy.*", IsRegex: true, IsEqual: true}},
+	}}
+	notif := newNotifierMock()
+	analyzer := &analyzerMock{}
+	svc := newTestAlertService(store, notif, analyzer)
+
+	noisy := makeAlert("fp-noisy", "firing", "warning")
+	noisy.Labels["alertname"] = "NoisyDiskAlert"
+	loud := makeAlert("fp-loud", "firing", "critical")
+
+	sent, failed := svc.ProcessWebhook(makeWebhook(noisy, loud))
+
+	if sent != 1 || failed != 0 {
+		t.Fatalf("ProcessWebhook() = sent=%d, failed=%d; want sent=1 (silenced alert skipped)", sent, failed)
+	}
+	if len(store.saveAlertCalls) != 2 {
+		t.Fatalf("SaveAlert called %d times; want 2 (silenced alerts are still stored)", len(store.saveAlertCalls))
+	}
+	if id := store.silencedBy["ALR-silenced"]; id == nil || *id != 7 {
+		t.Fatalf("silenced_by for silenced alert = %v; want 7", id)
+	}
+	if id, ok := store.silencedBy["ALR-loud"]; !ok || id != nil {
+		t.Fatalf("silenced_by for loud alert = %v (recorded=%v); want nil", id, ok)
+	}
+}
+
+func TestProcessWebhook_ResolvedAfterSilenceExpiresSkipsNotification(t *testing.T) {
+	store := newAlertStoreMock()
+	store.saveAlertResults = []saveAlertResult{{AlertID: "ALR-silenced"}, {AlertID: "ALR-silenced"}}
+	store.silenceRules = []model.SilenceRule{{
+		ID:       7,
+		Matchers: model.LabelMatchers{{Name: "alertname", Value: "NoisyDiskAlert", IsEqual: true}},
+	}}
+	store.threadTS["fp-noisy"] = "1712345678.000100"
+	notif := newNotifierMock()
+	svc := newTestAlertService(store, notif, &analyzerMock{})
+
+	firing := makeAlert("fp-noisy", "firing", "warning")
+	firing.Labels["alertname"] = "NoisyDiskAlert"
+	svc.ProcessWebhook(makeWebhook(firing))
+
+	// silence 만료 후 resolved 도착
+	store.silenceRules = nil
+	resolved := makeAlert("fp-noisy", "resolved", "warning")
+	resolved.Labels["alertname"] = "NoisyDiskAlert"
+	sent, _ := svc.ProcessWebhook(makeWebhook(resolved))
+
+	if sent != 0 || len(notif.events) != 0 {
+		t.Fatalf("sent=%d events=%d; want no resolved notification for silenced firing", sent, len(notif.events))
+	}
+	if id := store.silencedBy["ALR-silenced"]; id == nil || *id != 7 {
+		t.Fatalf("silenced_by after resolve = %v; want 7 kept", id)
+	}
+}
+
+func TestProcessWebhook_MaintenanceWindowTagsAlert(t *testing.T) {
+	store := newAlertStoreMock()
+	store.saveAlertResults = []saveAlertResult{{AlertID: "ALR-maint"}, {AlertID: "ALR-other"}}
+	// 매분 시작하는 60분짜리 창 = 항상 진행 중
+	store.maintenanceWindows = []model.MaintenanceWindow{{
+		ID: 3, Schedule: "* * * * *", TimeZone: "UTC", DurationMinutes: 60, Enabled: true,
+		Matchers: model.LabelMatchers{{Name: "cluster", Value: "staging", IsEqual: true}},
+	}}
+	notif := newNotifierMock()
+	analyzer := &analyzerMock{}
+	svc := newTestAlertService(store, notif, analyzer)
+
+	inWindow := makeAlert("fp-maint", "firing", "critical")
+	inWindow.Labels["cluster"] = "staging"
+	other := makeAlert("fp-other", "firing", "critical")
+	other.Labels["cluster"] = "prod"
+
+	sent, _ := svc.ProcessWebhook(makeWebhook(inWindow, other))
+
+	if sent != 1 || len(store.saveAlertCalls) != 2 {
+		t.Fatalf("sent=%d saved=%d; want 1 notification and 2 stored alerts", sent, len(store.saveAlertCalls))
+	}
+	if id := store.maintenanceBy["ALR-maint"]; id == nil || *id != 3 {
+		t.Fatalf("maintenance window for staging alert = %v; want 3", id)
+	}
+	if id := store.maintenanceBy["ALR-other"]; id != nil {
+		t.Fatalf("maintenance window for prod alert = %v; want nil", *id)
+	}
+}
+
+func TestProcessWebhook_ResolvedAfterMaintenanceKeepsFlagAndSkipsNotification(t *testing.T) {
+	store := newAlertStoreMock()
+	store.saveAlertResults = []saveAlertResult{{AlertID: "ALR-maint"}, {AlertID: "ALR-maint"}}
+	store.maintenanceWindows = []model.MaintenanceWindow{{
+		ID: 3, Schedule: "* * * * *", TimeZone: "UTC", DurationMinutes: 60, Enabled: true,
+		Matchers: model.LabelMatchers{{Name: "cluster", Value: "staging", IsEqual: true}},
+	}}
+	// 이전 firing 주기의 스레드가 남아 있어도 resolved 알림을 보내지 않아야 함
+	store.threadTS["fp-maint"] = "1712345678.000100"
+	notif := newNotifierMock()
+	analyzer := &analyzerMock{}
+	svc := newTestAlertService(store, notif, analyzer)
+
+	firing := makeAlert("fp-maint", "firing", "critical")
+	firing.Labels["cluster"] = "staging"
+	svc.ProcessWebhook(makeWebhook(firing))
+
+	// 점검 종료 후 resolved 도착
+	store.maintenanceWindows = nil
+	resolved := makeAlert("fp-maint", "resolved", "critical")
+	resolved.Labels["cluster"] = "staging"
+	sent, failed := svc.ProcessWebhook(makeWebhook(resolved))
+
+	if sent != 0 || failed != 0 || len(notif.events) != 0 {
+		t.Fatalf("sent=%d failed=%d events=%d; want no notification for resolved maintenance alert", sent, failed, len(notif.events))
+	}
+	if id := store.maintenanceBy["ALR-maint"]; id == nil || *id != 3 {
+		t.Fatalf("maintenance window after resolve = %v; want 3 kept", id)
+	}
+	if len(analyzer.calls) != 0 {
+		t.Fatalf("analysis calls = %d; want 0", len(analyzer.calls))
+	}
+}
+
+func TestProcessWebhook_InhibitedBySourceInSameBatch(t *testing.T) {
+	store := newAlertStoreMock()
+	store.saveAlertResults = []saveAlertResult{{AlertID: "ALR-node"}, {AlertID: "ALR-pod1"}, {AlertID: "ALR-pod2"}}
+	store.inhibitionRules = []model.InhibitionRule{{
+		ID:             5,
+		SourceMatchers: model.LabelMatchers{{Name: "alertname", Value: "KubeNodeNotReady", IsEqual: true}},
+		TargetMatchers: model.LabelMatchers{{Name: "severity", Value: "warning", IsEqual: true}},
+		Equal:          []string{"node"},
+		Enabled:        true,
+	}}
+	notif := newNotifierMock()
+	analyzer := &analyzerMock{}
+	svc := newTestAlertService(store, notif, analyzer)
+
+	node := makeAlert("fp-node", "firing", "critical")
+	node.Labels["alertname"] = "KubeNodeNotReady"
+	node.Labels["node"] = "worker-1"
+	samePod := makeAlert("fp-pod1", "firing", "warning")
+	samePod.Labels["node"] = "worker-1"
+	otherPod := makeAlert("fp-pod2", "firing", "warning")
+	otherPod.Labels["node"] = "worker-2"
+
+	sent, _ := svc.ProcessWebhook(makeWebhook(node, samePod, otherPod))
+
+	if sent != 2 || len(store.saveAlertCalls) != 3 {
+		t.Fatalf("sent=%d saved=%d; want 2 notifications and 3 stored alerts", sent, len(store.saveAlertCalls))
+	}
+	if by := store.inhibitedBy["ALR-pod1"]; by == nil || *by != "ALR-node" {
+		t.Fatalf("inhibited_by for pod on same node = %v; want ALR-node", by)
+	}
+	if by := store.inhibitedBy["ALR-pod2"]; by != nil {
+		t.Fatalf("inhibited_by for pod on other node = %v; want nil", *by)
+	}
+}
+
+func TestProcessWebhook_InhibitedBySourceLaterInSameBatch(t *testing.T) {
+	store := newAlertStoreMock()
+	store.saveAlertResults = []saveAlertResult{{AlertID: "ALR-pod1"}, {AlertID: "ALR-node"}}
+	store.inhibitionRules = []model.InhibitionRule{{
+		ID:             5,
+		SourceMatchers: model.LabelMatchers{{Name: "alertname", Value: "KubeNodeNotReady", IsEqual: true}},
+		TargetMatchers: model.LabelMatchers{{Name: "severity", Value: "warning", IsEqual: true}},
+		Equal:          []string{"node"},
+		Enabled:        true,
+	}}
+	notif := newNotifierMock()
+	svc := newTestAlertService(store, notif, &analyzerMock{})
+
+	// target이 source보다 먼저 온 웹훅
+	pod := makeAlert("fp-pod1", "firing", "warning")
+	pod.Labels["node"] = "worker-1"
+	node := makeAlert("fp-node", "firing", "critical")
+	node.Labels["alertname"] = "KubeNodeNotReady"
+	node.Labels["node"] = "worker-1"
+
+	sent, _ := svc.ProcessWebhook(makeWebhook(pod, node))
+
+	if sent != 1 || len(notif.events) != 1 {
+		t.Fatalf("sent=%d events=%d; want only the source notified", sent, len(notif.events))
+	}
+	if ev, ok := notif.events[0].(client.AlertStatusChangedEvent); !ok || ev.Alert.Fingerprint != "fp-node" {
+		t.Fatalf("notification = %+v; want fp-node", notif.events[0])
+	}
+	if by := store.inhibitedBy["ALR-pod1"]; by == nil || *by != "ALR-node" {
+		t.Fatalf("inhibited_by = %v; want ALR-node recorded after the source was saved", by)
+	}
+}
+
+func TestProcessWebhook_ResolvedAfterSourceClearsSkipsInhibitedNotification(t *testing.T) {
+	store := newAlertStoreMock()
+	store.saveAlertResults = []saveAlertResult{{AlertID: "ALR-pod1"}, {AlertID: "ALR-pod1"}}
+	store.inhibitionRules = []model.InhibitionRule{{
+		ID:             5,
+		SourceMatchers: model.LabelMatchers{{Name: "alertname", Value: "KubeNodeNotReady", IsEqual: true}},
+		TargetMatchers: model.LabelMatchers{{Name: "severity", Value: "warning", IsEqual: true}},
+		Equal:          []string{"node"},
+		Enabled:        true,
+	}}
+	store.inhibitionSources = []model.InhibitionSource{{
+		AlertID: "ALR-node", Fingerprint: "fp-node",
+		Labels: map[string]string{"alertname": "KubeNodeNotReady", "node": "worker-1"},
+	}}
+	store.threadTS["fp-pod1"] = "1712345678.000100"
+	notif := newNotifierMock()
+	svc := newTestAlertService(store, notif, &analyzerMock{})
+
+	firing := makeAlert("fp-pod1", "firing", "warning")
+	firing.Labels["node"] = "worker-1"
+	svc.ProcessWebhook(makeWebhook(firing))
+
+	// source alert가 해결된 뒤 target resolved 도착
+	store.inhibitionSources = nil
+	resolved := makeAlert("fp-pod1", "resolved", "warning")
+	resolved.Labels["node"] = "worker-1"
+	sent, _ := svc.ProcessWebhook(makeWebhook(resolved))
+
+	if sent != 0 || len(notif.events) != 0 {
+		t.Fatalf("sent=%d events=%d; want no resolved notification for inhibited firing", sent, len(notif.events))
+	}
+	if by := store.inhibitedBy["ALR-pod1"]; by == nil || *by != "ALR-node" {
+		t.Fatalf("inhibited_by after resolve = %v; want ALR-node kept", by)
+	}
+}
+
+func TestProcessWebhook_ResolvesOwningService(t *testing.T) {
+	store := newAlertStoreMock()
+	store.saveAlertResults = []saveAlertResult{{AlertID: "ALR-pay"}, {AlertID: "ALR-other"}}
+	store.services = []model.Service{
+		{ID: 1, Name: "payments", Namespaces: []string{"payments"}, SlackChannel: "C-pay"},
+		{ID: 2, Name: "payments-api", Namespaces: []string{"payments"}, Selectors: model.LabelMatchers{{Name: "app", Value: "api", IsEqual: true}}, SlackChannel: "C-pay-api"},
+	}
+	notif := newNotifierMock()
+	svc := newTestAlertService(store, notif, &analyzerMock{})
+
+	pay := makeAlert("fp-pay", "firing", "warning")
+	pay.Labels["namespace"] = "payments"
+	pay.Labels["app"] = "api"
+	other := makeAlert("fp-other", "firing", "warning")
+	other.Labels["namespace"] = "default"
+
+	svc.ProcessWebhook(makeWebhook(pay, other))
+
+	if id := store.serviceBy["ALR-pay"]; id == nil || *id != 2 {
+		t.Fatalf("service for payments alert = %v; want 2 (more specific selector wins)", id)
+	}
+	if id := store.serviceBy["ALR-other"]; id != nil {
+		t.Fatalf("service for unmatched alert = %d; want nil", *id)
+	}
+	if store.incidentServices["INC-test0001"] != 2 {
+		t.Fatalf("incident services = %v; want INC-test0001 → 2", store.incidentServices)
+	}
+	root, ok := notif.events[0].(client.AlertStatusChangedEvent)
+	if !ok || root.Alert.ServiceChannel != "C-pay-api" {
+		t.Fatalf("first notification = %+v; want service channel C-pay-api", notif.events[0])
+	}
+}
+
+func TestProcessWebhook_HADuplicateAcknowledgedWithoutSideEffects(t *testing.T) {
+	store := newAlertStoreMock()
+	store.saveAlertResults = []saveAlertResult{{AlertID: "ALR-ha"}}
+	notif := newNotifierMock()
+	analyzer := &analyzerMock{}
+	svc := newTestAlertService(store, notif, analyzer)
+	svc.dedupeWindow = 2 * time.Minute
+
+	alert := makeAlert("fp-ha", "firing", "critical")
+	firstSent, _ := svc.ProcessWebhook(makeWebhook(alert))
+	// 두 번째 Alertmanager peer가 같은 알림을 전송
+	secondSent, secondFailed := svc.ProcessWebhook(makeWebhook(alert))
+
+	if firstSent != 1 || secondSent != 0 || secondFailed != 0 {
+		t.Fatalf("sent=%d/%d failed=%d; want the duplicate acknowledged without notification", firstSent, secondSent, secondFailed)
+	}
+	if len(store.saveAlertCalls) != 1 {
+		t.Fatalf("SaveAlert called %d times; want 1", len(store.saveAlertCalls))
+	}
+
+	// resolved는 다른 키이므로 처리됨
+	resolved := makeAlert("fp-ha", "resolved", "critical")
+	resolved.StartsAt = alert.StartsAt
+	svc.ProcessWebhook(makeWebhook(resolved))
+	if len(store.saveAlertCalls) != 2 {
+		t.Fatalf("SaveAlert called %d times after resolve; want 2", len(store.saveAlertCalls))
+	}
+}
+
+func TestProcessWebhook_SaveFailureReleasesIngestKey(t *testing.T) {
+	store := newAlertStoreMock()
+	store.saveAlertResults = []saveAlertResult{{Err: fmt.Errorf("db down")}, {AlertID: "ALR-retry"}}
+	notif := newNotifierMock()
+	svc := newTestAlertService(store, notif, &analyzerMock{})
+	svc.dedupeWindow = 2 * time.Minute
+
+	alert := makeAlert("fp-retry", "firing", "critical")
+	_, _, err := svc.IngestWebhook(makeWebhook(alert))
+	var saveErr *AlertSaveError
+	if !errors.As(err, &saveErr) || len(saveErr.Alerts) != 1 || saveErr.Alerts[0].Fingerprint != "fp-retry" {
+		t.Fatalf("IngestWebhook() error = %v; want AlertSaveError for fp-retry", err)
+	}
+	// 저장 실패한 alert는 재시도 전까지 알림을 보내지 않음
+	if notif.notifyCallCount != 0 || len(notif.events) != 0 {
+		t.Fatalf("notifications sent for unsaved alert: %d", len(notif.events))
+	}
+	if len(store.releasedKeys) != 1 {
+		t.Fatalf("released keys = %v; want the failed alert's key", store.releasedKeys)
+	}
+	// inbox 재시도는 중복으로 버려지지 않아야 함
+	if _, _, err := svc.IngestWebhook(makeWebhook(alert)); err != nil {
+		t.Fatalf("retry IngestWebhook() error = %v", err)
+	}
+	if len(store.saveAlertCalls) != 2 {
+		t.Fatalf("SaveAlert called %d times; want 2", len(store.saveAlertCalls))
+	}
+}
+
 func TestProcessWebhook_DuplicateResolvedSkipped(t *testing.T) {
 	store := newAlertStoreMock()
 	store.saveAlertResults = []saveAlertResult{
@@ -623,9 +1289,9 @@ func TestProcessWebhook_SaveAlertError_ContinuesProcessing(t *testing.T) {
 	sent, failed := svc.ProcessWebhook(webhook)
 
 	// First alert save failed, but second should succeed
-	// Both should attempt Slack send (DB failure doesn't block Slack)
-	if sent+failed != 2 {
-		t.Fatalf("ProcessWebhook() total processed = %d; want 2", sent+failed)
+	// Only the saved alert is notified; the failed one is notified when the inbox retry saves it
+	if sent != 1 || failed != 0 {
+		t.Fatalf("ProcessWebhook() = sent=%d, failed=%d; want sent=1, failed=0", sent, failed)
 	}
 
 	if len(store.saveAlertCalls) != 2 {
@@ -673,7 +1339,7 @@ func TestResolveAlert_Success(t *testing.T) {
 	}
 
 	notifier := &notifierMock{threadRefs: map[string]string{}}
-	svc := NewAlertService(notifier, nil, nil, config.FlappingConfig{}, nil, nil)
+	svc := NewAlertService(notifier, nil, nil, config.FlappingConfig{}, config.AlertDedupeConfig{}, nil, nil)
 	svc.db = store
 
 	err := svc.ResolveAlert("ALR-test0001")
@@ -712,6 +1378,118 @@ func TestProcessWebhook_ResolvedRecoversLegacyDeliveryForSingleRoute(t *testing.
 	}
 }
 
+// outboxPlannerStub - 라우팅 대상 계산 결과를 고정한 client.OutboxPlanner
+type outboxPlannerStub struct {
+	targets []client.OutboxTarget
+}
+
+func (p *outboxPlannerStub) PlanOutboxTargets(_ client.NotifierEvent) ([]client.OutboxTarget, error) {
+	return p.targets, nil
+}
+
+func (p *outboxPlannerStub) PlanThreadOutboxTargets(_ client.NotifierEvent, deliveries []model.AlertNotificationDelivery) []client.OutboxTarget {
+	targets := make([]client.OutboxTarget, 0, len(deliveries))
+	for i := range deliveries {
+		targets = append(targets, client.OutboxTarget{Mode: model.NotificationOutboxModeThread, Delivery: &deliveries[i]})
+	}
+	return targets
+}
+
+// alertOutboxMock - PrepareEntries/Release/Cancel 호출을 기록하는 alertNotificationOutbox
+type alertOutboxMock struct {
+	released  []int64
+	cancelled []int64
+}
+
+func (m *alertOutboxMock) PrepareEntries(event client.NotifierEvent, targets []client.OutboxTarget) ([]model.NotificationOutboxEntry, error) {
+	entries := make([]model.NotificationOutboxEntry, 0, len(targets))
+	for _, target := range targets {
+		entry, err := newNotificationOutboxEntry(event, target)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func (m *alertOutboxMock) Release(_ context.Context, ids []int64) {
+	m.released = append(m.released, ids...)
+}
+
+func (m *alertOutboxMock) Cancel(_ context.Context, ids []int64, _ string) {
+	m.cancelled = append(m.cancelled, ids...)
+}
+
+func TestProcessWebhook_OutboxRecordsNotificationsWithAlertSave(t *testing.T) {
+	store := newAlertStoreMock()
+	store.saveAlertResults = []saveAlertResult{
+		{AlertID: "ALR-outbox01", Err: nil},
+		{AlertID: "ALR-outbox01", Err: nil},
+	}
+	notifier := newNotifierMock()
+	svc := newTestAlertService(store, notifier, &analyzerMock{})
+	outbox := &alertOutboxMock{}
+	configID := 4
+	svc.outbox = outbox
+	svc.planner = &outboxPlannerStub{targets: []client.OutboxTarget{
+		{Mode: model.NotificationOutboxModeRoot, WebhookConfigID: &configID, Channel: "C-OPS"},
+		{Mode: model.NotificationOutboxModeRoot},
+	}}
+
+	sent, failed := svc.ProcessWebhook(makeWebhook(makeAlert("fp-outbox", "firing", "warning")))
+	if sent != 1 || failed != 0 {
+		t.Fatalf("ProcessWebhook(firing) = sent=%d, failed=%d; want sent=1, failed=0", sent, failed)
+	}
+	if notifier.notifyCallCount != 0 {
+		t.Fatalf("notifier calls = %d, want 0 (outbox worker delivers)", notifier.notifyCallCount)
+	}
+	if len(store.outboxEntries) != 2 || len(outbox.released) != 2 {
+		t.Fatalf("outbox entries = %d, released = %d; want 2 and 2", len(store.outboxEntries), len(outbox.released))
+	}
+	root := store.outboxEntries[0]
+	if root.AlertID != "ALR-outbox01" || root.Mode != model.NotificationOutboxModeRoot || root.Channel != "C-OPS" || root.Fingerprint != "fp-outbox" {
+		t.Fatalf("unexpected root entry: %+v", root)
+	}
+
+	// resolved는 firing alert의 스레드 delivery에 답글하도록 같은 트랜잭션에 기록
+	store.deliveries["ALR-outbox01"] = []model.AlertNotificationDelivery{{
+		AlertID: "ALR-outbox01", Fingerprint: "fp-outbox", NotifierType: "slack", WebhookConfigID: &configID, ChannelID: "C-OPS", ThreadTS: "111.1",
+	}}
+	sent, failed = svc.ProcessWebhook(makeWebhook(makeAlert("fp-outbox", "resolved", "warning")))
+	if sent != 1 || failed != 0 {
+		t.Fatalf("ProcessWebhook(resolved) = sent=%d, failed=%d; want sent=1, failed=0", sent, failed)
+	}
+	if len(store.outboxEntries) != 3 || notifier.notifyCallCount != 0 {
+		t.Fatalf("outbox entries = %d, notifier calls = %d; want 3 and 0", len(store.outboxEntries), notifier.notifyCallCount)
+	}
+	reply := store.outboxEntries[2]
+	if reply.Mode != model.NotificationOutboxModeThread || reply.Delivery == nil || reply.Delivery.ThreadTS != "111.1" || reply.AlertID != "ALR-outbox01" {
+		t.Fatalf("unexpected thread entry: %+v", reply)
+	}
+}
+
+func TestProcessWebhook_OutboxCancelsNotificationsForFlappingAlert(t *testing.T) {
+	store := newAlertStoreMock()
+	store.currentStatus["fp-flap"] = "firing"
+	store.isFlapping["fp-flap"] = true
+	notifier := newNotifierMock()
+	svc := newTestAlertService(store, notifier, &analyzerMock{})
+	svc.envFlapping = config.FlappingConfig{Enabled: true, DetectionWindowMinutes: 30, CycleThreshold: 3, ClearanceWindowMinutes: 30}
+	outbox := &alertOutboxMock{}
+	svc.outbox = outbox
+	svc.planner = &outboxPlannerStub{targets: []client.OutboxTarget{{Mode: model.NotificationOutboxModeRoot}}}
+
+	svc.ProcessWebhook(makeWebhook(makeAlert("fp-flap", "firing", "warning")))
+
+	if len(store.outboxEntries) != 1 || len(outbox.cancelled) != 1 || len(outbox.released) != 0 {
+		t.Fatalf("entries = %d, cancelled = %v, released = %v; want the entry cancelled", len(store.outboxEntries), outbox.cancelled, outbox.released)
+	}
+	if notifier.notifyCallCount != 0 {
+		t.Fatalf("notifier calls = %d, want 0 for flapping alert", notifier.notifyCallCount)
+	}
+}
+
 func TestResolveAlert_AlreadyResolved(t *testing.T) {
 	store := newAlertStoreMock()
 	store.alertByID["ALR-test0002"] = &model.AlertDetailResponse{
@@ -719,7 +1497,7 @@ func TestResolveAlert_AlreadyResolved(t *testing.T) {
 		Status:  "resolved",
 	}
 
-	svc := NewAlertService(&notifierMock{threadRefs: map[string]string{}}, nil, nil, config.FlappingConfig{}, nil, nil)
+	svc := NewAlertService(&notifierMock{threadRefs: map[string]string{}}, nil, nil, config.FlappingConfig{}, config.AlertDedupeConfig{}, nil, nil)
 	svc.db = store
 
 	err := svc.ResolveAlert("ALR-test0002")
@@ -733,7 +1511,7 @@ func TestResolveAlert_AlreadyResolved(t *testing.T) {
 
 func TestResolveAlert_NotFound(t *testing.T) {
 	store := newAlertStoreMock()
-	svc := NewAlertService(&notifierMock{threadRefs: map[string]string{}}, nil, nil, config.FlappingConfig{}, nil, nil)
+	svc := NewAlertService(&notifierMock{threadRefs: map[string]string{}}, nil, nil, config.FlappingConfig{}, config.AlertDedupeConfig{}, nil, nil)
 	svc.db = store
 
 	err := svc.ResolveAlert("ALR-nonexist")
@@ -754,7 +1532,7 @@ func TestBulkResolveAlerts(t *testing.T) {
 		AlertID: "ALR-a3", Status: "firing", Fingerprint: "fp-a3",
 	}
 
-	svc := NewAlertService(&notifierMock{threadRefs: map[string]string{}}, nil, nil, config.FlappingConfig{}, nil, nil)
+	svc := NewAlertService(&notifierMock{threadRefs: map[string]string{}}, nil, nil, config.FlappingConfig{}, config.AlertDedupeConfig{}, nil, nil)
 	svc.db = store
 
 	resolved, failed := svc.BulkResolveAlerts([]string{"ALR-a1", "ALR-a2", "ALR-a3", "ALR-nonexist"})
@@ -765,3 +1543,160 @@ func TestBulkResolveAlerts(t *testing.T) {
 		t.Errorf("expected 2 failed, got %d", failed)
 	}
 }
+
+func TestDetectFlapping_AppliesMostSpecificPolicy(t *testing.T) {
+	store := newAlertStoreMock()
+	store.flappingCycles = 3
+	svc := newTestAlertService(store, newNotifierMock(), &analyzerMock{})
+	global := config.FlappingConfig{Enabled: true, DetectionWindowMinutes: 30, CycleThreshold: 3, ClearanceWindowMinutes: 30}
+	policies := []model.FlappingPolicy{
+		{ID: 1, Name: "batch", Enabled: true, NeverFlapping: true, Matchers: model.LabelMatchers{{Name: "namespace", Value: "batch", IsEqual: true}}},
+		{ID: 2, Name: "noisy", Enabled: true, CycleThreshold: 5, Matchers: model.LabelMatchers{{Name: "alertname", Value: "TestAlert", IsEqual: true}}},
+	}
+
+	cases := []struct {
+		name      string
+		labels    map[string]string
+		wantID    int64
+		wantFlaps bool
+	}{
+		{"never flapping", map[string]string{"alertname": "Other", "namespace": "batch"}, 1, false},
+		{"raised threshold", map[string]string{"alertname": "TestAlert"}, 2, false},
+		{"global", map[string]string{"alertname": "Other"}, 0, true},
+	}
+	for _, tc := range cases {
+		fp := "fp-" + tc.name
+		store.currentStatus[fp] = "firing"
+		alert := makeAlert(fp, "resolved", "warning")
+		alert.Labels = tc.labels
+
+		policy := effectiveFlappingPolicy(global, matchFlappingPolicy(policies, alert.Labels))
+		if got := policy.PolicyID; (got == nil) != (tc.wantID == 0) || (got != nil && *got != tc.wantID) {
+			t.Fatalf("%s: policy id = %v; want %d", tc.name, got, tc.wantID)
+		}
+		if isFlapping, _ := svc.detectFlapping(alert, policy); isFlapping != tc.wantFlaps {
+			t.Fatalf("%s: isFlapping = %v; want %v", tc.name, isFlapping, tc.wantFlaps)
+		}
+	}
+}
+
+func TestProcessWebhook_RecordsFlappingPolicy(t *testing.T) {
+	store := newAlertStoreMock()
+	store.saveAlertResults = []saveAlertResult{{AlertID: "ALR-batch"}, {AlertID: "ALR-other"}}
+	store.flappingPolicies = []model.FlappingPolicy{
+		{ID: 7, Name: "batch", Enabled: true, NeverFlapping: true, Matchers: model.LabelMatchers{{Name: "namespace", Value: "batch", IsEqual: true}}},
+	}
+	svc := newTestAlertService(store, newNotifierMock(), &analyzerMock{})
+
+	batch := makeAlert("fp-batch", "firing", "warning")
+	batch.Labels["namespace"] = "batch"
+	svc.ProcessWebhook(makeWebhook(batch, makeAlert("fp-other", "firing", "warning")))
+
+	if id := store.flappingPolicyBy["ALR-batch"]; id == nil || *id != 7 {
+		t.Fatalf("flapping policy for batch alert = %v; want 7", id)
+	}
+	if id, ok := store.flappingPolicyBy["ALR-other"]; !ok || id != nil {
+		t.Fatalf("flapping policy for other alert = %v (recorded=%v); want nil recorded", id, ok)
+	}
+}
+
+func TestScheduleFlappingClearanceCheck_PersistsJob(t *testing.T) {
+	store := newAlertStoreMock()
+	jobs := &jobSchedulerMock{}
+	svc := newTestAlertService(store, newNotifierMock(), &analyzerMock{})
+	svc.envFlapping.ClearanceWindowMinutes = 30
+	svc.jobs = jobs
+
+	resolvedAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc.scheduleFlappingClearanceCheck("fp-flap", resolvedAt, effectiveFlappingPolicy(svc.envFlapping, nil))
+
+	if len(jobs.scheduled) != 1 {
+		t.Fatalf("scheduled jobs = %d; want 1", len(jobs.scheduled))
+	}
+	job := jobs.scheduled[0]
+	if job.JobType != model.ScheduledJobTypeFlappingClearance || job.JobKey != "fp-flap" {
+		t.Fatalf("job = %+v; want flapping_clearance keyed by fingerprint", job)
+	}
+	if !job.RunAt.Equal(resolvedAt.Add(30 * time.Minute)) {
+		t.Fatalf("run_at = %v; want %v", job.RunAt, resolvedAt.Add(30*time.Minute))
+	}
+}
+
+func flappingClearanceJob(t *testing.T, fingerprint string, resolvedAt time.Time) model.ScheduledJob {
+	t.Helper()
+	payload, err := json.Marshal(model.FlappingClearancePayload{Fingerprint: fingerprint, ResolvedAt: resolvedAt})
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	return model.ScheduledJob{JobType: model.ScheduledJobTypeFlappingClearance, JobKey: fingerprint, Payload: payload}
+}
+
+func TestRunFlappingClearanceCheck_ClearsStableAlert(t *testing.T) {
+	store := newAlertStoreMock()
+	notifier := newNotifierMock()
+	svc := newTestAlertService(store, notifier, &analyzerMock{})
+
+	resolvedAt := time.Now().Add(-time.Hour)
+	store.isFlapping["fp-flap"] = true
+	store.latestAlerts = map[string]*model.AlertDetailResponse{
+		"fp-flap": {AlertID: "ALR-1", Fingerprint: "fp-flap", Status: "resolved", IsFlapping: true, ResolvedAt: &resolvedAt},
+	}
+	store.deliveries["ALR-1"] = []model.AlertNotificationDelivery{{AlertID: "ALR-1", NotifierType: "slack", ChannelID: "C-test", ThreadTS: "ts-1"}}
+
+	if err := svc.RunFlappingClearanceCheck(context.Background(), flappingClearanceJob(t, "fp-flap", resolvedAt)); err != nil {
+		t.Fatalf("RunFlappingClearanceCheck() error = %v", err)
+	}
+	if len(store.flappingCleared) != 1 || store.isFlapping["fp-flap"] {
+		t.Fatalf("flappingCleared = %v; want fp-flap cleared", store.flappingCleared)
+	}
+	if len(notifier.events) != 1 {
+		t.Fatalf("notifier events = %d; want flapping cleared notification", len(notifier.events))
+	}
+	if _, ok := notifier.events[0].(client.FlappingClearedEvent); !ok {
+		t.Fatalf("event = %T; want FlappingClearedEvent", notifier.events[0])
+	}
+}
+
+func TestRunFlappingClearanceCheck_SkipsRefiredAlert(t *testing.T) {
+	store := newAlertStoreMock()
+	svc := newTestAlertService(store, newNotifierMock(), &analyzerMock{})
+
+	store.latestAlerts = map[string]*model.AlertDetailResponse{
+		"fp-flap": {AlertID: "ALR-2", Fingerprint: "fp-flap", Status: "firing", IsFlapping: true},
+	}
+
+	if err := svc.RunFlappingClearanceCheck(context.Background(), flappingClearanceJob(t, "fp-flap", time.Now().Add(-time.Hour))); err != nil {
+		t.Fatalf("RunFlappingClearanceCheck() error = %v", err)
+	}
+	if len(store.flappingCleared) != 0 {
+		t.Fatalf("flappingCleared = %v; want none for re-fired alert", store.flappingCleared)
+	}
+}
+
+func TestClearFlapping_CancelsPendingJob(t *testing.T) {
+	store := newAlertStoreMock()
+	jobs := &jobSchedulerMock{}
+	svc := newTestAlertService(store, newNotifierMock(), &analyzerMock{})
+	svc.jobs = jobs
+
+	store.alertByID["ALR-1"] = &model.AlertDetailResponse{AlertID: "ALR-1", Fingerprint: "fp-flap", Status: "resolved", IsFlapping: true}
+	store.alertByID["ALR-2"] = &model.AlertDetailResponse{AlertID: "ALR-2", Fingerprint: "fp-calm", Status: "firing"}
+
+	alert, cancelled, err := svc.ClearFlapping(context.Background(), "ALR-1")
+	if err != nil {
+		t.Fatalf("ClearFlapping() error = %v", err)
+	}
+	if alert.Fingerprint != "fp-flap" || cancelled != 1 {
+		t.Fatalf("ClearFlapping() = (%s, %d); want (fp-flap, 1)", alert.Fingerprint, cancelled)
+	}
+	if len(jobs.cancelled) != 1 || jobs.cancelled[0] != model.ScheduledJobTypeFlappingClearance+"/fp-flap" {
+		t.Fatalf("cancelled = %v; want flapping_clearance/fp-flap", jobs.cancelled)
+	}
+	if len(store.flappingCleared) != 1 {
+		t.Fatalf("flappingCleared = %v; want fp-flap", store.flappingCleared)
+	}
+
+	if _, _, err := svc.ClearFlapping(context.Background(), "ALR-2"); !errors.Is(err, ErrAlertNotFlapping) {
+		t.Fatalf("ClearFlapping(non-flapping) error = %v; want ErrAlertNotFlapping", err)
+	}
+}
@@ -0,0 +1,314 @@
+// Alertmanager silence 생성/만료 (alert 상세에서 바로 음소거)
+//
+// 처리 흐름:
+//  1. 수신 라벨 원본(source_labels)으로 equality 매처 생성 (match_labels 지정 시 해당 라벨만)
+//     - kube-rca가 붙인 enrichment 라벨/정규화된 severity는 Alertmanager의 alert에 없으므로 사용하지 않음
+//  2. alert의 external_url(웹훅 externalURL)로 호출할 Alertmanager 결정
+//     - ALERTMANAGER_URL_MAP에 매핑이 있으면 내부 주소, ALERTMANAGER_URL과 같거나 비어 있으면 ALERTMANAGER_URL
+//     - 설정되지 않은 주소는 호출하지 않음 (웹훅 payload의 externalURL로 임의 주소를 호출하는 SSRF 방지)
+//  3. Alertmanager v2 API로 silence 생성 후 silence ID/만료 시각을 alertmanager_silences에 기록
+//  4. 조기 만료 시 같은 Alertmanager에 DELETE /api/v2/silence/{id} 호출 후 expired_at 기록
+//
+// kube-rca 자체 silence 규칙(silence_rules)과 달리 Alertmanager에서 알림 자체가 멈추므로
+// 다른 receiver(PagerDuty 등)까지 함께 음소거된다.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/db"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// defaultAlertSilenceDuration - 기간 미지정 시 silence 유지 시간
+const defaultAlertSilenceDuration = 2 * time.Hour
+
+var (
+	ErrInvalidAlertmanagerSilence  = errors.New("invalid alertmanager silence")
+	ErrAlertmanagerSilenceNotFound = errors.New("alertmanager silence not found")
+	ErrAlertNotFound               = errors.New("alert not found")
+)
+
+// alertmanagerSilencer - Alertmanager v2 silence API 인터페이스 (client.AlertmanagerClient)
+type alertmanagerSilencer interface {
+	CreateSilence(ctx context.Context, baseURL string, silence model.AlertmanagerAPISilence) (string, error)
+	ExpireSilence(ctx context.Context, baseURL, silenceID string) error
+}
+
+// alertmanagerSilenceStore - AlertmanagerSilenceService가 사용하는 DB 인터페이스
+type alertmanagerSilenceStore interface {
+	GetAlertDetail(alertID string) (*model.AlertDetailResponse, error)
+	GetAlertSourceLabels(alertID string) (map[string]string, error)
+	CreateAlertmanagerSilence(ctx context.Context, s model.AlertmanagerSilence) (int64, error)
+	GetAlertmanagerSilence(ctx context.Context, id int64) (*model.AlertmanagerSilence, error)
+	ListActiveAlertmanagerSilencesByAlert(ctx context.Context, alertID string, at time.Time) ([]model.AlertmanagerSilence, error)
+	ListActiveAlertmanagerSilencesByIncident(ctx context.Context, incidentID string, at time.Time) ([]model.AlertmanagerSilence, error)
+	MarkAlertmanagerSilenceExpired(ctx context.Context, id int64, at time.Time) error
+}
+
+// AlertmanagerSilenceService - alert 기반 Alertmanager silence 관리
+type AlertmanagerSilenceService struct {
+	am     alertmanagerSilencer
+	store  alertmanagerSilenceStore
+	url    string
+	urlMap map[string]string // externalURL → 내부 API 주소
+	now    func() time.Time
+}
+
+func NewAlertmanagerSilenceService(am alertmanagerSilencer, store alertmanagerSilenceStore, cfg config.AlertmanagerConfig) *AlertmanagerSilenceService {
+	return &AlertmanagerSilenceService{
+		am:     am,
+		store:  store,
+		url:    strings.TrimRight(strings.TrimSpace(cfg.URL), "/"),
+		urlMap: parseAlertmanagerURLMap(cfg.URLMap),
+		now:    time.Now,
+	}
+}
+
+// SilenceAlert - alert 라벨로 Alertmanager silence 생성
+func (s *AlertmanagerSilenceService) SilenceAlert(ctx context.Context, alertID string, req model.AlertSilenceRequest, createdBy string) (*model.AlertmanagerSilence, error) {
+	alert, err := s.store.GetAlertDetail(alertID)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return nil, ErrAlertNotFound
+		}
+		return nil, fmt.Errorf("failed to load alert: %w", err)
+	}
+
+	baseURL, err := s.resolveURL(alert.ExternalURL)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := s.store.GetAlertSourceLabels(alert.AlertID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load alert source labels: %w", err)
+	}
+	if labels == nil {
+		if labels, err = legacyAlertSourceLabels(alert); err != nil {
+			return nil, err
+		}
+	}
+	matchers, err := alertSilenceMatchers(labels, req.MatchLabels)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	endsAt, err := alertSilenceEndsAt(now, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if createdBy == "" {
+		createdBy = "kube-rca"
+	}
+	comment := strings.TrimSpace(req.Comment)
+	if comment == "" {
+		comment = fmt.Sprintf("Silenced from kube-rca (alert_id=%s)", alert.AlertID)
+	}
+
+	apiMatchers := make([]model.AlertmanagerAPIMatcher, 0, len(matchers))
+	for _, m := range matchers {
+		apiMatchers = append(apiMatchers, model.AlertmanagerAPIMatcher(m))
+	}
+	silenceID, err := s.am.CreateSilence(ctx, baseURL, model.AlertmanagerAPISilence{
+		Matchers:  apiMatchers,
+		StartsAt:  now,
+		EndsAt:    endsAt,
+		CreatedBy: createdBy,
+		Comment:   comment,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create alertmanager silence: %w", err)
+	}
+
+	silence := model.AlertmanagerSilence{
+		SilenceID:       silenceID,
+		AlertmanagerURL: baseURL,
+		AlertID:         alert.AlertID,
+		Matchers:        matchers,
+		StartsAt:        now,
+		EndsAt:          endsAt,
+		Comment:         comment,
+		CreatedBy:       createdBy,
+		CreatedAt:       now,
+	}
+	if alert.IncidentID != nil {
+		silence.IncidentID = *alert.IncidentID
+	}
+	id, err := s.store.CreateAlertmanagerSilence(ctx, silence)
+	if err != nil {
+		return nil, fmt.Errorf("alertmanager silence %s created but not recorded: %w", silenceID, err)
+	}
+	silence.ID = id
+	return &silence, nil
+}
+
+// ListForAlert - alert에 대해 현재 유효한 silence 목록
+func (s *AlertmanagerSilenceService) ListForAlert(ctx context.Context, alertID string) ([]model.AlertmanagerSilence, error) {
+	return s.store.ListActiveAlertmanagerSilencesByAlert(ctx, alertID, s.now())
+}
+
+// ListForIncident - incident에 속한 alert들의 현재 유효한 silence 목록
+func (s *AlertmanagerSilenceService) ListForIncident(ctx context.Context, incidentID string) ([]model.AlertmanagerSilence, error) {
+	return s.store.ListActiveAlertmanagerSilencesByIncident(ctx, incidentID, s.now())
+}
+
+// Expire - silence 조기 만료 (이미 만료된 silence는 그대로 반환)
+func (s *AlertmanagerSilenceService) Expire(ctx context.Context, id int64) (*model.AlertmanagerSilence, error) {
+	silence, err := s.store.GetAlertmanagerSilence(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if silence == nil {
+		return nil, ErrAlertmanagerSilenceNotFound
+	}
+
+	now := s.now()
+	if silence.ExpiredAt != nil || !silence.EndsAt.After(now) {
+		return silence, nil
+	}
+
+	if err := s.am.ExpireSilence(ctx, silence.AlertmanagerURL, silence.SilenceID); err != nil {
+		return nil, fmt.Errorf("failed to expire alertmanager silence: %w", err)
+	}
+	if err := s.store.MarkAlertmanagerSilenceExpired(ctx, id, now); err != nil {
+		return nil, err
+	}
+	silence.ExpiredAt = &now
+	return silence, nil
+}
+
+// resolveURL - alert의 externalURL로 호출할 Alertmanager API 주소 결정 (설정된 주소만 허용)
+func (s *AlertmanagerSilenceService) resolveURL(externalURL string) (string, error) {
+	externalURL = strings.TrimRight(strings.TrimSpace(externalURL), "/")
+	if mapped, ok := s.urlMap[externalURL]; ok {
+		return mapped, nil
+	}
+	if s.url == "" {
+		return "", ErrAlertmanagerNotConfigured
+	}
+	if externalURL != "" && externalURL != s.url {
+		return "", fmt.Errorf("%w: externalURL %s is not in ALERTMANAGER_URL_MAP", ErrAlertmanagerNotConfigured, externalURL)
+	}
+	return s.url, nil
+}
+
+// parseAlertmanagerURLMap - "external=internal,..." 형식 파싱 (잘못된 항목은 무시)
+func parseAlertmanagerURLMap(raw string) map[string]string {
+	m := make(map[string]string)
+	for _, pair := range strings.Split(raw, ",") {
+		external, internal, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		external = strings.TrimRight(strings.TrimSpace(external), "/")
+		internal = strings.TrimRight(strings.TrimSpace(internal), "/")
+		if external == "" || internal == "" {
+			continue
+		}
+		m[external] = internal
+	}
+	return m
+}
+
+// legacyAlertSourceLabels - source_labels가 없는 이전 alert의 수신 라벨 복원
+// enrichment가 추가한 라벨은 제거(덮어쓴 라벨은 이전 값으로), 정규화된 severity는 source_severity로 되돌림
+func legacyAlertSourceLabels(alert *model.AlertDetailResponse) (map[string]string, error) {
+	labels := map[string]string{}
+	if len(alert.Labels) > 0 {
+		if err := json.Unmarshal(alert.Labels, &labels); err != nil {
+			return nil, fmt.Errorf("failed to decode alert labels: %w", err)
+		}
+	}
+	var enrichments []model.AlertEnrichment
+	if len(alert.Enrichments) > 0 {
+		if err := json.Unmarshal(alert.Enrichments, &enrichments); err != nil {
+			return nil, fmt.Errorf("failed to decode alert enrichments: %w", err)
+		}
+	}
+	for i := len(enrichments) - 1; i >= 0; i-- {
+		e := enrichments[i]
+		if e.Target != model.EnrichmentTargetLabel {
+			continue
+		}
+		if e.Replaced != "" {
+			labels[e.Key] = e.Replaced
+		} else {
+			delete(labels, e.Key)
+		}
+	}
+	var annotations map[string]string
+	if len(alert.Annotations) > 0 {
+		if err := json.Unmarshal(alert.Annotations, &annotations); err != nil {
+			return nil, fmt.Errorf("failed to decode alert annotations: %w", err)
+		}
+	}
+	if raw := annotations["source_severity"]; raw != "" {
+		labels["severity"] = raw
+	}
+	return labels, nil
+}
+
+// alertSilenceMatchers - alert 라벨로 equality 매처 생성 (라벨 이름순)
+// only가 비어 있으면 전체 라벨 사용, 지정 시 해당 라벨만 (alert에 없는 라벨은 오류)
+func alertSilenceMatchers(labels map[string]string, only []string) (model.LabelMatchers, error) {
+	names := make([]string, 0, len(labels))
+	if len(only) == 0 {
+		for name := range labels {
+			names = append(names, name)
+		}
+	} else {
+		seen := make(map[string]bool, len(only))
+		for _, name := range only {
+			name = strings.TrimSpace(name)
+			if name == "" || seen[name] {
+				continue
+			}
+			if _, ok := labels[name]; !ok {
+				return nil, fmt.Errorf("%w: alert has no label %q", ErrInvalidAlertmanagerSilence, name)
+			}
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	matchers := make(model.LabelMatchers, 0, len(names))
+	for _, name := range names {
+		if labels[name] == "" {
+			continue
+		}
+		matchers = append(matchers, model.LabelMatcher{Name: name, Value: labels[name], IsEqual: true})
+	}
+	if len(matchers) == 0 {
+		return nil, fmt.Errorf("%w: no labels to match", ErrInvalidAlertmanagerSilence)
+	}
+	return matchers, nil
+}
+
+// alertSilenceEndsAt - ends_at > duration_minutes > 기본값 순으로 만료 시각 결정
+func alertSilenceEndsAt(now time.Time, req model.AlertSilenceRequest) (time.Time, error) {
+	switch {
+	case req.EndsAt != nil:
+		if !req.EndsAt.After(now) {
+			return time.Time{}, fmt.Errorf("%w: ends_at must be in the future", ErrInvalidAlertmanagerSilence)
+		}
+		return *req.EndsAt, nil
+	case req.DurationMinutes < 0:
+		return time.Time{}, fmt.Errorf("%w: duration_minutes must be positive", ErrInvalidAlertmanagerSilence)
+	case req.DurationMinutes > 0:
+		return now.Add(time.Duration(req.DurationMinutes) * time.Minute), nil
+	default:
+		return now.Add(defaultAlertSilenceDuration), nil
+	}
+}
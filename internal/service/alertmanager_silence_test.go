@@ -0,0 +1,253 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// ============================================================================
+// Mock: alertmanagerSilencer / alertmanagerSilenceStore
+// ============================================================================
+
+type alertmanagerSilencerMock struct {
+	created []model.AlertmanagerAPISilence
+	urls    []string
+	expired []string
+}
+
+func (m *alertmanagerSilencerMock) CreateSilence(_ context.Context, baseURL string, silence model.AlertmanagerAPISilence) (string, error) {
+	m.created = append(m.created, silence)
+	m.urls = append(m.urls, baseURL)
+	return "sil-1", nil
+}
+
+func (m *alertmanagerSilencerMock) ExpireSilence(_ context.Context, baseURL, silenceID string) error {
+	m.expired = append(m.expired, baseURL+"#"+silenceID)
+	return nil
+}
+
+type alertmanagerSilenceStoreMock struct {
+	alerts       map[string]*model.AlertDetailResponse
+	sourceLabels map[string]map[string]string
+	silences     map[int64]*model.AlertmanagerSilence
+}
+
+func (m *alertmanagerSilenceStoreMock) GetAlertDetail(alertID string) (*model.AlertDetailResponse, error) {
+	if a, ok := m.alerts[alertID]; ok {
+		return a, nil
+	}
+	return nil, pgx.ErrNoRows
+}
+
+func (m *alertmanagerSilenceStoreMock) GetAlertSourceLabels(alertID string) (map[string]string, error) {
+	return m.sourceLabels[alertID], nil
+}
+
+func (m *alertmanagerSilenceStoreMock) CreateAlertmanagerSilence(_ context.Context, s model.AlertmanagerSilence) (int64, error) {
+	id := int64(len(m.silences) + 1)
+	s.ID = id
+	m.silences[id] = &s
+	return id, nil
+}
+
+func (m *alertmanagerSilenceStoreMock) GetAlertmanagerSilence(_ context.Context, id int64) (*model.AlertmanagerSilence, error) {
+	return m.silences[id], nil
+}
+
+func (m *alertmanagerSilenceStoreMock) ListActiveAlertmanagerSilencesByAlert(_ context.Context, alertID string, at time.Time) ([]model.AlertmanagerSilence, error) {
+	var list []model.AlertmanagerSilence
+	for _, s := range m.silences {
+		if s.AlertID == alertID && s.ExpiredAt == nil && s.EndsAt.After(at) {
+			list = append(list, *s)
+		}
+	}
+	return list, nil
+}
+
+func (m *alertmanagerSilenceStoreMock) ListActiveAlertmanagerSilencesByIncident(_ context.Context, incidentID string, at time.Time) ([]model.AlertmanagerSilence, error) {
+	var list []model.AlertmanagerSilence
+	for _, s := range m.silences {
+		if s.IncidentID == incidentID && s.ExpiredAt == nil && s.EndsAt.After(at) {
+			list = append(list, *s)
+		}
+	}
+	return list, nil
+}
+
+func (m *alertmanagerSilenceStoreMock) MarkAlertmanagerSilenceExpired(_ context.Context, id int64, at time.Time) error {
+	m.silences[id].ExpiredAt = &at
+	return nil
+}
+
+func newTestAlertmanagerSilenceService(cfg config.AlertmanagerConfig) (*AlertmanagerSilenceService, *alertmanagerSilencerMock, *alertmanagerSilenceStoreMock) {
+	incidentID := "INC-1"
+	labels, _ := json.Marshal(map[string]string{"alertname": "PodCrashLooping", "namespace": "prod", "pod": "api-0", "empty": ""})
+	store := &alertmanagerSilenceStoreMock{
+		alerts: map[string]*model.AlertDetailResponse{
+			"ALR-1": {AlertID: "ALR-1", IncidentID: &incidentID, Labels: labels, ExternalURL: "https://am.example.com/"},
+		},
+		silences: make(map[int64]*model.AlertmanagerSilence),
+	}
+	am := &alertmanagerSilencerMock{}
+	svc := NewAlertmanagerSilenceService(am, store, cfg)
+	svc.now = func() time.Time { return time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC) }
+	return svc, am, store
+}
+
+// ============================================================================
+// Tests
+// ============================================================================
+
+func TestAlertmanagerSilence_CreatesFromAlertLabels(t *testing.T) {
+	svc, am, _ := newTestAlertmanagerSilenceService(config.AlertmanagerConfig{
+		URL:    "http://fallback:9093",
+		URLMap: "https://am.example.com=http://alertmanager.monitoring:9093/",
+	})
+
+	silence, err := svc.SilenceAlert(context.Background(), "ALR-1", model.AlertSilenceRequest{}, "alice")
+	if err != nil {
+		t.Fatalf("SilenceAlert() error = %v", err)
+	}
+
+	if len(am.urls) != 1 || am.urls[0] != "http://alertmanager.monitoring:9093" {
+		t.Fatalf("alertmanager url = %v; want mapped internal url", am.urls)
+	}
+	got := am.created[0].Matchers
+	if len(got) != 3 || got[0].Name != "alertname" || got[1].Name != "namespace" || got[2].Name != "pod" || got[2].Value != "api-0" || !got[0].IsEqual {
+		t.Fatalf("matchers = %+v; want sorted equality matchers without empty label", got)
+	}
+	if want := svc.now().Add(2 * time.Hour); !silence.EndsAt.Equal(want) || !am.created[0].EndsAt.Equal(want) {
+		t.Fatalf("EndsAt = %v; want %v", silence.EndsAt, want)
+	}
+	if silence.SilenceID != "sil-1" || silence.IncidentID != "INC-1" || silence.CreatedBy != "alice" || silence.Comment == "" {
+		t.Fatalf("silence = %+v", silence)
+	}
+
+	list, _ := svc.ListForIncident(context.Background(), "INC-1")
+	if len(list) != 1 || list[0].ID != silence.ID {
+		t.Fatalf("ListForIncident() = %+v; want the created silence", list)
+	}
+}
+
+func TestAlertmanagerSilence_MatchLabelsSubset(t *testing.T) {
+	svc, am, _ := newTestAlertmanagerSilenceService(config.AlertmanagerConfig{URL: "https://am.example.com/"})
+
+	_, err := svc.SilenceAlert(context.Background(), "ALR-1", model.AlertSilenceRequest{MatchLabels: []string{"namespace", "alertname"}, DurationMinutes: 30}, "")
+	if err != nil {
+		t.Fatalf("SilenceAlert() error = %v", err)
+	}
+	if am.urls[0] != "https://am.example.com" {
+		t.Fatalf("alertmanager url = %s; want ALERTMANAGER_URL matching the external url", am.urls[0])
+	}
+	got := am.created[0]
+	if len(got.Matchers) != 2 || got.Matchers[0].Name != "alertname" || got.Matchers[1].Name != "namespace" || got.CreatedBy != "kube-rca" {
+		t.Fatalf("silence = %+v", got)
+	}
+
+	_, err = svc.SilenceAlert(context.Background(), "ALR-1", model.AlertSilenceRequest{MatchLabels: []string{"node"}}, "")
+	if !errors.Is(err, ErrInvalidAlertmanagerSilence) {
+		t.Fatalf("SilenceAlert(unknown label) error = %v; want ErrInvalidAlertmanagerSilence", err)
+	}
+}
+
+func TestAlertmanagerSilence_UsesSourceLabels(t *testing.T) {
+	svc, am, store := newTestAlertmanagerSilenceService(config.AlertmanagerConfig{URL: "https://am.example.com"})
+	// 저장된 라벨: severity 정규화(P1 → critical) + enrichment가 추가한 team
+	labels, _ := json.Marshal(map[string]string{"alertname": "DiskFull", "severity": "critical", "team": "storage"})
+	store.alerts["ALR-2"] = &model.AlertDetailResponse{AlertID: "ALR-2", Labels: labels, ExternalURL: "https://am.example.com"}
+	store.sourceLabels = map[string]map[string]string{"ALR-2": {"alertname": "DiskFull", "severity": "P1"}}
+
+	if _, err := svc.SilenceAlert(context.Background(), "ALR-2", model.AlertSilenceRequest{}, ""); err != nil {
+		t.Fatalf("SilenceAlert() error = %v", err)
+	}
+	got := am.created[0].Matchers
+	if len(got) != 2 || got[0].Name != "alertname" || got[1].Name != "severity" || got[1].Value != "P1" {
+		t.Fatalf("matchers = %+v; want original alertname/severity only", got)
+	}
+}
+
+func TestLegacyAlertSourceLabels(t *testing.T) {
+	labels, _ := json.Marshal(map[string]string{"alertname": "DiskFull", "severity": "critical", "team": "storage", "env": "prod"})
+	annotations, _ := json.Marshal(map[string]string{"source_severity": "P1"})
+	enrichments, _ := json.Marshal([]model.AlertEnrichment{
+		{Target: model.EnrichmentTargetLabel, Key: "team", Value: "storage"},
+		{Target: model.EnrichmentTargetLabel, Key: "env", Value: "prod", Replaced: "production"},
+		{Target: "annotation", Key: "runbook_url", Value: "https://runbooks"},
+	})
+
+	got, err := legacyAlertSourceLabels(&model.AlertDetailResponse{Labels: labels, Annotations: annotations, Enrichments: enrichments})
+	if err != nil {
+		t.Fatalf("legacyAlertSourceLabels() error = %v", err)
+	}
+	want := map[string]string{"alertname": "DiskFull", "severity": "P1", "env": "production"}
+	if len(got) != len(want) {
+		t.Fatalf("labels = %v; want %v", got, want)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Fatalf("labels = %v; want %v", got, want)
+		}
+	}
+}
+
+func TestAlertmanagerSilence_Errors(t *testing.T) {
+	svc, am, store := newTestAlertmanagerSilenceService(config.AlertmanagerConfig{URL: "https://am.example.com"})
+	ctx := context.Background()
+
+	if _, err := svc.SilenceAlert(ctx, "ALR-404", model.AlertSilenceRequest{}, ""); !errors.Is(err, ErrAlertNotFound) {
+		t.Fatalf("missing alert error = %v; want ErrAlertNotFound", err)
+	}
+
+	past := svc.now().Add(-time.Minute)
+	if _, err := svc.SilenceAlert(ctx, "ALR-1", model.AlertSilenceRequest{EndsAt: &past}, ""); !errors.Is(err, ErrInvalidAlertmanagerSilence) {
+		t.Fatalf("past ends_at error = %v; want ErrInvalidAlertmanagerSilence", err)
+	}
+
+	// 설정되지 않은 externalURL은 호출하지 않음
+	store.alerts["ALR-1"].ExternalURL = "http://169.254.169.254/latest"
+	if _, err := svc.SilenceAlert(ctx, "ALR-1", model.AlertSilenceRequest{}, ""); !errors.Is(err, ErrAlertmanagerNotConfigured) || len(am.urls) != 0 {
+		t.Fatalf("unmapped external url error = %v, calls = %v; want ErrAlertmanagerNotConfigured without a call", err, am.urls)
+	}
+
+	unconfigured, _, unconfiguredStore := newTestAlertmanagerSilenceService(config.AlertmanagerConfig{})
+	unconfiguredStore.alerts["ALR-1"].ExternalURL = ""
+	if _, err := unconfigured.SilenceAlert(ctx, "ALR-1", model.AlertSilenceRequest{}, ""); !errors.Is(err, ErrAlertmanagerNotConfigured) {
+		t.Fatalf("no alertmanager error = %v; want ErrAlertmanagerNotConfigured", err)
+	}
+
+	if _, err := svc.Expire(ctx, 99); !errors.Is(err, ErrAlertmanagerSilenceNotFound) {
+		t.Fatalf("Expire(missing) error = %v; want ErrAlertmanagerSilenceNotFound", err)
+	}
+}
+
+func TestAlertmanagerSilence_ExpireEarly(t *testing.T) {
+	svc, am, _ := newTestAlertmanagerSilenceService(config.AlertmanagerConfig{URL: "https://am.example.com"})
+	ctx := context.Background()
+
+	silence, err := svc.SilenceAlert(ctx, "ALR-1", model.AlertSilenceRequest{}, "")
+	if err != nil {
+		t.Fatalf("SilenceAlert() error = %v", err)
+	}
+	expired, err := svc.Expire(ctx, silence.ID)
+	if err != nil {
+		t.Fatalf("Expire() error = %v", err)
+	}
+	if expired.ExpiredAt == nil || len(am.expired) != 1 || am.expired[0] != "https://am.example.com#sil-1" {
+		t.Fatalf("expired = %+v, calls = %v", expired, am.expired)
+	}
+
+	// 두 번째 만료는 Alertmanager를 다시 호출하지 않는다
+	if _, err := svc.Expire(ctx, silence.ID); err != nil || len(am.expired) != 1 {
+		t.Fatalf("second Expire() error = %v, calls = %v", err, am.expired)
+	}
+	if list, _ := svc.ListForAlert(ctx, "ALR-1"); len(list) != 0 {
+		t.Fatalf("ListForAlert() after expiry = %+v; want empty", list)
+	}
+}
@@ -0,0 +1,279 @@
+// Alertmanager 상태 동기화 (truncated/누락 alert 복구)
+//
+// 처리 흐름:
+//  1. 시작 시(다운타임 동안 놓친 alert), 주기적으로, truncated 웹훅 수신 시 Alertmanager /api/v2/alerts 조회
+//  2. kube-rca receiver로 웹훅이 전달될 alert(silence/inhibit 제외) 중 DB에 firing이 없고
+//     severity 분류상 처리 대상인 alert → firing으로 enqueue (복구)
+//  3. DB에는 firing인데 Alertmanager에 없는(suppressed 포함) alertmanager 수신 alert → resolved로 enqueue
+//     - 방금 수신된 alert는 Alertmanager 반영 지연을 고려해 resolveGrace 동안 제외
+//     - ALERTMANAGER_URL_MAP으로 여러 Alertmanager를 쓰면 저장된 externalURL이 ALERTMANAGER_URL에 대응하는 alert만 대상
+//       (다른 Alertmanager의 alert는 조회한 목록에 없어도 resolved 처리하지 않음)
+//  4. enqueue된 웹훅은 일반 웹훅과 같은 inbox 파이프라인으로 처리 (멱등 키로 실제 웹훅과 중복 제거)
+//
+// 다른 receiver로만 라우팅되는 alert를 가져오지 않도록 ALERTMANAGER_RECEIVER가 없으면 동기화하지 않는다.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// resolveGrace - 최근 수신된 firing alert는 Alertmanager에 없어도 resolved 처리하지 않음
+const resolveGrace = 5 * time.Minute
+
+const (
+	reconcileTriggerStartup   = "startup"
+	reconcileTriggerInterval  = "interval"
+	reconcileTriggerTruncated = "truncated"
+	reconcileTriggerManual    = "manual"
+)
+
+// ErrAlertmanagerNotConfigured - ALERTMANAGER_URL(동기화는 ALERTMANAGER_RECEIVER 포함) 미설정
+var ErrAlertmanagerNotConfigured = errors.New("alertmanager is not configured")
+
+// alertmanagerAlertLister - Alertmanager v2 API 조회 인터페이스 (client.AlertmanagerClient)
+type alertmanagerAlertLister interface {
+	IsConfigured() bool
+	ListAlerts(ctx context.Context, includeSuppressed bool) ([]model.AlertmanagerAPIAlert, error)
+}
+
+// alertIngestFilter - 웹훅으로 수신했을 때 저장·처리 대상인 alert인지 판단 (AlertService)
+type alertIngestFilter interface {
+	WouldIngest(labels map[string]string) bool
+}
+
+// alertmanagerSyncStore - AlertmanagerSyncService가 사용하는 DB 인터페이스
+type alertmanagerSyncStore interface {
+	ListFiringFingerprints() ([]string, error)
+	ListQuietFiringAlerts(source string, quietSince time.Time) ([]model.Alert, error)
+}
+
+// AlertmanagerSyncService - Alertmanager 상태와 alerts 테이블 동기화
+type AlertmanagerSyncService struct {
+	am      alertmanagerAlertLister
+	store   alertmanagerSyncStore
+	inbox   webhookEnqueuer
+	filter  alertIngestFilter
+	cfg     config.AlertmanagerConfig
+	url     string            // 조회 대상 Alertmanager API 주소 (ALERTMANAGER_URL)
+	urlMap  map[string]string // externalURL → API 주소 (ALERTMANAGER_URL_MAP)
+	now     func() time.Time
+	trigger chan struct{}
+	mu      sync.Mutex // Reconcile 동시 실행 방지
+}
+
+func NewAlertmanagerSyncService(am alertmanagerAlertLister, store alertmanagerSyncStore, inbox webhookEnqueuer, cfg config.AlertmanagerConfig) *AlertmanagerSyncService {
+	return &AlertmanagerSyncService{
+		am:      am,
+		store:   store,
+		inbox:   inbox,
+		cfg:     cfg,
+		url:     strings.TrimRight(strings.TrimSpace(cfg.URL), "/"),
+		urlMap:  parseAlertmanagerURLMap(cfg.URLMap),
+		now:     time.Now,
+		trigger: make(chan struct{}, 1),
+	}
+}
+
+// SetIngestFilter - 복구 대상을 severity 분류상 처리되는 alert로 제한
+func (s *AlertmanagerSyncService) SetIngestFilter(filter alertIngestFilter) {
+	s.filter = filter
+}
+
+// Enabled - Alertmanager URL과 kube-rca receiver가 설정되었는지 여부
+func (s *AlertmanagerSyncService) Enabled() bool {
+	return s.am != nil && s.am.IsConfigured() && s.cfg.Receiver != ""
+}
+
+// Trigger - truncated 웹훅 수신 시 즉시 동기화 요청 (여러 요청은 한 번으로 합쳐짐)
+func (s *AlertmanagerSyncService) Trigger() {
+	select {
+	case s.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// Start - 시작 시 1회 + 주기/트리거 동기화 (URL 미설정 시 비활성, interval <= 0이면 주기 동기화 안 함)
+func (s *AlertmanagerSyncService) Start(ctx context.Context) {
+	if !s.Enabled() {
+		log.Println("Alertmanager sync disabled (ALERTMANAGER_URL or ALERTMANAGER_RECEIVER is empty)")
+		return
+	}
+	go func() {
+		s.runAndLog(ctx, reconcileTriggerStartup)
+
+		var tick <-chan time.Time
+		if s.cfg.ReconcileIntervalSeconds > 0 {
+			ticker := time.NewTicker(time.Duration(s.cfg.ReconcileIntervalSeconds) * time.Second)
+			defer ticker.Stop()
+			tick = ticker.C
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-tick:
+				s.runAndLog(ctx, reconcileTriggerInterval)
+			case <-s.trigger:
+				s.runAndLog(ctx, reconcileTriggerTruncated)
+			}
+		}
+	}()
+}
+
+func (s *AlertmanagerSyncService) runAndLog(ctx context.Context, trigger string) {
+	result, err := s.Reconcile(ctx, trigger)
+	if err != nil {
+		log.Printf("Alertmanager sync failed (trigger=%s): %v", trigger, err)
+		return
+	}
+	if result.Recovered > 0 || result.Resolved > 0 {
+		log.Printf("Alertmanager sync (trigger=%s): fetched=%d recovered=%d resolved=%d inbox_id=%d",
+			trigger, result.Fetched, result.Recovered, result.Resolved, result.InboxID)
+	}
+}
+
+// ReconcileNow - 관리자 API용 수동 동기화
+func (s *AlertmanagerSyncService) ReconcileNow(ctx context.Context) (model.AlertmanagerReconcileResult, error) {
+	if !s.Enabled() {
+		return model.AlertmanagerReconcileResult{}, ErrAlertmanagerNotConfigured
+	}
+	return s.Reconcile(ctx, reconcileTriggerManual)
+}
+
+// Reconcile - Alertmanager active alert와 DB firing alert를 비교하여 차이를 inbox에 enqueue
+func (s *AlertmanagerSyncService) Reconcile(ctx context.Context, trigger string) (model.AlertmanagerReconcileResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now().UTC()
+	result := model.AlertmanagerReconcileResult{Trigger: trigger, RanAt: now}
+
+	amAlerts, err := s.am.ListAlerts(ctx, false)
+	if err != nil {
+		return result, err
+	}
+	result.Fetched = len(amAlerts)
+
+	firingList, err := s.store.ListFiringFingerprints()
+	if err != nil {
+		return result, err
+	}
+	firing := make(map[string]bool, len(firingList))
+	for _, fp := range firingList {
+		firing[fp] = true
+	}
+
+	var alerts []model.Alert
+	for _, a := range amAlerts {
+		if firing[a.Fingerprint] {
+			continue
+		}
+		// severity 분류에서 버려지는 alert는 웹훅으로 와도 저장되지 않으므로 복구하지 않음
+		if s.filter != nil && !s.filter.WouldIngest(a.Labels) {
+			continue
+		}
+		alerts = append(alerts, alertFromAlertmanagerAPI(a))
+		result.Recovered++
+	}
+
+	quiet, err := s.store.ListQuietFiringAlerts(model.AlertSourceAlertmanager, now.Add(-resolveGrace))
+	if err != nil {
+		return result, err
+	}
+	// silence/inhibit된 alert도 여전히 firing이므로 resolved 판단에는 suppressed를 포함한 목록을 사용
+	active := make(map[string]bool)
+	if len(quiet) > 0 {
+		allAlerts, err := s.am.ListAlerts(ctx, true)
+		if err != nil {
+			return result, err
+		}
+		for _, a := range allAlerts {
+			active[a.Fingerprint] = true
+		}
+	}
+	// 여러 backend replica가 같은 분 안에 동기화해도 resolved 멱등 키가 같도록 분 단위로 절삭
+	endsAt := now.Truncate(time.Minute)
+	for _, a := range quiet {
+		if active[a.Fingerprint] || !s.fromQueriedAlertmanager(a.ExternalURL) {
+			continue
+		}
+		a.Status = "resolved"
+		a.EndsAt = endsAt
+		alerts = append(alerts, a)
+		result.Resolved++
+	}
+
+	if len(alerts) == 0 {
+		return result, nil
+	}
+	webhook := buildIngestWebhook(model.AlertSourceAlertmanager, "alertmanager:reconcile", alerts)
+	// 복구된 alert도 다음 동기화에서 같은 Alertmanager의 alert로 식별되도록 externalURL 지정
+	webhook.ExternalURL = s.queriedExternalURL()
+	id, err := s.inbox.Enqueue(ctx, webhook, model.AlertSourceAlertmanager, "")
+	if err != nil {
+		return result, err
+	}
+	result.InboxID = id
+	return result, nil
+}
+
+// fromQueriedAlertmanager - 저장된 externalURL이 조회한 Alertmanager(ALERTMANAGER_URL)의 alert인지
+// URL map이 없으면 Alertmanager가 하나이므로 모두 대상, 있으면 map 또는 ALERTMANAGER_URL과 일치하는 alert만 대상
+func (s *AlertmanagerSyncService) fromQueriedAlertmanager(externalURL string) bool {
+	if len(s.urlMap) == 0 {
+		return true
+	}
+	externalURL = strings.TrimRight(strings.TrimSpace(externalURL), "/")
+	if mapped, ok := s.urlMap[externalURL]; ok {
+		return mapped == s.url
+	}
+	return externalURL != "" && externalURL == s.url
+}
+
+// queriedExternalURL - 조회한 Alertmanager의 externalURL (URL map이 없으면 빈 값 = 저장된 값 유지)
+func (s *AlertmanagerSyncService) queriedExternalURL() string {
+	if len(s.urlMap) == 0 {
+		return ""
+	}
+	var externals []string
+	for external, internal := range s.urlMap {
+		if internal == s.url {
+			externals = append(externals, external)
+		}
+	}
+	if len(externals) == 0 {
+		return s.url
+	}
+	sort.Strings(externals)
+	return externals[0]
+}
+
+// alertFromAlertmanagerAPI - v2 API alert를 웹훅 alert 형식으로 변환 (active alert는 firing)
+func alertFromAlertmanagerAPI(a model.AlertmanagerAPIAlert) model.Alert {
+	labels := a.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	annotations := a.Annotations
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	return model.Alert{
+		Status:       "firing",
+		Labels:       labels,
+		Annotations:  annotations,
+		StartsAt:     a.StartsAt.UTC(),
+		GeneratorURL: a.GeneratorURL,
+		Fingerprint:  a.Fingerprint,
+	}
+}
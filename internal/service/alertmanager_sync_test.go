@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+type alertmanagerListerMock struct {
+	alerts     []model.AlertmanagerAPIAlert
+	suppressed []model.AlertmanagerAPIAlert
+}
+
+func (m *alertmanagerListerMock) IsConfigured() bool { return true }
+
+func (m *alertmanagerListerMock) ListAlerts(_ context.Context, includeSuppressed bool) ([]model.AlertmanagerAPIAlert, error) {
+	if includeSuppressed {
+		return append(append([]model.AlertmanagerAPIAlert{}, m.alerts...), m.suppressed...), nil
+	}
+	return m.alerts, nil
+}
+
+type ingestFilterFunc func(labels map[string]string) bool
+
+func (f ingestFilterFunc) WouldIngest(labels map[string]string) bool { return f(labels) }
+
+type alertmanagerSyncStoreMock struct {
+	firing     []string
+	quiet      []model.Alert
+	quietSince time.Time
+}
+
+func (m *alertmanagerSyncStoreMock) ListFiringFingerprints() ([]string, error) {
+	return m.firing, nil
+}
+
+func (m *alertmanagerSyncStoreMock) ListQuietFiringAlerts(_ string, quietSince time.Time) ([]model.Alert, error) {
+	m.quietSince = quietSince
+	return m.quiet, nil
+}
+
+func TestAlertmanagerSync_RecoversMissingAndResolvesStale(t *testing.T) {
+	now := time.Date(2026, 5, 1, 10, 30, 45, 0, time.UTC)
+	am := &alertmanagerListerMock{alerts: []model.AlertmanagerAPIAlert{
+		{Fingerprint: "fp-known", Labels: map[string]string{"alertname": "Known"}},
+		{Fingerprint: "fp-truncated", Labels: map[string]string{"alertname": "Truncated"}, StartsAt: now.Add(-time.Hour)},
+	}}
+	store := &alertmanagerSyncStoreMock{
+		firing: []string{"fp-known", "fp-gone"},
+		quiet: []model.Alert{
+			{Fingerprint: "fp-known", Status: "firing"},
+			{Fingerprint: "fp-gone", Status: "firing", Labels: map[string]string{"alertname": "Gone"}},
+		},
+	}
+	inbox := &enqueuerMock{}
+	svc := NewAlertmanagerSyncService(am, store, inbox, config.AlertmanagerConfig{URL: "http://am", Receiver: "kube-rca"})
+	svc.now = func() time.Time { return now }
+
+	result, err := svc.Reconcile(context.Background(), reconcileTriggerTruncated)
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if result.Fetched != 2 || result.Recovered != 1 || result.Resolved != 1 || result.InboxID != 1 || inbox.sources[0] != model.AlertSourceAlertmanager {
+		t.Fatalf("result = %+v; want fetched=2 recovered=1 resolved=1", result)
+	}
+	if !store.quietSince.Equal(now.Add(-resolveGrace)) {
+		t.Fatalf("quietSince = %s; want now-%s", store.quietSince, resolveGrace)
+	}
+
+	alerts := inbox.webhooks[0].Alerts
+	if len(alerts) != 2 {
+		t.Fatalf("enqueued alerts = %+v; want 2", alerts)
+	}
+	if a := alerts[0]; a.Fingerprint != "fp-truncated" || a.Status != "firing" || !a.StartsAt.Equal(now.Add(-time.Hour)) {
+		t.Fatalf("recovered alert = %+v", a)
+	}
+	if a := alerts[1]; a.Fingerprint != "fp-gone" || a.Status != "resolved" || !a.EndsAt.Equal(now.Truncate(time.Minute)) {
+		t.Fatalf("resolved alert = %+v", a)
+	}
+}
+
+func TestAlertmanagerSync_InSyncEnqueuesNothing(t *testing.T) {
+	am := &alertmanagerListerMock{alerts: []model.AlertmanagerAPIAlert{{Fingerprint: "fp-1"}}}
+	store := &alertmanagerSyncStoreMock{firing: []string{"fp-1"}}
+	inbox := &enqueuerMock{}
+	svc := NewAlertmanagerSyncService(am, store, inbox, config.AlertmanagerConfig{URL: "http://am", Receiver: "kube-rca"})
+
+	result, err := svc.Reconcile(context.Background(), reconcileTriggerInterval)
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if result.Recovered != 0 || result.Resolved != 0 || len(inbox.webhooks) != 0 {
+		t.Fatalf("result = %+v, webhooks = %d; want nothing enqueued", result, len(inbox.webhooks))
+	}
+}
+
+func TestAlertmanagerSync_SkipsDroppedSeverityAndKeepsSuppressedFiring(t *testing.T) {
+	am := &alertmanagerListerMock{
+		alerts: []model.AlertmanagerAPIAlert{
+			{Fingerprint: "fp-info", Labels: map[string]string{"severity": "info"}},
+			{Fingerprint: "fp-page", Labels: map[string]string{"severity": "critical"}},
+		},
+		suppressed: []model.AlertmanagerAPIAlert{{Fingerprint: "fp-silenced", Status: model.AlertmanagerAPIAlertStatus{State: "suppressed"}}},
+	}
+	store := &alertmanagerSyncStoreMock{
+		firing: []string{"fp-silenced"},
+		quiet:  []model.Alert{{Fingerprint: "fp-silenced", Status: "firing"}},
+	}
+	inbox := &enqueuerMock{}
+	svc := NewAlertmanagerSyncService(am, store, inbox, config.AlertmanagerConfig{URL: "http://am", Receiver: "kube-rca"})
+	svc.SetIngestFilter(ingestFilterFunc(func(labels map[string]string) bool { return labels["severity"] != "info" }))
+
+	result, err := svc.Reconcile(context.Background(), reconcileTriggerInterval)
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if result.Recovered != 1 || result.Resolved != 0 {
+		t.Fatalf("result = %+v; want recovered=1 resolved=0", result)
+	}
+	if alerts := inbox.webhooks[0].Alerts; len(alerts) != 1 || alerts[0].Fingerprint != "fp-page" {
+		t.Fatalf("enqueued alerts = %+v; want only fp-page", alerts)
+	}
+}
+
+func TestAlertmanagerSync_ResolvesOnlyAlertsOfQueriedAlertmanager(t *testing.T) {
+	am := &alertmanagerListerMock{alerts: []model.AlertmanagerAPIAlert{
+		{Fingerprint: "fp-new", Labels: map[string]string{"alertname": "New"}},
+	}}
+	store := &alertmanagerSyncStoreMock{
+		firing: []string{"fp-prod", "fp-stg", "fp-legacy"},
+		quiet: []model.Alert{
+			{Fingerprint: "fp-prod", Status: "firing", ExternalURL: "https://am-prod.example.com/"},
+			{Fingerprint: "fp-stg", Status: "firing", ExternalURL: "https://am-stg.example.com"},
+			{Fingerprint: "fp-legacy", Status: "firing"},
+		},
+	}
+	inbox := &enqueuerMock{}
+	svc := NewAlertmanagerSyncService(am, store, inbox, config.AlertmanagerConfig{
+		URL:      "http://am-prod.monitoring:9093",
+		URLMap:   "https://am-prod.example.com=http://am-prod.monitoring:9093,https://am-stg.example.com=http://am-stg.monitoring:9093",
+		Receiver: "kube-rca",
+	})
+
+	result, err := svc.Reconcile(context.Background(), reconcileTriggerInterval)
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	// 조회하지 않은 staging Alertmanager와 externalURL이 없는 alert는 resolved 처리하지 않음
+	if result.Recovered != 1 || result.Resolved != 1 {
+		t.Fatalf("result = %+v; want recovered=1 resolved=1", result)
+	}
+	webhook := inbox.webhooks[0]
+	if a := webhook.Alerts[1]; a.Fingerprint != "fp-prod" || a.Status != "resolved" {
+		t.Fatalf("resolved alert = %+v; want fp-prod", a)
+	}
+	if webhook.ExternalURL != "https://am-prod.example.com" {
+		t.Fatalf("externalURL = %q; want the queried Alertmanager's external URL", webhook.ExternalURL)
+	}
+}
+
+func TestAlertmanagerSync_RequiresReceiver(t *testing.T) {
+	svc := NewAlertmanagerSyncService(&alertmanagerListerMock{}, &alertmanagerSyncStoreMock{}, &enqueuerMock{}, config.AlertmanagerConfig{URL: "http://am"})
+	if svc.Enabled() {
+		t.Fatal("Enabled() = true without ALERTMANAGER_RECEIVER; want false")
+	}
+	if _, err := svc.ReconcileNow(context.Background()); !errors.Is(err, ErrAlertmanagerNotConfigured) {
+		t.Fatalf("ReconcileNow() error = %v; want ErrAlertmanagerNotConfigured", err)
+	}
+}
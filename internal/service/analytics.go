@@ -54,6 +54,7 @@ func (s *AnalyticsService) BuildDashboard(windowRaw string) (*model.AnalyticsDas
 	summary := model.AnalyticsSummary{}
 	var totalMTTRMinutes float64
 	var resolvedForMTTR int
+	var totalMTTAMinutes float64
 
 	for _, incident := range incidents {
 		if incident.FiredAt.Before(cutoff) {
@@ -70,10 +71,16 @@ func (s *AnalyticsService) BuildDashboard(windowRaw string) (*model.AnalyticsDas
 			summary.FiringIncidents++
 		}
 
-		if incident.ResolvedAt != nil && incident.ResolvedAt.After(incident.FiredAt) {
+		// 점검 시간대 alert만으로 구성된 Incident는 MTTR에서 제외
+		if incident.ResolvedAt != nil && incident.ResolvedAt.After(incident.FiredAt) && !incident.InMaintenance {
 			totalMTTRMinutes += incident.ResolvedAt.Sub(incident.FiredAt).Minutes()
 			resolvedForMTTR++
 		}
+		// MTTA: 확인(acknowledge)된 Incident의 발생~첫 확인 시간 (점검 시간대 Incident 제외)
+		if incident.TimeToAckSeconds != nil && !incident.InMaintenance {
+			totalMTTAMinutes += float64(*incident.TimeToAckSeconds) / 60
+			summary.AcknowledgedIncidents++
+		}
 
 		bucket := incident.FiredAt.UTC().Format("2006-01-02")
 		if point, ok := trend[bucket]; ok {
@@ -111,6 +118,9 @@ func (s *AnalyticsService) BuildDashboard(windowRaw string) (*model.AnalyticsDas
 	if resolvedForMTTR > 0 {
 		summary.AvgMTTRMinutes = totalMTTRMinutes / float64(resolvedForMTTR)
 	}
+	if summary.AcknowledgedIncidents > 0 {
+		summary.AvgMTTAMinutes = totalMTTAMinutes / float64(summary.AcknowledgedIncidents)
+	}
 	if summary.TotalIncidents > 0 {
 		summary.AvgAlertsPerIncident = float64(summary.TotalAlerts) / float64(summary.TotalIncidents)
 	}
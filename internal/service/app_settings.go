@@ -17,6 +17,9 @@ var allowedKeys = map[string]bool{
 	"ai":           true,
 	"notification": true,
 	"analysis":     true,
+	"correlation":  true,
+	"severity":     true,
+	"enrichment":   true,
 }
 
 // appSettingsRepo - DB 인터페이스
@@ -81,6 +84,33 @@ func (s *AppSettingsService) UpdateSetting(ctx context.Context, key string, valu
 		if err := json.Unmarshal(value, &v); err != nil {
 			return fmt.Errorf("invalid analysis settings: %w", err)
 		}
+	case "correlation":
+		var v model.CorrelationSettings
+		if err := json.Unmarshal(value, &v); err != nil {
+			return fmt.Errorf("invalid correlation settings: %w", err)
+		}
+		if v.WindowMinutes < 0 {
+			return fmt.Errorf("invalid correlation settings: windowMinutes must be >= 0")
+		}
+		if v.SimilarityThreshold < 0 || v.SimilarityThreshold > 1 {
+			return fmt.Errorf("invalid correlation settings: similarityThreshold must be between 0 and 1")
+		}
+	case "severity":
+		var v model.SeveritySettings
+		if err := json.Unmarshal(value, &v); err != nil {
+			return fmt.Errorf("invalid severity settings: %w", err)
+		}
+		if err := validateSeveritySettings(v); err != nil {
+			return fmt.Errorf("invalid severity settings: %w", err)
+		}
+	case "enrichment":
+		var v model.EnrichmentSettings
+		if err := json.Unmarshal(value, &v); err != nil {
+			return fmt.Errorf("invalid enrichment settings: %w", err)
+		}
+		if err := validateEnrichmentSettings(v); err != nil {
+			return fmt.Errorf("invalid enrichment settings: %w", err)
+		}
 	}
 
 	return s.db.UpsertAppSetting(ctx, key, value)
@@ -184,6 +214,85 @@ func (s *AppSettingsService) GetAnalysisSettings() *model.AnalysisSettings {
 	return &as
 }
 
+// DefaultCorrelationSettings - 상관관계 기본 설정 (그룹핑 없이 단일 Incident, 1시간 창)
+// 그룹핑은 관리자가 groupBy를 지정해야 적용된다 (기존 단일 Incident 동작 유지).
+func DefaultCorrelationSettings() model.CorrelationSettings {
+	return model.CorrelationSettings{
+		GroupBy:             []string{},
+		WindowMinutes:       60,
+		SimilarityLabels:    []string{},
+		SimilarityThreshold: 0,
+	}
+}
+
+// GetCorrelationSettings - DB 조회 (없으면 기본값)
+func (s *AppSettingsService) GetCorrelationSettings() model.CorrelationSettings {
+	ctx := context.Background()
+	setting, err := s.db.GetAppSetting(ctx, "correlation")
+	if err != nil {
+		log.Printf("Failed to get correlation settings from DB: %v", err)
+		return DefaultCorrelationSettings()
+	}
+	if setting == nil {
+		return DefaultCorrelationSettings()
+	}
+
+	var cs model.CorrelationSettings
+	if err := json.Unmarshal(setting.Value, &cs); err != nil {
+		log.Printf("Failed to unmarshal correlation settings: %v", err)
+		return DefaultCorrelationSettings()
+	}
+	return cs
+}
+
+// GetSeveritySettings - DB 조회 (없거나 잘못된 값이면 기본값)
+func (s *AppSettingsService) GetSeveritySettings() model.SeveritySettings {
+	ctx := context.Background()
+	setting, err := s.db.GetAppSetting(ctx, "severity")
+	if err != nil {
+		log.Printf("Failed to get severity settings from DB: %v", err)
+		return DefaultSeveritySettings()
+	}
+	if setting == nil {
+		return DefaultSeveritySettings()
+	}
+
+	var ss model.SeveritySettings
+	if err := json.Unmarshal(setting.Value, &ss); err != nil {
+		log.Printf("Failed to unmarshal severity settings: %v", err)
+		return DefaultSeveritySettings()
+	}
+	if err := validateSeveritySettings(ss); err != nil {
+		log.Printf("Ignoring invalid severity settings: %v", err)
+		return DefaultSeveritySettings()
+	}
+	return ss
+}
+
+// GetEnrichmentSettings - DB 조회 (없거나 잘못된 값이면 비활성 기본값)
+func (s *AppSettingsService) GetEnrichmentSettings() model.EnrichmentSettings {
+	ctx := context.Background()
+	setting, err := s.db.GetAppSetting(ctx, "enrichment")
+	if err != nil {
+		log.Printf("Failed to get enrichment settings from DB: %v", err)
+		return DefaultEnrichmentSettings()
+	}
+	if setting == nil {
+		return DefaultEnrichmentSettings()
+	}
+
+	var es model.EnrichmentSettings
+	if err := json.Unmarshal(setting.Value, &es); err != nil {
+		log.Printf("Failed to unmarshal enrichment settings: %v", err)
+		return DefaultEnrichmentSettings()
+	}
+	if err := validateEnrichmentSettings(es); err != nil {
+		log.Printf("Ignoring invalid enrichment settings: %v", err)
+		return DefaultEnrichmentSettings()
+	}
+	return es
+}
+
 // ShouldAutoAnalyze - 주어진 severity의 alert를 자동 분석해야 하는지 판단
 // 기본: 모든 severity 자동 분석. manualAnalyzeSeverities에 포함된 severity만 수동.
 // DB 설정 우선, 없으면 ENV fallback.
@@ -309,6 +418,12 @@ func (s *AppSettingsService) GetSettingWithFallback(ctx context.Context, key str
 		fallbackValue = model.AnalysisSettings{
 			ManualAnalyzeSeverities: s.envAnalysis.ManualAnalyzeSeverities,
 		}
+	case "correlation":
+		fallbackValue = DefaultCorrelationSettings()
+	case "severity":
+		fallbackValue = DefaultSeveritySettings()
+	case "enrichment":
+		fallbackValue = DefaultEnrichmentSettings()
 	default:
 		return nil, fmt.Errorf("unknown setting key: %s", key)
 	}
@@ -0,0 +1,237 @@
+// Alert → Incident 상관관계(그룹핑) 로직
+//
+// 매칭 순서:
+//  1. 이미 firing 중인 동일 fingerprint alert가 있으면 그 Incident 유지 (existing_alert)
+//  2. 그룹핑 키가 같은 열린 Incident (group_key)
+//     - 시간 창이 지났으면 해당 Incident는 매칭 대상에서 제외하고 새 Incident 생성 (window_expired)
+//     - 키가 다른 Incident도 시간 창이 지났으면 매칭 대상에서 제외 (GroupBy 변경 전 키의 Incident가 남지 않도록)
+//  3. 라벨 유사도가 임계값 이상인 열린 Incident 중 가장 유사한 것 (label_similarity)
+//  4. 매칭 없으면 새 Incident 생성 (new_group)
+
+package service
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+	"github.com/kube-rca/backend/internal/sse"
+)
+
+const (
+	correlationReasonExistingAlert   = "existing_alert"
+	correlationReasonGroupKey        = "group_key"
+	correlationReasonLabelSimilarity = "label_similarity"
+	correlationReasonNewGroup        = "new_group"
+	correlationReasonWindowExpired   = "window_expired"
+
+	// correlationGroupKeyLabel - GroupBy에 지정하면 Alertmanager GroupKey를 그룹핑 키로 사용
+	correlationGroupKeyLabel = "groupKey"
+)
+
+// buildCorrelationKey - GroupBy 라벨 값으로 그룹핑 키 생성 (예: "namespace=payments,app=api")
+// 값이 없는 라벨은 키에서 제외 (모두 없으면 빈 키 = 단일 Incident)
+func buildCorrelationKey(groupBy []string, webhookGroupKey string, labels map[string]string) string {
+	parts := make([]string, 0, len(groupBy))
+	for _, name := range groupBy {
+		value := labels[name]
+		if name == correlationGroupKeyLabel {
+			value = webhookGroupKey
+		}
+		if value == "" {
+			continue
+		}
+		parts = append(parts, name+"="+value)
+	}
+	return strings.Join(parts, ",")
+}
+
+// buildCorrelationLabels - Incident에 저장할 대표 라벨 (그룹핑 + 유사도 비교 라벨)
+func buildCorrelationLabels(settings model.CorrelationSettings, labels map[string]string) map[string]string {
+	result := make(map[string]string)
+	for _, names := range [][]string{settings.GroupBy, settings.SimilarityLabels} {
+		for _, name := range names {
+			if v, ok := labels[name]; ok && v != "" {
+				result[name] = v
+			}
+		}
+	}
+	return result
+}
+
+// labelSimilarity - 비교 라벨의 name=value 쌍 Jaccard 유사도 (0~1)
+func labelSimilarity(names []string, a, b map[string]string) float64 {
+	union, intersection := 0, 0
+	for _, name := range names {
+		va, okA := a[name]
+		vb, okB := b[name]
+		okA = okA && va != ""
+		okB = okB && vb != ""
+		switch {
+		case okA && okB && va == vb:
+			union++
+			intersection++
+		case okA && okB:
+			union += 2
+		case okA || okB:
+			union++
+		}
+	}
+	if union == 0 {
+		return 0
+	}
+	return float64(intersection) / float64(union)
+}
+
+// correlationDecision - 후보 Incident 평가 결과
+type correlationDecision struct {
+	match   *model.IncidentCorrelationCandidate
+	reason  string
+	score   float64
+	expired []string // 시간 창이 지나 매칭 대상에서 제외해야 하는 Incident
+	// 같은 그룹핑 키의 Incident가 시간 창이 지나 제외됨 (새 Incident의 매칭 이유 window_expired)
+	keyExpired bool
+}
+
+// selectCorrelationCandidate - 열린 Incident 중 Alert에 가장 잘 맞는 Incident 선택
+func selectCorrelationCandidate(settings model.CorrelationSettings, key string, labels map[string]string, candidates []model.IncidentCorrelationCandidate, now time.Time) correlationDecision {
+	var decision correlationDecision
+	window := time.Duration(settings.WindowMinutes) * time.Minute
+
+	var similar []model.IncidentCorrelationCandidate
+	for _, c := range candidates {
+		if settings.WindowMinutes > 0 && now.Sub(c.LastAlertAt) > window {
+			decision.expired = append(decision.expired, c.IncidentID)
+			decision.keyExpired = decision.keyExpired || c.CorrelationKey == key
+			continue
+		}
+		if c.CorrelationKey == key {
+			candidate := c
+			decision.match = &candidate
+			decision.reason = correlationReasonGroupKey
+			decision.score = 1
+			return decision
+		}
+		similar = append(similar, c)
+	}
+
+	if settings.SimilarityThreshold <= 0 || len(settings.SimilarityLabels) == 0 {
+		return decision
+	}
+
+	// 동점이면 가장 최근에 Alert가 붙은 Incident 우선
+	sort.SliceStable(similar, func(i, j int) bool {
+		return similar[i].LastAlertAt.After(similar[j].LastAlertAt)
+	})
+	for _, c := range similar {
+		score := labelSimilarity(settings.SimilarityLabels, labels, c.CorrelationLabels)
+		if score >= settings.SimilarityThreshold && score > decision.score {
+			candidate := c
+			decision.match = &candidate
+			decision.reason = correlationReasonLabelSimilarity
+			decision.score = score
+		}
+	}
+	return decision
+}
+
+// correlateIncident - Alert를 가장 잘 맞는 열린 Incident에 연결하거나 새 Incident 생성
+// resolved alert는 새 Incident를 만들지 않음 (매칭 실패 시 빈 IncidentID 반환)
+func (s *AlertService) correlateIncident(webhook model.AlertmanagerWebhook, alert model.Alert) (model.IncidentMatch, error) {
+	settings := s.getCorrelationSettings()
+	key := buildCorrelationKey(settings.GroupBy, webhook.GroupKey, alert.Labels)
+	match := model.IncidentMatch{CorrelationKey: key}
+	now := time.Now()
+
+	// 1. 이미 firing 중인 alert는 기존 Incident 유지
+	existingID, err := s.db.GetFiringAlertIncidentID(alert.Fingerprint)
+	if err != nil {
+		log.Printf("Failed to get incident of firing alert (fingerprint=%s): %v", alert.Fingerprint, err)
+	} else if existingID != "" {
+		match.IncidentID = existingID
+		match.Reason = correlationReasonExistingAlert
+		match.Score = 1
+		match.Detail = "alert is already firing in this incident"
+		_ = s.db.TouchIncidentCorrelation(existingID, now)
+		return match, nil
+	}
+
+	candidates, err := s.db.ListCorrelationCandidates()
+	if err != nil {
+		return match, err
+	}
+
+	decision := selectCorrelationCandidate(settings, key, alert.Labels, candidates, now)
+	for _, incidentID := range decision.expired {
+		if err := s.db.CloseIncidentCorrelation(incidentID); err != nil {
+			log.Printf("Failed to close incident correlation (incident_id=%s): %v", incidentID, err)
+		}
+	}
+
+	if decision.match != nil {
+		match.IncidentID = decision.match.IncidentID
+		match.Reason = decision.reason
+		match.Score = decision.score
+		if decision.reason == correlationReasonGroupKey {
+			match.Detail = fmt.Sprintf("same group key %q", key)
+		} else {
+			match.Detail = fmt.Sprintf("label similarity %.2f >= %.2f on %v (incident group %q)",
+				decision.score, settings.SimilarityThreshold, settings.SimilarityLabels, decision.match.CorrelationKey)
+		}
+		if err := s.db.TouchIncidentCorrelation(match.IncidentID, now); err != nil {
+			log.Printf("Failed to touch incident correlation (incident_id=%s): %v", match.IncidentID, err)
+		}
+		return match, nil
+	}
+
+	if alert.Status != "firing" {
+		return match, nil
+	}
+
+	// 2. 매칭되는 Incident가 없으면 그룹핑 키로 새 Incident 생성
+	title := "Ongoing"
+	if key != "" {
+		title = fmt.Sprintf("Ongoing (%s)", key)
+	}
+	incidentID, created, err := s.db.GetOrCreateCorrelatedIncident(key, title, "TBD", buildCorrelationLabels(settings, alert.Labels), alert.StartsAt)
+	if err != nil {
+		return match, err
+	}
+
+	match.IncidentID = incidentID
+	match.Created = created
+	match.Score = 1
+	switch {
+	case !created:
+		// 다른 요청이 같은 키로 먼저 생성함
+		match.Reason = correlationReasonGroupKey
+		match.Detail = fmt.Sprintf("same group key %q", key)
+	case decision.keyExpired:
+		match.Reason = correlationReasonWindowExpired
+		match.Detail = fmt.Sprintf("previous incident for group key %q exceeded %d min window", key, settings.WindowMinutes)
+	default:
+		match.Reason = correlationReasonNewGroup
+		match.Detail = fmt.Sprintf("no open incident for group key %q", key)
+	}
+
+	if created {
+		log.Printf("Created new incident: %s (triggered by alert: %s, group=%q)", incidentID, alert.Fingerprint, key)
+		if s.sseHub != nil {
+			s.sseHub.Broadcast(sse.Event{
+				Type: sse.EventIncidentCreated,
+				Data: sse.EventData{IncidentID: incidentID},
+			})
+		}
+	}
+	return match, nil
+}
+
+func (s *AlertService) getCorrelationSettings() model.CorrelationSettings {
+	if s.appSettings != nil {
+		return s.appSettings.GetCorrelationSettings()
+	}
+	return DefaultCorrelationSettings()
+}
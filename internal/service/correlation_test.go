@@ -0,0 +1,194 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+func TestBuildCorrelationKey(t *testing.T) {
+	labels := map[string]string{"namespace": "payments", "app": "api", "pod": "api-0"}
+
+	got := buildCorrelationKey([]string{"namespace", "app"}, "", labels)
+	if got != "namespace=payments,app=api" {
+		t.Fatalf("buildCorrelationKey() = %q; want %q", got, "namespace=payments,app=api")
+	}
+
+	got = buildCorrelationKey([]string{"groupKey"}, `{}:{alertname="X"}`, labels)
+	if got != `groupKey={}:{alertname="X"}` {
+		t.Fatalf("buildCorrelationKey(groupKey) = %q", got)
+	}
+
+	if got := buildCorrelationKey(nil, "", labels); got != "" {
+		t.Fatalf("buildCorrelationKey(empty) = %q; want empty (single global incident)", got)
+	}
+
+	// 없는 라벨과 빈 groupKey는 키에서 제외
+	if got := buildCorrelationKey([]string{"cluster", "namespace", "groupKey"}, "", labels); got != "namespace=payments" {
+		t.Fatalf("buildCorrelationKey(absent) = %q; want %q", got, "namespace=payments")
+	}
+	if got := buildCorrelationKey([]string{"cluster"}, "", labels); got != "" {
+		t.Fatalf("buildCorrelationKey(all absent) = %q; want empty", got)
+	}
+}
+
+func TestLabelSimilarity(t *testing.T) {
+	names := []string{"cluster", "namespace", "app"}
+	a := map[string]string{"cluster": "prod", "namespace": "payments", "app": "api"}
+	b := map[string]string{"cluster": "prod", "namespace": "payments", "app": "worker"}
+
+	// 일치 2, 불일치 1 (양쪽 값이 달라 union 2) → 2/4
+	if got := labelSimilarity(names, a, b); got != 0.5 {
+		t.Fatalf("labelSimilarity() = %v; want 0.5", got)
+	}
+	if got := labelSimilarity(names, a, a); got != 1 {
+		t.Fatalf("labelSimilarity(same) = %v; want 1", got)
+	}
+	if got := labelSimilarity(names, map[string]string{}, map[string]string{}); got != 0 {
+		t.Fatalf("labelSimilarity(empty) = %v; want 0", got)
+	}
+}
+
+func TestSelectCorrelationCandidate_GroupKeyMatch(t *testing.T) {
+	now := time.Now()
+	settings := model.CorrelationSettings{GroupBy: []string{"namespace"}, WindowMinutes: 30}
+	candidates := []model.IncidentCorrelationCandidate{
+		{IncidentID: "INC-other", CorrelationKey: "namespace=orders", LastAlertAt: now},
+		{IncidentID: "INC-pay", CorrelationKey: "namespace=payments", LastAlertAt: now.Add(-10 * time.Minute)},
+	}
+
+	decision := selectCorrelationCandidate(settings, "namespace=payments", nil, candidates, now)
+	if decision.match == nil || decision.match.IncidentID != "INC-pay" {
+		t.Fatalf("match = %+v; want INC-pay", decision.match)
+	}
+	if decision.reason != correlationReasonGroupKey {
+		t.Fatalf("reason = %q; want %q", decision.reason, correlationReasonGroupKey)
+	}
+}
+
+func TestSelectCorrelationCandidate_WindowExpired(t *testing.T) {
+	now := time.Now()
+	settings := model.CorrelationSettings{GroupBy: []string{"namespace"}, WindowMinutes: 30}
+	candidates := []model.IncidentCorrelationCandidate{
+		{IncidentID: "INC-old", CorrelationKey: "namespace=payments", LastAlertAt: now.Add(-2 * time.Hour)},
+	}
+
+	decision := selectCorrelationCandidate(settings, "namespace=payments", nil, candidates, now)
+	if decision.match != nil {
+		t.Fatalf("match = %+v; want nil", decision.match)
+	}
+	if len(decision.expired) != 1 || decision.expired[0] != "INC-old" || !decision.keyExpired {
+		t.Fatalf("expired = %v keyExpired = %v; want [INC-old] for the same key", decision.expired, decision.keyExpired)
+	}
+}
+
+func TestSelectCorrelationCandidate_ExpiresOtherKeys(t *testing.T) {
+	now := time.Now()
+	settings := model.CorrelationSettings{GroupBy: []string{"namespace"}, WindowMinutes: 30}
+	candidates := []model.IncidentCorrelationCandidate{
+		// groupBy 변경 전 빈 키로 생성된 Incident
+		{IncidentID: "INC-legacy", CorrelationKey: "", LastAlertAt: now.Add(-2 * time.Hour)},
+		{IncidentID: "INC-orders", CorrelationKey: "namespace=orders", LastAlertAt: now},
+	}
+
+	decision := selectCorrelationCandidate(settings, "namespace=payments", nil, candidates, now)
+	if decision.match != nil || decision.keyExpired {
+		t.Fatalf("match = %+v keyExpired = %v; want no match and no same-key expiry", decision.match, decision.keyExpired)
+	}
+	if len(decision.expired) != 1 || decision.expired[0] != "INC-legacy" {
+		t.Fatalf("expired = %v; want [INC-legacy]", decision.expired)
+	}
+}
+
+func TestSelectCorrelationCandidate_LabelSimilarity(t *testing.T) {
+	now := time.Now()
+	settings := model.CorrelationSettings{
+		GroupBy:             []string{"namespace", "app"},
+		WindowMinutes:       60,
+		SimilarityLabels:    []string{"cluster", "namespace", "node"},
+		SimilarityThreshold: 0.6,
+	}
+	labels := map[string]string{"cluster": "prod", "namespace": "payments", "app": "worker", "node": "n1"}
+	candidates := []model.IncidentCorrelationCandidate{
+		{
+			IncidentID:        "INC-far",
+			CorrelationKey:    "namespace=orders,app=api",
+			CorrelationLabels: map[string]string{"cluster": "prod", "namespace": "orders", "node": "n2"},
+			LastAlertAt:       now,
+		},
+		{
+			IncidentID:        "INC-near",
+			CorrelationKey:    "namespace=payments,app=api",
+			CorrelationLabels: map[string]string{"cluster": "prod", "namespace": "payments", "node": "n1"},
+			LastAlertAt:       now.Add(-5 * time.Minute),
+		},
+	}
+
+	decision := selectCorrelationCandidate(settings, "namespace=payments,app=worker", labels, candidates, now)
+	if decision.match == nil || decision.match.IncidentID != "INC-near" {
+		t.Fatalf("match = %+v; want INC-near", decision.match)
+	}
+	if decision.reason != correlationReasonLabelSimilarity || decision.score != 1 {
+		t.Fatalf("reason=%q score=%v; want label_similarity 1", decision.reason, decision.score)
+	}
+
+	// 임계값 미만이면 매칭하지 않음
+	settings.SimilarityThreshold = 0
+	decision = selectCorrelationCandidate(settings, "namespace=payments,app=worker", labels, candidates, now)
+	if decision.match != nil {
+		t.Fatalf("match = %+v; want nil when similarity disabled", decision.match)
+	}
+}
+
+func TestProcessWebhook_RecordsCorrelationReason(t *testing.T) {
+	store := newAlertStoreMock()
+	store.saveAlertResults = []saveAlertResult{{AlertID: "ALR-corr0001"}}
+	svc := newTestAlertService(store, newNotifierMock(), &analyzerMock{})
+
+	alert := makeAlert("fp-corr", "firing", "warning")
+	alert.Labels["cluster"] = "prod"
+	alert.Labels["namespace"] = "payments"
+	svc.ProcessWebhook(makeWebhook(alert))
+
+	// 기본 설정은 그룹핑 없음 → 빈 키의 단일 Incident
+	if len(store.createdIncidentKeys) != 1 || store.createdIncidentKeys[0] != "" {
+		t.Fatalf("created incident keys = %q; want a single empty key", store.createdIncidentKeys)
+	}
+	match, ok := store.correlations["ALR-corr0001"]
+	if !ok {
+		t.Fatal("expected correlation to be recorded for alert")
+	}
+	if match.Reason != correlationReasonNewGroup || match.IncidentID != "INC-test0001" {
+		t.Fatalf("correlation = %+v; want new_group on INC-test0001", match)
+	}
+}
+
+func TestProcessWebhook_FiringAlertKeepsExistingIncident(t *testing.T) {
+	store := newAlertStoreMock()
+	store.firingAlertIncident["fp-keep"] = "INC-keep"
+	svc := newTestAlertService(store, newNotifierMock(), &analyzerMock{})
+
+	svc.ProcessWebhook(makeWebhook(makeAlert("fp-keep", "firing", "warning")))
+
+	if len(store.createdIncidentKeys) != 0 {
+		t.Fatalf("expected no incident creation, got %v", store.createdIncidentKeys)
+	}
+	if got := store.saveAlertCalls[0].IncidentID; got != "INC-keep" {
+		t.Fatalf("SaveAlert incidentID = %q; want INC-keep", got)
+	}
+	if len(store.correlations) != 0 {
+		t.Fatalf("expected original correlation reason to be kept, got %v", store.correlations)
+	}
+}
+
+func TestProcessWebhook_ResolvedAlertDoesNotCreateIncident(t *testing.T) {
+	store := newAlertStoreMock()
+	svc := newTestAlertService(store, newNotifierMock(), &analyzerMock{})
+
+	svc.ProcessWebhook(makeWebhook(makeAlert("fp-orphan", "resolved", "warning")))
+
+	if len(store.createdIncidentKeys) != 0 {
+		t.Fatalf("expected no incident creation for resolved alert, got %v", store.createdIncidentKeys)
+	}
+}
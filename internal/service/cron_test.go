@@ -0,0 +1,76 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCronSchedule_Invalid(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "*/0 * * * *", "5-1 * * * *", "* * * * FUNDAY"} {
+		if _, err := parseCronSchedule(expr); err == nil {
+			t.Errorf("parseCronSchedule(%q) error = nil; want error", expr)
+		}
+	}
+}
+
+func TestCronSchedule_Next(t *testing.T) {
+	seoul, err := time.LoadLocation("Asia/Seoul")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	// 2026-03-03은 화요일
+	base := time.Date(2026, 3, 3, 21, 30, 0, 0, seoul)
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"0 22 * * TUE", time.Date(2026, 3, 3, 22, 0, 0, 0, seoul)},
+		{"0 22 * * 2", time.Date(2026, 3, 3, 22, 0, 0, 0, seoul)},
+		{"0 21 * * tue", time.Date(2026, 3, 10, 21, 0, 0, 0, seoul)},
+		{"*/15 * * * *", time.Date(2026, 3, 3, 21, 45, 0, 0, seoul)},
+		{"0 0 1 * *", time.Date(2026, 4, 1, 0, 0, 0, 0, seoul)},
+		{"0 9 * * 1-5", time.Date(2026, 3, 4, 9, 0, 0, 0, seoul)},
+		{"0 0 * * 7", time.Date(2026, 3, 8, 0, 0, 0, 0, seoul)},
+		{"0 0 15 * MON", time.Date(2026, 3, 9, 0, 0, 0, 0, seoul)}, // 일/요일 OR
+	}
+	for _, tt := range tests {
+		s, err := parseCronSchedule(tt.expr)
+		if err != nil {
+			t.Fatalf("parseCronSchedule(%q) error = %v", tt.expr, err)
+		}
+		if got := s.Next(base, seoul); !got.Equal(tt.want) {
+			t.Errorf("Next(%q) = %v; want %v", tt.expr, got, tt.want)
+		}
+	}
+
+	never, _ := parseCronSchedule("0 0 30 2 *")
+	if got := never.Next(base, seoul); !got.IsZero() {
+		t.Fatalf("Next(Feb 30) = %v; want zero", got)
+	}
+}
+
+func TestCronSchedule_ActiveWindow(t *testing.T) {
+	s, _ := parseCronSchedule("0 22 * * TUE")
+	loc := time.UTC
+	duration := 3 * time.Hour
+
+	tests := []struct {
+		at     time.Time
+		active bool
+	}{
+		{time.Date(2026, 3, 3, 21, 59, 0, 0, loc), false},
+		{time.Date(2026, 3, 3, 22, 0, 0, 0, loc), true},
+		{time.Date(2026, 3, 4, 0, 59, 0, 0, loc), true}, // 자정을 넘어 수요일까지 이어짐
+		{time.Date(2026, 3, 4, 1, 0, 0, 0, loc), false},
+	}
+	for _, tt := range tests {
+		start, ok := s.ActiveWindow(tt.at, duration, loc)
+		if ok != tt.active {
+			t.Errorf("ActiveWindow(%v) = %v, %v; want active=%v", tt.at, start, ok, tt.active)
+		}
+		if ok && !start.Equal(time.Date(2026, 3, 3, 22, 0, 0, 0, loc)) {
+			t.Errorf("ActiveWindow(%v) start = %v", tt.at, start)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+// Alert 보강(enrichment) 파이프라인 (app_settings "enrichment")
+//
+// 처리 흐름:
+//  1. IngestWebhook이 웹훅마다 설정을 한 번 읽어 enricher 목록 준비
+//  2. severity 정규화 후, silence/억제 매칭과 SaveAlert 전에 enricher를 순서대로 적용
+//     - team: namespace → team 라벨 (소유 팀 매핑)
+//     - runbook: alertname → runbook_url annotation (런북 카탈로그)
+//     - environment: cluster → environment 라벨
+//     - lookup: 임의 라벨 값 → 라벨/annotation
+//     - static: 매처에 맞는 alert에 고정 라벨/annotation
+//  3. 기록한 값마다 출처(enricher 이름/종류, 조회한 라벨)를 alerts.enrichments에 저장
+//
+// 보강된 라벨은 silence/점검/억제 매칭, Incident 상관관계, Slack 알림, Agent 분석에 그대로 사용된다.
+// alertname/severity는 파이프라인 전후 처리가 의존하므로 보강 대상에서 제외한다.
+
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// enrichmentProtectedLabels - enricher가 기록할 수 없는 라벨
+var enrichmentProtectedLabels = map[string]bool{
+	"alertname": true,
+	"severity":  true,
+}
+
+// enricherDefaults - 종류별 기본 sourceLabel, target, key
+var enricherDefaults = map[string]struct {
+	sourceLabel, target, key string
+}{
+	model.EnricherTypeTeam:        {"namespace", model.EnrichmentTargetLabel, "team"},
+	model.EnricherTypeRunbook:     {"alertname", model.EnrichmentTargetAnnotation, "runbook_url"},
+	model.EnricherTypeEnvironment: {"cluster", model.EnrichmentTargetLabel, "environment"},
+	model.EnricherTypeLookup:      {"", model.EnrichmentTargetLabel, ""},
+}
+
+// DefaultEnrichmentSettings - 기본값 (비활성, enricher 없음)
+func DefaultEnrichmentSettings() model.EnrichmentSettings {
+	return model.EnrichmentSettings{Enabled: false, Enrichers: []model.Enricher{}}
+}
+
+// normalizeEnricher - 생략된 sourceLabel/target/key를 종류별 기본값으로 채움
+func normalizeEnricher(e model.Enricher) model.Enricher {
+	e.Type = strings.ToLower(strings.TrimSpace(e.Type))
+	if d, ok := enricherDefaults[e.Type]; ok {
+		if strings.TrimSpace(e.SourceLabel) == "" {
+			e.SourceLabel = d.sourceLabel
+		}
+		if strings.TrimSpace(e.Target) == "" {
+			e.Target = d.target
+		}
+		if strings.TrimSpace(e.Key) == "" {
+			e.Key = d.key
+		}
+	}
+	return e
+}
+
+// validateEnrichmentSettings - enricher 이름 중복, 종류, 필수 값, 보호 라벨 기록 여부 검증
+func validateEnrichmentSettings(v model.EnrichmentSettings) error {
+	names := make(map[string]bool, len(v.Enrichers))
+	for _, raw := range v.Enrichers {
+		e := normalizeEnricher(raw)
+		name := strings.TrimSpace(e.Name)
+		if name == "" {
+			return fmt.Errorf("enricher name must not be empty")
+		}
+		if names[name] {
+			return fmt.Errorf("duplicate enricher: %s", name)
+		}
+		names[name] = true
+
+		switch e.Type {
+		case model.EnricherTypeStatic:
+			if len(e.Labels) == 0 && len(e.Annotations) == 0 {
+				return fmt.Errorf("enricher %s: labels or annotations are required", name)
+			}
+			for _, m := range e.Matchers {
+				if err := m.Validate(); err != nil {
+					return fmt.Errorf("enricher %s: %w", name, err)
+				}
+			}
+			for key := range e.Labels {
+				if strings.TrimSpace(key) == "" || enrichmentProtectedLabels[key] {
+					return fmt.Errorf("enricher %s: label %q cannot be enriched", name, key)
+				}
+			}
+		case model.EnricherTypeTeam, model.EnricherTypeRunbook, model.EnricherTypeEnvironment, model.EnricherTypeLookup:
+			if strings.TrimSpace(e.SourceLabel) == "" || strings.TrimSpace(e.Key) == "" {
+				return fmt.Errorf("enricher %s: sourceLabel and key are required", name)
+			}
+			if e.Target != model.EnrichmentTargetLabel && e.Target != model.EnrichmentTargetAnnotation {
+				return fmt.Errorf("enricher %s: target must be label or annotation", name)
+			}
+			if e.Target == model.EnrichmentTargetLabel && enrichmentProtectedLabels[e.Key] {
+				return fmt.Errorf("enricher %s: label %q cannot be enriched", name, e.Key)
+			}
+			if len(e.Values) == 0 {
+				return fmt.Errorf("enricher %s: values must not be empty", name)
+			}
+		default:
+			return fmt.Errorf("enricher %s: unknown type %q", name, raw.Type)
+		}
+	}
+	return nil
+}
+
+// enrichmentPipeline - 웹훅 단위로 준비된 enricher 목록
+type enrichmentPipeline struct {
+	enrichers []model.Enricher
+}
+
+// newEnrichmentPipeline - 비활성 설정이면 빈 파이프라인
+func newEnrichmentPipeline(settings model.EnrichmentSettings) enrichmentPipeline {
+	if !settings.Enabled {
+		return enrichmentPipeline{}
+	}
+	enrichers := make([]model.Enricher, 0, len(settings.Enrichers))
+	for _, e := range settings.Enrichers {
+		enrichers = append(enrichers, normalizeEnricher(e))
+	}
+	return enrichmentPipeline{enrichers: enrichers}
+}
+
+// apply - enricher를 순서대로 적용하고 기록한 값의 출처를 alert.Enrichments에 남김
+// 웹훅 페이로드의 맵을 공유하지 않도록 변경이 있을 때만 라벨/annotation을 복사한다.
+func (p enrichmentPipeline) apply(alert model.Alert) model.Alert {
+	if len(p.enrichers) == 0 {
+		return alert
+	}
+
+	labels, annotations := alert.Labels, alert.Annotations
+	copiedLabels, copiedAnnotations := false, false
+	var records []model.AlertEnrichment
+
+	set := func(e model.Enricher, target, key, value, source string) {
+		if key == "" || value == "" {
+			return
+		}
+		current := annotations
+		if target == model.EnrichmentTargetLabel {
+			current = labels
+		}
+		existing, exists := current[key]
+		if existing == value || (exists && existing != "" && !e.Overwrite) {
+			return
+		}
+
+		if target == model.EnrichmentTargetLabel {
+			if !copiedLabels {
+				labels, copiedLabels = copyStringMap(labels), true
+			}
+			labels[key] = value
+		} else {
+			if !copiedAnnotations {
+				annotations, copiedAnnotations = copyStringMap(annotations), true
+			}
+			annotations[key] = value
+		}
+		records = append(records, model.AlertEnrichment{
+			Target:   target,
+			Key:      key,
+			Value:    value,
+			Replaced: existing,
+			Enricher: e.Name,
+			Type:     e.Type,
+			Source:   source,
+		})
+	}
+
+	for _, e := range p.enrichers {
+		switch e.Type {
+		case model.EnricherTypeStatic:
+			if len(e.Matchers) > 0 && !e.Matchers.Matches(labels) {
+				continue
+			}
+			for _, key := range sortedKeys(e.Labels) {
+				if enrichmentProtectedLabels[key] {
+					continue
+				}
+				set(e, model.EnrichmentTargetLabel, key, e.Labels[key], "static")
+			}
+			for _, key := range sortedKeys(e.Annotations) {
+				set(e, model.EnrichmentTargetAnnotation, key, e.Annotations[key], "static")
+			}
+		default:
+			if e.Target == model.EnrichmentTargetLabel && enrichmentProtectedLabels[e.Key] {
+				continue
+			}
+			sourceValue := labels[e.SourceLabel]
+			value, ok := e.Values[sourceValue]
+			if !ok || sourceValue == "" {
+				value, ok = e.Values["*"]
+			}
+			if !ok {
+				continue
+			}
+			set(e, e.Target, e.Key, value, e.SourceLabel+"="+sourceValue)
+		}
+	}
+
+	alert.Labels, alert.Annotations = labels, annotations
+	if len(records) > 0 {
+		alert.Enrichments = records
+	}
+	return alert
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+func testEnrichmentSettings() model.EnrichmentSettings {
+	return model.EnrichmentSettings{
+		Enabled: true,
+		Enrichers: []model.Enricher{
+			{Name: "owners", Type: "team", Values: map[string]string{"payments": "team-pay", "*": "platform"}},
+			{Name: "runbooks", Type: "runbook", Values: map[string]string{"PodCrashLooping": "https://runbooks/crashloop"}},
+			{Name: "env", Type: "environment", Values: map[string]string{"prod-eu": "production"}},
+			{
+				Name:        "pay-oncall",
+				Type:        "static",
+				Matchers:    model.LabelMatchers{{Name: "team", Value: "team-pay", IsEqual: true}},
+				Labels:      map[string]string{"tier": "1"},
+				Annotations: map[string]string{"escalation": "pay-oncall"},
+			},
+		},
+	}
+}
+
+func TestEnrichmentPipeline_AppliesEnrichersInOrder(t *testing.T) {
+	pipeline := newEnrichmentPipeline(testEnrichmentSettings())
+	labels := map[string]string{"alertname": "PodCrashLooping", "namespace": "payments", "cluster": "prod-eu", "severity": "critical"}
+	alert := model.Alert{Labels: labels, Annotations: map[string]string{"summary": "crash"}}
+
+	got := pipeline.apply(alert)
+
+	if got.Labels["team"] != "team-pay" || got.Labels["environment"] != "production" || got.Labels["tier"] != "1" {
+		t.Fatalf("labels = %v", got.Labels)
+	}
+	if got.Annotations["runbook_url"] != "https://runbooks/crashloop" || got.Annotations["escalation"] != "pay-oncall" {
+		t.Fatalf("annotations = %v", got.Annotations)
+	}
+	if _, mutated := labels["team"]; mutated {
+		t.Fatal("apply() mutated the webhook label map")
+	}
+	if len(got.Enrichments) != 5 {
+		t.Fatalf("enrichments = %+v; want 5 records", got.Enrichments)
+	}
+	first := got.Enrichments[0]
+	if first.Enricher != "owners" || first.Type != "team" || first.Key != "team" || first.Source != "namespace=payments" {
+		t.Fatalf("first enrichment = %+v", first)
+	}
+}
+
+func TestEnrichmentPipeline_KeepsExistingValuesAndFallsBack(t *testing.T) {
+	settings := testEnrichmentSettings()
+	pipeline := newEnrichmentPipeline(settings)
+
+	// 이미 있는 team 라벨은 유지 (static 규칙도 team=team-pay가 아니므로 스킵)
+	got := pipeline.apply(model.Alert{Labels: map[string]string{"alertname": "X", "namespace": "payments", "team": "custom"}})
+	if got.Labels["team"] != "custom" || got.Labels["tier"] != "" {
+		t.Fatalf("labels = %v; want existing team kept and static rule skipped", got.Labels)
+	}
+
+	got = pipeline.apply(model.Alert{Labels: map[string]string{"alertname": "X", "namespace": "other"}})
+	if got.Labels["team"] != "platform" {
+		t.Fatalf("team = %q; want wildcard fallback platform", got.Labels["team"])
+	}
+
+	// overwrite면 기존 값을 기록하고 덮어씀
+	settings.Enrichers[0].Overwrite = true
+	got = newEnrichmentPipeline(settings).apply(model.Alert{Labels: map[string]string{"namespace": "payments", "team": "custom"}})
+	if got.Labels["team"] != "team-pay" || got.Enrichments[0].Replaced != "custom" {
+		t.Fatalf("labels = %v, enrichments = %+v; want overwritten team", got.Labels, got.Enrichments)
+	}
+
+	// 비활성 설정은 아무것도 바꾸지 않음
+	settings.Enabled = false
+	got = newEnrichmentPipeline(settings).apply(model.Alert{Labels: map[string]string{"namespace": "payments"}})
+	if len(got.Labels) != 1 || got.Enrichments != nil {
+		t.Fatalf("disabled pipeline changed alert: %+v", got)
+	}
+}
+
+func TestValidateEnrichmentSettings(t *testing.T) {
+	if err := validateEnrichmentSettings(testEnrichmentSettings()); err != nil {
+		t.Fatalf("validateEnrichmentSettings(valid) error = %v", err)
+	}
+
+	invalid := map[string]model.Enricher{
+		"unknown type":     {Name: "x", Type: "bogus"},
+		"missing name":     {Type: "team", Values: map[string]string{"a": "b"}},
+		"empty values":     {Name: "x", Type: "team"},
+		"lookup no source": {Name: "x", Type: "lookup", Key: "k", Values: map[string]string{"a": "b"}},
+		"protected label":  {Name: "x", Type: "lookup", SourceLabel: "team", Key: "severity", Values: map[string]string{"a": "critical"}},
+		"static no values": {Name: "x", Type: "static"},
+		"static bad regex": {Name: "x", Type: "static", Labels: map[string]string{"a": "b"}, Matchers: model.LabelMatchers{{Name: "n", Value: "(", IsRegex: true, IsEqual: true}}},
+		"bad target":       {Name: "x", Type: "team", Target: "header", Values: map[string]string{"a": "b"}},
+		"static alertname": {Name: "x", Type: "static", Labels: map[string]string{"alertname": "b"}},
+	}
+	for name, e := range invalid {
+		if err := validateEnrichmentSettings(model.EnrichmentSettings{Enrichers: []model.Enricher{e}}); err == nil {
+			t.Errorf("%s: validateEnrichmentSettings() error = nil; want error", name)
+		}
+	}
+
+	dup := model.EnrichmentSettings{Enrichers: []model.Enricher{
+		{Name: "x", Type: "team", Values: map[string]string{"a": "b"}},
+		{Name: "x", Type: "runbook", Values: map[string]string{"a": "b"}},
+	}}
+	if err := validateEnrichmentSettings(dup); err == nil {
+		t.Fatal("duplicate names: error = nil; want error")
+	}
+}
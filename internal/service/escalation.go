@@ -0,0 +1,435 @@
+// 다단계 Incident 에스컬레이션 로직
+//
+// 처리 흐름:
+//  1. 관리자가 매처 + 순서가 있는 레벨(대상: webhook 설정/Slack 사용자/Slack 채널, timeout)로 정책 생성
+//  2. AlertService가 firing alert를 Incident에 연결할 때 가장 구체적인 정책으로 에스컬레이션 시작
+//     (silence/점검/억제/알림 비대상 alert는 제외, incident_escalations에 Incident당 한 번만 기록)
+//  3. 레벨 1은 즉시, 이후 레벨은 이전 레벨 timeout 후 scheduled_jobs(incident_escalation)로 실행
+//  4. 실행 시 Incident가 종료/확인되었거나 정책이 삭제·비활성화되었으면 중단, 아니면 레벨 대상에 알림 후 다음 레벨 예약
+//     (알림 실패 시 레벨을 올리지 않고 예약 작업 재시도로 같은 레벨을 다시 알림)
+//  5. 마지막 레벨 timeout까지 확인되지 않으면 exhausted
+//
+// 시작/레벨 진행/중단/소진은 모두 incident_audit_events에 기록되며,
+// 진행 상태는 DB에 있으므로 재시작 후에도 이어진다 (누락된 예약 작업은 시작 시 복구).
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/kube-rca/backend/internal/client"
+	"github.com/kube-rca/backend/internal/db"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+var (
+	ErrInvalidEscalationPolicy  = errors.New("invalid escalation policy")
+	ErrEscalationPolicyNotFound = errors.New("escalation policy not found")
+)
+
+const (
+	maxEscalationLevels = 10
+	auditActorSystem    = "system"
+)
+
+// escalationRepo - EscalationService가 사용하는 DB 인터페이스
+type escalationRepo interface {
+	ListEscalationPolicies(ctx context.Context) ([]model.EscalationPolicy, error)
+	GetEscalationPolicy(ctx context.Context, id int64) (*model.EscalationPolicy, error)
+	CreateEscalationPolicy(ctx context.Context, ep model.EscalationPolicy) (int64, error)
+	UpdateEscalationPolicy(ctx context.Context, id int64, ep model.EscalationPolicy) error
+	DeleteEscalationPolicy(ctx context.Context, id int64) error
+	GetIncidentEscalation(ctx context.Context, incidentID string) (*model.IncidentEscalation, error)
+	ListActiveIncidentEscalations(ctx context.Context) ([]model.IncidentEscalation, error)
+	CreateIncidentEscalation(ctx context.Context, incidentID string, policyID int64, policyName string, at time.Time) (bool, error)
+	AdvanceIncidentEscalation(ctx context.Context, incidentID string, fromLevel, toLevel int, at, nextAt time.Time) (bool, error)
+	StopIncidentEscalation(ctx context.Context, incidentID, status, reason string) (bool, error)
+	GetIncidentDetail(incidentID string) (*model.IncidentDetailResponse, error)
+	InsertIncidentAuditEvent(ctx context.Context, event model.IncidentAuditEvent) error
+	ListIncidentAuditEvents(ctx context.Context, incidentID string) ([]model.IncidentAuditEvent, error)
+}
+
+// EscalationService - 에스컬레이션 정책 CRUD 및 Incident 에스컬레이션 진행
+type EscalationService struct {
+	db       escalationRepo
+	notifier client.EscalationNotifier // nil이면 레벨 실행이 실패하여 재시도됨
+	jobs     jobScheduler
+	now      func() time.Time
+}
+
+func NewEscalationService(db escalationRepo, notifier client.Notifier) *EscalationService {
+	svc := &EscalationService{db: db, now: time.Now}
+	if n, ok := notifier.(client.EscalationNotifier); ok {
+		svc.notifier = n
+	}
+	return svc
+}
+
+// SetJobScheduler - 예약 작업 스케줄러 설정 및 에스컬레이션 작업 실행 함수 등록
+func (s *EscalationService) SetJobScheduler(jobs *ScheduledJobService) {
+	s.jobs = jobs
+	jobs.Register(model.ScheduledJobTypeIncidentEscalation, s.RunEscalationJob)
+}
+
+// List - 전체 정책 조회
+func (s *EscalationService) List(ctx context.Context) ([]model.EscalationPolicy, error) {
+	return s.db.ListEscalationPolicies(ctx)
+}
+
+// Get - 단건 조회 (없으면 nil)
+func (s *EscalationService) Get(ctx context.Context, id int64) (*model.EscalationPolicy, error) {
+	return s.db.GetEscalationPolicy(ctx, id)
+}
+
+// Create - 정책 생성 (createdBy: 로그인 사용자 ID)
+func (s *EscalationService) Create(ctx context.Context, req model.EscalationPolicyRequest, createdBy string) (int64, error) {
+	ep, err := buildEscalationPolicy(req)
+	if err != nil {
+		return 0, err
+	}
+	ep.CreatedBy = createdBy
+	return s.db.CreateEscalationPolicy(ctx, ep)
+}
+
+// Update - 정책 수정
+func (s *EscalationService) Update(ctx context.Context, id int64, req model.EscalationPolicyRequest) error {
+	existing, err := s.db.GetEscalationPolicy(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return fmt.Errorf("%w: id=%d", ErrEscalationPolicyNotFound, id)
+	}
+	ep, err := buildEscalationPolicy(req)
+	if err != nil {
+		return err
+	}
+	return s.db.UpdateEscalationPolicy(ctx, id, ep)
+}
+
+// Delete - 정책 삭제
+func (s *EscalationService) Delete(ctx context.Context, id int64) error {
+	existing, err := s.db.GetEscalationPolicy(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return fmt.Errorf("%w: id=%d", ErrEscalationPolicyNotFound, id)
+	}
+	return s.db.DeleteEscalationPolicy(ctx, id)
+}
+
+// GetIncidentEscalation - Incident 에스컬레이션 진행 상태 (없으면 nil)
+func (s *EscalationService) GetIncidentEscalation(ctx context.Context, incidentID string) (*model.IncidentEscalation, error) {
+	return s.db.GetIncidentEscalation(ctx, incidentID)
+}
+
+// ListAuditEvents - Incident 감사 기록 (시간순)
+func (s *EscalationService) ListAuditEvents(ctx context.Context, incidentID string) ([]model.IncidentAuditEvent, error) {
+	return s.db.ListIncidentAuditEvents(ctx, incidentID)
+}
+
+// StartEscalation - Incident 에스컬레이션 시작 (이미 시작되었거나 firing이 아니거나 확인된 Incident는 스킵)
+func (s *EscalationService) StartEscalation(ctx context.Context, incidentID string, policy model.EscalationPolicy) {
+	existing, err := s.db.GetIncidentEscalation(ctx, incidentID)
+	if err != nil {
+		log.Printf("Failed to load incident escalation (incident_id=%s): %v", incidentID, err)
+		return
+	}
+	if existing != nil {
+		return
+	}
+	incident, err := s.db.GetIncidentDetail(incidentID)
+	if err != nil {
+		log.Printf("Failed to load incident for escalation (incident_id=%s): %v", incidentID, err)
+		return
+	}
+	if incident.Status != "firing" || incident.Acknowledged {
+		return
+	}
+
+	now := s.now()
+	created, err := s.db.CreateIncidentEscalation(ctx, incidentID, policy.ID, policy.Name, now)
+	if err != nil {
+		log.Printf("Failed to start incident escalation (incident_id=%s): %v", incidentID, err)
+		return
+	}
+	if !created {
+		return
+	}
+	log.Printf("Incident escalation started (incident_id=%s, policy=%s(id=%d), levels=%d)", incidentID, policy.Name, policy.ID, len(policy.Levels))
+	s.audit(ctx, incidentID, model.IncidentAuditEscalationStarted, auditActorSystem,
+		fmt.Sprintf("에스컬레이션 시작 (정책: %s, %d단계)", policy.Name, len(policy.Levels)),
+		map[string]any{"policy_id": policy.ID, "policy_name": policy.Name, "level_count": len(policy.Levels)})
+	s.scheduleLevel(ctx, incidentID, policy.ID, 1, now)
+}
+
+// IncidentAcknowledged - Incident 확인 기록 후 진행 중인 에스컬레이션 중단
+func (s *EscalationService) IncidentAcknowledged(ctx context.Context, incidentID, acknowledgedBy, alertID string) {
+	message := fmt.Sprintf("%s님이 Incident를 확인", acknowledgedBy)
+	detail := map[string]any{}
+	if alertID != "" {
+		message = fmt.Sprintf("%s님이 alert %s 확인으로 Incident를 확인", acknowledgedBy, alertID)
+		detail["alert_id"] = alertID
+	}
+	s.audit(ctx, incidentID, model.IncidentAuditAcknowledged, acknowledgedBy, message, detail)
+	if err := s.stopEscalation(ctx, incidentID, model.EscalationStatusAcknowledged, "incident acknowledged", acknowledgedBy); err != nil {
+		log.Printf("Failed to stop incident escalation on acknowledgement (incident_id=%s): %v", incidentID, err)
+	}
+}
+
+// ScheduleMissingEscalations - 진행 중인 에스컬레이션의 다음 레벨 작업 재예약 (시작 시 1회, 같은 key의 pending 작업은 갱신)
+func (s *EscalationService) ScheduleMissingEscalations(ctx context.Context) {
+	active, err := s.db.ListActiveIncidentEscalations(ctx)
+	if err != nil {
+		log.Printf("Failed to list active incident escalations: %v", err)
+		return
+	}
+	for _, e := range active {
+		runAt := s.now()
+		if e.NextEscalationAt != nil {
+			runAt = *e.NextEscalationAt
+		}
+		s.scheduleLevel(ctx, e.IncidentID, e.PolicyID, e.CurrentLevel+1, runAt)
+	}
+	if len(active) > 0 {
+		log.Printf("Rescheduled next escalation level for %d active incident escalations", len(active))
+	}
+}
+
+// RunEscalationJob - 예약된 에스컬레이션 레벨 실행 (scheduled job handler)
+// DB 오류와 레벨 알림 실패는 error로 반환하여 레벨을 올리지 않고 재시도하고, 이미 진행/중단된 에스컬레이션은 완료로 처리한다.
+func (s *EscalationService) RunEscalationJob(ctx context.Context, job model.ScheduledJob) error {
+	var payload model.IncidentEscalationPayload
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return fmt.Errorf("failed to decode incident escalation payload: %w", err)
+	}
+	incidentID := payload.IncidentID
+
+	state, err := s.db.GetIncidentEscalation(ctx, incidentID)
+	if err != nil {
+		return err
+	}
+	if state == nil || state.Status != model.EscalationStatusActive || state.CurrentLevel != payload.Level-1 {
+		return nil
+	}
+
+	incident, err := s.db.GetIncidentDetail(incidentID)
+	if err != nil {
+		if db.IsNoRows(err) {
+			return s.stopEscalation(ctx, incidentID, model.EscalationStatusCancelled, "incident not found", auditActorSystem)
+		}
+		return fmt.Errorf("failed to load incident for escalation: %w", err)
+	}
+	if incident.Status != "firing" {
+		return s.stopEscalation(ctx, incidentID, model.EscalationStatusResolved, "incident resolved", auditActorSystem)
+	}
+	if incident.Acknowledged {
+		return s.stopEscalation(ctx, incidentID, model.EscalationStatusAcknowledged, "incident acknowledged", incident.AcknowledgedBy)
+	}
+
+	policy, err := s.db.GetEscalationPolicy(ctx, state.PolicyID)
+	if err != nil {
+		return err
+	}
+	if policy == nil || !policy.Enabled {
+		return s.stopEscalation(ctx, incidentID, model.EscalationStatusCancelled, "escalation policy deleted or disabled", auditActorSystem)
+	}
+	if payload.Level > len(policy.Levels) {
+		return s.stopEscalation(ctx, incidentID, model.EscalationStatusExhausted,
+			fmt.Sprintf("not acknowledged after all %d levels", len(policy.Levels)), auditActorSystem)
+	}
+
+	if s.notifier == nil {
+		return errors.New("notifier does not support escalation targets")
+	}
+
+	now := s.now()
+	level := policy.Levels[payload.Level-1]
+	nextAt := now.Add(time.Duration(level.TimeoutMinutes) * time.Minute)
+	event := client.IncidentEscalatedEvent{
+		IncidentID:     incidentID,
+		Title:          incident.Title,
+		Severity:       incident.Severity,
+		FiredAt:        incident.FiredAt,
+		PolicyName:     policy.Name,
+		Level:          payload.Level,
+		LevelCount:     len(policy.Levels),
+		Unacknowledged: now.Sub(incident.FiredAt),
+	}
+	// 레벨을 올리기 전에 알림: 실패하면 error를 반환하여 같은 레벨을 재시도
+	if err := s.notifier.NotifyEscalationTargets(event, level.Targets); err != nil {
+		return fmt.Errorf("failed to notify escalation targets (level=%d): %w", payload.Level, err)
+	}
+
+	advanced, err := s.db.AdvanceIncidentEscalation(ctx, incidentID, payload.Level-1, payload.Level, now, nextAt)
+	if err != nil {
+		return err
+	}
+	if !advanced {
+		return nil
+	}
+	s.scheduleLevel(ctx, incidentID, policy.ID, payload.Level+1, nextAt)
+
+	log.Printf("Incident escalated (incident_id=%s, policy=%s, level=%d/%d)", incidentID, policy.Name, payload.Level, len(policy.Levels))
+	s.audit(ctx, incidentID, model.IncidentAuditEscalated, auditActorSystem,
+		fmt.Sprintf("레벨 %d/%d로 에스컬레이션 (정책: %s, 대상 %d개)", payload.Level, len(policy.Levels), policy.Name, len(level.Targets)),
+		map[string]any{
+			"policy_id":          policy.ID,
+			"level":              payload.Level,
+			"level_count":        len(policy.Levels),
+			"targets":            level.Targets,
+			"next_escalation_at": nextAt,
+		})
+	return nil
+}
+
+// stopEscalation - active 에스컬레이션 중단 + 대기 중인 작업 취소 + 감사 기록
+func (s *EscalationService) stopEscalation(ctx context.Context, incidentID, status, reason, actor string) error {
+	stopped, err := s.db.StopIncidentEscalation(ctx, incidentID, status, reason)
+	if err != nil {
+		return err
+	}
+	if !stopped {
+		return nil
+	}
+	if s.jobs != nil {
+		if _, err := s.jobs.Cancel(ctx, model.ScheduledJobTypeIncidentEscalation, incidentID); err != nil {
+			log.Printf("Failed to cancel incident escalation job (incident_id=%s): %v", incidentID, err)
+		}
+	}
+	eventType, message := model.IncidentAuditEscalationStopped, fmt.Sprintf("에스컬레이션 중단 (%s)", reason)
+	if status == model.EscalationStatusExhausted {
+		eventType, message = model.IncidentAuditEscalationExhausted, fmt.Sprintf("에스컬레이션 종료: 모든 레벨 이후에도 확인되지 않음 (%s)", reason)
+	}
+	log.Printf("Incident escalation stopped (incident_id=%s, status=%s, reason=%s)", incidentID, status, reason)
+	s.audit(ctx, incidentID, eventType, actor, message, map[string]any{"status": status, "reason": reason})
+	return nil
+}
+
+func (s *EscalationService) scheduleLevel(ctx context.Context, incidentID string, policyID int64, level int, runAt time.Time) {
+	if s.jobs == nil {
+		log.Printf("Job scheduler not configured, skipping escalation level %d (incident_id=%s)", level, incidentID)
+		return
+	}
+	payload := model.IncidentEscalationPayload{IncidentID: incidentID, PolicyID: policyID, Level: level}
+	if _, err := s.jobs.Schedule(ctx, model.ScheduledJobTypeIncidentEscalation, incidentID, runAt, payload); err != nil {
+		log.Printf("Failed to schedule escalation level %d (incident_id=%s): %v", level, incidentID, err)
+	}
+}
+
+// audit - Incident 감사 기록 추가 (실패는 로그만 남김)
+func (s *EscalationService) audit(ctx context.Context, incidentID, eventType, actor, message string, detail any) {
+	raw, err := json.Marshal(detail)
+	if err != nil {
+		log.Printf("Failed to encode incident audit detail (incident_id=%s, event=%s): %v", incidentID, eventType, err)
+		raw = nil
+	}
+	event := model.IncidentAuditEvent{IncidentID: incidentID, EventType: eventType, Actor: actor, Message: message, Detail: raw}
+	if err := s.db.InsertIncidentAuditEvent(ctx, event); err != nil {
+		log.Printf("Failed to record incident audit event (incident_id=%s, event=%s): %v", incidentID, eventType, err)
+	}
+}
+
+// buildEscalationPolicy - 요청 검증 및 정규화
+func buildEscalationPolicy(req model.EscalationPolicyRequest) (model.EscalationPolicy, error) {
+	ep := model.EscalationPolicy{
+		Name:    strings.TrimSpace(req.Name),
+		Enabled: req.Enabled == nil || *req.Enabled,
+		Comment: strings.TrimSpace(req.Comment),
+	}
+	if ep.Name == "" {
+		return ep, fmt.Errorf("%w: name is required", ErrInvalidEscalationPolicy)
+	}
+
+	ep.Matchers = make(model.LabelMatchers, 0, len(req.Matchers))
+	for _, m := range req.Matchers {
+		m.Name = strings.TrimSpace(m.Name)
+		ep.Matchers = append(ep.Matchers, m)
+	}
+	// 매처가 비어 있으면 catch-all 정책 (가장 덜 구체적이므로 다른 매칭 정책이 우선)
+	if len(ep.Matchers) > 0 {
+		if err := ep.Matchers.Validate(); err != nil {
+			return ep, fmt.Errorf("%w: matchers: %v", ErrInvalidEscalationPolicy, err)
+		}
+	}
+
+	if len(req.Levels) == 0 || len(req.Levels) > maxEscalationLevels {
+		return ep, fmt.Errorf("%w: between 1 and %d levels are required", ErrInvalidEscalationPolicy, maxEscalationLevels)
+	}
+	ep.Levels = make([]model.EscalationLevel, 0, len(req.Levels))
+	for i, level := range req.Levels {
+		if level.TimeoutMinutes < 1 {
+			return ep, fmt.Errorf("%w: level %d: timeout_minutes must be at least 1", ErrInvalidEscalationPolicy, i+1)
+		}
+		if len(level.Targets) == 0 {
+			return ep, fmt.Errorf("%w: level %d: at least one target is required", ErrInvalidEscalationPolicy, i+1)
+		}
+		targets := make([]model.EscalationTarget, 0, len(level.Targets))
+		for _, t := range level.Targets {
+			target, err := normalizeEscalationTarget(t)
+			if err != nil {
+				return ep, fmt.Errorf("%w: level %d: %v", ErrInvalidEscalationPolicy, i+1, err)
+			}
+			targets = append(targets, target)
+		}
+		ep.Levels = append(ep.Levels, model.EscalationLevel{TimeoutMinutes: level.TimeoutMinutes, Targets: targets})
+	}
+	return ep, nil
+}
+
+// normalizeEscalationTarget - 대상 종류별 필수 값 검증 (종류와 무관한 값은 제거)
+func normalizeEscalationTarget(t model.EscalationTarget) (model.EscalationTarget, error) {
+	switch strings.ToLower(strings.TrimSpace(t.Type)) {
+	case model.EscalationTargetWebhook:
+		if t.WebhookConfigID <= 0 {
+			return t, errors.New("webhook target requires webhook_config_id")
+		}
+		return model.EscalationTarget{Type: model.EscalationTargetWebhook, WebhookConfigID: t.WebhookConfigID}, nil
+	case model.EscalationTargetUser:
+		user := strings.TrimSpace(t.User)
+		if user == "" {
+			return t, errors.New("user target requires user (Slack user ID)")
+		}
+		return model.EscalationTarget{Type: model.EscalationTargetUser, User: user}, nil
+	case model.EscalationTargetChannel:
+		channel := strings.TrimSpace(t.Channel)
+		if channel == "" {
+			return t, errors.New("channel target requires channel (Slack channel ID)")
+		}
+		return model.EscalationTarget{Type: model.EscalationTargetChannel, Channel: channel}, nil
+	default:
+		return t, fmt.Errorf("unsupported target type %q (webhook, user, channel)", t.Type)
+	}
+}
+
+// matchEscalationPolicy - 라벨에 매칭되는 가장 구체적인 정책 (없으면 nil, 우선순위는 flapping 정책과 동일)
+func matchEscalationPolicy(policies []model.EscalationPolicy, labels map[string]string) *model.EscalationPolicy {
+	var best *model.EscalationPolicy
+	for i := range policies {
+		p := &policies[i]
+		if !p.Enabled || len(p.Levels) == 0 || (len(p.Matchers) > 0 && !p.Matchers.Matches(labels)) {
+			continue
+		}
+		if best == nil || moreSpecificEscalationPolicy(p, best) {
+			best = p
+		}
+	}
+	return best
+}
+
+func moreSpecificEscalationPolicy(a, b *model.EscalationPolicy) bool {
+	if len(a.Matchers) != len(b.Matchers) {
+		return len(a.Matchers) > len(b.Matchers)
+	}
+	if ea, eb := exactMatcherCount(a.Matchers), exactMatcherCount(b.Matchers); ea != eb {
+		return ea > eb
+	}
+	return a.ID < b.ID
+}
@@ -0,0 +1,375 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kube-rca/backend/internal/client"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// ============================================================================
+// Mock: escalationRepo
+// ============================================================================
+
+type escalationRepoMock struct {
+	policies    map[int64]*model.EscalationPolicy
+	escalations map[string]*model.IncidentEscalation
+	incidents   map[string]*model.IncidentDetailResponse
+	audit       []model.IncidentAuditEvent
+}
+
+func newEscalationRepoMock() *escalationRepoMock {
+	return &escalationRepoMock{
+		policies:    make(map[int64]*model.EscalationPolicy),
+		escalations: make(map[string]*model.IncidentEscalation),
+		incidents:   make(map[string]*model.IncidentDetailResponse),
+	}
+}
+
+func (m *escalationRepoMock) ListEscalationPolicies(_ context.Context) ([]model.EscalationPolicy, error) {
+	var out []model.EscalationPolicy
+	for _, p := range m.policies {
+		out = append(out, *p)
+	}
+	return out, nil
+}
+
+func (m *escalationRepoMock) GetEscalationPolicy(_ context.Context, id int64) (*model.EscalationPolicy, error) {
+	return m.policies[id], nil
+}
+
+func (m *escalationRepoMock) CreateEscalationPolicy(_ context.Context, ep model.EscalationPolicy) (int64, error) {
+	ep.ID = int64(len(m.policies) + 1)
+	m.policies[ep.ID] = &ep
+	return ep.ID, nil
+}
+
+func (m *escalationRepoMock) UpdateEscalationPolicy(_ context.Context, id int64, ep model.EscalationPolicy) error {
+	ep.ID = id
+	m.policies[id] = &ep
+	return nil
+}
+
+func (m *escalationRepoMock) DeleteEscalationPolicy(_ context.Context, id int64) error {
+	delete(m.policies, id)
+	return nil
+}
+
+func (m *escalationRepoMock) GetIncidentEscalation(_ context.Context, incidentID string) (*model.IncidentEscalation, error) {
+	return m.escalations[incidentID], nil
+}
+
+func (m *escalationRepoMock) ListActiveIncidentEscalations(_ context.Context) ([]model.IncidentEscalation, error) {
+	var out []model.IncidentEscalation
+	for _, e := range m.escalations {
+		if e.Status == model.EscalationStatusActive {
+			out = append(out, *e)
+		}
+	}
+	return out, nil
+}
+
+func (m *escalationRepoMock) CreateIncidentEscalation(_ context.Context, incidentID string, policyID int64, policyName string, at time.Time) (bool, error) {
+	if _, ok := m.escalations[incidentID]; ok {
+		return false, nil
+	}
+	m.escalations[incidentID] = &model.IncidentEscalation{
+		IncidentID: incidentID, PolicyID: policyID, PolicyName: policyName,
+		Status: model.EscalationStatusActive, StartedAt: at, NextEscalationAt: &at,
+	}
+	return true, nil
+}
+
+func (m *escalationRepoMock) AdvanceIncidentEscalation(_ context.Context, incidentID string, fromLevel, toLevel int, at, nextAt time.Time) (bool, error) {
+	e, ok := m.escalations[incidentID]
+	if !ok || e.Status != model.EscalationStatusActive || e.CurrentLevel != fromLevel {
+		return false, nil
+	}
+	e.CurrentLevel, e.LastEscalatedAt, e.NextEscalationAt = toLevel, &at, &nextAt
+	return true, nil
+}
+
+func (m *escalationRepoMock) StopIncidentEscalation(_ context.Context, incidentID, status, reason string) (bool, error) {
+	e, ok := m.escalations[incidentID]
+	if !ok || e.Status != model.EscalationStatusActive {
+		return false, nil
+	}
+	e.Status, e.StoppedReason, e.NextEscalationAt = status, reason, nil
+	return true, nil
+}
+
+func (m *escalationRepoMock) GetIncidentDetail(incidentID string) (*model.IncidentDetailResponse, error) {
+	if i, ok := m.incidents[incidentID]; ok {
+		return i, nil
+	}
+	return nil, pgx.ErrNoRows
+}
+
+func (m *escalationRepoMock) InsertIncidentAuditEvent(_ context.Context, event model.IncidentAuditEvent) error {
+	m.audit = append(m.audit, event)
+	return nil
+}
+
+func (m *escalationRepoMock) ListIncidentAuditEvents(_ context.Context, incidentID string) ([]model.IncidentAuditEvent, error) {
+	var out []model.IncidentAuditEvent
+	for _, e := range m.audit {
+		if e.IncidentID == incidentID {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+func (m *escalationRepoMock) auditTypes() []string {
+	types := make([]string, 0, len(m.audit))
+	for _, e := range m.audit {
+		types = append(types, e.EventType)
+	}
+	return types
+}
+
+// ============================================================================
+// Mock: client.EscalationNotifier
+// ============================================================================
+
+type escalationNotifierMock struct {
+	events  []client.IncidentEscalatedEvent
+	targets [][]model.EscalationTarget
+	err     error
+}
+
+func (m *escalationNotifierMock) NotifyEscalationTargets(event client.IncidentEscalatedEvent, targets []model.EscalationTarget) error {
+	m.events = append(m.events, event)
+	m.targets = append(m.targets, targets)
+	return m.err
+}
+
+// ============================================================================
+// Mock: incidentEscalator
+// ============================================================================
+
+type escalatorMock struct {
+	started      map[string]int64 // incidentID → policyID
+	acknowledged []string
+}
+
+func (m *escalatorMock) StartEscalation(_ context.Context, incidentID string, policy model.EscalationPolicy) {
+	if m.started == nil {
+		m.started = make(map[string]int64)
+	}
+	m.started[incidentID] = policy.ID
+}
+
+func (m *escalatorMock) IncidentAcknowledged(_ context.Context, incidentID, _, _ string) {
+	m.acknowledged = append(m.acknowledged, incidentID)
+}
+
+func newTestEscalationService(repo *escalationRepoMock, notifier *escalationNotifierMock, jobs *jobSchedulerMock, now time.Time) *EscalationService {
+	return &EscalationService{db: repo, notifier: notifier, jobs: jobs, now: func() time.Time { return now }}
+}
+
+func twoLevelEscalationPolicy() *model.EscalationPolicy {
+	return &model.EscalationPolicy{
+		ID: 1, Name: "prod-oncall", Enabled: true,
+		Levels: []model.EscalationLevel{
+			{TimeoutMinutes: 10, Targets: []model.EscalationTarget{{Type: model.EscalationTargetUser, User: "U-primary"}}},
+			{TimeoutMinutes: 15, Targets: []model.EscalationTarget{{Type: model.EscalationTargetWebhook, WebhookConfigID: 3}}},
+		},
+	}
+}
+
+func runEscalationJob(t *testing.T, svc *EscalationService, incidentID string, level int) {
+	t.Helper()
+	payload, _ := json.Marshal(model.IncidentEscalationPayload{IncidentID: incidentID, PolicyID: 1, Level: level})
+	job := model.ScheduledJob{JobType: model.ScheduledJobTypeIncidentEscalation, JobKey: incidentID, Payload: payload}
+	if err := svc.RunEscalationJob(context.Background(), job); err != nil {
+		t.Fatalf("RunEscalationJob(level=%d) error = %v", level, err)
+	}
+}
+
+func TestBuildEscalationPolicy_Validation(t *testing.T) {
+	user := []model.EscalationTarget{{Type: "user", User: "U1"}}
+	cases := map[string]model.EscalationPolicyRequest{
+		"missing name":      {Levels: []model.EscalationLevel{{TimeoutMinutes: 5, Targets: user}}},
+		"no levels":         {Name: "p"},
+		"zero timeout":      {Name: "p", Levels: []model.EscalationLevel{{Targets: user}}},
+		"no targets":        {Name: "p", Levels: []model.EscalationLevel{{TimeoutMinutes: 5}}},
+		"unknown target":    {Name: "p", Levels: []model.EscalationLevel{{TimeoutMinutes: 5, Targets: []model.EscalationTarget{{Type: "sms"}}}}},
+		"webhook without":   {Name: "p", Levels: []model.EscalationLevel{{TimeoutMinutes: 5, Targets: []model.EscalationTarget{{Type: "webhook"}}}}},
+		"channel without":   {Name: "p", Levels: []model.EscalationLevel{{TimeoutMinutes: 5, Targets: []model.EscalationTarget{{Type: "channel", Channel: " "}}}}},
+		"invalid matcher":   {Name: "p", Matchers: model.LabelMatchers{{Name: "", Value: "x", IsEqual: true}}, Levels: []model.EscalationLevel{{TimeoutMinutes: 5, Targets: user}}},
+		"too many levels":   {Name: "p", Levels: make([]model.EscalationLevel, maxEscalationLevels+1)},
+		"invalid regex":     {Name: "p", Matchers: model.LabelMatchers{{Name: "namespace", Value: "(", IsRegex: true, IsEqual: true}}, Levels: []model.EscalationLevel{{TimeoutMinutes: 5, Targets: user}}},
+		"second level fail": {Name: "p", Levels: []model.EscalationLevel{{TimeoutMinutes: 5, Targets: user}, {TimeoutMinutes: 5}}},
+	}
+	for name, req := range cases {
+		if _, err := buildEscalationPolicy(req); !errors.Is(err, ErrInvalidEscalationPolicy) {
+			t.Fatalf("%s: error = %v; want ErrInvalidEscalationPolicy", name, err)
+		}
+	}
+
+	ep, err := buildEscalationPolicy(model.EscalationPolicyRequest{
+		Name: " oncall ",
+		Levels: []model.EscalationLevel{{TimeoutMinutes: 5, Targets: []model.EscalationTarget{
+			{Type: " Webhook ", WebhookConfigID: 2, User: "ignored"},
+			{Type: "channel", Channel: " C-ops "},
+		}}},
+	})
+	if err != nil {
+		t.Fatalf("buildEscalationPolicy() error = %v", err)
+	}
+	if ep.Name != "oncall" || !ep.Enabled {
+		t.Fatalf("policy = %+v; want trimmed name and enabled by default", ep)
+	}
+	targets := ep.Levels[0].Targets
+	if targets[0] != (model.EscalationTarget{Type: "webhook", WebhookConfigID: 2}) || targets[1] != (model.EscalationTarget{Type: "channel", Channel: "C-ops"}) {
+		t.Fatalf("targets = %+v; want normalized webhook and channel targets", targets)
+	}
+}
+
+func TestMatchEscalationPolicy_PrefersMostSpecific(t *testing.T) {
+	level := []model.EscalationLevel{{TimeoutMinutes: 5, Targets: []model.EscalationTarget{{Type: "user", User: "U1"}}}}
+	policies := []model.EscalationPolicy{
+		{ID: 1, Name: "default", Enabled: true, Levels: level},
+		{ID: 2, Name: "prod", Enabled: true, Levels: level, Matchers: model.LabelMatchers{{Name: "namespace", Value: "prod", IsEqual: true}}},
+		{ID: 3, Name: "prod-critical", Enabled: false, Levels: level, Matchers: model.LabelMatchers{{Name: "namespace", Value: "prod", IsEqual: true}, {Name: "severity", Value: "critical", IsEqual: true}}},
+	}
+
+	if got := matchEscalationPolicy(policies, map[string]string{"namespace": "prod", "severity": "critical"}); got == nil || got.ID != 2 {
+		t.Fatalf("match = %+v; want policy 2 (disabled ignored)", got)
+	}
+	if got := matchEscalationPolicy(policies, map[string]string{"namespace": "dev"}); got == nil || got.ID != 1 {
+		t.Fatalf("match = %+v; want catch-all policy 1", got)
+	}
+	if got := matchEscalationPolicy(policies[1:2], map[string]string{"namespace": "dev"}); got != nil {
+		t.Fatalf("match = %+v; want nil", got)
+	}
+}
+
+func TestEscalation_AdvancesLevelsUntilExhausted(t *testing.T) {
+	repo := newEscalationRepoMock()
+	notifier := &escalationNotifierMock{}
+	jobs := &jobSchedulerMock{}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc := newTestEscalationService(repo, notifier, jobs, now)
+
+	policy := twoLevelEscalationPolicy()
+	repo.policies[1] = policy
+	repo.incidents["INC-1"] = &model.IncidentDetailResponse{IncidentID: "INC-1", Title: "HighCPU", Severity: "critical", Status: "firing", FiredAt: now.Add(-time.Minute)}
+
+	svc.StartEscalation(context.Background(), "INC-1", *policy)
+	svc.StartEscalation(context.Background(), "INC-1", *policy)
+	if len(jobs.scheduled) != 1 || jobs.scheduled[0].RunAt != now {
+		t.Fatalf("scheduled = %+v; want a single level 1 job at start time", jobs.scheduled)
+	}
+
+	runEscalationJob(t, svc, "INC-1", 1)
+	if len(notifier.events) != 1 || notifier.targets[0][0].User != "U-primary" || notifier.events[0].LevelCount != 2 {
+		t.Fatalf("notifications = %+v; want level 1 sent to U-primary", notifier.events)
+	}
+	next := jobs.scheduled[len(jobs.scheduled)-1]
+	if p := next.Payload.(model.IncidentEscalationPayload); p.Level != 2 || next.RunAt != now.Add(10*time.Minute) {
+		t.Fatalf("next job = %+v; want level 2 after 10m timeout", next)
+	}
+
+	// 같은 레벨 중복 실행은 무시
+	runEscalationJob(t, svc, "INC-1", 1)
+	if len(notifier.events) != 1 {
+		t.Fatalf("notifications = %d; want duplicate level run ignored", len(notifier.events))
+	}
+
+	runEscalationJob(t, svc, "INC-1", 2)
+	runEscalationJob(t, svc, "INC-1", 3)
+	if len(notifier.events) != 2 || notifier.targets[1][0].WebhookConfigID != 3 {
+		t.Fatalf("notifications = %+v; want level 2 sent to webhook 3", notifier.targets)
+	}
+	if state := repo.escalations["INC-1"]; state.Status != model.EscalationStatusExhausted || state.CurrentLevel != 2 {
+		t.Fatalf("state = %+v; want exhausted after level 2", state)
+	}
+
+	want := []string{model.IncidentAuditEscalationStarted, model.IncidentAuditEscalated, model.IncidentAuditEscalated, model.IncidentAuditEscalationExhausted}
+	if got := repo.auditTypes(); len(got) != len(want) || got[0] != want[0] || got[1] != want[1] || got[2] != want[2] || got[3] != want[3] {
+		t.Fatalf("audit = %v; want %v", got, want)
+	}
+}
+
+func TestEscalation_NotifyFailureKeepsLevelForRetry(t *testing.T) {
+	repo := newEscalationRepoMock()
+	notifier := &escalationNotifierMock{err: errors.New("slack unavailable")}
+	jobs := &jobSchedulerMock{}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc := newTestEscalationService(repo, notifier, jobs, now)
+	policy := twoLevelEscalationPolicy()
+	repo.policies[1] = policy
+	repo.incidents["INC-1"] = &model.IncidentDetailResponse{IncidentID: "INC-1", Status: "firing", FiredAt: now}
+	svc.StartEscalation(context.Background(), "INC-1", *policy)
+
+	payload, _ := json.Marshal(model.IncidentEscalationPayload{IncidentID: "INC-1", PolicyID: 1, Level: 1})
+	job := model.ScheduledJob{JobType: model.ScheduledJobTypeIncidentEscalation, JobKey: "INC-1", Payload: payload}
+	if err := svc.RunEscalationJob(context.Background(), job); err == nil {
+		t.Fatalf("expected error so the scheduled job retries")
+	}
+	if state := repo.escalations["INC-1"]; state.CurrentLevel != 0 || len(jobs.scheduled) != 1 {
+		t.Fatalf("state = %+v, scheduled = %d; want level not advanced and no next level", state, len(jobs.scheduled))
+	}
+
+	// 재시도에서 알림이 성공하면 같은 레벨을 다시 보내고 진행
+	notifier.err = nil
+	runEscalationJob(t, svc, "INC-1", 1)
+	if len(notifier.events) != 2 || repo.escalations["INC-1"].CurrentLevel != 1 {
+		t.Fatalf("notifications = %d, state = %+v; want level 1 resent and reached", len(notifier.events), repo.escalations["INC-1"])
+	}
+}
+
+func TestEscalation_StopsWhenAcknowledgedOrResolved(t *testing.T) {
+	repo := newEscalationRepoMock()
+	jobs := &jobSchedulerMock{}
+	now := time.Now()
+	svc := newTestEscalationService(repo, &escalationNotifierMock{}, jobs, now)
+	policy := twoLevelEscalationPolicy()
+	repo.policies[1] = policy
+	repo.incidents["INC-1"] = &model.IncidentDetailResponse{IncidentID: "INC-1", Status: "firing", FiredAt: now}
+	repo.incidents["INC-2"] = &model.IncidentDetailResponse{IncidentID: "INC-2", Status: "firing", FiredAt: now}
+
+	svc.StartEscalation(context.Background(), "INC-1", *policy)
+	svc.StartEscalation(context.Background(), "INC-2", *policy)
+
+	svc.IncidentAcknowledged(context.Background(), "INC-1", "alice", "ALR-1")
+	if state := repo.escalations["INC-1"]; state.Status != model.EscalationStatusAcknowledged {
+		t.Fatalf("state = %+v; want acknowledged", state)
+	}
+	if len(jobs.cancelled) != 1 || jobs.cancelled[0] != model.ScheduledJobTypeIncidentEscalation+"/INC-1" {
+		t.Fatalf("cancelled = %v; want pending escalation job of INC-1", jobs.cancelled)
+	}
+	audit, _ := repo.ListIncidentAuditEvents(context.Background(), "INC-1")
+	if last := audit[len(audit)-1]; last.EventType != model.IncidentAuditEscalationStopped || last.Actor != "alice" {
+		t.Fatalf("last audit = %+v; want escalation_stopped by alice", last)
+	}
+
+	repo.incidents["INC-2"].Status = "resolved"
+	runEscalationJob(t, svc, "INC-2", 1)
+	if state := repo.escalations["INC-2"]; state.Status != model.EscalationStatusResolved || state.CurrentLevel != 0 {
+		t.Fatalf("state = %+v; want resolved before level 1", state)
+	}
+}
+
+func TestProcessWebhook_StartsEscalationForUnsilencedFiringAlert(t *testing.T) {
+	store := newAlertStoreMock()
+	store.saveAlertResults = []saveAlertResult{{AlertID: "ALR-1"}, {AlertID: "ALR-2"}}
+	store.escalationPolicies = []model.EscalationPolicy{*twoLevelEscalationPolicy()}
+	store.escalationPolicies[0].Matchers = model.LabelMatchers{{Name: "severity", Value: "critical", IsEqual: true}}
+	escalator := &escalatorMock{}
+	svc := newTestAlertService(store, newNotifierMock(), &analyzerMock{})
+	svc.escalator = escalator
+
+	svc.ProcessWebhook(makeWebhook(makeAlert("fp-1", "firing", "critical"), makeAlert("fp-2", "resolved", "critical")))
+
+	if len(escalator.started) != 1 || escalator.started["INC-test0001"] != 1 {
+		t.Fatalf("started = %v; want escalation of INC-test0001 with policy 1", escalator.started)
+	}
+}
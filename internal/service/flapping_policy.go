@@ -0,0 +1,218 @@
+// 라벨 매처별 flapping 정책 관리 및 매칭 로직
+//
+// 처리 흐름:
+//  1. 관리자가 매처(alertname/namespace/severity 등) + window/threshold/clearance 또는 never_flapping으로 정책 생성
+//  2. AlertService가 웹훅 처리 시 활성화된 정책을 한 번 조회
+//  3. alert 라벨에 매칭되는 정책 중 가장 구체적인 정책을 선택 (매처 수 → 정확 일치 매처 수 → id 순)
+//  4. 정책 값(0이면 전역 설정)으로 flapping 감지/clearance 수행, alerts.flapping_policy_id에 적용된 정책 기록
+//
+// 전역 flapping 설정이 비활성이면 정책과 관계없이 감지하지 않는다.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+var (
+	ErrInvalidFlappingPolicy  = errors.New("invalid flapping policy")
+	ErrFlappingPolicyNotFound = errors.New("flapping policy not found")
+)
+
+// flappingPolicyRepo - FlappingPolicyService가 사용하는 DB 인터페이스
+type flappingPolicyRepo interface {
+	ListFlappingPolicies(ctx context.Context) ([]model.FlappingPolicy, error)
+	ListEnabledFlappingPolicies() ([]model.FlappingPolicy, error)
+	GetFlappingPolicy(ctx context.Context, id int64) (*model.FlappingPolicy, error)
+	CreateFlappingPolicy(ctx context.Context, fp model.FlappingPolicy) (int64, error)
+	UpdateFlappingPolicy(ctx context.Context, id int64, fp model.FlappingPolicy) error
+	DeleteFlappingPolicy(ctx context.Context, id int64) error
+}
+
+// FlappingPolicyService - flapping 정책 CRUD 및 적용 정책 조회
+type FlappingPolicyService struct {
+	db          flappingPolicyRepo
+	appSettings *AppSettingsService
+	envFlapping config.FlappingConfig
+}
+
+func NewFlappingPolicyService(db flappingPolicyRepo, appSettings *AppSettingsService, envFlapping config.FlappingConfig) *FlappingPolicyService {
+	return &FlappingPolicyService{db: db, appSettings: appSettings, envFlapping: envFlapping}
+}
+
+// List - 전체 정책 조회
+func (s *FlappingPolicyService) List(ctx context.Context) ([]model.FlappingPolicy, error) {
+	return s.db.ListFlappingPolicies(ctx)
+}
+
+// Get - 단건 조회 (없으면 nil)
+func (s *FlappingPolicyService) Get(ctx context.Context, id int64) (*model.FlappingPolicy, error) {
+	return s.db.GetFlappingPolicy(ctx, id)
+}
+
+// Create - 정책 생성 (createdBy: 로그인 사용자 ID)
+func (s *FlappingPolicyService) Create(ctx context.Context, req model.FlappingPolicyRequest, createdBy string) (int64, error) {
+	fp, err := buildFlappingPolicy(req)
+	if err != nil {
+		return 0, err
+	}
+	fp.CreatedBy = createdBy
+	return s.db.CreateFlappingPolicy(ctx, fp)
+}
+
+// Update - 정책 수정
+func (s *FlappingPolicyService) Update(ctx context.Context, id int64, req model.FlappingPolicyRequest) error {
+	existing, err := s.db.GetFlappingPolicy(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return fmt.Errorf("%w: id=%d", ErrFlappingPolicyNotFound, id)
+	}
+	fp, err := buildFlappingPolicy(req)
+	if err != nil {
+		return err
+	}
+	return s.db.UpdateFlappingPolicy(ctx, id, fp)
+}
+
+// Delete - 정책 삭제
+func (s *FlappingPolicyService) Delete(ctx context.Context, id int64) error {
+	existing, err := s.db.GetFlappingPolicy(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return fmt.Errorf("%w: id=%d", ErrFlappingPolicyNotFound, id)
+	}
+	return s.db.DeleteFlappingPolicy(ctx, id)
+}
+
+// Match - 라벨에 적용될 정책과 최종 설정 (관리자 확인용)
+func (s *FlappingPolicyService) Match(_ context.Context, labels map[string]string) (model.EffectiveFlappingPolicy, error) {
+	if len(labels) == 0 {
+		return model.EffectiveFlappingPolicy{}, fmt.Errorf("%w: labels are required", ErrInvalidFlappingPolicy)
+	}
+	policies, err := s.db.ListEnabledFlappingPolicies()
+	if err != nil {
+		return model.EffectiveFlappingPolicy{}, err
+	}
+	global := s.envFlapping
+	if s.appSettings != nil {
+		global = s.appSettings.GetEffectiveFlappingConfig()
+	}
+	return effectiveFlappingPolicy(global, matchFlappingPolicy(policies, labels)), nil
+}
+
+// buildFlappingPolicy - 요청 검증 및 정규화
+func buildFlappingPolicy(req model.FlappingPolicyRequest) (model.FlappingPolicy, error) {
+	fp := model.FlappingPolicy{
+		Name:                   strings.TrimSpace(req.Name),
+		NeverFlapping:          req.NeverFlapping,
+		DetectionWindowMinutes: req.DetectionWindowMinutes,
+		CycleThreshold:         req.CycleThreshold,
+		ClearanceWindowMinutes: req.ClearanceWindowMinutes,
+		Enabled:                req.Enabled == nil || *req.Enabled,
+		Comment:                strings.TrimSpace(req.Comment),
+	}
+	if fp.Name == "" {
+		return fp, fmt.Errorf("%w: name is required", ErrInvalidFlappingPolicy)
+	}
+
+	fp.Matchers = make(model.LabelMatchers, 0, len(req.Matchers))
+	for _, m := range req.Matchers {
+		m.Name = strings.TrimSpace(m.Name)
+		fp.Matchers = append(fp.Matchers, m)
+	}
+	if err := fp.Matchers.Validate(); err != nil {
+		return fp, fmt.Errorf("%w: matchers: %v", ErrInvalidFlappingPolicy, err)
+	}
+	if fp.Matchers.Matches(map[string]string{}) {
+		return fp, fmt.Errorf("%w: matchers must not match every alert (use the flapping app setting instead)", ErrInvalidFlappingPolicy)
+	}
+
+	if fp.DetectionWindowMinutes < 0 || fp.CycleThreshold < 0 || fp.ClearanceWindowMinutes < 0 {
+		return fp, fmt.Errorf("%w: window, threshold and clearance must not be negative", ErrInvalidFlappingPolicy)
+	}
+	if fp.CycleThreshold == 1 {
+		return fp, fmt.Errorf("%w: cycle_threshold must be at least 2", ErrInvalidFlappingPolicy)
+	}
+	if fp.NeverFlapping {
+		// never_flapping 정책은 임계값을 사용하지 않음
+		fp.DetectionWindowMinutes, fp.CycleThreshold, fp.ClearanceWindowMinutes = 0, 0, 0
+	} else if fp.DetectionWindowMinutes == 0 && fp.CycleThreshold == 0 && fp.ClearanceWindowMinutes == 0 {
+		return fp, fmt.Errorf("%w: set never_flapping or at least one of detection_window_minutes, cycle_threshold, clearance_window_minutes", ErrInvalidFlappingPolicy)
+	}
+	return fp, nil
+}
+
+// matchFlappingPolicy - 라벨에 매칭되는 가장 구체적인 정책 (없으면 nil)
+// 매처가 많은 정책 → 정확 일치(=) 매처가 많은 정책 → 먼저 생성된 정책 순으로 우선한다.
+func matchFlappingPolicy(policies []model.FlappingPolicy, labels map[string]string) *model.FlappingPolicy {
+	var best *model.FlappingPolicy
+	for i := range policies {
+		p := &policies[i]
+		if !p.Enabled || !p.Matchers.Matches(labels) {
+			continue
+		}
+		if best == nil || moreSpecificFlappingPolicy(p, best) {
+			best = p
+		}
+	}
+	return best
+}
+
+func moreSpecificFlappingPolicy(a, b *model.FlappingPolicy) bool {
+	if len(a.Matchers) != len(b.Matchers) {
+		return len(a.Matchers) > len(b.Matchers)
+	}
+	if ea, eb := exactMatcherCount(a.Matchers), exactMatcherCount(b.Matchers); ea != eb {
+		return ea > eb
+	}
+	return a.ID < b.ID
+}
+
+func exactMatcherCount(matchers model.LabelMatchers) int {
+	n := 0
+	for _, m := range matchers {
+		if m.IsEqual && !m.IsRegex {
+			n++
+		}
+	}
+	return n
+}
+
+// effectiveFlappingPolicy - 전역 설정에 정책 값을 덮어쓴 최종 설정 (policy nil이면 전역 설정)
+func effectiveFlappingPolicy(global config.FlappingConfig, policy *model.FlappingPolicy) model.EffectiveFlappingPolicy {
+	eff := model.EffectiveFlappingPolicy{
+		Enabled:                global.Enabled,
+		DetectionWindowMinutes: global.DetectionWindowMinutes,
+		CycleThreshold:         global.CycleThreshold,
+		ClearanceWindowMinutes: global.ClearanceWindowMinutes,
+	}
+	if policy == nil {
+		return eff
+	}
+	id := policy.ID
+	eff.PolicyID, eff.PolicyName = &id, policy.Name
+	if policy.NeverFlapping {
+		eff.Enabled = false
+		return eff
+	}
+	if policy.DetectionWindowMinutes > 0 {
+		eff.DetectionWindowMinutes = policy.DetectionWindowMinutes
+	}
+	if policy.CycleThreshold > 0 {
+		eff.CycleThreshold = policy.CycleThreshold
+	}
+	if policy.ClearanceWindowMinutes > 0 {
+		eff.ClearanceWindowMinutes = policy.ClearanceWindowMinutes
+	}
+	return eff
+}
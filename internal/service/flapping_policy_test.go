@@ -0,0 +1,71 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+func TestBuildFlappingPolicy_Validation(t *testing.T) {
+	ns := model.LabelMatchers{{Name: "namespace", Value: "batch", IsEqual: true}}
+	cases := map[string]model.FlappingPolicyRequest{
+		"missing name":      {Matchers: ns, CycleThreshold: 5},
+		"no matchers":       {Name: "all", CycleThreshold: 5},
+		"match all":         {Name: "all", Matchers: model.LabelMatchers{{Name: "namespace", Value: ".*", IsRegex: true, IsEqual: true}}, CycleThreshold: 5},
+		"negative window":   {Name: "p", Matchers: ns, DetectionWindowMinutes: -1},
+		"threshold of one":  {Name: "p", Matchers: ns, CycleThreshold: 1},
+		"no override value": {Name: "p", Matchers: ns},
+	}
+	for name, req := range cases {
+		if _, err := buildFlappingPolicy(req); !errors.Is(err, ErrInvalidFlappingPolicy) {
+			t.Fatalf("%s: error = %v; want ErrInvalidFlappingPolicy", name, err)
+		}
+	}
+
+	fp, err := buildFlappingPolicy(model.FlappingPolicyRequest{Name: " batch ", Matchers: ns, NeverFlapping: true, CycleThreshold: 4})
+	if err != nil {
+		t.Fatalf("buildFlappingPolicy(never_flapping) error = %v", err)
+	}
+	if fp.Name != "batch" || fp.CycleThreshold != 0 || !fp.Enabled {
+		t.Fatalf("policy = %+v; want trimmed name, cleared threshold, enabled by default", fp)
+	}
+}
+
+func TestMatchFlappingPolicy_PrefersMostSpecific(t *testing.T) {
+	policies := []model.FlappingPolicy{
+		{ID: 1, Name: "ns", Enabled: true, Matchers: model.LabelMatchers{{Name: "namespace", Value: "prod", IsEqual: true}}},
+		{ID: 2, Name: "ns-regex-sev", Enabled: true, Matchers: model.LabelMatchers{{Name: "namespace", Value: "prod", IsEqual: true}, {Name: "severity", Value: "warn.*", IsRegex: true, IsEqual: true}}},
+		{ID: 3, Name: "ns-sev", Enabled: true, Matchers: model.LabelMatchers{{Name: "namespace", Value: "prod", IsEqual: true}, {Name: "severity", Value: "warning", IsEqual: true}}},
+		{ID: 4, Name: "disabled", Enabled: false, Matchers: model.LabelMatchers{{Name: "namespace", Value: "prod", IsEqual: true}, {Name: "severity", Value: "warning", IsEqual: true}, {Name: "alertname", Value: "X", IsEqual: true}}},
+	}
+
+	if got := matchFlappingPolicy(policies, map[string]string{"namespace": "prod", "severity": "warning", "alertname": "X"}); got == nil || got.ID != 3 {
+		t.Fatalf("match = %+v; want policy 3 (exact matchers beat regex, disabled ignored)", got)
+	}
+	if got := matchFlappingPolicy(policies, map[string]string{"namespace": "prod", "severity": "critical"}); got == nil || got.ID != 1 {
+		t.Fatalf("match = %+v; want policy 1", got)
+	}
+	if got := matchFlappingPolicy(policies, map[string]string{"namespace": "dev"}); got != nil {
+		t.Fatalf("match = %+v; want nil", got)
+	}
+}
+
+func TestEffectiveFlappingPolicy_OverridesGlobal(t *testing.T) {
+	global := config.FlappingConfig{Enabled: true, DetectionWindowMinutes: 30, CycleThreshold: 3, ClearanceWindowMinutes: 30}
+
+	eff := effectiveFlappingPolicy(global, &model.FlappingPolicy{ID: 5, Name: "slow", CycleThreshold: 6})
+	if eff.PolicyID == nil || *eff.PolicyID != 5 || eff.CycleThreshold != 6 || eff.DetectionWindowMinutes != 30 || !eff.Enabled {
+		t.Fatalf("effective = %+v; want threshold 6 from policy and global window", eff)
+	}
+
+	if eff := effectiveFlappingPolicy(global, &model.FlappingPolicy{ID: 6, NeverFlapping: true}); eff.Enabled {
+		t.Fatalf("never_flapping effective = %+v; want disabled", eff)
+	}
+
+	global.Enabled = false
+	if eff := effectiveFlappingPolicy(global, &model.FlappingPolicy{ID: 5, CycleThreshold: 6}); eff.Enabled {
+		t.Fatalf("effective with global disabled = %+v; want disabled", eff)
+	}
+}
@@ -0,0 +1,366 @@
+// Alertmanager 외 알림 소스(Grafana, PagerDuty Events v2, 일반 JSON) 정규화 로직
+//
+// 처리 흐름:
+//  1. handler가 소스별 페이로드를 파싱하여 adapter에 전달
+//  2. adapter가 model.Alert로 정규화 (안정적인 fingerprint, severity 라벨, 소스 메타데이터)
+//     severity 값은 그대로 두고 AlertService가 분류 체계(app_settings "severity")로 정규 레벨에 매핑
+//  3. 정규화된 AlertmanagerWebhook을 webhook inbox에 저장 → AlertService 파이프라인에서 동일하게 처리
+//
+// 라벨 없이 해결 이벤트만 오는 소스(PagerDuty resolve 등)는 직전 alert의 라벨을 복원한다.
+// 처리할 alert가 없는 이벤트(PagerDuty acknowledge, 알 수 없는 resolve)는 alert 없는 웹훅을 반환하고 inbox에 저장하지 않는다.
+// 소스가 지정한 fingerprint는 Alertmanager 등 다른 소스의 fingerprint와 겹치지 않도록 소스를 접두어로 붙인다.
+
+package service
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// ingestAlertLookup - 해결 이벤트의 라벨 복원에 사용하는 DB 인터페이스
+type ingestAlertLookup interface {
+	GetLatestAlertByFingerprint(fingerprint string) (*model.AlertDetailResponse, error)
+}
+
+// IngestAdapterService - 외부 알림 소스 페이로드를 AlertmanagerWebhook으로 정규화
+type IngestAdapterService struct {
+	store ingestAlertLookup
+	now   func() time.Time
+}
+
+func NewIngestAdapterService(store ingestAlertLookup) *IngestAdapterService {
+	return &IngestAdapterService{store: store, now: time.Now}
+}
+
+// labelsFingerprint - 라벨 name=value를 정렬하여 sha256 해시 (앞 16자리)
+func labelsFingerprint(source string, labels map[string]string) string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	h.Write([]byte(source))
+	for _, name := range names {
+		h.Write([]byte{0xff})
+		h.Write([]byte(name + "=" + labels[name]))
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// keyFingerprint - 외부 시스템의 dedup 키로 fingerprint 생성
+func keyFingerprint(source, key string) string {
+	sum := sha256.Sum256([]byte(source + "\xff" + key))
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+// setSeverity - 소스의 severity 값을 라벨로 설정 (정규 레벨 매핑은 AlertService의 severity 분류 체계에서 수행)
+func setSeverity(alert *model.Alert, raw string) {
+	if raw = strings.TrimSpace(raw); raw != "" {
+		alert.Labels["severity"] = raw
+	}
+}
+
+func setIfEmpty(m map[string]string, key, value string) {
+	if value != "" && m[key] == "" {
+		m[key] = value
+	}
+}
+
+func copyStringMap(src map[string]string) map[string]string {
+	dst := make(map[string]string, len(src))
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
+
+// genericFingerprint - 일반 JSON 소스가 지정한 fingerprint에 소스 접두어 추가 (예: "generic:cron:abc123")
+func genericFingerprint(source, fingerprint string) string {
+	return model.AlertSourceGeneric + ":" + source + ":" + fingerprint
+}
+
+// buildIngestWebhook - 정규화된 alert 목록으로 AlertmanagerWebhook 구성
+func buildIngestWebhook(source, groupKey string, alerts []model.Alert) model.AlertmanagerWebhook {
+	status := "resolved"
+	for _, a := range alerts {
+		if a.Status == "firing" {
+			status = "firing"
+			break
+		}
+	}
+	if alerts == nil {
+		alerts = []model.Alert{}
+	}
+	return model.AlertmanagerWebhook{
+		Version:  "4",
+		GroupKey: groupKey,
+		Status:   status,
+		Receiver: source,
+		Alerts:   alerts,
+	}
+}
+
+// restoreResolvedAlert - 라벨 없이 도착한 해결 이벤트에 직전 alert의 라벨/어노테이션/발생 시각 복원
+// 복원할 alert가 없으면 false
+func (s *IngestAdapterService) restoreResolvedAlert(alert *model.Alert) bool {
+	if s.store == nil {
+		return false
+	}
+	prev, err := s.store.GetLatestAlertByFingerprint(alert.Fingerprint)
+	if err != nil || prev == nil {
+		return false
+	}
+	var labels, annotations map[string]string
+	if err := json.Unmarshal(prev.Labels, &labels); err != nil || len(labels) == 0 {
+		return false
+	}
+	_ = json.Unmarshal(prev.Annotations, &annotations)
+
+	for k, v := range labels {
+		setIfEmpty(alert.Labels, k, v)
+	}
+	for k, v := range annotations {
+		setIfEmpty(alert.Annotations, k, v)
+	}
+	if alert.StartsAt.IsZero() {
+		alert.StartsAt = prev.FiredAt
+	}
+	return true
+}
+
+// NormalizeGrafana - Grafana unified alerting 웹훅 정규화
+// Grafana가 제공하는 fingerprint를 그대로 사용하고, 없으면 라벨로 생성
+func (s *IngestAdapterService) NormalizeGrafana(payload model.GrafanaWebhook) (model.AlertmanagerWebhook, error) {
+	alerts := make([]model.Alert, 0, len(payload.Alerts))
+	for _, ga := range payload.Alerts {
+		alert := model.Alert{
+			Status:       strings.ToLower(ga.Status),
+			Labels:       copyStringMap(ga.Labels),
+			Annotations:  copyStringMap(ga.Annotations),
+			StartsAt:     ga.StartsAt,
+			EndsAt:       ga.EndsAt,
+			GeneratorURL: ga.GeneratorURL,
+			Fingerprint:  ga.Fingerprint,
+		}
+		if alert.Status != "resolved" {
+			alert.Status = "firing"
+		}
+		setIfEmpty(alert.Labels, "alertname", payload.Title)
+		if alert.Fingerprint == "" {
+			alert.Fingerprint = labelsFingerprint(model.AlertSourceGrafana, alert.Labels)
+		}
+
+		setIfEmpty(alert.Annotations, "dashboard_url", ga.DashboardURL)
+		setIfEmpty(alert.Annotations, "panel_url", ga.PanelURL)
+		setIfEmpty(alert.Annotations, "silence_url", ga.SilenceURL)
+		setIfEmpty(alert.Annotations, "value_string", strings.TrimSpace(ga.ValueString))
+		alerts = append(alerts, alert)
+	}
+
+	webhook := buildIngestWebhook(model.AlertSourceGrafana, payload.GroupKey, alerts)
+	webhook.Receiver = payload.Receiver
+	webhook.TruncatedAlerts = payload.TruncatedAlerts
+	webhook.GroupLabels = payload.GroupLabels
+	webhook.CommonLabels = payload.CommonLabels
+	webhook.CommonAnnotations = payload.CommonAnnotations
+	webhook.ExternalURL = payload.ExternalURL
+	return webhook, nil
+}
+
+// NormalizePagerDuty - PagerDuty Events v2 이벤트 정규화
+//   - trigger: firing alert 생성 (dedup_key 기준 fingerprint)
+//   - resolve: 같은 dedup_key의 직전 alert 라벨을 복원하여 resolved 처리
+//   - acknowledge: 대응하는 상태가 없으므로 무시 (alert 없는 웹훅 반환)
+func (s *IngestAdapterService) NormalizePagerDuty(event model.PagerDutyEvent) (model.AlertmanagerWebhook, error) {
+	action := strings.ToLower(strings.TrimSpace(event.EventAction))
+	now := s.now().UTC()
+
+	switch action {
+	case "trigger":
+		if event.Payload == nil || strings.TrimSpace(event.Payload.Summary) == "" {
+			return model.AlertmanagerWebhook{}, fmt.Errorf("payload.summary is required for trigger events")
+		}
+		p := event.Payload
+		alert := model.Alert{
+			Status:       "firing",
+			Labels:       map[string]string{},
+			Annotations:  map[string]string{"summary": p.Summary},
+			StartsAt:     now,
+			GeneratorURL: event.ClientURL,
+		}
+		if p.Timestamp != nil && !p.Timestamp.IsZero() {
+			alert.StartsAt = p.Timestamp.UTC()
+		}
+		alertName := p.Class
+		if alertName == "" {
+			alertName = p.Summary
+		}
+		alert.Labels["alertname"] = alertName
+		setIfEmpty(alert.Labels, "instance", p.Source)
+		setIfEmpty(alert.Labels, "component", p.Component)
+		setIfEmpty(alert.Labels, "group", p.Group)
+		setSeverity(&alert, p.Severity)
+		if len(p.CustomDetails) > 0 && !bytes.Equal(p.CustomDetails, []byte("null")) {
+			alert.Annotations["description"] = string(p.CustomDetails)
+		}
+		setIfEmpty(alert.Annotations, "client", event.Client)
+		setIfEmpty(alert.Annotations, "dedup_key", event.DedupKey)
+		for i, link := range event.Links {
+			alert.Annotations[fmt.Sprintf("link_%d", i+1)] = link.Href
+		}
+
+		if event.DedupKey != "" {
+			alert.Fingerprint = keyFingerprint(model.AlertSourcePagerDuty, event.DedupKey)
+		} else {
+			alert.Fingerprint = labelsFingerprint(model.AlertSourcePagerDuty, alert.Labels)
+		}
+		return buildIngestWebhook(model.AlertSourcePagerDuty, "pagerduty:"+alert.Labels["group"], []model.Alert{alert}), nil
+
+	case "resolve":
+		if strings.TrimSpace(event.DedupKey) == "" {
+			return model.AlertmanagerWebhook{}, fmt.Errorf("dedup_key is required for resolve events")
+		}
+		alert := model.Alert{
+			Status:      "resolved",
+			Labels:      map[string]string{},
+			Annotations: map[string]string{"dedup_key": event.DedupKey},
+			EndsAt:      now,
+			Fingerprint: keyFingerprint(model.AlertSourcePagerDuty, event.DedupKey),
+		}
+		if !s.restoreResolvedAlert(&alert) {
+			log.Printf("Ignoring PagerDuty resolve for unknown dedup_key (fingerprint=%s)", alert.Fingerprint)
+			return model.AlertmanagerWebhook{}, nil
+		}
+		return buildIngestWebhook(model.AlertSourcePagerDuty, "pagerduty:"+alert.Labels["group"], []model.Alert{alert}), nil
+
+	case "acknowledge":
+		log.Printf("Ignoring PagerDuty acknowledge event (dedup_key=%s)", event.DedupKey)
+		return model.AlertmanagerWebhook{}, nil
+
+	default:
+		return model.AlertmanagerWebhook{}, fmt.Errorf("unsupported event_action: %q", event.EventAction)
+	}
+}
+
+// NormalizeGeneric - 일반 JSON 페이로드 정규화
+// 허용 형식: {"source": "...", "alerts": [...]}, [...], 단건 {...}
+func (s *IngestAdapterService) NormalizeGeneric(body []byte) (model.AlertmanagerWebhook, error) {
+	payload, err := decodeGenericWebhook(body)
+	if err != nil {
+		return model.AlertmanagerWebhook{}, err
+	}
+	if len(payload.Alerts) == 0 {
+		return model.AlertmanagerWebhook{}, fmt.Errorf("no alerts in payload")
+	}
+
+	now := s.now().UTC()
+	alerts := make([]model.Alert, 0, len(payload.Alerts))
+	for i, ga := range payload.Alerts {
+		alert := model.Alert{
+			Status:       strings.ToLower(strings.TrimSpace(ga.Status)),
+			Labels:       copyStringMap(ga.Labels),
+			Annotations:  copyStringMap(ga.Annotations),
+			StartsAt:     now,
+			GeneratorURL: ga.URL,
+		}
+		switch alert.Status {
+		case "", "firing", "trigger", "triggered", "open", "problem":
+			alert.Status = "firing"
+		case "resolved", "resolve", "ok", "closed", "recovered":
+			alert.Status = "resolved"
+			alert.EndsAt = now
+		default:
+			return model.AlertmanagerWebhook{}, fmt.Errorf("alerts[%d]: unsupported status %q", i, ga.Status)
+		}
+		if ga.StartsAt != nil && !ga.StartsAt.IsZero() {
+			alert.StartsAt = ga.StartsAt.UTC()
+		}
+		if ga.EndsAt != nil && !ga.EndsAt.IsZero() && alert.Status == "resolved" {
+			alert.EndsAt = ga.EndsAt.UTC()
+		}
+
+		setIfEmpty(alert.Labels, "alertname", ga.Name)
+		setIfEmpty(alert.Labels, "source_system", payload.Source)
+		setIfEmpty(alert.Annotations, "summary", ga.Summary)
+		setIfEmpty(alert.Annotations, "description", ga.Description)
+
+		// fingerprint: 지정값 > id > 라벨 해시 (severity 변경으로 fingerprint가 바뀌지 않도록 라벨 해시는 severity 설정 전에 계산)
+		switch {
+		case ga.Fingerprint != "":
+			alert.Fingerprint = genericFingerprint(payload.Source, ga.Fingerprint)
+		case ga.ID != "":
+			alert.Fingerprint = keyFingerprint(model.AlertSourceGeneric, payload.Source+"/"+ga.ID)
+		default:
+			if alert.Labels["alertname"] == "" {
+				return model.AlertmanagerWebhook{}, fmt.Errorf("alerts[%d]: one of fingerprint, id, name or labels.alertname is required", i)
+			}
+			identity := copyStringMap(alert.Labels)
+			delete(identity, "severity")
+			alert.Fingerprint = labelsFingerprint(model.AlertSourceGeneric, identity)
+		}
+
+		// 라벨 없이 해결만 통지하는 경우 직전 alert 라벨 복원
+		if alert.Status == "resolved" && alert.Labels["alertname"] == "" && !s.restoreResolvedAlert(&alert) {
+			log.Printf("Ignoring generic resolve for unknown alert (fingerprint=%s)", alert.Fingerprint)
+			continue
+		}
+
+		severity := ga.Severity
+		if severity == "" {
+			severity = alert.Labels["severity"]
+		}
+		setSeverity(&alert, severity)
+		alerts = append(alerts, alert)
+	}
+
+	return buildIngestWebhook(model.AlertSourceGeneric, "generic:"+payload.Source, alerts), nil
+}
+
+// decodeGenericWebhook - 래퍼 객체/배열/단건 형식을 GenericWebhook으로 변환
+func decodeGenericWebhook(body []byte) (model.GenericWebhook, error) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 {
+		return model.GenericWebhook{}, fmt.Errorf("empty payload")
+	}
+
+	if trimmed[0] == '[' {
+		var alerts []model.GenericAlert
+		if err := json.Unmarshal(trimmed, &alerts); err != nil {
+			return model.GenericWebhook{}, fmt.Errorf("failed to decode generic alerts: %w", err)
+		}
+		return model.GenericWebhook{Alerts: alerts}, nil
+	}
+
+	var probe map[string]json.RawMessage
+	if err := json.Unmarshal(trimmed, &probe); err != nil {
+		return model.GenericWebhook{}, fmt.Errorf("failed to decode generic payload: %w", err)
+	}
+	if _, ok := probe["alerts"]; ok {
+		var payload model.GenericWebhook
+		if err := json.Unmarshal(trimmed, &payload); err != nil {
+			return model.GenericWebhook{}, fmt.Errorf("failed to decode generic payload: %w", err)
+		}
+		return payload, nil
+	}
+
+	var single model.GenericAlert
+	if err := json.Unmarshal(trimmed, &single); err != nil {
+		return model.GenericWebhook{}, fmt.Errorf("failed to decode generic alert: %w", err)
+	}
+	var source struct {
+		Source string `json:"source"`
+	}
+	_ = json.Unmarshal(trimmed, &source)
+	return model.GenericWebhook{Source: source.Source, Alerts: []model.GenericAlert{single}}, nil
+}
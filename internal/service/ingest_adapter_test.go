@@ -0,0 +1,160 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+type ingestLookupMock struct {
+	alerts map[string]*model.AlertDetailResponse
+}
+
+func (m *ingestLookupMock) GetLatestAlertByFingerprint(fingerprint string) (*model.AlertDetailResponse, error) {
+	if a, ok := m.alerts[fingerprint]; ok {
+		return a, nil
+	}
+	return nil, errors.New("no rows")
+}
+
+func newTestIngestAdapter(lookup *ingestLookupMock) *IngestAdapterService {
+	svc := NewIngestAdapterService(lookup)
+	svc.now = func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) }
+	return svc
+}
+
+func TestLabelsFingerprint_StableAcrossOrder(t *testing.T) {
+	a := labelsFingerprint("generic", map[string]string{"alertname": "X", "namespace": "ns"})
+	b := labelsFingerprint("generic", map[string]string{"namespace": "ns", "alertname": "X"})
+	if a != b || len(a) != 16 {
+		t.Fatalf("labelsFingerprint = %q / %q; want equal 16-char hashes", a, b)
+	}
+	if c := labelsFingerprint("grafana", map[string]string{"alertname": "X", "namespace": "ns"}); c == a {
+		t.Fatal("labelsFingerprint should differ per source")
+	}
+}
+
+func TestNormalizeGrafana(t *testing.T) {
+	svc := newTestIngestAdapter(&ingestLookupMock{})
+	webhook, err := svc.NormalizeGrafana(model.GrafanaWebhook{
+		Receiver: "kube-rca",
+		GroupKey: "{}:{alertname=\"HighCPU\"}",
+		Alerts: []model.GrafanaAlert{{
+			Status:       "firing",
+			Labels:       map[string]string{"alertname": "HighCPU", "severity": "high"},
+			Fingerprint:  "abcdef0123456789",
+			DashboardURL: "https://grafana/d/1",
+			ValueString:  "[ var='A' value=97 ]",
+		}},
+	})
+	if err != nil {
+		t.Fatalf("NormalizeGrafana() error = %v", err)
+	}
+	alert := webhook.Alerts[0]
+	if alert.Fingerprint != "abcdef0123456789" {
+		t.Fatalf("Fingerprint = %q; want grafana fingerprint", alert.Fingerprint)
+	}
+	if alert.Labels["severity"] != "high" {
+		t.Fatalf("severity = %q; want source value high (mapped later by the severity taxonomy)", alert.Labels["severity"])
+	}
+	if alert.Annotations["dashboard_url"] != "https://grafana/d/1" {
+		t.Fatalf("dashboard_url annotation = %q", alert.Annotations["dashboard_url"])
+	}
+	if webhook.Status != "firing" || webhook.Receiver != "kube-rca" {
+		t.Fatalf("webhook status/receiver = %s/%s", webhook.Status, webhook.Receiver)
+	}
+}
+
+func TestNormalizePagerDuty_TriggerAndResolve(t *testing.T) {
+	lookup := &ingestLookupMock{alerts: map[string]*model.AlertDetailResponse{}}
+	svc := newTestIngestAdapter(lookup)
+
+	trigger, err := svc.NormalizePagerDuty(model.PagerDutyEvent{
+		EventAction: "trigger",
+		DedupKey:    "disk-full-db1",
+		Payload: &model.PagerDutyPayload{
+			Summary:  "Disk full on db1",
+			Source:   "db1.example.com",
+			Severity: "error",
+			Class:    "DiskFull",
+		},
+	})
+	if err != nil {
+		t.Fatalf("NormalizePagerDuty(trigger) error = %v", err)
+	}
+	alert := trigger.Alerts[0]
+	if alert.Status != "firing" || alert.Labels["alertname"] != "DiskFull" || alert.Labels["severity"] != "error" || alert.Labels["instance"] != "db1.example.com" {
+		t.Fatalf("trigger alert = %+v", alert)
+	}
+	if alert.Fingerprint != keyFingerprint(model.AlertSourcePagerDuty, "disk-full-db1") {
+		t.Fatalf("Fingerprint = %q; want dedup_key based", alert.Fingerprint)
+	}
+
+	// 알 수 없는 dedup_key의 resolve는 무시
+	resolved, err := svc.NormalizePagerDuty(model.PagerDutyEvent{EventAction: "resolve", DedupKey: "disk-full-db1"})
+	if err != nil || len(resolved.Alerts) != 0 {
+		t.Fatalf("resolve for unknown alert = %d alerts, %v; want 0, nil", len(resolved.Alerts), err)
+	}
+
+	labels, _ := json.Marshal(alert.Labels)
+	lookup.alerts[alert.Fingerprint] = &model.AlertDetailResponse{Labels: labels, Annotations: json.RawMessage(`{}`), FiredAt: alert.StartsAt}
+	resolved, err = svc.NormalizePagerDuty(model.PagerDutyEvent{EventAction: "resolve", DedupKey: "disk-full-db1"})
+	if err != nil || len(resolved.Alerts) != 1 {
+		t.Fatalf("resolve = %d alerts, %v; want 1, nil", len(resolved.Alerts), err)
+	}
+	if r := resolved.Alerts[0]; r.Status != "resolved" || r.Labels["alertname"] != "DiskFull" || r.EndsAt.IsZero() {
+		t.Fatalf("resolved alert = %+v", r)
+	}
+
+	// acknowledge는 alert 없는 웹훅 (inbox에 저장하지 않음)
+	ack, err := svc.NormalizePagerDuty(model.PagerDutyEvent{EventAction: "acknowledge", DedupKey: "disk-full-db1"})
+	if err != nil || len(ack.Alerts) != 0 || ack.GroupKey != "" {
+		t.Fatalf("acknowledge = %+v, %v; want empty webhook", ack, err)
+	}
+
+	if _, err := svc.NormalizePagerDuty(model.PagerDutyEvent{EventAction: "bogus"}); err == nil {
+		t.Fatal("NormalizePagerDuty(bogus) error = nil; want error")
+	}
+}
+
+func TestNormalizeGeneric_Formats(t *testing.T) {
+	svc := newTestIngestAdapter(&ingestLookupMock{})
+
+	single, err := svc.NormalizeGeneric([]byte(`{"name":"BackupFailed","severity":"p1","labels":{"job":"nightly"},"source":"cron"}`))
+	if err != nil {
+		t.Fatalf("NormalizeGeneric(single) error = %v", err)
+	}
+	alert := single.Alerts[0]
+	if alert.Labels["alertname"] != "BackupFailed" || alert.Labels["severity"] != "p1" || alert.Labels["source_system"] != "cron" {
+		t.Fatalf("single alert labels = %v", alert.Labels)
+	}
+
+	// severity만 바뀌어도 fingerprint는 유지
+	again, _ := svc.NormalizeGeneric([]byte(`[{"name":"BackupFailed","severity":"warning","labels":{"job":"nightly","source_system":"cron"}}]`))
+	if again.Alerts[0].Fingerprint != alert.Fingerprint {
+		t.Fatalf("fingerprint changed with severity: %q vs %q", again.Alerts[0].Fingerprint, alert.Fingerprint)
+	}
+
+	wrapped, err := svc.NormalizeGeneric([]byte(`{"source":"legacy","alerts":[{"id":"42","name":"Q","status":"ok"}]}`))
+	if err != nil {
+		t.Fatalf("NormalizeGeneric(wrapped) error = %v", err)
+	}
+	if w := wrapped.Alerts[0]; w.Status != "resolved" || w.Fingerprint != keyFingerprint(model.AlertSourceGeneric, "legacy/42") {
+		t.Fatalf("wrapped alert = %+v", w)
+	}
+
+	// 지정한 fingerprint는 소스 접두어로 다른 소스와 구분
+	provided, err := svc.NormalizeGeneric([]byte(`{"source":"cron","alerts":[{"fingerprint":"abc123","name":"Q"}]}`))
+	if err != nil || provided.Alerts[0].Fingerprint != "generic:cron:abc123" {
+		t.Fatalf("provided fingerprint = %+v, %v; want generic:cron:abc123", provided.Alerts, err)
+	}
+
+	for _, body := range []string{``, `{"alerts":[]}`, `[{"status":"weird","name":"x"}]`, `[{"summary":"no identity"}]`} {
+		if _, err := svc.NormalizeGeneric([]byte(body)); err == nil {
+			t.Fatalf("NormalizeGeneric(%q) error = nil; want error", body)
+		}
+	}
+}
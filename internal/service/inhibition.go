@@ -0,0 +1,237 @@
+// 억제(inhibition) 규칙 관리 및 매칭 로직
+//
+// 처리 흐름:
+//  1. 관리자가 source 매처(원인 alert) + target 매처(파생 alert) + equal 라벨 목록으로 규칙 생성
+//  2. AlertService가 웹훅 처리 시 활성화된 규칙과 현재 firing 중인 alert(source 후보)를 한 번 조회
+//  3. alert가 target 매처에 매칭되고, source 매처에 매칭되며 equal 라벨 값이 같은 firing alert가 있으면 억제
+//  4. 억제된 alert는 DB에 저장하고 alerts.inhibited_by에 source alert_id 기록, 알림 전송/자동 분석은 스킵
+//  5. 같은 웹훅의 firing alert는 처리 전에 source 후보에 미리 추가 (resolved면 제거) → 웹훅 내 순서와 무관하게 억제
+//     아직 저장되지 않은 source에 억제된 alert의 inhibited_by는 웹훅 처리 후 source alert_id로 기록
+//
+// 예: source=alertname="KubeNodeNotReady", target=severity="warning", equal=["node"]
+// → NotReady인 노드의 pod 수준 warning은 Slack root message/Agent 분석을 만들지 않는다.
+//
+// source/target 양쪽에 모두 매칭되는 alert끼리는 서로 억제하지 않는다 (Alertmanager와 동일, 자기 억제 방지).
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+var (
+	ErrInvalidInhibitionRule  = errors.New("invalid inhibition rule")
+	ErrInhibitionRuleNotFound = errors.New("inhibition rule not found")
+)
+
+// inhibitionRepo - InhibitionService가 사용하는 DB 인터페이스
+type inhibitionRepo interface {
+	ListInhibitionRules(ctx context.Context) ([]model.InhibitionRule, error)
+	GetInhibitionRule(ctx context.Context, id int64) (*model.InhibitionRule, error)
+	CreateInhibitionRule(ctx context.Context, r model.InhibitionRule) (int64, error)
+	UpdateInhibitionRule(ctx context.Context, id int64, r model.InhibitionRule) error
+	DeleteInhibitionRule(ctx context.Context, id int64) error
+}
+
+// InhibitionService - 억제 규칙 CRUD
+type InhibitionService struct {
+	db inhibitionRepo
+}
+
+func NewInhibitionService(db inhibitionRepo) *InhibitionService {
+	return &InhibitionService{db: db}
+}
+
+// List - 전체 억제 규칙 조회
+func (s *InhibitionService) List(ctx context.Context) ([]model.InhibitionRule, error) {
+	return s.db.ListInhibitionRules(ctx)
+}
+
+// Get - 단건 조회 (없으면 nil)
+func (s *InhibitionService) Get(ctx context.Context, id int64) (*model.InhibitionRule, error) {
+	return s.db.GetInhibitionRule(ctx, id)
+}
+
+// Create - 억제 규칙 생성 (createdBy: 로그인 사용자 ID)
+func (s *InhibitionService) Create(ctx context.Context, req model.InhibitionRuleRequest, createdBy string) (int64, error) {
+	r, err := buildInhibitionRule(req)
+	if err != nil {
+		return 0, err
+	}
+	r.CreatedBy = createdBy
+	return s.db.CreateInhibitionRule(ctx, r)
+}
+
+// Update - 억제 규칙 수정
+func (s *InhibitionService) Update(ctx context.Context, id int64, req model.InhibitionRuleRequest) error {
+	existing, err := s.db.GetInhibitionRule(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return fmt.Errorf("%w: id=%d", ErrInhibitionRuleNotFound, id)
+	}
+	r, err := buildInhibitionRule(req)
+	if err != nil {
+		return err
+	}
+	return s.db.UpdateInhibitionRule(ctx, id, r)
+}
+
+// Delete - 억제 규칙 삭제
+func (s *InhibitionService) Delete(ctx context.Context, id int64) error {
+	existing, err := s.db.GetInhibitionRule(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return fmt.Errorf("%w: id=%d", ErrInhibitionRuleNotFound, id)
+	}
+	return s.db.DeleteInhibitionRule(ctx, id)
+}
+
+// buildInhibitionRule - 요청 검증 및 정규화
+func buildInhibitionRule(req model.InhibitionRuleRequest) (model.InhibitionRule, error) {
+	r := model.InhibitionRule{
+		Name:    strings.TrimSpace(req.Name),
+		Enabled: req.Enabled == nil || *req.Enabled,
+		Comment: strings.TrimSpace(req.Comment),
+		Equal:   []string{},
+	}
+	if r.Name == "" {
+		return r, fmt.Errorf("%w: name is required", ErrInvalidInhibitionRule)
+	}
+
+	var err error
+	if r.SourceMatchers, err = normalizeInhibitionMatchers("source_matchers", req.SourceMatchers); err != nil {
+		return r, err
+	}
+	if r.TargetMatchers, err = normalizeInhibitionMatchers("target_matchers", req.TargetMatchers); err != nil {
+		return r, err
+	}
+
+	seen := make(map[string]bool, len(req.Equal))
+	for _, name := range req.Equal {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		r.Equal = append(r.Equal, name)
+	}
+	return r, nil
+}
+
+// normalizeInhibitionMatchers - 매처 이름 정리 및 검증 (모든 alert에 매칭되는 매처는 거부)
+func normalizeInhibitionMatchers(field string, in model.LabelMatchers) (model.LabelMatchers, error) {
+	matchers := make(model.LabelMatchers, 0, len(in))
+	for _, m := range in {
+		m.Name = strings.TrimSpace(m.Name)
+		matchers = append(matchers, m)
+	}
+	if err := matchers.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrInvalidInhibitionRule, field, err)
+	}
+	if matchers.Matches(map[string]string{}) {
+		return nil, fmt.Errorf("%w: %s must not match every alert", ErrInvalidInhibitionRule, field)
+	}
+	return matchers, nil
+}
+
+// inhibitionState - 웹훅 1건 처리 동안 사용하는 억제 규칙과 source 후보 (firing alert)
+type inhibitionState struct {
+	rules   []model.InhibitionRule
+	sources []model.InhibitionSource
+}
+
+// match - alert를 억제하는 규칙과 source alert 반환 (없으면 nil, nil)
+func (st *inhibitionState) match(alert model.Alert) (*model.InhibitionRule, *model.InhibitionSource) {
+	if st == nil {
+		return nil, nil
+	}
+	for i := range st.rules {
+		rule := &st.rules[i]
+		if !rule.TargetMatchers.Matches(alert.Labels) {
+			continue
+		}
+		// source/target 양쪽에 매칭되는 alert는 같은 조건의 alert로부터 억제되지 않음
+		twoSided := rule.SourceMatchers.Matches(alert.Labels)
+		for j := range st.sources {
+			src := &st.sources[j]
+			if src.Fingerprint == alert.Fingerprint || !rule.SourceMatchers.Matches(src.Labels) {
+				continue
+			}
+			if twoSided && rule.TargetMatchers.Matches(src.Labels) {
+				continue
+			}
+			if equalLabels(rule.Equal, src.Labels, alert.Labels) {
+				// observe가 sources를 변경하므로 복사본 반환
+				copied := *src
+				return rule, &copied
+			}
+		}
+	}
+	return nil, nil
+}
+
+// prescan - 웹훅의 alert를 처리 전에 source 후보에 반영 (firing이면 추가, resolved면 제거)
+// 새로 추가된 source는 저장 전이므로 AlertID가 비어 있고, observe에서 채워진다.
+func (st *inhibitionState) prescan(alerts []preparedAlert) {
+	if st == nil {
+		return
+	}
+	for _, p := range alerts {
+		st.observe(st.sourceAlertID(p.alert.Fingerprint), p.alert)
+		if p.alert.Status == "firing" && st.sourceAlertID(p.alert.Fingerprint) == "" {
+			st.sources = append(st.sources, model.InhibitionSource{Fingerprint: p.alert.Fingerprint, Labels: p.alert.Labels})
+		}
+	}
+}
+
+// sourceAlertID - fingerprint의 source 후보 alert_id (후보가 아니거나 아직 저장되지 않았으면 빈 문자열)
+func (st *inhibitionState) sourceAlertID(fingerprint string) string {
+	if st == nil {
+		return ""
+	}
+	for _, src := range st.sources {
+		if src.Fingerprint == fingerprint {
+			return src.AlertID
+		}
+	}
+	return ""
+}
+
+// observe - 처리된 alert를 source 후보에 반영 (firing이면 추가/갱신, resolved면 제거)
+func (st *inhibitionState) observe(alertID string, alert model.Alert) {
+	if st == nil {
+		return
+	}
+	for i := range st.sources {
+		if st.sources[i].Fingerprint == alert.Fingerprint {
+			st.sources = append(st.sources[:i], st.sources[i+1:]...)
+			break
+		}
+	}
+	if alert.Status == "firing" && alertID != "" {
+		st.sources = append(st.sources, model.InhibitionSource{
+			AlertID:     alertID,
+			Fingerprint: alert.Fingerprint,
+			Labels:      alert.Labels,
+		})
+	}
+}
+
+// equalLabels - equal 목록의 라벨 값이 양쪽에서 같은지 확인 (양쪽 모두 없으면 같은 것으로 간주)
+func equalLabels(names []string, a, b map[string]string) bool {
+	for _, name := range names {
+		if a[name] != b[name] {
+			return false
+		}
+	}
+	return true
+}
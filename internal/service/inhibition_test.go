@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+type inhibitionRepoMock struct {
+	rules   map[int64]*model.InhibitionRule
+	created model.InhibitionRule
+}
+
+func (m *inhibitionRepoMock) ListInhibitionRules(_ context.Context) ([]model.InhibitionRule, error) {
+	var out []model.InhibitionRule
+	for _, r := range m.rules {
+		out = append(out, *r)
+	}
+	return out, nil
+}
+
+func (m *inhibitionRepoMock) GetInhibitionRule(_ context.Context, id int64) (*model.InhibitionRule, error) {
+	if r, ok := m.rules[id]; ok {
+		copied := *r
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (m *inhibitionRepoMock) CreateInhibitionRule(_ context.Context, r model.InhibitionRule) (int64, error) {
+	m.created = r
+	return 1, nil
+}
+
+func (m *inhibitionRepoMock) UpdateInhibitionRule(_ context.Context, _ int64, _ model.InhibitionRule) error {
+	return nil
+}
+
+func (m *inhibitionRepoMock) DeleteInhibitionRule(_ context.Context, _ int64) error {
+	return nil
+}
+
+func nodeDownRule() model.InhibitionRule {
+	return model.InhibitionRule{
+		ID:             1,
+		SourceMatchers: model.LabelMatchers{{Name: "alertname", Value: "KubeNodeNotReady", IsEqual: true}},
+		TargetMatchers: model.LabelMatchers{{Name: "severity", Value: "warning", IsEqual: true}},
+		Equal:          []string{"node"},
+		Enabled:        true,
+	}
+}
+
+func TestInhibitionService_CreateNormalizes(t *testing.T) {
+	repo := &inhibitionRepoMock{}
+	svc := NewInhibitionService(repo)
+
+	_, err := svc.Create(context.Background(), model.InhibitionRuleRequest{
+		Name:           " node down ",
+		SourceMatchers: model.LabelMatchers{{Name: " alertname ", Value: "KubeNodeNotReady", IsEqual: true}},
+		TargetMatchers: model.LabelMatchers{{Name: "severity", Value: "warning", IsEqual: true}},
+		Equal:          []string{" node ", "", "node", "cluster"},
+	}, "admin")
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	r := repo.created
+	if r.Name != "node down" || !r.Enabled || r.CreatedBy != "admin" || r.SourceMatchers[0].Name != "alertname" {
+		t.Fatalf("created = %+v", r)
+	}
+	if len(r.Equal) != 2 || r.Equal[0] != "node" || r.Equal[1] != "cluster" {
+		t.Fatalf("equal = %v; want [node cluster]", r.Equal)
+	}
+}
+
+func TestInhibitionService_CreateRejectsInvalid(t *testing.T) {
+	svc := NewInhibitionService(&inhibitionRepoMock{})
+	valid := model.InhibitionRuleRequest{
+		Name:           "r",
+		SourceMatchers: model.LabelMatchers{{Name: "alertname", Value: "A", IsEqual: true}},
+		TargetMatchers: model.LabelMatchers{{Name: "alertname", Value: "B", IsEqual: true}},
+	}
+
+	cases := map[string]func(r *model.InhibitionRuleRequest){
+		"no name":   func(r *model.InhibitionRuleRequest) { r.Name = "" },
+		"no source": func(r *model.InhibitionRuleRequest) { r.SourceMatchers = nil },
+		"no target": func(r *model.InhibitionRuleRequest) { r.TargetMatchers = nil },
+		"match-all target": func(r *model.InhibitionRuleRequest) {
+			r.TargetMatchers = model.LabelMatchers{{Name: "alertname", Value: ".*", IsRegex: true, IsEqual: true}}
+		},
+	}
+	for name, mutate := range cases {
+		req := valid
+		mutate(&req)
+		if _, err := svc.Create(context.Background(), req, ""); !errors.Is(err, ErrInvalidInhibitionRule) {
+			t.Errorf("%s: Create() error = %v; want ErrInvalidInhibitionRule", name, err)
+		}
+	}
+}
+
+func TestInhibitionService_UpdateNotFound(t *testing.T) {
+	svc := NewInhibitionService(&inhibitionRepoMock{})
+	err := svc.Update(context.Background(), 42, model.InhibitionRuleRequest{})
+	if !errors.Is(err, ErrInhibitionRuleNotFound) {
+		t.Fatalf("Update() error = %v; want ErrInhibitionRuleNotFound", err)
+	}
+}
+
+func TestInhibitionState_MatchRequiresEqualLabels(t *testing.T) {
+	st := &inhibitionState{
+		rules: []model.InhibitionRule{nodeDownRule()},
+		sources: []model.InhibitionSource{{
+			AlertID:     "ALR-node",
+			Fingerprint: "fp-node",
+			Labels:      map[string]string{"alertname": "KubeNodeNotReady", "node": "worker-1"},
+		}},
+	}
+
+	same := model.Alert{Fingerprint: "fp-pod", Labels: map[string]string{"severity": "warning", "node": "worker-1"}}
+	if rule, src := st.match(same); rule == nil || src.AlertID != "ALR-node" {
+		t.Fatalf("match(same node) = %v, %v; want inhibited by ALR-node", rule, src)
+	}
+	other := model.Alert{Fingerprint: "fp-pod", Labels: map[string]string{"severity": "warning", "node": "worker-2"}}
+	if rule, _ := st.match(other); rule != nil {
+		t.Fatalf("match(other node) = %+v; want nil", rule)
+	}
+	critical := model.Alert{Fingerprint: "fp-pod", Labels: map[string]string{"severity": "critical", "node": "worker-1"}}
+	if rule, _ := st.match(critical); rule != nil {
+		t.Fatalf("match(critical) = %+v; want nil (not a target)", rule)
+	}
+}
+
+func TestInhibitionState_NoSelfInhibition(t *testing.T) {
+	rule := model.InhibitionRule{
+		ID:             2,
+		SourceMatchers: model.LabelMatchers{{Name: "alertname", Value: "Disk.*", IsRegex: true, IsEqual: true}},
+		TargetMatchers: model.LabelMatchers{{Name: "alertname", Value: "Disk.*", IsRegex: true, IsEqual: true}},
+		Enabled:        true,
+	}
+	st := &inhibitionState{rules: []model.InhibitionRule{rule}}
+	first := model.Alert{Fingerprint: "fp-a", Status: "firing", Labels: map[string]string{"alertname": "DiskFull"}}
+	second := model.Alert{Fingerprint: "fp-b", Status: "firing", Labels: map[string]string{"alertname": "DiskSlow"}}
+
+	st.observe("ALR-a", first)
+	if r, _ := st.match(first); r != nil {
+		t.Fatal("alert inhibited itself")
+	}
+	if r, _ := st.match(second); r != nil {
+		t.Fatal("alerts matching both source and target inhibited each other")
+	}
+}
+
+func TestInhibitionState_ResolvedSourceRemoved(t *testing.T) {
+	st := &inhibitionState{rules: []model.InhibitionRule{nodeDownRule()}}
+	node := model.Alert{Fingerprint: "fp-node", Status: "firing", Labels: map[string]string{"alertname": "KubeNodeNotReady", "node": "worker-1"}}
+	pod := model.Alert{Fingerprint: "fp-pod", Labels: map[string]string{"severity": "warning", "node": "worker-1"}}
+
+	st.observe("ALR-node", node)
+	if r, _ := st.match(pod); r == nil {
+		t.Fatal("match() = nil while source firing; want inhibited")
+	}
+	node.Status = "resolved"
+	st.observe("ALR-node", node)
+	if r, _ := st.match(pod); r != nil {
+		t.Fatal("match() still inhibited after source resolved")
+	}
+}
+
+func TestInhibitionState_PrescanKeepsKnownAlertID(t *testing.T) {
+	st := &inhibitionState{
+		rules:   []model.InhibitionRule{nodeDownRule()},
+		sources: []model.InhibitionSource{{AlertID: "ALR-old", Fingerprint: "fp-old", Labels: map[string]string{"alertname": "KubeNodeNotReady", "node": "worker-9"}}},
+	}
+	node := model.Alert{Fingerprint: "fp-node", Status: "firing", Labels: map[string]string{"alertname": "KubeNodeNotReady", "node": "worker-1"}}
+	old := model.Alert{Fingerprint: "fp-old", Status: "firing", Labels: map[string]string{"alertname": "KubeNodeNotReady", "node": "worker-9"}}
+	st.prescan([]preparedAlert{{alert: node}, {alert: node}, {alert: old}})
+
+	if len(st.sources) != 2 || st.sourceAlertID("fp-old") != "ALR-old" || st.sourceAlertID("fp-node") != "" {
+		t.Fatalf("sources = %+v; want fp-old kept with its alert_id and fp-node added once without one", st.sources)
+	}
+	pod := model.Alert{Fingerprint: "fp-pod", Labels: map[string]string{"severity": "warning", "node": "worker-1"}}
+	if _, src := st.match(pod); src == nil || src.Fingerprint != "fp-node" {
+		t.Fatalf("match() source = %+v; want unsaved fp-node", src)
+	}
+
+	old.Status = "resolved"
+	st.prescan([]preparedAlert{{alert: old}})
+	if st.sourceAlertID("fp-old") != "" || len(st.sources) != 1 {
+		t.Fatalf("sources = %+v; want resolved fp-old removed", st.sources)
+	}
+}
@@ -0,0 +1,297 @@
+// Kubernetes Event → Alert 변환 로직
+//
+// 처리 흐름:
+//  1. Warning 타입 Event만 수집 (Normal은 무시)
+//  2. involvedObject + reason 기준 fingerprint 생성, 라벨 합성 (namespace, kind, 오브젝트 이름, reason, cluster)
+//  3. 같은 fingerprint의 firing alert가 있으면 annotations(count, 마지막 메시지)와 last_seen_at만 갱신 (중복 제거)
+//  4. 새 alert는 webhook inbox에 저장 → AlertService 파이프라인에서 Incident 연결/알림/분석 처리
+//  5. Event에는 해결 신호가 없으므로 ResolveAfterMinutes 동안 다시 수신되지 않은 alert는 resolved 웹훅을 inbox에 저장
+//     - 모든 replica에서 sweeper가 돌기 때문에 조회와 동시에 alert를 점유(resolve_claimed_at)하여 한 replica만 enqueue
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// kubeEventRecentWindow - inbox 처리 전 같은 Event가 다시 들어와 중복 enqueue되는 것을 막는 메모리 창
+const kubeEventRecentWindow = time.Minute
+
+// kubeEventResolveClaimTTL - 자동 해결로 점유했지만 resolved가 반영되지 않은 alert(enqueue 실패 등)를 다시 점유하기까지의 시간
+const kubeEventResolveClaimTTL = 10 * time.Minute
+
+// criticalKubeEventReasons - critical로 분류하는 Event reason (나머지 Warning은 warning)
+var criticalKubeEventReasons = map[string]bool{
+	"OOMKilling":   true,
+	"SystemOOM":    true,
+	"NodeNotReady": true,
+	"Evicted":      true,
+}
+
+// kubeEventStore - KubeEventService가 사용하는 DB 인터페이스
+type kubeEventStore interface {
+	TouchFiringAlert(fingerprint string, annotations map[string]string) (bool, error)
+	ClaimQuietFiringAlerts(source string, quietSince, claimStaleBefore time.Time) ([]model.Alert, error)
+}
+
+// KubeEventService - Kubernetes Event 수집 서비스
+type KubeEventService struct {
+	store kubeEventStore
+	inbox webhookEnqueuer
+	cfg   config.KubeEventConfig
+	now   func() time.Time
+
+	mu     sync.Mutex
+	recent map[string]time.Time // fingerprint → 마지막 enqueue 시각
+}
+
+// webhookEnqueuer - 정규화된 웹훅을 inbox에 저장하는 인터페이스 (WebhookInboxService)
+type webhookEnqueuer interface {
+	Enqueue(ctx context.Context, webhook model.AlertmanagerWebhook, source, credential string) (int64, error)
+}
+
+func NewKubeEventService(store kubeEventStore, inbox webhookEnqueuer, cfg config.KubeEventConfig) *KubeEventService {
+	return &KubeEventService{
+		store:  store,
+		inbox:  inbox,
+		cfg:    cfg,
+		now:    time.Now,
+		recent: make(map[string]time.Time),
+	}
+}
+
+// DecodeKubernetesEvents - 단건 Event 또는 EventList 페이로드 파싱
+func DecodeKubernetesEvents(body []byte) ([]model.KubernetesEvent, error) {
+	var probe struct {
+		Kind  string          `json:"kind"`
+		Items json.RawMessage `json:"items"`
+	}
+	if err := json.Unmarshal(body, &probe); err != nil {
+		return nil, fmt.Errorf("failed to decode kubernetes event: %w", err)
+	}
+	if probe.Kind == "EventList" || len(probe.Items) > 0 {
+		var list model.KubernetesEventList
+		if err := json.Unmarshal(body, &list); err != nil {
+			return nil, fmt.Errorf("failed to decode kubernetes event list: %w", err)
+		}
+		return list.Items, nil
+	}
+	var event model.KubernetesEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, fmt.Errorf("failed to decode kubernetes event: %w", err)
+	}
+	return []model.KubernetesEvent{event}, nil
+}
+
+// Ingest - Event 목록을 alert로 변환하여 새 alert만 inbox에 저장
+// cluster: 요청 쿼리 파라미터 (Event에 clusterName이 없을 때 사용, 비어 있으면 KUBE_EVENT_CLUSTER_NAME)
+func (s *KubeEventService) Ingest(ctx context.Context, events []model.KubernetesEvent, cluster, credential string) (model.KubernetesEventIngestResponse, error) {
+	resp := model.KubernetesEventIngestResponse{Status: "accepted"}
+	if cluster == "" {
+		cluster = s.cfg.ClusterName
+	}
+
+	var alerts []model.Alert
+	for _, event := range events {
+		if event.Type != model.KubernetesEventTypeWarning || event.Involved.Name == "" || event.Reason == "" {
+			resp.Ignored++
+			continue
+		}
+		alert := kubeEventToAlert(event, cluster, s.now().UTC())
+
+		// 이미 firing 중이면 count/메시지만 갱신하고 알림 파이프라인은 다시 타지 않음
+		touched, err := s.store.TouchFiringAlert(alert.Fingerprint, kubeEventTouchAnnotations(alert))
+		if err != nil {
+			return resp, err
+		}
+		if touched || !s.markRecent(alert.Fingerprint) {
+			resp.Deduplicated++
+			continue
+		}
+		alerts = append(alerts, alert)
+	}
+
+	resp.AlertCount = len(alerts)
+	if len(alerts) == 0 {
+		return resp, nil
+	}
+
+	webhook := buildIngestWebhook(model.AlertSourceKubernetes, "kubernetes:"+cluster, alerts)
+	id, err := s.inbox.Enqueue(ctx, webhook, model.AlertSourceKubernetes, credential)
+	if err != nil {
+		// 재전송 시 다시 enqueue될 수 있도록 중복 방지 기록 제거
+		s.mu.Lock()
+		for _, a := range alerts {
+			delete(s.recent, a.Fingerprint)
+		}
+		s.mu.Unlock()
+		return resp, err
+	}
+	resp.InboxID = id
+	return resp, nil
+}
+
+// markRecent - 최근 enqueue 기록 (kubeEventRecentWindow 내 중복이면 false)
+func (s *KubeEventService) markRecent(fingerprint string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for fp, at := range s.recent {
+		if now.Sub(at) > kubeEventRecentWindow {
+			delete(s.recent, fp)
+		}
+	}
+	if _, ok := s.recent[fingerprint]; ok {
+		return false
+	}
+	s.recent[fingerprint] = now
+	return true
+}
+
+// kubeEventFingerprint - involvedObject + reason 기준 fingerprint
+func kubeEventFingerprint(cluster string, event model.KubernetesEvent) string {
+	ns := event.Involved.Namespace
+	if ns == "" {
+		ns = event.Metadata.Namespace
+	}
+	key := strings.Join([]string{cluster, ns, event.Involved.Kind, event.Involved.Name, event.Reason}, "/")
+	return keyFingerprint(model.AlertSourceKubernetes, key)
+}
+
+// kubeEventToAlert - Event를 firing alert로 변환
+func kubeEventToAlert(event model.KubernetesEvent, cluster string, now time.Time) model.Alert {
+	if event.ClusterName != "" {
+		cluster = event.ClusterName
+	}
+	ns := event.Involved.Namespace
+	if ns == "" {
+		ns = event.Metadata.Namespace
+	}
+	kind := event.Involved.Kind
+
+	labels := map[string]string{
+		"alertname": event.Reason,
+		"reason":    event.Reason,
+		"kind":      kind,
+		"object":    event.Involved.Name,
+	}
+	severity := "warning"
+	if criticalKubeEventReasons[event.Reason] {
+		severity = "critical"
+	}
+	labels["severity"] = severity
+	setIfEmpty(labels, "namespace", ns)
+	setIfEmpty(labels, "cluster", cluster)
+	// Prometheus 알림과 같은 라벨 이름으로 상관관계/라우팅이 되도록 kind별 라벨 추가 (pod, node, deployment 등)
+	if kind != "" {
+		setIfEmpty(labels, strings.ToLower(kind), event.Involved.Name)
+	}
+	component := event.Source.Component
+	if component == "" {
+		component = event.ReportingComponent
+	}
+	setIfEmpty(labels, "source_component", component)
+
+	startsAt := firstNonZeroTime(event.FirstTimestamp, event.EventTime, event.Metadata.CreationTimestamp, now)
+	lastSeen := firstNonZeroTime(event.LastTimestamp, event.EventTime, startsAt)
+
+	annotations := map[string]string{
+		"summary":     fmt.Sprintf("%s %s/%s: %s", event.Reason, kind, event.Involved.Name, event.Message),
+		"description": event.Message,
+		"last_seen":   lastSeen.UTC().Format(time.RFC3339),
+	}
+	if event.Count > 0 {
+		annotations["event_count"] = strconv.Itoa(event.Count)
+	}
+	setIfEmpty(annotations, "field_path", event.Involved.FieldPath)
+	setIfEmpty(annotations, "host", event.Source.Host)
+
+	return model.Alert{
+		Status:      "firing",
+		Labels:      labels,
+		Annotations: annotations,
+		StartsAt:    startsAt.UTC(),
+		Fingerprint: kubeEventFingerprint(cluster, event),
+		Source:      model.AlertSourceKubernetes,
+	}
+}
+
+// kubeEventTouchAnnotations - 반복 수신 시 갱신할 annotations
+func kubeEventTouchAnnotations(alert model.Alert) map[string]string {
+	touch := map[string]string{
+		"description": alert.Annotations["description"],
+		"last_seen":   alert.Annotations["last_seen"],
+	}
+	if count := alert.Annotations["event_count"]; count != "" {
+		touch["event_count"] = count
+	}
+	return touch
+}
+
+func firstNonZeroTime(times ...time.Time) time.Time {
+	for _, t := range times {
+		if !t.IsZero() {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// Start - 자동 해결 sweeper 시작 (ResolveAfterMinutes <= 0이면 비활성)
+func (s *KubeEventService) Start(ctx context.Context) {
+	if s.cfg.ResolveAfterMinutes <= 0 {
+		log.Println("Kubernetes event auto-resolve disabled")
+		return
+	}
+	interval := time.Duration(s.cfg.SweepIntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := s.ResolveQuietAlerts(ctx); err != nil {
+					log.Printf("Failed to resolve quiet kubernetes event alerts: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// ResolveQuietAlerts - ResolveAfterMinutes 동안 다시 수신되지 않은 Event alert를 점유하여 resolved로 enqueue
+func (s *KubeEventService) ResolveQuietAlerts(ctx context.Context) (int, error) {
+	now := s.now().UTC()
+	quiet, err := s.store.ClaimQuietFiringAlerts(model.AlertSourceKubernetes, now.Add(-time.Duration(s.cfg.ResolveAfterMinutes)*time.Minute), now.Add(-kubeEventResolveClaimTTL))
+	if err != nil {
+		return 0, err
+	}
+	if len(quiet) == 0 {
+		return 0, nil
+	}
+	for i := range quiet {
+		quiet[i].Status = "resolved"
+		quiet[i].EndsAt = now
+	}
+	webhook := buildIngestWebhook(model.AlertSourceKubernetes, "kubernetes:auto-resolve", quiet)
+	if _, err := s.inbox.Enqueue(ctx, webhook, model.AlertSourceKubernetes, ""); err != nil {
+		return 0, err
+	}
+	log.Printf("Auto-resolving %d kubernetes event alerts (quiet for %d minutes)", len(quiet), s.cfg.ResolveAfterMinutes)
+	return len(quiet), nil
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+type kubeEventStoreMock struct {
+	firing  map[string]bool
+	touched map[string]map[string]string
+	quiet   []model.Alert
+	claimed map[string]bool
+}
+
+func (m *kubeEventStoreMock) TouchFiringAlert(fingerprint string, annotations map[string]string) (bool, error) {
+	if !m.firing[fingerprint] {
+		return false, nil
+	}
+	m.touched[fingerprint] = annotations
+	return true, nil
+}
+
+func (m *kubeEventStoreMock) ClaimQuietFiringAlerts(_ string, _, _ time.Time) ([]model.Alert, error) {
+	if m.claimed == nil {
+		m.claimed = make(map[string]bool)
+	}
+	var list []model.Alert
+	for _, a := range m.quiet {
+		if m.claimed[a.Fingerprint] {
+			continue
+		}
+		m.claimed[a.Fingerprint] = true
+		list = append(list, a)
+	}
+	return list, nil
+}
+
+type enqueuerMock struct {
+	webhooks []model.AlertmanagerWebhook
+	sources  []string
+}
+
+func (m *enqueuerMock) Enqueue(_ context.Context, webhook model.AlertmanagerWebhook, source, _ string) (int64, error) {
+	m.webhooks = append(m.webhooks, webhook)
+	m.sources = append(m.sources, source)
+	return int64(len(m.webhooks)), nil
+}
+
+func newTestKubeEventService(store *kubeEventStoreMock, inbox *enqueuerMock) *KubeEventService {
+	svc := NewKubeEventService(store, inbox, config.KubeEventConfig{ClusterName: "prod", ResolveAfterMinutes: 30})
+	svc.now = func() time.Time { return time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC) }
+	return svc
+}
+
+func makeKubeEvent(reason, eventType string) model.KubernetesEvent {
+	return model.KubernetesEvent{
+		Metadata: model.KubernetesObjectMeta{Name: "api-7f9.17a", Namespace: "payments"},
+		Involved: model.KubernetesObjectReference{Kind: "Pod", Namespace: "payments", Name: "api-7f9"},
+		Reason:   reason,
+		Message:  "Back-off restarting failed container",
+		Type:     eventType,
+		Count:    3,
+		Source:   model.KubernetesEventSource{Component: "kubelet", Host: "node-1"},
+	}
+}
+
+func TestDecodeKubernetesEvents_SingleAndList(t *testing.T) {
+	single, err := DecodeKubernetesEvents([]byte(`{"kind":"Event","reason":"BackOff","type":"Warning","involvedObject":{"kind":"Pod","name":"p"}}`))
+	if err != nil || len(single) != 1 || single[0].Reason != "BackOff" {
+		t.Fatalf("single = %+v, %v", single, err)
+	}
+	list, err := DecodeKubernetesEvents([]byte(`{"kind":"EventList","items":[{"reason":"A"},{"reason":"B"}]}`))
+	if err != nil || len(list) != 2 {
+		t.Fatalf("list = %+v, %v", list, err)
+	}
+	if _, err := DecodeKubernetesEvents([]byte(`not json`)); err == nil {
+		t.Fatal("DecodeKubernetesEvents(invalid) error = nil; want error")
+	}
+}
+
+func TestKubeEventToAlert_SynthesizesLabels(t *testing.T) {
+	alert := kubeEventToAlert(makeKubeEvent("OOMKilling", "Warning"), "prod", time.Now())
+	want := map[string]string{
+		"alertname": "OOMKilling", "severity": "critical", "namespace": "payments",
+		"pod": "api-7f9", "kind": "Pod", "cluster": "prod", "source_component": "kubelet",
+	}
+	for k, v := range want {
+		if alert.Labels[k] != v {
+			t.Fatalf("label %s = %q; want %q (labels=%v)", k, alert.Labels[k], v, alert.Labels)
+		}
+	}
+	if alert.Annotations["event_count"] != "3" || alert.Annotations["host"] != "node-1" {
+		t.Fatalf("annotations = %v", alert.Annotations)
+	}
+
+	// 메시지/count가 달라도 involvedObject + reason이 같으면 같은 fingerprint
+	other := makeKubeEvent("OOMKilling", "Warning")
+	other.Message, other.Count = "different", 10
+	if kubeEventToAlert(other, "prod", time.Now()).Fingerprint != alert.Fingerprint {
+		t.Fatal("fingerprint should depend only on involvedObject + reason")
+	}
+	if kubeEventToAlert(makeKubeEvent("BackOff", "Warning"), "prod", time.Now()).Fingerprint == alert.Fingerprint {
+		t.Fatal("different reasons should produce different fingerprints")
+	}
+}
+
+func TestKubeEventService_IngestDedupes(t *testing.T) {
+	store := &kubeEventStoreMock{firing: map[string]bool{}, touched: map[string]map[string]string{}}
+	inbox := &enqueuerMock{}
+	svc := newTestKubeEventService(store, inbox)
+	ctx := context.Background()
+
+	events := []model.KubernetesEvent{
+		makeKubeEvent("BackOff", "Warning"),
+		makeKubeEvent("Scheduled", "Normal"),
+		makeKubeEvent("BackOff", "Warning"), // 같은 요청 내 중복
+	}
+	resp, err := svc.Ingest(ctx, events, "", "exporter")
+	if err != nil {
+		t.Fatalf("Ingest() error = %v", err)
+	}
+	if resp.AlertCount != 1 || resp.Deduplicated != 1 || resp.Ignored != 1 || resp.InboxID != 1 {
+		t.Fatalf("Ingest() = %+v; want 1 new, 1 deduplicated, 1 ignored", resp)
+	}
+	if inbox.sources[0] != model.AlertSourceKubernetes || inbox.webhooks[0].Alerts[0].Labels["cluster"] != "prod" {
+		t.Fatalf("enqueued = %+v (source %s)", inbox.webhooks[0], inbox.sources[0])
+	}
+
+	// firing 중인 alert는 annotations만 갱신하고 enqueue하지 않음
+	fp := inbox.webhooks[0].Alerts[0].Fingerprint
+	store.firing[fp] = true
+	resp, _ = svc.Ingest(ctx, []model.KubernetesEvent{makeKubeEvent("BackOff", "Warning")}, "", "")
+	if resp.AlertCount != 0 || resp.Deduplicated != 1 || len(inbox.webhooks) != 1 {
+		t.Fatalf("repeat Ingest() = %+v, enqueued=%d; want deduplicated only", resp, len(inbox.webhooks))
+	}
+	if store.touched[fp]["event_count"] != "3" {
+		t.Fatalf("touched annotations = %v", store.touched[fp])
+	}
+}
+
+func TestKubeEventService_ResolveQuietAlerts(t *testing.T) {
+	store := &kubeEventStoreMock{quiet: []model.Alert{{Status: "firing", Fingerprint: "fp-1", Labels: map[string]string{"alertname": "BackOff"}}}}
+	inbox := &enqueuerMock{}
+	svc := newTestKubeEventService(store, inbox)
+
+	n, err := svc.ResolveQuietAlerts(context.Background())
+	if err != nil || n != 1 {
+		t.Fatalf("ResolveQuietAlerts() = %d, %v; want 1, nil", n, err)
+	}
+	resolved := inbox.webhooks[0].Alerts[0]
+	if resolved.Status != "resolved" || !resolved.EndsAt.Equal(svc.now()) || inbox.webhooks[0].Status != "resolved" {
+		t.Fatalf("resolved alert = %+v", resolved)
+	}
+
+	// 다른 replica의 sweep(같은 store)은 이미 점유된 alert를 다시 enqueue하지 않음
+	other := newTestKubeEventService(store, inbox)
+	if n, err := other.ResolveQuietAlerts(context.Background()); err != nil || n != 0 || len(inbox.webhooks) != 1 {
+		t.Fatalf("second sweep = %d, %v, enqueued=%d; want nothing", n, err, len(inbox.webhooks))
+	}
+}
@@ -0,0 +1,206 @@
+// 반복 점검 시간대(maintenance window) 관리 및 매칭 로직
+//
+// 처리 흐름:
+//  1. 관리자가 cron 표현식 + 타임존 + 지속 시간 + 라벨 범위(namespace, cluster 등)로 점검 시간대 등록
+//  2. AlertService가 웹훅 처리 시 현재 진행 중인 점검 시간대를 계산하고 alert 라벨과 매칭
+//  3. 매칭된 alert는 DB에 저장하되 in_maintenance로 표시, 알림 전송/자동 분석은 스킵
+//  4. analytics MTTR 계산에서 점검 중 alert만으로 구성된 Incident는 제외
+//
+// 전역 알림 스위치(notification.enabled)와 달리 점검이 끝나면 자동으로 원래대로 돌아온다.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// maxMaintenanceDuration - 점검 시간대 최대 길이 (반복 주기를 넘는 창으로 영구 음소거되는 것 방지)
+const maxMaintenanceDuration = 7 * 24 * time.Hour
+
+var (
+	ErrInvalidMaintenanceWindow  = errors.New("invalid maintenance window")
+	ErrMaintenanceWindowNotFound = errors.New("maintenance window not found")
+)
+
+// maintenanceRepo - MaintenanceService가 사용하는 DB 인터페이스
+type maintenanceRepo interface {
+	ListMaintenanceWindows(ctx context.Context) ([]model.MaintenanceWindow, error)
+	GetMaintenanceWindow(ctx context.Context, id int64) (*model.MaintenanceWindow, error)
+	CreateMaintenanceWindow(ctx context.Context, w model.MaintenanceWindow) (int64, error)
+	UpdateMaintenanceWindow(ctx context.Context, id int64, w model.MaintenanceWindow) error
+	DeleteMaintenanceWindow(ctx context.Context, id int64) error
+}
+
+// MaintenanceService - 점검 시간대 CRUD
+type MaintenanceService struct {
+	db  maintenanceRepo
+	now func() time.Time
+}
+
+func NewMaintenanceService(db maintenanceRepo) *MaintenanceService {
+	return &MaintenanceService{db: db, now: time.Now}
+}
+
+// List - 전체 점검 시간대 조회 (현재 진행 여부, 다음 시작 시각 포함)
+func (s *MaintenanceService) List(ctx context.Context) ([]model.MaintenanceWindow, error) {
+	windows, err := s.db.ListMaintenanceWindows(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	for i := range windows {
+		fillMaintenanceStatus(&windows[i], now)
+	}
+	return windows, nil
+}
+
+// Get - 단건 조회 (없으면 nil)
+func (s *MaintenanceService) Get(ctx context.Context, id int64) (*model.MaintenanceWindow, error) {
+	w, err := s.db.GetMaintenanceWindow(ctx, id)
+	if err != nil || w == nil {
+		return w, err
+	}
+	fillMaintenanceStatus(w, s.now())
+	return w, nil
+}
+
+// Create - 점검 시간대 생성 (createdBy: 로그인 사용자 ID)
+func (s *MaintenanceService) Create(ctx context.Context, req model.MaintenanceWindowRequest, createdBy string) (int64, error) {
+	w, err := buildMaintenanceWindow(req)
+	if err != nil {
+		return 0, err
+	}
+	w.CreatedBy = createdBy
+	return s.db.CreateMaintenanceWindow(ctx, w)
+}
+
+// Update - 점검 시간대 수정
+func (s *MaintenanceService) Update(ctx context.Context, id int64, req model.MaintenanceWindowRequest) error {
+	existing, err := s.db.GetMaintenanceWindow(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return fmt.Errorf("%w: id=%d", ErrMaintenanceWindowNotFound, id)
+	}
+	w, err := buildMaintenanceWindow(req)
+	if err != nil {
+		return err
+	}
+	return s.db.UpdateMaintenanceWindow(ctx, id, w)
+}
+
+// Delete - 점검 시간대 삭제
+func (s *MaintenanceService) Delete(ctx context.Context, id int64) error {
+	existing, err := s.db.GetMaintenanceWindow(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return fmt.Errorf("%w: id=%d", ErrMaintenanceWindowNotFound, id)
+	}
+	return s.db.DeleteMaintenanceWindow(ctx, id)
+}
+
+// buildMaintenanceWindow - 요청 검증 및 정규화
+func buildMaintenanceWindow(req model.MaintenanceWindowRequest) (model.MaintenanceWindow, error) {
+	w := model.MaintenanceWindow{
+		Name:            strings.TrimSpace(req.Name),
+		Schedule:        strings.Join(strings.Fields(req.Schedule), " "),
+		TimeZone:        strings.TrimSpace(req.TimeZone),
+		DurationMinutes: req.DurationMinutes,
+		Enabled:         req.Enabled == nil || *req.Enabled,
+		Comment:         strings.TrimSpace(req.Comment),
+		Matchers:        model.LabelMatchers{},
+	}
+	if w.Name == "" {
+		return w, fmt.Errorf("%w: name is required", ErrInvalidMaintenanceWindow)
+	}
+	if _, err := parseCronSchedule(w.Schedule); err != nil {
+		return w, fmt.Errorf("%w: %v", ErrInvalidMaintenanceWindow, err)
+	}
+	if w.TimeZone == "" {
+		w.TimeZone = "UTC"
+	}
+	if _, err := time.LoadLocation(w.TimeZone); err != nil {
+		return w, fmt.Errorf("%w: unknown time_zone %q", ErrInvalidMaintenanceWindow, w.TimeZone)
+	}
+	duration := time.Duration(w.DurationMinutes) * time.Minute
+	if duration <= 0 || duration > maxMaintenanceDuration {
+		return w, fmt.Errorf("%w: duration_minutes must be between 1 and %d", ErrInvalidMaintenanceWindow, int(maxMaintenanceDuration.Minutes()))
+	}
+	for _, m := range req.Matchers {
+		m.Name = strings.TrimSpace(m.Name)
+		w.Matchers = append(w.Matchers, m)
+	}
+	if len(w.Matchers) > 0 {
+		if err := w.Matchers.Validate(); err != nil {
+			return w, fmt.Errorf("%w: %v", ErrInvalidMaintenanceWindow, err)
+		}
+	}
+	return w, nil
+}
+
+// maintenanceWindowAt - 주어진 시각에 점검 중인지 판단 (진행 중이면 시작 시각 반환)
+func maintenanceWindowAt(w model.MaintenanceWindow, at time.Time) (time.Time, bool) {
+	schedule, err := parseCronSchedule(w.Schedule)
+	if err != nil {
+		return time.Time{}, false
+	}
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return schedule.ActiveWindow(at, time.Duration(w.DurationMinutes)*time.Minute, loc)
+}
+
+// fillMaintenanceStatus - 조회 응답용 진행 여부/다음 시작 시각 계산
+func fillMaintenanceStatus(w *model.MaintenanceWindow, now time.Time) {
+	_, w.Active = maintenanceWindowAt(*w, now)
+	w.Active = w.Active && w.Enabled
+	schedule, err := parseCronSchedule(w.Schedule)
+	if err != nil {
+		return
+	}
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return
+	}
+	if next := schedule.Next(now, loc); !next.IsZero() {
+		next = next.UTC()
+		w.NextStart = &next
+	}
+}
+
+// activeMaintenanceWindows - 활성화된 점검 시간대 중 at 시점에 진행 중인 것만 반환
+func activeMaintenanceWindows(windows []model.MaintenanceWindow, at time.Time) []model.MaintenanceWindow {
+	var active []model.MaintenanceWindow
+	for _, w := range windows {
+		if !w.Enabled {
+			continue
+		}
+		if _, ok := maintenanceWindowAt(w, at); ok {
+			active = append(active, w)
+		} else if _, err := parseCronSchedule(w.Schedule); err != nil {
+			log.Printf("Ignoring maintenance window with invalid schedule (id=%d): %v", w.ID, err)
+		}
+	}
+	return active
+}
+
+// matchMaintenanceWindow - alert 라벨이 범위에 포함되는 첫 번째 점검 시간대 (matchers가 없으면 전체 적용)
+func matchMaintenanceWindow(windows []model.MaintenanceWindow, labels map[string]string) *model.MaintenanceWindow {
+	for i := range windows {
+		if len(windows[i].Matchers) == 0 || windows[i].Matchers.Matches(labels) {
+			return &windows[i]
+		}
+	}
+	return nil
+}
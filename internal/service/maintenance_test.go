@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+type maintenanceRepoMock struct {
+	windows map[int64]*model.MaintenanceWindow
+	created model.MaintenanceWindow
+}
+
+func (m *maintenanceRepoMock) ListMaintenanceWindows(_ context.Context) ([]model.MaintenanceWindow, error) {
+	var out []model.MaintenanceWindow
+	for _, w := range m.windows {
+		out = append(out, *w)
+	}
+	return out, nil
+}
+
+func (m *maintenanceRepoMock) GetMaintenanceWindow(_ context.Context, id int64) (*model.MaintenanceWindow, error) {
+	if w, ok := m.windows[id]; ok {
+		copied := *w
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (m *maintenanceRepoMock) CreateMaintenanceWindow(_ context.Context, w model.MaintenanceWindow) (int64, error) {
+	m.created = w
+	return 1, nil
+}
+
+func (m *maintenanceRepoMock) UpdateMaintenanceWindow(_ context.Context, _ int64, _ model.MaintenanceWindow) error {
+	return nil
+}
+
+func (m *maintenanceRepoMock) DeleteMaintenanceWindow(_ context.Context, _ int64) error {
+	return nil
+}
+
+func TestMaintenanceService_CreateNormalizes(t *testing.T) {
+	repo := &maintenanceRepoMock{}
+	svc := NewMaintenanceService(repo)
+
+	_, err := svc.Create(context.Background(), model.MaintenanceWindowRequest{
+		Name:            " node upgrade ",
+		Schedule:        " 0  22 * *   TUE ",
+		DurationMinutes: 180,
+		Matchers:        model.LabelMatchers{{Name: "cluster", Value: "prod", IsEqual: true}},
+	}, "admin")
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	w := repo.created
+	if w.Name != "node upgrade" || w.Schedule != "0 22 * * TUE" || w.TimeZone != "UTC" || !w.Enabled || w.CreatedBy != "admin" {
+		t.Fatalf("created = %+v", w)
+	}
+}
+
+func TestMaintenanceService_CreateRejectsInvalid(t *testing.T) {
+	svc := NewMaintenanceService(&maintenanceRepoMock{})
+	valid := model.MaintenanceWindowRequest{Name: "w", Schedule: "0 22 * * 2", DurationMinutes: 60}
+
+	cases := map[string]func(r *model.MaintenanceWindowRequest){
+		"no name":       func(r *model.MaintenanceWindowRequest) { r.Name = "" },
+		"bad schedule":  func(r *model.MaintenanceWindowRequest) { r.Schedule = "every tuesday" },
+		"bad time zone": func(r *model.MaintenanceWindowRequest) { r.TimeZone = "Mars/Olympus" },
+		"zero duration": func(r *model.MaintenanceWindowRequest) { r.DurationMinutes = 0 },
+		"too long":      func(r *model.MaintenanceWindowRequest) { r.DurationMinutes = 8 * 24 * 60 },
+		"bad matcher":   func(r *model.MaintenanceWindowRequest) { r.Matchers = model.LabelMatchers{{Name: ""}} },
+	}
+	for name, mutate := range cases {
+		req := valid
+		mutate(&req)
+		if _, err := svc.Create(context.Background(), req, ""); !errors.Is(err, ErrInvalidMaintenanceWindow) {
+			t.Errorf("%s: Create() error = %v; want ErrInvalidMaintenanceWindow", name, err)
+		}
+	}
+}
+
+func TestMaintenanceWindowAt_TimeZone(t *testing.T) {
+	w := model.MaintenanceWindow{Schedule: "0 22 * * TUE", TimeZone: "Asia/Seoul", DurationMinutes: 120, Enabled: true}
+
+	// 화요일 22:30 KST = 화요일 13:30 UTC
+	if _, ok := maintenanceWindowAt(w, time.Date(2026, 3, 3, 13, 30, 0, 0, time.UTC)); !ok {
+		t.Fatal("maintenanceWindowAt(Tue 22:30 KST) = false; want true")
+	}
+	// 화요일 22:30 UTC는 수요일 07:30 KST
+	if _, ok := maintenanceWindowAt(w, time.Date(2026, 3, 3, 22, 30, 0, 0, time.UTC)); ok {
+		t.Fatal("maintenanceWindowAt(Tue 22:30 UTC) = true; want false")
+	}
+}
+
+func TestMatchMaintenanceWindow_Scope(t *testing.T) {
+	at := time.Date(2026, 3, 3, 13, 30, 0, 0, time.UTC)
+	windows := activeMaintenanceWindows([]model.MaintenanceWindow{
+		{ID: 1, Schedule: "0 22 * * TUE", TimeZone: "Asia/Seoul", DurationMinutes: 120, Enabled: false},
+		{ID: 2, Schedule: "0 22 * * TUE", TimeZone: "Asia/Seoul", DurationMinutes: 120, Enabled: true,
+			Matchers: model.LabelMatchers{{Name: "namespace", Value: "batch-.*", IsRegex: true, IsEqual: true}}},
+		{ID: 3, Schedule: "0 9 * * MON", TimeZone: "UTC", DurationMinutes: 60, Enabled: true},
+	}, at)
+
+	if len(windows) != 1 || windows[0].ID != 2 {
+		t.Fatalf("activeMaintenanceWindows() = %+v; want only window 2", windows)
+	}
+	if w := matchMaintenanceWindow(windows, map[string]string{"namespace": "batch-nightly"}); w == nil || w.ID != 2 {
+		t.Fatalf("matchMaintenanceWindow(batch) = %v; want window 2", w)
+	}
+	if w := matchMaintenanceWindow(windows, map[string]string{"namespace": "payments"}); w != nil {
+		t.Fatalf("matchMaintenanceWindow(payments) = %+v; want nil", w)
+	}
+}
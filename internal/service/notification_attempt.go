@@ -0,0 +1,127 @@
+// 알림 전송 기록 (notification_attempts)
+//
+// 처리 흐름:
+//  1. webhookRoutingNotifier가 대상별 전송 시도마다 RecordNotificationAttempt 호출 (client/notification_attempt.go)
+//     - 이벤트에 alert ID가 없으면(firing/resolved 등) fingerprint의 최신 alert로 채움
+//  2. 알림별(GET /alerts/:id/deliveries), 웹훅 설정별(GET /settings/webhooks/:id/deliveries) 조회
+//  3. 보관 기간이 지난 기록은 주기적으로 삭제
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/kube-rca/backend/internal/client"
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/db"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// notificationAttemptStore - NotificationAttemptService가 사용하는 DB 인터페이스
+type notificationAttemptStore interface {
+	InsertNotificationAttempt(ctx context.Context, attempt model.NotificationAttempt) error
+	ListNotificationAttemptsByAlert(ctx context.Context, alertID string, limit int) ([]model.NotificationAttempt, error)
+	ListNotificationAttemptsByWebhookConfig(ctx context.Context, configID int, limit int) ([]model.NotificationAttempt, error)
+	PurgeNotificationAttempts(ctx context.Context, before time.Time) (int64, error)
+	GetLatestAlertByFingerprint(fingerprint string) (*model.AlertDetailResponse, error)
+	GetAlertDetail(alertID string) (*model.AlertDetailResponse, error)
+}
+
+// attemptAwareNotifier - 전송 시도 기록 대상을 설정할 수 있는 notifier (webhookRoutingNotifier)
+type attemptAwareNotifier interface {
+	SetNotificationAttemptRecorder(recorder client.NotificationAttemptRecorder)
+}
+
+// NotificationAttemptService - 알림 전송 시도 기록/조회 서비스 (client.NotificationAttemptRecorder 구현)
+type NotificationAttemptService struct {
+	store notificationAttemptStore
+	cfg   config.NotificationAttemptConfig
+	now   func() time.Time
+}
+
+var _ client.NotificationAttemptRecorder = (*NotificationAttemptService)(nil)
+
+func NewNotificationAttemptService(store notificationAttemptStore, cfg config.NotificationAttemptConfig) *NotificationAttemptService {
+	return &NotificationAttemptService{
+		store: store,
+		cfg:   cfg,
+		now:   time.Now,
+	}
+}
+
+// AttachNotifier - notifier의 전송 시도를 기록하도록 설정 (지원하지 않으면 false)
+func (s *NotificationAttemptService) AttachNotifier(notifier client.Notifier) bool {
+	aware, ok := notifier.(attemptAwareNotifier)
+	if !ok {
+		return false
+	}
+	aware.SetNotificationAttemptRecorder(s)
+	return true
+}
+
+// RecordNotificationAttempt - 전송 시도 저장 (실패해도 전송에는 영향 없음, 로그만 기록)
+func (s *NotificationAttemptService) RecordNotificationAttempt(attempt model.NotificationAttempt) {
+	if attempt.AlertID == "" && attempt.Fingerprint != "" {
+		if alert, err := s.store.GetLatestAlertByFingerprint(attempt.Fingerprint); err == nil && alert != nil {
+			attempt.AlertID = alert.AlertID
+			if attempt.IncidentID == "" && alert.IncidentID != nil {
+				attempt.IncidentID = *alert.IncidentID
+			}
+		}
+	}
+	if err := s.store.InsertNotificationAttempt(context.Background(), attempt); err != nil {
+		log.Printf("Failed to record notification attempt (event=%s, mode=%s, status=%s): %v", attempt.EventType, attempt.Mode, attempt.Status, err)
+	}
+}
+
+// ListByAlert - alert의 전송 시도 목록 (최신순)
+func (s *NotificationAttemptService) ListByAlert(ctx context.Context, alertID string, limit int) ([]model.NotificationAttempt, error) {
+	if _, err := s.store.GetAlertDetail(alertID); err != nil {
+		if db.IsNoRows(err) {
+			return nil, ErrAlertNotFound
+		}
+		return nil, fmt.Errorf("failed to load alert: %w", err)
+	}
+	return s.store.ListNotificationAttemptsByAlert(ctx, alertID, normalizeAttemptLimit(limit))
+}
+
+// ListByWebhookConfig - 웹훅 설정의 전송 시도 목록 (최신순, 삭제된 설정의 기록도 조회 가능)
+func (s *NotificationAttemptService) ListByWebhookConfig(ctx context.Context, configID int, limit int) ([]model.NotificationAttempt, error) {
+	return s.store.ListNotificationAttemptsByWebhookConfig(ctx, configID, normalizeAttemptLimit(limit))
+}
+
+func normalizeAttemptLimit(limit int) int {
+	if limit <= 0 || limit > 500 {
+		return 100
+	}
+	return limit
+}
+
+// Start - 보관 기간이 지난 기록을 1시간마다 삭제 (RetentionDays 0이면 비활성)
+func (s *NotificationAttemptService) Start(ctx context.Context) {
+	if s.cfg.RetentionDays <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+
+		for {
+			before := s.now().AddDate(0, 0, -s.cfg.RetentionDays)
+			if purged, err := s.store.PurgeNotificationAttempts(ctx, before); err != nil {
+				log.Printf("Failed to purge notification attempts: %v", err)
+			} else if purged > 0 {
+				log.Printf("Purged notification attempts (count=%d)", purged)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	log.Printf("Notification attempt log started (retention_days=%d)", s.cfg.RetentionDays)
+}
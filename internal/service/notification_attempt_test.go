@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// ============================================================================
+// Mock: notificationAttemptStore
+// ============================================================================
+
+type notificationAttemptStoreMock struct {
+	inserted  []model.NotificationAttempt
+	alerts    map[string]*model.AlertDetailResponse // alert_id -> alert
+	latest    map[string]*model.AlertDetailResponse // fingerprint -> alert
+	listLimit int
+}
+
+func (m *notificationAttemptStoreMock) InsertNotificationAttempt(_ context.Context, attempt model.NotificationAttempt) error {
+	m.inserted = append(m.inserted, attempt)
+	return nil
+}
+
+func (m *notificationAttemptStoreMock) ListNotificationAttemptsByAlert(_ context.Context, alertID string, limit int) ([]model.NotificationAttempt, error) {
+	m.listLimit = limit
+	var list []model.NotificationAttempt
+	for _, a := range m.inserted {
+		if a.AlertID == alertID {
+			list = append(list, a)
+		}
+	}
+	return list, nil
+}
+
+func (m *notificationAttemptStoreMock) ListNotificationAttemptsByWebhookConfig(_ context.Context, _ int, limit int) ([]model.NotificationAttempt, error) {
+	m.listLimit = limit
+	return nil, nil
+}
+
+func (m *notificationAttemptStoreMock) PurgeNotificationAttempts(_ context.Context, _ time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (m *notificationAttemptStoreMock) GetLatestAlertByFingerprint(fingerprint string) (*model.AlertDetailResponse, error) {
+	if alert, ok := m.latest[fingerprint]; ok {
+		return alert, nil
+	}
+	return nil, pgx.ErrNoRows
+}
+
+func (m *notificationAttemptStoreMock) GetAlertDetail(alertID string) (*model.AlertDetailResponse, error) {
+	if alert, ok := m.alerts[alertID]; ok {
+		return alert, nil
+	}
+	return nil, pgx.ErrNoRows
+}
+
+// ============================================================================
+// Tests
+// ============================================================================
+
+func TestNotificationAttemptService_RecordFillsAlertFromFingerprint(t *testing.T) {
+	incidentID := "INC-1"
+	store := &notificationAttemptStoreMock{
+		latest: map[string]*model.AlertDetailResponse{
+			"fp-1": {AlertID: "ALR-1", IncidentID: &incidentID},
+		},
+	}
+	svc := NewNotificationAttemptService(store, config.NotificationAttemptConfig{})
+
+	svc.RecordNotificationAttempt(model.NotificationAttempt{Fingerprint: "fp-1", EventType: "alert_status_changed"})
+	svc.RecordNotificationAttempt(model.NotificationAttempt{Fingerprint: "fp-unknown", EventType: "alert_status_changed"})
+	svc.RecordNotificationAttempt(model.NotificationAttempt{AlertID: "ALR-9", Fingerprint: "fp-1", EventType: "analysis_result_posted"})
+
+	if len(store.inserted) != 3 {
+		t.Fatalf("inserted = %d, want 3", len(store.inserted))
+	}
+	if got := store.inserted[0]; got.AlertID != "ALR-1" || got.IncidentID != "INC-1" {
+		t.Fatalf("expected alert/incident filled from fingerprint, got %+v", got)
+	}
+	if got := store.inserted[1]; got.AlertID != "" {
+		t.Fatalf("expected empty alert id for unknown fingerprint, got %q", got.AlertID)
+	}
+	if got := store.inserted[2]; got.AlertID != "ALR-9" || got.IncidentID != "" {
+		t.Fatalf("expected explicit alert id kept, got %+v", got)
+	}
+}
+
+func TestNotificationAttemptService_ListByAlert(t *testing.T) {
+	store := &notificationAttemptStoreMock{
+		alerts: map[string]*model.AlertDetailResponse{"ALR-1": {AlertID: "ALR-1"}},
+		inserted: []model.NotificationAttempt{
+			{AlertID: "ALR-1", Status: model.NotificationAttemptStatusSuccess},
+			{AlertID: "ALR-2", Status: model.NotificationAttemptStatusFailed},
+		},
+	}
+	svc := NewNotificationAttemptService(store, config.NotificationAttemptConfig{})
+
+	if _, err := svc.ListByAlert(context.Background(), "ALR-404", 0); !errors.Is(err, ErrAlertNotFound) {
+		t.Fatalf("expected ErrAlertNotFound, got %v", err)
+	}
+
+	list, err := svc.ListByAlert(context.Background(), "ALR-1", 1000)
+	if err != nil {
+		t.Fatalf("ListByAlert() error = %v", err)
+	}
+	if len(list) != 1 || list[0].AlertID != "ALR-1" {
+		t.Fatalf("unexpected attempts: %+v", list)
+	}
+	if store.listLimit != 100 {
+		t.Fatalf("limit = %d, want default 100 for out-of-range value", store.listLimit)
+	}
+
+	if _, err := svc.ListByWebhookConfig(context.Background(), 3, 50); err != nil {
+		t.Fatalf("ListByWebhookConfig() error = %v", err)
+	}
+	if store.listLimit != 50 {
+		t.Fatalf("limit = %d, want 50", store.listLimit)
+	}
+}
@@ -0,0 +1,389 @@
+// 알림 outbox (전송 대상별 기록 + 재시도 + dead-letter)
+//
+// 처리 흐름:
+//  1. 전송 대상을 notification_outbox에 pending 상태로 기록
+//     - alert 알림: AlertService가 PrepareEntries로 만든 entry를 alert 저장 트랜잭션에 함께 기록 (보류 시각까지 대기)
+//       이후 Release로 즉시 전송 대상으로 바꾸거나, flapping으로 판정되면 Cancel로 폐기
+//     - 그 밖의 이벤트: webhookRoutingNotifier가 대상별 전송을 Deliver로 기록 (기록 실패 시에만 즉시 전송)
+//  2. worker가 전송 시각이 지난 entry(또는 전송 중 Pod가 종료된 stale entry)를 점유하여 같은 대상에만 전송 (첫 시도 포함)
+//     - 성공: delivered / 실패: 지수 백오프(Retry-After가 더 길면 그 시각) 후 재시도 예약
+//     - firing root 메시지인데 alert가 이미 resolved면 보내지 않고 폐기
+//     - Slack root 재전송에 성공하면 스레드 delivery/thread_ts 기록 (이후 resolved가 같은 스레드에 답글)
+//  3. 최대 시도 횟수를 넘으면 dead (dead-letter), 관리자 API로 조회/재시도/폐기
+//  4. 전송 완료 entry는 보관 기간 후 삭제
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/kube-rca/backend/internal/client"
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// alertOutboxHold - alert 저장과 함께 기록한 entry의 보류 시간
+// 저장 후 flapping 판정 전에 worker가 전송하지 않도록 하고, Release 전에 Pod가 종료되어도 이 시간 후 전송된다.
+const alertOutboxHold = 30 * time.Second
+
+var (
+	ErrNotificationOutboxNotFound = errors.New("notification outbox entry not found")
+	ErrNotificationOutboxConflict = errors.New("notification outbox entry is not in a retryable state")
+)
+
+// notificationOutboxStore - NotificationOutboxService가 사용하는 DB 인터페이스
+type notificationOutboxStore interface {
+	InsertNotificationOutboxEntry(ctx context.Context, entry model.NotificationOutboxEntry) (int64, error)
+	ReleaseNotificationOutboxEntries(ctx context.Context, ids []int64) error
+	DiscardPendingNotificationOutboxEntries(ctx context.Context, ids []int64, reason string) error
+	ClaimNotificationOutboxEntries(ctx context.Context, limit int, staleAfter time.Duration) ([]model.NotificationOutboxEntry, error)
+	CompleteNotificationOutboxEntry(ctx context.Context, id int64) error
+	FailNotificationOutboxEntry(ctx context.Context, id int64, errMsg string, nextAttemptAt time.Time, dead bool) error
+	DiscardClaimedNotificationOutboxEntry(ctx context.Context, id int64, reason string) error
+	ListNotificationOutboxEntries(ctx context.Context, status string, limit int) ([]model.NotificationOutboxEntry, error)
+	GetNotificationOutboxEntry(ctx context.Context, id int64) (*model.NotificationOutboxEntry, error)
+	RequeueNotificationOutboxEntry(ctx context.Context, id int64) (bool, error)
+	DiscardNotificationOutboxEntry(ctx context.Context, id int64) (bool, error)
+	PurgeDeliveredNotificationOutbox(ctx context.Context, before time.Time) (int64, error)
+
+	GetLatestAlertByFingerprint(fingerprint string) (*model.AlertDetailResponse, error)
+	UpsertAlertNotificationDeliveries(deliveries []model.AlertNotificationDelivery) error
+	UpdateAlertThreadTS(fingerprint, threadTS string) error
+}
+
+// outboxAwareNotifier - outbox를 설정할 수 있는 notifier (webhookRoutingNotifier)
+type outboxAwareNotifier interface {
+	SetNotificationOutbox(outbox client.NotificationOutbox)
+}
+
+// NotificationOutboxService - 알림 전송 기록 및 재시도 서비스 (client.NotificationOutbox 구현)
+type NotificationOutboxService struct {
+	store     notificationOutboxStore
+	deliverer client.OutboxDeliverer
+	cfg       config.NotificationOutboxConfig
+	wake      chan struct{}
+	now       func() time.Time
+}
+
+var _ client.NotificationOutbox = (*NotificationOutboxService)(nil)
+
+func NewNotificationOutboxService(store notificationOutboxStore, cfg config.NotificationOutboxConfig) *NotificationOutboxService {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 1
+	}
+	if cfg.PollIntervalSecs <= 0 {
+		cfg.PollIntervalSecs = 5
+	}
+	if cfg.StaleLockSeconds <= 0 {
+		cfg.StaleLockSeconds = 300
+	}
+	return &NotificationOutboxService{
+		store: store,
+		cfg:   cfg,
+		wake:  make(chan struct{}, 1),
+		now:   time.Now,
+	}
+}
+
+// AttachNotifier - notifier에 outbox를 설정하고 재전송에 사용할 notifier를 등록 (지원하지 않으면 false)
+func (s *NotificationOutboxService) AttachNotifier(notifier client.Notifier) bool {
+	aware, ok := notifier.(outboxAwareNotifier)
+	if !ok {
+		return false
+	}
+	deliverer, ok := notifier.(client.OutboxDeliverer)
+	if !ok {
+		return false
+	}
+	s.deliverer = deliverer
+	aware.SetNotificationOutbox(s)
+	return true
+}
+
+// Deliver - 대상 전송을 outbox에 기록하고 worker를 깨운다 (전송은 worker가 수행)
+// outbox 저장에 실패하면 기록 없이 즉시 전송하고 그 결과를 반환한다.
+func (s *NotificationOutboxService) Deliver(event client.NotifierEvent, target client.OutboxTarget, send func(outboxID int64) error) error {
+	entry, err := newNotificationOutboxEntry(event, target)
+	if err == nil {
+		_, err = s.store.InsertNotificationOutboxEntry(context.Background(), entry)
+	}
+	if err != nil {
+		log.Printf("Failed to record notification outbox entry (event=%s, mode=%s): %v", event.EventType(), target.Mode, err)
+		return send(0)
+	}
+	s.notify()
+	return nil
+}
+
+// PrepareEntries - alert 저장 트랜잭션에 함께 기록할 entry 생성 (Release 전까지 worker가 점유하지 않도록 보류)
+func (s *NotificationOutboxService) PrepareEntries(event client.NotifierEvent, targets []client.OutboxTarget) ([]model.NotificationOutboxEntry, error) {
+	holdUntil := s.now().Add(alertOutboxHold)
+	entries := make([]model.NotificationOutboxEntry, 0, len(targets))
+	for _, target := range targets {
+		entry, err := newNotificationOutboxEntry(event, target)
+		if err != nil {
+			return nil, err
+		}
+		entry.NextAttemptAt = holdUntil
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// Release - 보류 중인 entry를 즉시 전송 대상으로 변경하고 worker를 깨움
+// 실패해도 보류 시간이 지나면 worker가 전송한다.
+func (s *NotificationOutboxService) Release(ctx context.Context, ids []int64) {
+	if err := s.store.ReleaseNotificationOutboxEntries(ctx, ids); err != nil {
+		log.Printf("Failed to release notification outbox entries (ids=%v): %v", ids, err)
+	}
+	s.notify()
+}
+
+// Cancel - 보류 중인 entry를 전송하지 않고 폐기 (reason은 last_error에 기록)
+func (s *NotificationOutboxService) Cancel(ctx context.Context, ids []int64, reason string) {
+	if err := s.store.DiscardPendingNotificationOutboxEntries(ctx, ids, reason); err != nil {
+		log.Printf("Failed to discard notification outbox entries (ids=%v): %v", ids, err)
+	}
+}
+
+// newNotificationOutboxEntry - 이벤트와 전송 대상으로 outbox entry 생성
+func newNotificationOutboxEntry(event client.NotifierEvent, target client.OutboxTarget) (model.NotificationOutboxEntry, error) {
+	payload, err := client.EncodeNotifierEvent(event)
+	if err != nil {
+		return model.NotificationOutboxEntry{}, err
+	}
+	entry := model.NotificationOutboxEntry{
+		EventType:       event.EventType(),
+		Mode:            target.Mode,
+		WebhookConfigID: target.WebhookConfigID,
+		Channel:         target.Channel,
+		Delivery:        target.Delivery,
+		Payload:         payload,
+	}
+	entry.AlertID, entry.Fingerprint, entry.IncidentID = client.NotificationEventRefs(event, target.Delivery)
+	return entry, nil
+}
+
+// finish - 전송 결과 기록 (실패 시 재시도 시각 계산, 최대 시도 횟수 초과 시 dead)
+// 실패 기록에 실패해도 stale lock 이후 worker가 다시 점유한다.
+func (s *NotificationOutboxService) finish(ctx context.Context, entry model.NotificationOutboxEntry, sendErr error) {
+	if sendErr == nil {
+		if err := s.store.CompleteNotificationOutboxEntry(ctx, entry.ID); err != nil {
+			log.Printf("Failed to complete notification outbox entry (id=%d): %v", entry.ID, err)
+		}
+		return
+	}
+
+	dead := entry.Attempts >= s.cfg.MaxAttempts
+	nextAttemptAt := s.now().Add(s.retryDelay(entry.Attempts, sendErr))
+	if dead {
+		log.Printf("Notification outbox entry moved to dead-letter (id=%d, event=%s, attempts=%d): %v", entry.ID, entry.EventType, entry.Attempts, sendErr)
+	} else {
+		log.Printf("Notification outbox entry failed, retrying at %s (id=%d, event=%s, attempts=%d): %v", nextAttemptAt.Format(time.RFC3339), entry.ID, entry.EventType, entry.Attempts, sendErr)
+	}
+	if err := s.store.FailNotificationOutboxEntry(ctx, entry.ID, sendErr.Error(), nextAttemptAt, dead); err != nil {
+		log.Printf("Failed to record notification outbox failure (id=%d): %v", entry.ID, err)
+	}
+}
+
+// retryDelay - 지수 백오프, 수신 측이 Retry-After로 더 긴 대기를 요구하면 그 값
+func (s *NotificationOutboxService) retryDelay(attempts int, err error) time.Duration {
+	delay := exponentialBackoff(s.cfg.RetryBaseBackoffSecs, s.cfg.RetryMaxBackoffSecs, attempts)
+	if retryAfter, ok := client.RetryAfter(err); ok && retryAfter > delay {
+		delay = retryAfter
+	}
+	return delay
+}
+
+func (s *NotificationOutboxService) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Start - 전송/재시도 worker와 보관 기간 정리 시작 (ctx 종료 시 중단)
+func (s *NotificationOutboxService) Start(ctx context.Context) {
+	if s.deliverer == nil {
+		log.Printf("Notification outbox worker disabled: notifier does not support redelivery")
+		return
+	}
+	go s.runWorker(ctx)
+	if s.cfg.RetentionDays > 0 {
+		go s.runPurge(ctx)
+	}
+	log.Printf("Notification outbox started (max_attempts=%d, retention_days=%d)", s.cfg.MaxAttempts, s.cfg.RetentionDays)
+}
+
+func (s *NotificationOutboxService) runWorker(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(s.cfg.PollIntervalSecs) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		// 전송할 entry가 남아 있는 동안 연속 처리
+		for s.ProcessNext(ctx) {
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *NotificationOutboxService) runPurge(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		before := s.now().AddDate(0, 0, -s.cfg.RetentionDays)
+		if purged, err := s.store.PurgeDeliveredNotificationOutbox(ctx, before); err != nil {
+			log.Printf("Failed to purge notification outbox: %v", err)
+		} else if purged > 0 {
+			log.Printf("Purged delivered notification outbox entries (count=%d)", purged)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessNext - 전송할 entry 하나를 점유하여 전송 (처리한 entry가 있으면 true)
+func (s *NotificationOutboxService) ProcessNext(ctx context.Context) bool {
+	entries, err := s.store.ClaimNotificationOutboxEntries(ctx, 1, time.Duration(s.cfg.StaleLockSeconds)*time.Second)
+	if err != nil {
+		log.Printf("Failed to claim notification outbox entries: %v", err)
+		return false
+	}
+	if len(entries) == 0 {
+		return false
+	}
+	for _, entry := range entries {
+		s.processEntry(ctx, entry)
+	}
+	return true
+}
+
+func (s *NotificationOutboxService) processEntry(ctx context.Context, entry model.NotificationOutboxEntry) {
+	var alert *model.AlertDetailResponse
+	if entry.Mode == model.NotificationOutboxModeRoot && entry.Fingerprint != "" {
+		latest, err := s.store.GetLatestAlertByFingerprint(entry.Fingerprint)
+		if err != nil {
+			log.Printf("Failed to load alert for notification outbox entry (id=%d, fingerprint=%s): %v", entry.ID, entry.Fingerprint, err)
+		}
+		if latest != nil && latest.Status != "firing" {
+			reason := fmt.Sprintf("alert is %s; firing notification no longer needed", latest.Status)
+			log.Printf("Discarding notification outbox entry (id=%d, fingerprint=%s): %s", entry.ID, entry.Fingerprint, reason)
+			if err := s.store.DiscardClaimedNotificationOutboxEntry(ctx, entry.ID, reason); err != nil {
+				log.Printf("Failed to discard notification outbox entry (id=%d): %v", entry.ID, err)
+			}
+			return
+		}
+		alert = latest
+	}
+
+	receipt, err := s.redeliver(entry)
+	if err == nil && receipt != nil && alert != nil {
+		s.persistRootReceipt(entry, *alert, *receipt)
+	}
+	s.finish(ctx, entry, err)
+}
+
+// redeliver - 기록된 대상에만 전송 (panic도 실패로 기록)
+func (s *NotificationOutboxService) redeliver(entry model.NotificationOutboxEntry) (receipt *client.NotificationDeliveryReceipt, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while redelivering notification: %v", r)
+		}
+	}()
+	return s.deliverer.RedeliverOutboxEntry(entry)
+}
+
+// persistRootReceipt - 전송한 Slack root 메시지의 스레드를 delivery로 기록
+// legacy thread_ts는 비어 있는 경우에만 채운다 (다른 대상의 root가 먼저 기록했을 수 있음).
+func (s *NotificationOutboxService) persistRootReceipt(entry model.NotificationOutboxEntry, alert model.AlertDetailResponse, receipt client.NotificationDeliveryReceipt) {
+	alertID := entry.AlertID
+	if alertID == "" {
+		alertID = alert.AlertID
+	}
+	incidentID := entry.IncidentID
+	if incidentID == "" && alert.IncidentID != nil {
+		incidentID = *alert.IncidentID
+	}
+	deliveries := notificationDeliveriesFromReceipts(alertID, entry.Fingerprint, incidentID, alert.Status, []client.NotificationDeliveryReceipt{receipt})
+	if len(deliveries) == 0 {
+		return
+	}
+	if err := s.store.UpsertAlertNotificationDeliveries(deliveries); err != nil {
+		log.Printf("Failed to persist redelivered notification delivery (id=%d): %v", entry.ID, err)
+	}
+	if alert.ThreadTS == "" {
+		if err := s.store.UpdateAlertThreadTS(entry.Fingerprint, receipt.ThreadTS); err != nil {
+			log.Printf("Failed to save legacy thread_ts to DB: %v", err)
+		}
+	}
+}
+
+// List - outbox 목록 조회 (payload 제외)
+func (s *NotificationOutboxService) List(ctx context.Context, status string, limit int) ([]model.NotificationOutboxEntry, error) {
+	switch status {
+	case "", model.NotificationOutboxStatusPending, model.NotificationOutboxStatusSending, model.NotificationOutboxStatusDelivered,
+		model.NotificationOutboxStatusDead, model.NotificationOutboxStatusDiscarded:
+	default:
+		return nil, fmt.Errorf("invalid status: %s", status)
+	}
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	return s.store.ListNotificationOutboxEntries(ctx, status, limit)
+}
+
+// Get - outbox 단건 조회 (payload 포함)
+func (s *NotificationOutboxService) Get(ctx context.Context, id int64) (*model.NotificationOutboxEntry, error) {
+	entry, err := s.store.GetNotificationOutboxEntry(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, ErrNotificationOutboxNotFound
+	}
+	return entry, nil
+}
+
+// Retry - pending/dead/discarded entry를 시도 횟수를 초기화하여 즉시 재시도
+func (s *NotificationOutboxService) Retry(ctx context.Context, id int64) error {
+	if _, err := s.Get(ctx, id); err != nil {
+		return err
+	}
+	ok, err := s.store.RequeueNotificationOutboxEntry(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNotificationOutboxConflict
+	}
+	s.notify()
+	return nil
+}
+
+// Discard - pending/dead entry를 폐기 (더 이상 재시도하지 않음)
+func (s *NotificationOutboxService) Discard(ctx context.Context, id int64) error {
+	if _, err := s.Get(ctx, id); err != nil {
+		return err
+	}
+	ok, err := s.store.DiscardNotificationOutboxEntry(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNotificationOutboxConflict
+	}
+	return nil
+}
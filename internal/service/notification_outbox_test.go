@@ -0,0 +1,434 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kube-rca/backend/internal/client"
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// ============================================================================
+// Mock: notificationOutboxStore / client.OutboxDeliverer
+// ============================================================================
+
+type notificationOutboxStoreMock struct {
+	entries    map[int64]*model.NotificationOutboxEntry
+	nextID     int64
+	insertErr  error
+	now        time.Time // zero면 next_attempt_at과 무관하게 점유
+	alert      *model.AlertDetailResponse
+	deliveries []model.AlertNotificationDelivery
+	threadTS   map[string]string
+}
+
+func newNotificationOutboxStoreMock() *notificationOutboxStoreMock {
+	return &notificationOutboxStoreMock{
+		entries:  make(map[int64]*model.NotificationOutboxEntry),
+		threadTS: make(map[string]string),
+	}
+}
+
+func (m *notificationOutboxStoreMock) InsertNotificationOutboxEntry(_ context.Context, entry model.NotificationOutboxEntry) (int64, error) {
+	if m.insertErr != nil {
+		return 0, m.insertErr
+	}
+	m.nextID++
+	entry.ID = m.nextID
+	entry.Status = model.NotificationOutboxStatusPending
+	entry.Attempts = 0
+	m.entries[m.nextID] = &entry
+	return m.nextID, nil
+}
+
+func (m *notificationOutboxStoreMock) ReleaseNotificationOutboxEntries(_ context.Context, ids []int64) error {
+	for _, id := range ids {
+		if e, ok := m.entries[id]; ok && e.Status == model.NotificationOutboxStatusPending {
+			e.NextAttemptAt = m.now
+		}
+	}
+	return nil
+}
+
+func (m *notificationOutboxStoreMock) DiscardPendingNotificationOutboxEntries(_ context.Context, ids []int64, reason string) error {
+	for _, id := range ids {
+		if e, ok := m.entries[id]; ok && e.Status == model.NotificationOutboxStatusPending {
+			e.Status = model.NotificationOutboxStatusDiscarded
+			e.LastError = reason
+		}
+	}
+	return nil
+}
+
+func (m *notificationOutboxStoreMock) ClaimNotificationOutboxEntries(_ context.Context, limit int, _ time.Duration) ([]model.NotificationOutboxEntry, error) {
+	var claimed []model.NotificationOutboxEntry
+	for id := int64(1); id <= m.nextID && len(claimed) < limit; id++ {
+		e, ok := m.entries[id]
+		if !ok || e.Status != model.NotificationOutboxStatusPending {
+			continue
+		}
+		if !m.now.IsZero() && e.NextAttemptAt.After(m.now) {
+			continue
+		}
+		e.Status = model.NotificationOutboxStatusSending
+		e.Attempts++
+		claimed = append(claimed, *e)
+	}
+	return claimed, nil
+}
+
+func (m *notificationOutboxStoreMock) CompleteNotificationOutboxEntry(_ context.Context, id int64) error {
+	m.entries[id].Status = model.NotificationOutboxStatusDelivered
+	m.entries[id].LastError = ""
+	return nil
+}
+
+func (m *notificationOutboxStoreMock) FailNotificationOutboxEntry(_ context.Context, id int64, errMsg string, nextAttemptAt time.Time, dead bool) error {
+	e := m.entries[id]
+	e.LastError = errMsg
+	e.NextAttemptAt = nextAttemptAt
+	if dead {
+		e.Status = model.NotificationOutboxStatusDead
+	} else {
+		e.Status = model.NotificationOutboxStatusPending
+	}
+	return nil
+}
+
+func (m *notificationOutboxStoreMock) DiscardClaimedNotificationOutboxEntry(_ context.Context, id int64, reason string) error {
+	m.entries[id].Status = model.NotificationOutboxStatusDiscarded
+	m.entries[id].LastError = reason
+	return nil
+}
+
+func (m *notificationOutboxStoreMock) ListNotificationOutboxEntries(_ context.Context, status string, limit int) ([]model.NotificationOutboxEntry, error) {
+	var list []model.NotificationOutboxEntry
+	for id := m.nextID; id >= 1 && len(list) < limit; id-- {
+		if e, ok := m.entries[id]; ok && (status == "" || e.Status == status) {
+			list = append(list, *e)
+		}
+	}
+	return list, nil
+}
+
+func (m *notificationOutboxStoreMock) GetNotificationOutboxEntry(_ context.Context, id int64) (*model.NotificationOutboxEntry, error) {
+	e, ok := m.entries[id]
+	if !ok {
+		return nil, nil
+	}
+	cp := *e
+	return &cp, nil
+}
+
+func (m *notificationOutboxStoreMock) RequeueNotificationOutboxEntry(_ context.Context, id int64) (bool, error) {
+	e := m.entries[id]
+	switch e.Status {
+	case model.NotificationOutboxStatusPending, model.NotificationOutboxStatusDead, model.NotificationOutboxStatusDiscarded:
+		e.Status = model.NotificationOutboxStatusPending
+		e.Attempts = 0
+		return true, nil
+	}
+	return false, nil
+}
+
+func (m *notificationOutboxStoreMock) DiscardNotificationOutboxEntry(_ context.Context, id int64) (bool, error) {
+	e := m.entries[id]
+	switch e.Status {
+	case model.NotificationOutboxStatusPending, model.NotificationOutboxStatusDead:
+		e.Status = model.NotificationOutboxStatusDiscarded
+		return true, nil
+	}
+	return false, nil
+}
+
+func (m *notificationOutboxStoreMock) PurgeDeliveredNotificationOutbox(_ context.Context, _ time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (m *notificationOutboxStoreMock) GetLatestAlertByFingerprint(_ string) (*model.AlertDetailResponse, error) {
+	if m.alert == nil {
+		return nil, errors.New("no rows in result set")
+	}
+	cp := *m.alert
+	return &cp, nil
+}
+
+func (m *notificationOutboxStoreMock) UpsertAlertNotificationDeliveries(deliveries []model.AlertNotificationDelivery) error {
+	m.deliveries = append(m.deliveries, deliveries...)
+	return nil
+}
+
+func (m *notificationOutboxStoreMock) UpdateAlertThreadTS(fingerprint, threadTS string) error {
+	m.threadTS[fingerprint] = threadTS
+	return nil
+}
+
+type outboxDelivererMock struct {
+	calls   []model.NotificationOutboxEntry
+	receipt *client.NotificationDeliveryReceipt
+	err     error
+}
+
+func (d *outboxDelivererMock) RedeliverOutboxEntry(entry model.NotificationOutboxEntry) (*client.NotificationDeliveryReceipt, error) {
+	d.calls = append(d.calls, entry)
+	return d.receipt, d.err
+}
+
+func newTestNotificationOutboxService(store *notificationOutboxStoreMock, deliverer client.OutboxDeliverer) *NotificationOutboxService {
+	svc := NewNotificationOutboxService(store, config.NotificationOutboxConfig{
+		MaxAttempts:          3,
+		RetryBaseBackoffSecs: 10,
+		RetryMaxBackoffSecs:  60,
+	})
+	svc.deliverer = deliverer
+	svc.now = func() time.Time { return time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC) }
+	return svc
+}
+
+func firingOutboxEvent() client.AlertStatusChangedEvent {
+	return client.AlertStatusChangedEvent{
+		Alert:      model.Alert{Status: "firing", Fingerprint: "fp-1", ServiceChannel: "C-SVC"},
+		IncidentID: "INC-1",
+	}
+}
+
+// ============================================================================
+// Tests
+// ============================================================================
+
+func TestNotificationOutbox_DeliverQueuesForWorker(t *testing.T) {
+	store := newNotificationOutboxStoreMock()
+	deliverer := &outboxDelivererMock{}
+	svc := newTestNotificationOutboxService(store, deliverer)
+
+	configID := 7
+	sent := false
+	err := svc.Deliver(firingOutboxEvent(), client.OutboxTarget{Mode: model.NotificationOutboxModeRoot, WebhookConfigID: &configID, Channel: "C-SVC"}, func(int64) error {
+		sent = true
+		return nil
+	})
+	if err != nil || sent {
+		t.Fatalf("Deliver() = %v, sent inline = %v; want queued without sending", err, sent)
+	}
+
+	e := store.entries[1]
+	if e.Status != model.NotificationOutboxStatusPending || e.Attempts != 0 {
+		t.Fatalf("status = %s, attempts = %d; want pending, 0", e.Status, e.Attempts)
+	}
+	if e.Fingerprint != "fp-1" || e.IncidentID != "INC-1" || e.Channel != "C-SVC" || e.WebhookConfigID == nil || *e.WebhookConfigID != 7 {
+		t.Fatalf("unexpected entry: %+v", e)
+	}
+	event, err := client.DecodeNotifierEvent(e.EventType, e.Payload)
+	if err != nil {
+		t.Fatalf("DecodeNotifierEvent() error = %v", err)
+	}
+	if got := event.(client.AlertStatusChangedEvent).Alert.ServiceChannel; got != "C-SVC" {
+		t.Fatalf("decoded service channel = %q, want C-SVC", got)
+	}
+
+	if !svc.ProcessNext(context.Background()) {
+		t.Fatalf("expected entry to be processed")
+	}
+	if e.Status != model.NotificationOutboxStatusDelivered || e.Attempts != 1 || len(deliverer.calls) != 1 {
+		t.Fatalf("status = %s, attempts = %d, deliveries = %d; want delivered on first worker attempt", e.Status, e.Attempts, len(deliverer.calls))
+	}
+}
+
+func TestNotificationOutbox_DeliverSendsInlineWhenRecordFails(t *testing.T) {
+	store := newNotificationOutboxStoreMock()
+	store.insertErr = errors.New("db down")
+	svc := newTestNotificationOutboxService(store, &outboxDelivererMock{})
+
+	sendErr := errors.New("connection refused")
+	var outboxID int64 = -1
+	err := svc.Deliver(firingOutboxEvent(), client.OutboxTarget{Mode: model.NotificationOutboxModeNotify}, func(id int64) error {
+		outboxID = id
+		return sendErr
+	})
+	if !errors.Is(err, sendErr) || outboxID != 0 {
+		t.Fatalf("Deliver() = %v (outbox id %d), want inline send error without entry", err, outboxID)
+	}
+}
+
+func TestNotificationOutbox_FailureSchedulesRetry(t *testing.T) {
+	store := newNotificationOutboxStoreMock()
+	svc := newTestNotificationOutboxService(store, &outboxDelivererMock{err: errors.New("connection refused")})
+
+	_ = svc.Deliver(firingOutboxEvent(), client.OutboxTarget{Mode: model.NotificationOutboxModeNotify}, nil)
+	svc.ProcessNext(context.Background())
+
+	e := store.entries[1]
+	if e.Status != model.NotificationOutboxStatusPending {
+		t.Fatalf("status = %s, want pending", e.Status)
+	}
+	if want := svc.now().Add(10 * time.Second); !e.NextAttemptAt.Equal(want) {
+		t.Fatalf("next attempt = %v, want %v", e.NextAttemptAt, want)
+	}
+}
+
+func TestNotificationOutbox_HonoursRetryAfter(t *testing.T) {
+	store := newNotificationOutboxStoreMock()
+	svc := newTestNotificationOutboxService(store, &outboxDelivererMock{
+		err: &client.RetryAfterError{Err: errors.New("slack API rate limited"), RetryAfter: 2 * time.Minute},
+	})
+
+	_ = svc.Deliver(firingOutboxEvent(), client.OutboxTarget{Mode: model.NotificationOutboxModeNotify}, nil)
+	svc.ProcessNext(context.Background())
+
+	if want := svc.now().Add(2 * time.Minute); !store.entries[1].NextAttemptAt.Equal(want) {
+		t.Fatalf("next attempt = %v, want %v", store.entries[1].NextAttemptAt, want)
+	}
+}
+
+func TestNotificationOutbox_DeadAfterMaxAttempts(t *testing.T) {
+	store := newNotificationOutboxStoreMock()
+	deliverer := &outboxDelivererMock{err: errors.New("still down")}
+	svc := newTestNotificationOutboxService(store, deliverer)
+
+	_ = svc.Deliver(firingOutboxEvent(), client.OutboxTarget{Mode: model.NotificationOutboxModeNotify}, nil)
+	for svc.ProcessNext(context.Background()) {
+	}
+
+	e := store.entries[1]
+	if e.Status != model.NotificationOutboxStatusDead {
+		t.Fatalf("status = %s, want dead", e.Status)
+	}
+	if e.Attempts != 3 || len(deliverer.calls) != 3 {
+		t.Fatalf("attempts = %d, deliveries = %d, want 3 and 3", e.Attempts, len(deliverer.calls))
+	}
+	if e.LastError != "still down" {
+		t.Fatalf("last error = %q", e.LastError)
+	}
+}
+
+func TestNotificationOutbox_DeliversRootAndPersistsThread(t *testing.T) {
+	store := newNotificationOutboxStoreMock()
+	store.alert = &model.AlertDetailResponse{AlertID: "ALR-1", Status: "firing", Fingerprint: "fp-1"}
+	configID := 7
+	deliverer := &outboxDelivererMock{receipt: &client.NotificationDeliveryReceipt{
+		NotifierType:    "slack",
+		WebhookConfigID: &configID,
+		ChannelID:       "C-SVC",
+		RootMessageTS:   "111.1",
+		ThreadTS:        "111.1",
+	}}
+	svc := newTestNotificationOutboxService(store, deliverer)
+
+	_ = svc.Deliver(firingOutboxEvent(), client.OutboxTarget{Mode: model.NotificationOutboxModeRoot, WebhookConfigID: &configID, Channel: "C-SVC"}, nil)
+	if !svc.ProcessNext(context.Background()) {
+		t.Fatalf("expected entry to be processed")
+	}
+
+	if store.entries[1].Status != model.NotificationOutboxStatusDelivered {
+		t.Fatalf("status = %s, want delivered", store.entries[1].Status)
+	}
+	if len(store.deliveries) != 1 {
+		t.Fatalf("expected 1 persisted delivery, got %d", len(store.deliveries))
+	}
+	d := store.deliveries[0]
+	if d.AlertID != "ALR-1" || d.ThreadTS != "111.1" || d.IncidentID == nil || *d.IncidentID != "INC-1" {
+		t.Fatalf("unexpected delivery: %+v", d)
+	}
+	if store.threadTS["fp-1"] != "111.1" {
+		t.Fatalf("legacy thread_ts = %q, want 111.1", store.threadTS["fp-1"])
+	}
+}
+
+func TestNotificationOutbox_DiscardsRootForResolvedAlert(t *testing.T) {
+	store := newNotificationOutboxStoreMock()
+	store.alert = &model.AlertDetailResponse{AlertID: "ALR-1", Status: "resolved", Fingerprint: "fp-1"}
+	deliverer := &outboxDelivererMock{}
+	svc := newTestNotificationOutboxService(store, deliverer)
+
+	_ = svc.Deliver(firingOutboxEvent(), client.OutboxTarget{Mode: model.NotificationOutboxModeRoot}, nil)
+	svc.ProcessNext(context.Background())
+
+	if store.entries[1].Status != model.NotificationOutboxStatusDiscarded {
+		t.Fatalf("status = %s, want discarded", store.entries[1].Status)
+	}
+	if len(deliverer.calls) != 0 {
+		t.Fatalf("expected no delivery for resolved alert")
+	}
+}
+
+func TestNotificationOutbox_PreparedEntriesHeldUntilRelease(t *testing.T) {
+	store := newNotificationOutboxStoreMock()
+	deliverer := &outboxDelivererMock{}
+	svc := newTestNotificationOutboxService(store, deliverer)
+	store.now = svc.now()
+	ctx := context.Background()
+
+	configID := 7
+	entries, err := svc.PrepareEntries(firingOutboxEvent(), []client.OutboxTarget{
+		{Mode: model.NotificationOutboxModeRoot, WebhookConfigID: &configID, Channel: "C-SVC"},
+		{Mode: model.NotificationOutboxModeRoot},
+	})
+	if err != nil || len(entries) != 2 {
+		t.Fatalf("PrepareEntries() = %d entries, %v", len(entries), err)
+	}
+	if want := svc.now().Add(alertOutboxHold); !entries[0].NextAttemptAt.Equal(want) {
+		t.Fatalf("next attempt = %v, want held until %v", entries[0].NextAttemptAt, want)
+	}
+	// alert 저장 트랜잭션에서 기록되는 것과 같은 상태
+	var ids []int64
+	for _, entry := range entries {
+		id, _ := store.InsertNotificationOutboxEntry(ctx, entry)
+		ids = append(ids, id)
+	}
+
+	if svc.ProcessNext(ctx) {
+		t.Fatalf("expected held entries not to be claimed before release")
+	}
+	svc.Release(ctx, ids[:1])
+	svc.Cancel(ctx, ids[1:], "alert is flapping")
+	for svc.ProcessNext(ctx) {
+	}
+
+	if store.entries[1].Status != model.NotificationOutboxStatusDelivered || len(deliverer.calls) != 1 {
+		t.Fatalf("released entry status = %s, deliveries = %d", store.entries[1].Status, len(deliverer.calls))
+	}
+	if e := store.entries[2]; e.Status != model.NotificationOutboxStatusDiscarded || e.LastError != "alert is flapping" {
+		t.Fatalf("cancelled entry = %+v, want discarded", e)
+	}
+}
+
+func TestNotificationOutbox_RetryAndDiscard(t *testing.T) {
+	store := newNotificationOutboxStoreMock()
+	svc := newTestNotificationOutboxService(store, &outboxDelivererMock{})
+	ctx := context.Background()
+
+	_ = svc.Deliver(firingOutboxEvent(), client.OutboxTarget{Mode: model.NotificationOutboxModeNotify}, nil)
+	svc.ProcessNext(ctx)
+	store.entries[2] = &model.NotificationOutboxEntry{ID: 2, Status: model.NotificationOutboxStatusDead, Attempts: 3}
+	store.nextID = 2
+
+	if err := svc.Retry(ctx, 1); !errors.Is(err, ErrNotificationOutboxConflict) {
+		t.Fatalf("Retry(delivered) error = %v, want conflict", err)
+	}
+	if err := svc.Discard(ctx, 1); !errors.Is(err, ErrNotificationOutboxConflict) {
+		t.Fatalf("Discard(delivered) error = %v, want conflict", err)
+	}
+	if err := svc.Retry(ctx, 99); !errors.Is(err, ErrNotificationOutboxNotFound) {
+		t.Fatalf("Retry(missing) error = %v, want not found", err)
+	}
+
+	if err := svc.Retry(ctx, 2); err != nil {
+		t.Fatalf("Retry(dead) error = %v", err)
+	}
+	if e := store.entries[2]; e.Status != model.NotificationOutboxStatusPending || e.Attempts != 0 {
+		t.Fatalf("after retry: status = %s, attempts = %d", e.Status, e.Attempts)
+	}
+	if err := svc.Discard(ctx, 2); err != nil {
+		t.Fatalf("Discard(pending) error = %v", err)
+	}
+	if store.entries[2].Status != model.NotificationOutboxStatusDiscarded {
+		t.Fatalf("status = %s, want discarded", store.entries[2].Status)
+	}
+
+	if _, err := svc.List(ctx, "bogus", 0); err == nil {
+		t.Fatalf("expected invalid status error")
+	}
+}
@@ -0,0 +1,532 @@
+// On-call 스케줄/로테이션 관리 및 현재 on-call 계산 로직
+//
+// 처리 흐름:
+//  1. 관리자가 타임존 + 라벨 범위 + 로테이션 레이어(daily/weekly, 사용자 순서, 시작일, 교대 시각, 적용 요일/시간대)로 스케줄 등록
+//  2. 현재 on-call 계산: 진행 중인 override → 뒤쪽 레이어부터 적용 중인 레이어의 교대 차례 순으로 결정
+//  3. AlertService가 웹훅 처리 시 alert 라벨에 가장 구체적으로 매칭되는 스케줄의 현재 on-call을 계산
+//     - 새 Incident 생성 시 incidents.oncall_user에 기록 (발생 시점 기준, 이후 교대와 무관)
+//     - mention_in_slack이면 firing 알림에 Slack 사용자 ID(users.slack_user_id)로 멘션
+//  4. GET /oncall/now는 활성화된 모든 스케줄의 현재 on-call과 다음 교대 시각을 반환
+//
+// 교대는 스케줄 타임존의 달력 날짜 기준으로 계산하므로 DST 전환일에도 교대 시각(HH:MM)이 유지된다.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+const (
+	maxOncallLayers          = 10
+	defaultOncallHandoffTime = "09:00"
+)
+
+var (
+	ErrInvalidOncallSchedule  = errors.New("invalid oncall schedule")
+	ErrOncallScheduleNotFound = errors.New("oncall schedule not found")
+	ErrInvalidOncallOverride  = errors.New("invalid oncall override")
+	ErrOncallOverrideNotFound = errors.New("oncall override not found")
+	ErrOncallUserNotFound     = errors.New("user not found")
+	ErrInvalidSlackUserID     = errors.New("invalid slack user id")
+)
+
+// slackUserIDPattern - Slack 사용자 ID 형식 (U..., 엔터프라이즈 그리드는 W...)
+var slackUserIDPattern = regexp.MustCompile(`^[UW][A-Z0-9]{2,}$`)
+
+var oncallWeekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// oncallRepo - OncallService가 사용하는 DB 인터페이스
+type oncallRepo interface {
+	ListOncallSchedules(ctx context.Context) ([]model.OncallSchedule, error)
+	ListEnabledOncallSchedules() ([]model.OncallSchedule, error)
+	GetOncallSchedule(ctx context.Context, id int64) (*model.OncallSchedule, error)
+	CreateOncallSchedule(ctx context.Context, s model.OncallSchedule) (int64, error)
+	UpdateOncallSchedule(ctx context.Context, id int64, s model.OncallSchedule) error
+	DeleteOncallSchedule(ctx context.Context, id int64) error
+	ListOncallOverrides(ctx context.Context, scheduleID int64, since time.Time) ([]model.OncallOverride, error)
+	ListActiveOncallOverrides(at time.Time) ([]model.OncallOverride, error)
+	CreateOncallOverride(ctx context.Context, o model.OncallOverride) (int64, error)
+	DeleteOncallOverride(ctx context.Context, scheduleID, id int64) (bool, error)
+	ListOncallUsers(ctx context.Context) ([]model.OncallUser, error)
+	ListUserSlackIDs() (map[string]string, error)
+	UpdateUserSlackID(ctx context.Context, loginID, slackUserID string) (bool, error)
+}
+
+// OncallService - on-call 스케줄/override CRUD, 사용자 Slack ID 매핑, 현재 on-call 조회
+type OncallService struct {
+	db  oncallRepo
+	now func() time.Time
+}
+
+func NewOncallService(db oncallRepo) *OncallService {
+	return &OncallService{db: db, now: time.Now}
+}
+
+// List - 전체 스케줄 조회
+func (s *OncallService) List(ctx context.Context) ([]model.OncallSchedule, error) {
+	return s.db.ListOncallSchedules(ctx)
+}
+
+// Get - 단건 조회 (없으면 nil)
+func (s *OncallService) Get(ctx context.Context, id int64) (*model.OncallSchedule, error) {
+	return s.db.GetOncallSchedule(ctx, id)
+}
+
+// Create - 스케줄 생성 (createdBy: 로그인 사용자 ID)
+func (s *OncallService) Create(ctx context.Context, req model.OncallScheduleRequest, createdBy string) (int64, error) {
+	schedule, err := s.buildSchedule(req)
+	if err != nil {
+		return 0, err
+	}
+	schedule.CreatedBy = createdBy
+	return s.db.CreateOncallSchedule(ctx, schedule)
+}
+
+// Update - 스케줄 수정
+func (s *OncallService) Update(ctx context.Context, id int64, req model.OncallScheduleRequest) error {
+	if err := s.requireSchedule(ctx, id); err != nil {
+		return err
+	}
+	schedule, err := s.buildSchedule(req)
+	if err != nil {
+		return err
+	}
+	return s.db.UpdateOncallSchedule(ctx, id, schedule)
+}
+
+// Delete - 스케줄 삭제
+func (s *OncallService) Delete(ctx context.Context, id int64) error {
+	if err := s.requireSchedule(ctx, id); err != nil {
+		return err
+	}
+	return s.db.DeleteOncallSchedule(ctx, id)
+}
+
+// ListOverrides - 스케줄의 진행 중/예정 override
+func (s *OncallService) ListOverrides(ctx context.Context, scheduleID int64) ([]model.OncallOverride, error) {
+	if err := s.requireSchedule(ctx, scheduleID); err != nil {
+		return nil, err
+	}
+	return s.db.ListOncallOverrides(ctx, scheduleID, s.now())
+}
+
+// CreateOverride - override 생성 (start_at 생략 시 지금부터)
+func (s *OncallService) CreateOverride(ctx context.Context, scheduleID int64, req model.OncallOverrideRequest, createdBy string) (int64, error) {
+	if err := s.requireSchedule(ctx, scheduleID); err != nil {
+		return 0, err
+	}
+	now := s.now()
+	o := model.OncallOverride{
+		ScheduleID: scheduleID,
+		User:       strings.TrimSpace(req.User),
+		StartAt:    req.StartAt,
+		EndAt:      req.EndAt,
+		Reason:     strings.TrimSpace(req.Reason),
+		CreatedBy:  createdBy,
+	}
+	if o.StartAt.IsZero() {
+		o.StartAt = now
+	}
+	if o.User == "" {
+		return 0, fmt.Errorf("%w: user is required", ErrInvalidOncallOverride)
+	}
+	if !o.EndAt.After(o.StartAt) {
+		return 0, fmt.Errorf("%w: end_at must be after start_at", ErrInvalidOncallOverride)
+	}
+	if !o.EndAt.After(now) {
+		return 0, fmt.Errorf("%w: end_at must be in the future", ErrInvalidOncallOverride)
+	}
+	if err := s.requireUsers(o.User); err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidOncallOverride, err)
+	}
+	return s.db.CreateOncallOverride(ctx, o)
+}
+
+// DeleteOverride - override 삭제
+func (s *OncallService) DeleteOverride(ctx context.Context, scheduleID, id int64) error {
+	deleted, err := s.db.DeleteOncallOverride(ctx, scheduleID, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return fmt.Errorf("%w: schedule_id=%d, id=%d", ErrOncallOverrideNotFound, scheduleID, id)
+	}
+	return nil
+}
+
+// Now - 활성화된 스케줄별 현재 on-call (on-call이 없는 스케줄은 source=none)
+func (s *OncallService) Now(ctx context.Context) ([]model.OncallShift, error) {
+	schedules, err := s.db.ListEnabledOncallSchedules()
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	overrides, err := s.db.ListActiveOncallOverrides(now)
+	if err != nil {
+		return nil, err
+	}
+	slackIDs, err := s.db.ListUserSlackIDs()
+	if err != nil {
+		return nil, err
+	}
+	shifts := make([]model.OncallShift, 0, len(schedules))
+	for _, schedule := range schedules {
+		shifts = append(shifts, currentOncall(schedule, overrides, slackIDs, now))
+	}
+	return shifts, nil
+}
+
+// ListUsers - 사용자별 Slack ID 매핑 조회
+func (s *OncallService) ListUsers(ctx context.Context) ([]model.OncallUser, error) {
+	return s.db.ListOncallUsers(ctx)
+}
+
+// SetUserSlackID - 사용자 Slack ID 설정 (빈 값이면 매핑 해제)
+func (s *OncallService) SetUserSlackID(ctx context.Context, loginID, slackUserID string) error {
+	slackUserID = strings.TrimSpace(slackUserID)
+	if slackUserID != "" && !slackUserIDPattern.MatchString(slackUserID) {
+		return fmt.Errorf("%w: %q (expected U... or W...)", ErrInvalidSlackUserID, slackUserID)
+	}
+	updated, err := s.db.UpdateUserSlackID(ctx, loginID, slackUserID)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return fmt.Errorf("%w: %s", ErrOncallUserNotFound, loginID)
+	}
+	return nil
+}
+
+func (s *OncallService) requireSchedule(ctx context.Context, id int64) error {
+	existing, err := s.db.GetOncallSchedule(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return fmt.Errorf("%w: id=%d", ErrOncallScheduleNotFound, id)
+	}
+	return nil
+}
+
+// requireUsers - login ID가 모두 등록된 사용자인지 확인
+func (s *OncallService) requireUsers(loginIDs ...string) error {
+	known, err := s.db.ListUserSlackIDs()
+	if err != nil {
+		return err
+	}
+	for _, loginID := range loginIDs {
+		if _, ok := known[loginID]; !ok {
+			return fmt.Errorf("unknown user %q", loginID)
+		}
+	}
+	return nil
+}
+
+// buildSchedule - 요청 검증 + 레이어 사용자 존재 확인
+func (s *OncallService) buildSchedule(req model.OncallScheduleRequest) (model.OncallSchedule, error) {
+	schedule, err := buildOncallSchedule(req)
+	if err != nil {
+		return schedule, err
+	}
+	var users []string
+	for _, layer := range schedule.Layers {
+		users = append(users, layer.Users...)
+	}
+	if err := s.requireUsers(users...); err != nil {
+		return schedule, fmt.Errorf("%w: %v", ErrInvalidOncallSchedule, err)
+	}
+	return schedule, nil
+}
+
+// buildOncallSchedule - 요청 검증 및 정규화
+func buildOncallSchedule(req model.OncallScheduleRequest) (model.OncallSchedule, error) {
+	schedule := model.OncallSchedule{
+		Name:           strings.TrimSpace(req.Name),
+		TimeZone:       strings.TrimSpace(req.TimeZone),
+		MentionInSlack: req.MentionInSlack,
+		Enabled:        req.Enabled == nil || *req.Enabled,
+		Comment:        strings.TrimSpace(req.Comment),
+		Matchers:       model.LabelMatchers{},
+	}
+	if schedule.Name == "" {
+		return schedule, fmt.Errorf("%w: name is required", ErrInvalidOncallSchedule)
+	}
+	if schedule.TimeZone == "" {
+		schedule.TimeZone = "UTC"
+	}
+	if _, err := time.LoadLocation(schedule.TimeZone); err != nil {
+		return schedule, fmt.Errorf("%w: unknown time_zone %q", ErrInvalidOncallSchedule, schedule.TimeZone)
+	}
+	for _, m := range req.Matchers {
+		m.Name = strings.TrimSpace(m.Name)
+		schedule.Matchers = append(schedule.Matchers, m)
+	}
+	if len(schedule.Matchers) > 0 {
+		if err := schedule.Matchers.Validate(); err != nil {
+			return schedule, fmt.Errorf("%w: matchers: %v", ErrInvalidOncallSchedule, err)
+		}
+	}
+
+	if len(req.Layers) == 0 || len(req.Layers) > maxOncallLayers {
+		return schedule, fmt.Errorf("%w: between 1 and %d layers are required", ErrInvalidOncallSchedule, maxOncallLayers)
+	}
+	for i, layer := range req.Layers {
+		normalized, err := normalizeOncallLayer(layer)
+		if err != nil {
+			return schedule, fmt.Errorf("%w: layer %d: %v", ErrInvalidOncallSchedule, i+1, err)
+		}
+		if normalized.Name == "" {
+			normalized.Name = fmt.Sprintf("Layer %d", i+1)
+		}
+		schedule.Layers = append(schedule.Layers, normalized)
+	}
+	return schedule, nil
+}
+
+// normalizeOncallLayer - 레이어 필드 검증 및 정규화
+func normalizeOncallLayer(layer model.OncallLayer) (model.OncallLayer, error) {
+	out := model.OncallLayer{
+		Name:        strings.TrimSpace(layer.Name),
+		Rotation:    strings.ToLower(strings.TrimSpace(layer.Rotation)),
+		StartDate:   strings.TrimSpace(layer.StartDate),
+		HandoffTime: strings.TrimSpace(layer.HandoffTime),
+		ActiveFrom:  strings.TrimSpace(layer.ActiveFrom),
+		ActiveTo:    strings.TrimSpace(layer.ActiveTo),
+	}
+	if out.Rotation != model.OncallRotationDaily && out.Rotation != model.OncallRotationWeekly {
+		return out, fmt.Errorf("rotation must be daily or weekly")
+	}
+	for _, user := range layer.Users {
+		if user = strings.TrimSpace(user); user != "" {
+			out.Users = append(out.Users, user)
+		}
+	}
+	if len(out.Users) == 0 {
+		return out, fmt.Errorf("at least one user is required")
+	}
+	if _, err := time.Parse("2006-01-02", out.StartDate); err != nil {
+		return out, fmt.Errorf("start_date must be YYYY-MM-DD")
+	}
+	if out.HandoffTime == "" {
+		out.HandoffTime = defaultOncallHandoffTime
+	}
+	if _, ok := parseClockMinutes(out.HandoffTime); !ok {
+		return out, fmt.Errorf("handoff_time must be HH:MM")
+	}
+	for _, day := range layer.Weekdays {
+		day = strings.ToLower(strings.TrimSpace(day))
+		if _, ok := oncallWeekdays[day]; !ok {
+			return out, fmt.Errorf("unknown weekday %q (mon..sun)", day)
+		}
+		out.Weekdays = append(out.Weekdays, day)
+	}
+	if (out.ActiveFrom == "") != (out.ActiveTo == "") {
+		return out, fmt.Errorf("active_from and active_to must be set together")
+	}
+	if out.ActiveFrom != "" {
+		from, okFrom := parseClockMinutes(out.ActiveFrom)
+		to, okTo := parseClockMinutes(out.ActiveTo)
+		if !okFrom || !okTo {
+			return out, fmt.Errorf("active_from/active_to must be HH:MM")
+		}
+		if from == to {
+			return out, fmt.Errorf("active_from and active_to must differ (omit both for all day)")
+		}
+	}
+	return out, nil
+}
+
+// parseClockMinutes - "HH:MM" → 자정부터의 분
+func parseClockMinutes(value string) (int, bool) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, false
+	}
+	return t.Hour()*60 + t.Minute(), true
+}
+
+// civilDays - 두 시각의 달력 날짜 차이 (각 시각의 타임존 기준)
+func civilDays(from, to time.Time) int {
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
+
+// oncallLayerAt - 레이어의 지정 시각 근무자와 다음 교대 시각 (시작 전이거나 적용 요일/시간대 밖이면 ok=false)
+func oncallLayerAt(layer model.OncallLayer, loc *time.Location, at time.Time) (string, time.Time, bool) {
+	start, err := time.ParseInLocation("2006-01-02", layer.StartDate, loc)
+	if err != nil || len(layer.Users) == 0 {
+		return "", time.Time{}, false
+	}
+	handoff, ok := parseClockMinutes(layer.HandoffTime)
+	if !ok {
+		handoff, _ = parseClockMinutes(defaultOncallHandoffTime)
+	}
+	local := at.In(loc)
+
+	// 현재 근무가 시작된 교대 시각 (오늘 교대 시각 이전이면 전날)
+	boundary := time.Date(local.Year(), local.Month(), local.Day(), handoff/60, handoff%60, 0, 0, loc)
+	if local.Before(boundary) {
+		boundary = time.Date(local.Year(), local.Month(), local.Day()-1, handoff/60, handoff%60, 0, 0, loc)
+	}
+	days := civilDays(start, boundary)
+	if days < 0 {
+		return "", time.Time{}, false
+	}
+	length := 1
+	if layer.Rotation == model.OncallRotationWeekly {
+		length = 7
+	}
+	shift := days / length
+	next := time.Date(start.Year(), start.Month(), start.Day()+(shift+1)*length, handoff/60, handoff%60, 0, 0, loc)
+	user := layer.Users[shift%len(layer.Users)]
+	if !oncallLayerActive(layer, local) {
+		return "", next, false
+	}
+	return user, next, true
+}
+
+// oncallLayerActive - 레이어 적용 요일/시간대 확인 (자정을 넘기는 시간대는 시작한 날의 요일 기준)
+func oncallLayerActive(layer model.OncallLayer, local time.Time) bool {
+	dayAllowed := func(day time.Weekday) bool {
+		if len(layer.Weekdays) == 0 {
+			return true
+		}
+		for _, d := range layer.Weekdays {
+			if oncallWeekdays[d] == day {
+				return true
+			}
+		}
+		return false
+	}
+	if layer.ActiveFrom == "" {
+		return dayAllowed(local.Weekday())
+	}
+	from, _ := parseClockMinutes(layer.ActiveFrom)
+	to, _ := parseClockMinutes(layer.ActiveTo)
+	now := local.Hour()*60 + local.Minute()
+	if from < to {
+		return now >= from && now < to && dayAllowed(local.Weekday())
+	}
+	if now >= from {
+		return dayAllowed(local.Weekday())
+	}
+	if now < to {
+		return dayAllowed((local.Weekday() + 6) % 7)
+	}
+	return false
+}
+
+// currentOncall - 스케줄의 지정 시각 on-call (override → 뒤쪽 레이어 순)
+// overrides는 여러 스케줄의 진행 중인 override를 생성순으로 담고 있으며, 겹치면 나중에 생성된 override가 우선한다.
+func currentOncall(schedule model.OncallSchedule, overrides []model.OncallOverride, slackIDs map[string]string, at time.Time) model.OncallShift {
+	shift := model.OncallShift{
+		ScheduleID:     schedule.ID,
+		ScheduleName:   schedule.Name,
+		Source:         model.OncallSourceNone,
+		MentionInSlack: schedule.MentionInSlack,
+	}
+
+	var override *model.OncallOverride
+	for i := range overrides {
+		o := &overrides[i]
+		if o.ScheduleID == schedule.ID && !at.Before(o.StartAt) && at.Before(o.EndAt) {
+			override = o
+		}
+	}
+	if override != nil {
+		id, until := override.ID, override.EndAt
+		shift.User, shift.Source, shift.OverrideID, shift.Until = override.User, model.OncallSourceOverride, &id, &until
+	} else {
+		loc, err := time.LoadLocation(schedule.TimeZone)
+		if err != nil {
+			loc = time.UTC
+		}
+		for i := len(schedule.Layers) - 1; i >= 0; i-- {
+			user, next, ok := oncallLayerAt(schedule.Layers[i], loc, at)
+			if !ok {
+				continue
+			}
+			shift.User, shift.Source, shift.LayerName, shift.Until = user, model.OncallSourceLayer, schedule.Layers[i].Name, &next
+			break
+		}
+	}
+	shift.SlackUserID = slackIDs[shift.User]
+	return shift
+}
+
+// matchOncallSchedule - 라벨에 매칭되는 가장 구체적인 스케줄 (없으면 nil, 매처가 비어 있으면 모든 라벨에 매칭)
+func matchOncallSchedule(schedules []model.OncallSchedule, labels map[string]string) *model.OncallSchedule {
+	var best *model.OncallSchedule
+	for i := range schedules {
+		s := &schedules[i]
+		if !s.Enabled || (len(s.Matchers) > 0 && !s.Matchers.Matches(labels)) {
+			continue
+		}
+		if best == nil || moreSpecificOncallSchedule(s, best) {
+			best = s
+		}
+	}
+	return best
+}
+
+func moreSpecificOncallSchedule(a, b *model.OncallSchedule) bool {
+	if len(a.Matchers) != len(b.Matchers) {
+		return len(a.Matchers) > len(b.Matchers)
+	}
+	if ea, eb := exactMatcherCount(a.Matchers), exactMatcherCount(b.Matchers); ea != eb {
+		return ea > eb
+	}
+	return a.ID < b.ID
+}
+
+// oncallRoster - 웹훅 처리 시점의 on-call 스케줄/override/Slack ID 스냅샷
+type oncallRoster struct {
+	schedules []model.OncallSchedule
+	overrides []model.OncallOverride
+	slackIDs  map[string]string
+	at        time.Time
+}
+
+// current - 라벨에 매칭되는 스케줄의 현재 on-call (매칭 스케줄이 없으면 nil)
+func (r oncallRoster) current(labels map[string]string) *model.OncallShift {
+	schedule := matchOncallSchedule(r.schedules, labels)
+	if schedule == nil {
+		return nil
+	}
+	shift := currentOncall(*schedule, r.overrides, r.slackIDs, r.at)
+	return &shift
+}
+
+// loadOncallRoster - 활성화된 on-call 스케줄 스냅샷 (스케줄이 없거나 조회 실패 시 빈 스냅샷)
+func (s *AlertService) loadOncallRoster() oncallRoster {
+	now := time.Now()
+	schedules, err := s.db.ListEnabledOncallSchedules()
+	if err != nil {
+		log.Printf("Failed to load oncall schedules: %v", err)
+		return oncallRoster{}
+	}
+	if len(schedules) == 0 {
+		return oncallRoster{}
+	}
+	overrides, err := s.db.ListActiveOncallOverrides(now)
+	if err != nil {
+		log.Printf("Failed to load oncall overrides: %v", err)
+	}
+	slackIDs, err := s.db.ListUserSlackIDs()
+	if err != nil {
+		log.Printf("Failed to load user slack ids: %v", err)
+	}
+	return oncallRoster{schedules: schedules, overrides: overrides, slackIDs: slackIDs, at: now}
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kube-rca/backend/internal/client"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+func TestBuildOncallSchedule_Validation(t *testing.T) {
+	valid := model.OncallLayer{Rotation: "weekly", Users: []string{"alice"}, StartDate: "2026-01-05"}
+	tests := []struct {
+		name    string
+		req     model.OncallScheduleRequest
+		wantErr bool
+	}{
+		{name: "valid", req: model.OncallScheduleRequest{Name: "primary", Layers: []model.OncallLayer{valid}}},
+		{name: "missing name", req: model.OncallScheduleRequest{Layers: []model.OncallLayer{valid}}, wantErr: true},
+		{name: "no layers", req: model.OncallScheduleRequest{Name: "primary"}, wantErr: true},
+		{name: "unknown time zone", req: model.OncallScheduleRequest{Name: "primary", TimeZone: "Mars/Base", Layers: []model.OncallLayer{valid}}, wantErr: true},
+		{name: "unknown rotation", req: model.OncallScheduleRequest{Name: "primary", Layers: []model.OncallLayer{{Rotation: "monthly", Users: []string{"alice"}, StartDate: "2026-01-05"}}}, wantErr: true},
+		{name: "bad start date", req: model.OncallScheduleRequest{Name: "primary", Layers: []model.OncallLayer{{Rotation: "daily", Users: []string{"alice"}, StartDate: "01/05/2026"}}}, wantErr: true},
+		{name: "unknown weekday", req: model.OncallScheduleRequest{Name: "primary", Layers: []model.OncallLayer{{Rotation: "daily", Users: []string{"alice"}, StartDate: "2026-01-05", Weekdays: []string{"funday"}}}}, wantErr: true},
+		{name: "half open window", req: model.OncallScheduleRequest{Name: "primary", Layers: []model.OncallLayer{{Rotation: "daily", Users: []string{"alice"}, StartDate: "2026-01-05", ActiveFrom: "09:00"}}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := buildOncallSchedule(tt.req)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidOncallSchedule) {
+					t.Fatalf("err = %v; want ErrInvalidOncallSchedule", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if schedule.TimeZone != "UTC" || !schedule.Enabled {
+				t.Fatalf("defaults = tz %q enabled %v; want UTC, true", schedule.TimeZone, schedule.Enabled)
+			}
+			if layer := schedule.Layers[0]; layer.HandoffTime != "09:00" || layer.Name != "Layer 1" {
+				t.Fatalf("layer defaults = %+v; want handoff 09:00 and name Layer 1", layer)
+			}
+		})
+	}
+}
+
+func TestOncallLayerAt_Rotations(t *testing.T) {
+	seoul, _ := time.LoadLocation("Asia/Seoul")
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("tzdata not available: %v", err)
+	}
+	daily := model.OncallLayer{Rotation: "daily", Users: []string{"alice", "bob", "carol"}, StartDate: "2026-03-02", HandoffTime: "09:00"}
+	weekly := model.OncallLayer{Rotation: "weekly", Users: []string{"alice", "bob"}, StartDate: "2026-03-02", HandoffTime: "09:00"}
+
+	tests := []struct {
+		name     string
+		layer    model.OncallLayer
+		loc      *time.Location
+		at       time.Time
+		wantUser string
+		wantNext time.Time
+		wantOK   bool
+	}{
+		{name: "before start", layer: daily, loc: seoul, at: time.Date(2026, 3, 2, 8, 59, 0, 0, seoul)},
+		{name: "first shift", layer: daily, loc: seoul, at: time.Date(2026, 3, 2, 9, 0, 0, 0, seoul), wantUser: "alice", wantNext: time.Date(2026, 3, 3, 9, 0, 0, 0, seoul), wantOK: true},
+		{name: "before handoff keeps previous user", layer: daily, loc: seoul, at: time.Date(2026, 3, 3, 8, 30, 0, 0, seoul), wantUser: "alice", wantNext: time.Date(2026, 3, 3, 9, 0, 0, 0, seoul), wantOK: true},
+		{name: "daily wraps around", layer: daily, loc: seoul, at: time.Date(2026, 3, 5, 10, 0, 0, 0, seoul), wantUser: "alice", wantNext: time.Date(2026, 3, 6, 9, 0, 0, 0, seoul), wantOK: true},
+		{name: "second week", layer: weekly, loc: seoul, at: time.Date(2026, 3, 12, 12, 0, 0, 0, seoul), wantUser: "bob", wantNext: time.Date(2026, 3, 16, 9, 0, 0, 0, seoul), wantOK: true},
+		// 2026-03-08 미국 서머타임 시작: 교대 시각은 현지 09:00 유지
+		{name: "dst keeps local handoff", layer: daily, loc: newYork, at: time.Date(2026, 3, 8, 9, 0, 0, 0, newYork), wantUser: "alice", wantNext: time.Date(2026, 3, 9, 9, 0, 0, 0, newYork), wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, next, ok := oncallLayerAt(tt.layer, tt.loc, tt.at)
+			if ok != tt.wantOK || user != tt.wantUser {
+				t.Fatalf("oncallLayerAt() = %q, %v; want %q, %v", user, ok, tt.wantUser, tt.wantOK)
+			}
+			if ok && !next.Equal(tt.wantNext) {
+				t.Fatalf("next handoff = %v; want %v", next, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestCurrentOncall_OverrideAndLayerPrecedence(t *testing.T) {
+	schedule := model.OncallSchedule{
+		ID:       1,
+		Name:     "primary",
+		TimeZone: "UTC",
+		Layers: []model.OncallLayer{
+			{Name: "24/7", Rotation: "weekly", Users: []string{"alice"}, StartDate: "2026-01-05", HandoffTime: "09:00"},
+			{Name: "business", Rotation: "daily", Users: []string{"bob"}, StartDate: "2026-01-05", HandoffTime: "09:00", Weekdays: []string{"mon", "tue", "wed", "thu", "fri"}, ActiveFrom: "09:00", ActiveTo: "18:00"},
+			{Name: "night", Rotation: "daily", Users: []string{"carol"}, StartDate: "2026-01-05", HandoffTime: "09:00", Weekdays: []string{"fri"}, ActiveFrom: "22:00", ActiveTo: "06:00"},
+		},
+	}
+	overrides := []model.OncallOverride{
+		{ID: 7, ScheduleID: 2, User: "mallory", StartAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), EndAt: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 8, ScheduleID: 1, User: "dave", StartAt: time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC), EndAt: time.Date(2026, 2, 11, 0, 0, 0, 0, time.UTC)},
+		{ID: 9, ScheduleID: 1, User: "erin", StartAt: time.Date(2026, 2, 10, 12, 0, 0, 0, time.UTC), EndAt: time.Date(2026, 2, 10, 13, 0, 0, 0, time.UTC)},
+	}
+	slackIDs := map[string]string{"bob": "U0BOB"}
+
+	tests := []struct {
+		name       string
+		at         time.Time
+		wantUser   string
+		wantSource string
+		wantLayer  string
+	}{
+		{name: "business hours layer", at: time.Date(2026, 2, 9, 10, 0, 0, 0, time.UTC), wantUser: "bob", wantSource: model.OncallSourceLayer, wantLayer: "business"},
+		{name: "evening falls back to 24/7", at: time.Date(2026, 2, 9, 19, 0, 0, 0, time.UTC), wantUser: "alice", wantSource: model.OncallSourceLayer, wantLayer: "24/7"},
+		{name: "overnight window after midnight", at: time.Date(2026, 2, 14, 3, 0, 0, 0, time.UTC), wantUser: "carol", wantSource: model.OncallSourceLayer, wantLayer: "night"},
+		{name: "override beats layers", at: time.Date(2026, 2, 10, 10, 0, 0, 0, time.UTC), wantUser: "dave", wantSource: model.OncallSourceOverride},
+		{name: "later override wins", at: time.Date(2026, 2, 10, 12, 30, 0, 0, time.UTC), wantUser: "erin", wantSource: model.OncallSourceOverride},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shift := currentOncall(schedule, overrides, slackIDs, tt.at)
+			if shift.User != tt.wantUser || shift.Source != tt.wantSource || shift.LayerName != tt.wantLayer {
+				t.Fatalf("shift = %+v; want user %q source %q layer %q", shift, tt.wantUser, tt.wantSource, tt.wantLayer)
+			}
+			if want := slackIDs[tt.wantUser]; shift.SlackUserID != want {
+				t.Fatalf("slack user id = %q; want %q", shift.SlackUserID, want)
+			}
+		})
+	}
+}
+
+func TestProcessWebhook_RecordsOncallAndMentions(t *testing.T) {
+	store := newAlertStoreMock()
+	store.saveAlertResults = []saveAlertResult{{AlertID: "ALR-oncall"}}
+	store.oncallSchedules = []model.OncallSchedule{
+		{ID: 1, Name: "catch-all", TimeZone: "UTC", Enabled: true, Layers: []model.OncallLayer{
+			{Name: "default", Rotation: "daily", Users: []string{"alice"}, StartDate: "2020-01-01", HandoffTime: "09:00"},
+		}},
+		{ID: 2, Name: "payments", TimeZone: "UTC", Enabled: true, MentionInSlack: true,
+			Matchers: model.LabelMatchers{{Name: "team", Value: "payments", IsEqual: true}},
+			Layers: []model.OncallLayer{
+				{Name: "default", Rotation: "weekly", Users: []string{"bob"}, StartDate: "2020-01-01", HandoffTime: "09:00"},
+			}},
+	}
+	store.userSlackIDs = map[string]string{"bob": "U0BOB"}
+	notif := newNotifierMock()
+	svc := newTestAlertService(store, notif, &analyzerMock{})
+
+	alert := makeAlert("fp-oncall", "firing", "critical")
+	alert.Labels["team"] = "payments"
+	svc.ProcessWebhook(makeWebhook(alert))
+
+	if user := store.incidentOncall["INC-test0001"]; user != "bob" {
+		t.Fatalf("incident on-call = %q; want bob", user)
+	}
+	if len(notif.events) == 0 {
+		t.Fatal("expected a notification")
+	}
+	event, ok := notif.events[0].(client.AlertStatusChangedEvent)
+	if !ok || event.Alert.OncallSlackUserID != "U0BOB" {
+		t.Fatalf("event = %+v; want firing alert mentioning U0BOB", notif.events[0])
+	}
+}
@@ -0,0 +1,197 @@
+// 예약 작업(scheduled_jobs) 처리 로직
+//
+// 처리 흐름:
+//  1. 서비스가 Schedule로 job_type/job_key/실행 시각/payload를 DB에 저장 (같은 key의 pending 작업은 갱신)
+//  2. worker가 poll 주기마다 실행 시각이 지난 작업을 FOR UPDATE SKIP LOCKED로 점유 (다중 replica 안전)
+//  3. job_type별 등록된 handler 실행
+//  4. 성공: done / 실패: 고정 백오프 후 재시도, 최대 시도 횟수 초과 시 failed
+//  5. running 상태로 오래 남은 작업(Pod 종료)은 stale lock 이후 다시 점유
+//  6. done/cancelled 작업은 보관 기간(RetentionDays) 후 삭제 (failed는 확인을 위해 유지)
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// scheduledJobStore - ScheduledJobService가 사용하는 DB 인터페이스
+type scheduledJobStore interface {
+	ScheduleJob(ctx context.Context, jobType, jobKey string, payload json.RawMessage, runAt time.Time) (int64, error)
+	ClaimScheduledJobs(ctx context.Context, limit int, staleAfter time.Duration) ([]model.ScheduledJob, error)
+	CompleteScheduledJob(ctx context.Context, id int64) error
+	FailScheduledJob(ctx context.Context, id int64, errMsg string, nextRunAt time.Time, dead bool) error
+	CancelScheduledJobs(ctx context.Context, jobType, jobKey string) (int64, error)
+	ListScheduledJobs(ctx context.Context, jobType, status string, limit int) ([]model.ScheduledJob, error)
+	PurgeCompletedScheduledJobs(ctx context.Context, before time.Time) (int64, error)
+}
+
+// ScheduledJobFunc - job_type별 실행 함수 (error 반환 시 재시도)
+type ScheduledJobFunc func(ctx context.Context, job model.ScheduledJob) error
+
+// ScheduledJobService - 재시작/다중 replica에서도 유지되는 지연 작업 스케줄러
+type ScheduledJobService struct {
+	store    scheduledJobStore
+	cfg      config.ScheduledJobConfig
+	handlers map[string]ScheduledJobFunc
+	now      func() time.Time
+}
+
+func NewScheduledJobService(store scheduledJobStore, cfg config.ScheduledJobConfig) *ScheduledJobService {
+	if cfg.PollIntervalSecs <= 0 {
+		cfg.PollIntervalSecs = 5
+	}
+	if cfg.StaleLockSeconds <= 0 {
+		cfg.StaleLockSeconds = 300
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 1
+	}
+	return &ScheduledJobService{
+		store:    store,
+		cfg:      cfg,
+		handlers: make(map[string]ScheduledJobFunc),
+		now:      time.Now,
+	}
+}
+
+// Register - job_type 실행 함수 등록 (Start 이전에 호출)
+func (s *ScheduledJobService) Register(jobType string, handler ScheduledJobFunc) {
+	s.handlers[jobType] = handler
+}
+
+// Schedule - runAt에 실행할 작업 예약 (같은 종류/key의 pending 작업은 runAt/payload 갱신)
+func (s *ScheduledJobService) Schedule(ctx context.Context, jobType, jobKey string, runAt time.Time, payload any) (int64, error) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal scheduled job payload: %w", err)
+	}
+	return s.store.ScheduleJob(ctx, jobType, jobKey, raw, runAt)
+}
+
+// Cancel - 종류/key가 같은 pending 작업 취소
+func (s *ScheduledJobService) Cancel(ctx context.Context, jobType, jobKey string) (int64, error) {
+	return s.store.CancelScheduledJobs(ctx, jobType, jobKey)
+}
+
+// List - 예약 작업 목록 (status 기본 pending, all이면 전체)
+func (s *ScheduledJobService) List(ctx context.Context, jobType, status string, limit int) ([]model.ScheduledJob, error) {
+	switch status {
+	case "":
+		status = model.ScheduledJobStatusPending
+	case "all":
+		status = ""
+	case model.ScheduledJobStatusPending, model.ScheduledJobStatusRunning, model.ScheduledJobStatusDone,
+		model.ScheduledJobStatusFailed, model.ScheduledJobStatusCancelled:
+	default:
+		return nil, fmt.Errorf("invalid status: %s", status)
+	}
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	return s.store.ListScheduledJobs(ctx, jobType, status, limit)
+}
+
+// Start - worker 시작 (ctx 종료 시 중단)
+func (s *ScheduledJobService) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(time.Duration(s.cfg.PollIntervalSecs) * time.Second)
+		defer ticker.Stop()
+		for {
+			// 실행할 작업이 남아 있는 동안 연속 처리
+			for s.ProcessNext(ctx) {
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	if s.cfg.RetentionDays > 0 {
+		go s.runPurge(ctx)
+	}
+	log.Printf("Scheduled job worker started (poll_interval=%ds, max_attempts=%d, retention_days=%d)", s.cfg.PollIntervalSecs, s.cfg.MaxAttempts, s.cfg.RetentionDays)
+}
+
+func (s *ScheduledJobService) runPurge(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		s.PurgeCompleted(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeCompleted - 보관 기간이 지난 done/cancelled 작업 삭제
+func (s *ScheduledJobService) PurgeCompleted(ctx context.Context) {
+	before := s.now().AddDate(0, 0, -s.cfg.RetentionDays)
+	if purged, err := s.store.PurgeCompletedScheduledJobs(ctx, before); err != nil {
+		log.Printf("Failed to purge scheduled jobs: %v", err)
+	} else if purged > 0 {
+		log.Printf("Purged completed scheduled jobs (count=%d)", purged)
+	}
+}
+
+// ProcessNext - 실행 시각이 지난 작업 하나를 점유하여 실행 (처리한 작업이 있으면 true)
+func (s *ScheduledJobService) ProcessNext(ctx context.Context) bool {
+	jobs, err := s.store.ClaimScheduledJobs(ctx, 1, time.Duration(s.cfg.StaleLockSeconds)*time.Second)
+	if err != nil {
+		log.Printf("Failed to claim scheduled jobs: %v", err)
+		return false
+	}
+	if len(jobs) == 0 {
+		return false
+	}
+	for _, job := range jobs {
+		s.runJob(ctx, job)
+	}
+	return true
+}
+
+func (s *ScheduledJobService) runJob(ctx context.Context, job model.ScheduledJob) {
+	err := s.execute(ctx, job)
+	if err == nil {
+		if err := s.store.CompleteScheduledJob(ctx, job.ID); err != nil {
+			log.Printf("Failed to complete scheduled job (id=%d): %v", job.ID, err)
+		}
+		return
+	}
+
+	dead := job.Attempts >= s.cfg.MaxAttempts
+	nextRunAt := s.now().Add(time.Duration(s.cfg.RetryBackoffSecs) * time.Second)
+	if dead {
+		log.Printf("Scheduled job failed permanently (id=%d, type=%s, attempts=%d): %v", job.ID, job.JobType, job.Attempts, err)
+	} else {
+		log.Printf("Scheduled job failed, retrying at %s (id=%d, type=%s, attempts=%d): %v", nextRunAt.Format(time.RFC3339), job.ID, job.JobType, job.Attempts, err)
+	}
+	if err := s.store.FailScheduledJob(ctx, job.ID, err.Error(), nextRunAt, dead); err != nil {
+		log.Printf("Failed to record scheduled job failure (id=%d): %v", job.ID, err)
+	}
+}
+
+// execute - job_type handler 실행 (panic도 실패로 기록)
+func (s *ScheduledJobService) execute(ctx context.Context, job model.ScheduledJob) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while running scheduled job: %v", r)
+		}
+	}()
+
+	handler, ok := s.handlers[job.JobType]
+	if !ok {
+		return fmt.Errorf("no handler registered for job type: %s", job.JobType)
+	}
+	return handler(ctx, job)
+}
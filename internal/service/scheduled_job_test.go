@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// ============================================================================
+// Mock: scheduledJobStore
+// ============================================================================
+
+type scheduledJobStoreMock struct {
+	jobs     map[int64]*model.ScheduledJob
+	nextID   int64
+	failures []scheduledJobFailure
+	listArgs [2]string // jobType, status
+	purgedAt []time.Time
+}
+
+type scheduledJobFailure struct {
+	ID        int64
+	Err       string
+	NextRunAt time.Time
+	Dead      bool
+}
+
+func newScheduledJobStoreMock() *scheduledJobStoreMock {
+	return &scheduledJobStoreMock{jobs: make(map[int64]*model.ScheduledJob)}
+}
+
+func (m *scheduledJobStoreMock) ScheduleJob(_ context.Context, jobType, jobKey string, payload json.RawMessage, runAt time.Time) (int64, error) {
+	for _, j := range m.jobs {
+		if j.JobType == jobType && j.JobKey == jobKey && j.Status == model.ScheduledJobStatusPending {
+			j.Payload = payload
+			j.RunAt = runAt
+			return j.ID, nil
+		}
+	}
+	m.nextID++
+	m.jobs[m.nextID] = &model.ScheduledJob{
+		ID: m.nextID, JobType: jobType, JobKey: jobKey, Payload: payload, RunAt: runAt,
+		Status: model.ScheduledJobStatusPending,
+	}
+	return m.nextID, nil
+}
+
+func (m *scheduledJobStoreMock) ClaimScheduledJobs(_ context.Context, limit int, _ time.Duration) ([]model.ScheduledJob, error) {
+	var claimed []model.ScheduledJob
+	for id := int64(1); id <= m.nextID && len(claimed) < limit; id++ {
+		j, ok := m.jobs[id]
+		if !ok || j.Status != model.ScheduledJobStatusPending || j.RunAt.After(time.Now()) {
+			continue
+		}
+		j.Status = model.ScheduledJobStatusRunning
+		j.Attempts++
+		claimed = append(claimed, *j)
+	}
+	return claimed, nil
+}
+
+func (m *scheduledJobStoreMock) CompleteScheduledJob(_ context.Context, id int64) error {
+	m.jobs[id].Status = model.ScheduledJobStatusDone
+	return nil
+}
+
+func (m *scheduledJobStoreMock) FailScheduledJob(_ context.Context, id int64, errMsg string, nextRunAt time.Time, dead bool) error {
+	m.failures = append(m.failures, scheduledJobFailure{ID: id, Err: errMsg, NextRunAt: nextRunAt, Dead: dead})
+	j := m.jobs[id]
+	j.LastError = errMsg
+	// 테스트에서는 즉시 재시도 가능하도록 run_at을 과거로 둔다
+	j.RunAt = time.Time{}
+	if dead {
+		j.Status = model.ScheduledJobStatusFailed
+	} else {
+		j.Status = model.ScheduledJobStatusPending
+	}
+	return nil
+}
+
+func (m *scheduledJobStoreMock) CancelScheduledJobs(_ context.Context, jobType, jobKey string) (int64, error) {
+	var n int64
+	for _, j := range m.jobs {
+		if j.JobType == jobType && j.JobKey == jobKey && j.Status == model.ScheduledJobStatusPending {
+			j.Status = model.ScheduledJobStatusCancelled
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (m *scheduledJobStoreMock) ListScheduledJobs(_ context.Context, jobType, status string, _ int) ([]model.ScheduledJob, error) {
+	m.listArgs = [2]string{jobType, status}
+	return nil, nil
+}
+
+func (m *scheduledJobStoreMock) PurgeCompletedScheduledJobs(_ context.Context, before time.Time) (int64, error) {
+	m.purgedAt = append(m.purgedAt, before)
+	var n int64
+	for id, j := range m.jobs {
+		if j.Status == model.ScheduledJobStatusDone || j.Status == model.ScheduledJobStatusCancelled {
+			delete(m.jobs, id)
+			n++
+		}
+	}
+	return n, nil
+}
+
+func newTestScheduledJobService(store *scheduledJobStoreMock, maxAttempts int) *ScheduledJobService {
+	svc := NewScheduledJobService(store, config.ScheduledJobConfig{MaxAttempts: maxAttempts, RetryBackoffSecs: 30})
+	svc.now = func() time.Time { return time.Unix(1_700_000_000, 0) }
+	return svc
+}
+
+// ============================================================================
+// Tests
+// ============================================================================
+
+func TestScheduledJob_RunsDueJobWithPayload(t *testing.T) {
+	store := newScheduledJobStoreMock()
+	svc := newTestScheduledJobService(store, 3)
+	ctx := context.Background()
+
+	var got model.FlappingClearancePayload
+	svc.Register("test", func(_ context.Context, job model.ScheduledJob) error {
+		return json.Unmarshal(job.Payload, &got)
+	})
+
+	id, err := svc.Schedule(ctx, "test", "fp-1", time.Now().Add(-time.Second), model.FlappingClearancePayload{Fingerprint: "fp-1"})
+	if err != nil {
+		t.Fatalf("Schedule() error = %v", err)
+	}
+	if _, err := svc.Schedule(ctx, "test", "fp-future", time.Now().Add(time.Hour), nil); err != nil {
+		t.Fatalf("Schedule() error = %v", err)
+	}
+
+	if !svc.ProcessNext(ctx) {
+		t.Fatal("ProcessNext() = false; want true")
+	}
+	if svc.ProcessNext(ctx) {
+		t.Fatal("ProcessNext() with only future jobs = true; want false")
+	}
+	if store.jobs[id].Status != model.ScheduledJobStatusDone || got.Fingerprint != "fp-1" {
+		t.Fatalf("job status = %s, payload = %+v; want done with fp-1", store.jobs[id].Status, got)
+	}
+}
+
+func TestScheduledJob_RescheduleKeepsSinglePendingJob(t *testing.T) {
+	store := newScheduledJobStoreMock()
+	svc := newTestScheduledJobService(store, 3)
+	ctx := context.Background()
+
+	first, _ := svc.Schedule(ctx, "test", "fp-1", time.Now().Add(time.Minute), nil)
+	later := time.Now().Add(time.Hour)
+	second, _ := svc.Schedule(ctx, "test", "fp-1", later, nil)
+
+	if first != second || len(store.jobs) != 1 || !store.jobs[first].RunAt.Equal(later) {
+		t.Fatalf("jobs = %+v; want one pending job moved to %v", store.jobs, later)
+	}
+
+	if n, _ := svc.Cancel(ctx, "test", "fp-1"); n != 1 || store.jobs[first].Status != model.ScheduledJobStatusCancelled {
+		t.Fatalf("Cancel() = %d, status = %s; want 1 cancelled", n, store.jobs[first].Status)
+	}
+}
+
+func TestScheduledJob_RetriesThenFails(t *testing.T) {
+	store := newScheduledJobStoreMock()
+	svc := newTestScheduledJobService(store, 2)
+	ctx := context.Background()
+
+	svc.Register("test", func(context.Context, model.ScheduledJob) error { return errMock })
+	id, _ := svc.Schedule(ctx, "test", "fp-1", time.Now().Add(-time.Second), nil)
+
+	svc.ProcessNext(ctx)
+	if len(store.failures) != 1 || store.failures[0].Dead {
+		t.Fatalf("first failure = %+v; want retryable failure", store.failures)
+	}
+	if want := svc.now().Add(30 * time.Second); !store.failures[0].NextRunAt.Equal(want) {
+		t.Fatalf("NextRunAt = %v; want %v", store.failures[0].NextRunAt, want)
+	}
+
+	svc.ProcessNext(ctx)
+	if len(store.failures) != 2 || !store.failures[1].Dead || store.jobs[id].Status != model.ScheduledJobStatusFailed {
+		t.Fatalf("failures = %+v, status = %s; want failed after max attempts", store.failures, store.jobs[id].Status)
+	}
+}
+
+func TestScheduledJob_UnknownTypeAndPanicAreFailures(t *testing.T) {
+	store := newScheduledJobStoreMock()
+	svc := newTestScheduledJobService(store, 5)
+	ctx := context.Background()
+
+	svc.Register("panics", func(context.Context, model.ScheduledJob) error { panic("boom") })
+	svc.Schedule(ctx, "unknown", "k", time.Now().Add(-time.Second), nil)
+	svc.Schedule(ctx, "panics", "k", time.Now().Add(-time.Second), nil)
+
+	svc.ProcessNext(ctx)
+	svc.ProcessNext(ctx)
+	if len(store.failures) != 2 || store.failures[0].Dead || store.failures[1].Dead {
+		t.Fatalf("failures = %+v; want two retryable failures", store.failures)
+	}
+}
+
+func TestScheduledJob_ListStatus(t *testing.T) {
+	store := newScheduledJobStoreMock()
+	svc := newTestScheduledJobService(store, 5)
+	ctx := context.Background()
+
+	cases := map[string]string{"": model.ScheduledJobStatusPending, "all": "", "failed": model.ScheduledJobStatusFailed}
+	for input, want := range cases {
+		if _, err := svc.List(ctx, model.ScheduledJobTypeFlappingClearance, input, 0); err != nil {
+			t.Fatalf("List(%q) error = %v", input, err)
+		}
+		if store.listArgs[1] != want {
+			t.Fatalf("List(%q) status = %q; want %q", input, store.listArgs[1], want)
+		}
+	}
+	if _, err := svc.List(ctx, "", "bogus", 0); err == nil {
+		t.Fatal("List(bogus) error = nil; want error")
+	}
+}
+
+func TestScheduledJob_PurgeCompletedKeepsPendingAndFailed(t *testing.T) {
+	store := newScheduledJobStoreMock()
+	svc := newTestScheduledJobService(store, 1)
+	svc.cfg.RetentionDays = 7
+	ctx := context.Background()
+
+	for key, status := range map[string]string{
+		"done":      model.ScheduledJobStatusDone,
+		"cancelled": model.ScheduledJobStatusCancelled,
+		"failed":    model.ScheduledJobStatusFailed,
+		"pending":   model.ScheduledJobStatusPending,
+	} {
+		id, _ := store.ScheduleJob(ctx, "test", key, nil, time.Time{})
+		store.jobs[id].Status = status
+	}
+
+	svc.PurgeCompleted(ctx)
+
+	if want := svc.now().AddDate(0, 0, -7); len(store.purgedAt) != 1 || !store.purgedAt[0].Equal(want) {
+		t.Fatalf("purge cutoff = %v; want %v", store.purgedAt, want)
+	}
+	var remaining []string
+	for _, j := range store.jobs {
+		remaining = append(remaining, j.Status)
+	}
+	sort.Strings(remaining)
+	if len(remaining) != 2 || remaining[0] != model.ScheduledJobStatusFailed || remaining[1] != model.ScheduledJobStatusPending {
+		t.Fatalf("remaining statuses = %v; want [failed pending]", remaining)
+	}
+}
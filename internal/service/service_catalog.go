@@ -0,0 +1,221 @@
+// 서비스 소유권 카탈로그 관리 및 alert → 서비스 매칭
+//
+// 처리 흐름:
+//  1. 관리자가 서비스(소유 팀, namespace, 라벨 셀렉터, Slack 채널, 에스컬레이션 연락처, 등급)를 등록
+//  2. AlertService가 웹훅 처리 시 서비스 목록을 한 번 조회하고, enrichment 이후 라벨로 서비스 매칭
+//     - namespaces/selectors 중 지정된 조건을 모두 만족해야 매칭
+//     - 여러 서비스가 매칭되면 셀렉터가 많은(더 구체적인) 서비스, 같으면 먼저 등록된 서비스
+//  3. alerts.service_id 기록, Incident에는 처음 매칭된 서비스 기록 (incidents.service_id)
+//  4. use_service_channel이 켜진 Slack 웹훅 설정은 root 메시지를 서비스의 Slack 채널로 전송
+//
+// 서비스별 열린 Incident 목록과 MTTR 등 지표를 조회할 수 있다.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+var (
+	ErrInvalidService  = errors.New("invalid service")
+	ErrServiceNotFound = errors.New("service not found")
+)
+
+// serviceCatalogRepo - ServiceCatalogService가 사용하는 DB 인터페이스
+type serviceCatalogRepo interface {
+	ListServices(ctx context.Context) ([]model.Service, error)
+	GetService(ctx context.Context, id int64) (*model.Service, error)
+	CreateService(ctx context.Context, s model.Service) (int64, error)
+	UpdateService(ctx context.Context, id int64, s model.Service) error
+	DeleteService(ctx context.Context, id int64) error
+	ListServiceIncidents(ctx context.Context, serviceID int64, status string) ([]model.IncidentListResponse, error)
+	GetServiceMetrics(ctx context.Context, since time.Time) ([]model.ServiceMetrics, error)
+}
+
+// ServiceCatalogService - 서비스 카탈로그 CRUD 및 서비스 단위 조회
+type ServiceCatalogService struct {
+	db  serviceCatalogRepo
+	now func() time.Time
+}
+
+func NewServiceCatalogService(db serviceCatalogRepo) *ServiceCatalogService {
+	return &ServiceCatalogService{db: db, now: time.Now}
+}
+
+// List - 전체 서비스 조회
+func (s *ServiceCatalogService) List(ctx context.Context) ([]model.Service, error) {
+	return s.db.ListServices(ctx)
+}
+
+// Get - 단건 조회 (없으면 nil)
+func (s *ServiceCatalogService) Get(ctx context.Context, id int64) (*model.Service, error) {
+	return s.db.GetService(ctx, id)
+}
+
+// Create - 서비스 생성 (createdBy: 로그인 사용자 ID)
+func (s *ServiceCatalogService) Create(ctx context.Context, req model.ServiceRequest, createdBy string) (int64, error) {
+	svc, err := buildService(req)
+	if err != nil {
+		return 0, err
+	}
+	svc.CreatedBy = createdBy
+	id, err := s.db.CreateService(ctx, svc)
+	if isUniqueViolation(err) {
+		return 0, fmt.Errorf("%w: service %q already exists", ErrInvalidService, svc.Name)
+	}
+	return id, err
+}
+
+// Update - 서비스 수정
+func (s *ServiceCatalogService) Update(ctx context.Context, id int64, req model.ServiceRequest) error {
+	existing, err := s.db.GetService(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return fmt.Errorf("%w: id=%d", ErrServiceNotFound, id)
+	}
+	svc, err := buildService(req)
+	if err != nil {
+		return err
+	}
+	err = s.db.UpdateService(ctx, id, svc)
+	if isUniqueViolation(err) {
+		return fmt.Errorf("%w: service %q already exists", ErrInvalidService, svc.Name)
+	}
+	return err
+}
+
+// Delete - 서비스 삭제
+func (s *ServiceCatalogService) Delete(ctx context.Context, id int64) error {
+	existing, err := s.db.GetService(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return fmt.Errorf("%w: id=%d", ErrServiceNotFound, id)
+	}
+	return s.db.DeleteService(ctx, id)
+}
+
+// ListIncidents - 서비스의 Incident 목록 (status: firing(기본), resolved, all)
+func (s *ServiceCatalogService) ListIncidents(ctx context.Context, id int64, status string) ([]model.IncidentListResponse, error) {
+	existing, err := s.db.GetService(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, fmt.Errorf("%w: id=%d", ErrServiceNotFound, id)
+	}
+
+	switch status = strings.ToLower(strings.TrimSpace(status)); status {
+	case "":
+		status = "firing"
+	case "all":
+		status = ""
+	case "firing", "resolved":
+	default:
+		return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidService, status)
+	}
+	return s.db.ListServiceIncidents(ctx, id, status)
+}
+
+// Metrics - 서비스별 열린 Incident 수와 기간 내 Incident/MTTR (window: 24h, 7d, 30d 등, 기본 30d)
+func (s *ServiceCatalogService) Metrics(ctx context.Context, windowRaw string) ([]model.ServiceMetrics, string, error) {
+	window, normalized, err := parseAnalyticsWindow(windowRaw)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrInvalidService, err)
+	}
+	metrics, err := s.db.GetServiceMetrics(ctx, s.now().Add(-window))
+	if err != nil {
+		return nil, "", err
+	}
+	return metrics, normalized, nil
+}
+
+// buildService - 요청 검증 및 정규화
+func buildService(req model.ServiceRequest) (model.Service, error) {
+	svc := model.Service{
+		Name:              strings.TrimSpace(req.Name),
+		Team:              strings.TrimSpace(req.Team),
+		Namespaces:        []string{},
+		Selectors:         model.LabelMatchers{},
+		SlackChannel:      strings.TrimSpace(req.SlackChannel),
+		EscalationContact: strings.TrimSpace(req.EscalationContact),
+		Tier:              strings.TrimSpace(req.Tier),
+		Description:       strings.TrimSpace(req.Description),
+	}
+	if svc.Name == "" {
+		return svc, fmt.Errorf("%w: name is required", ErrInvalidService)
+	}
+	if svc.Team == "" {
+		return svc, fmt.Errorf("%w: team is required", ErrInvalidService)
+	}
+
+	seen := make(map[string]bool, len(req.Namespaces))
+	for _, ns := range req.Namespaces {
+		ns = strings.TrimSpace(ns)
+		if ns == "" || seen[ns] {
+			continue
+		}
+		seen[ns] = true
+		svc.Namespaces = append(svc.Namespaces, ns)
+	}
+	for _, m := range req.Selectors {
+		m.Name = strings.TrimSpace(m.Name)
+		if err := m.Validate(); err != nil {
+			return svc, fmt.Errorf("%w: selectors: %v", ErrInvalidService, err)
+		}
+		svc.Selectors = append(svc.Selectors, m)
+	}
+	if len(svc.Namespaces) == 0 && len(svc.Selectors) == 0 {
+		return svc, fmt.Errorf("%w: namespaces or selectors are required", ErrInvalidService)
+	}
+	return svc, nil
+}
+
+// serviceMatches - namespaces/selectors 중 지정된 조건을 모두 만족하는지
+func serviceMatches(svc model.Service, labels map[string]string) bool {
+	if len(svc.Namespaces) == 0 && len(svc.Selectors) == 0 {
+		return false
+	}
+	if len(svc.Namespaces) > 0 {
+		namespace := labels["namespace"]
+		found := false
+		for _, ns := range svc.Namespaces {
+			if ns == namespace {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, m := range svc.Selectors {
+		if !m.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchService - 라벨에 매칭되는 서비스 (셀렉터가 많은 서비스 우선, 같으면 목록 순서)
+func matchService(services []model.Service, labels map[string]string) *model.Service {
+	var best *model.Service
+	for i := range services {
+		if !serviceMatches(services[i], labels) {
+			continue
+		}
+		if best == nil || len(services[i].Selectors) > len(best.Selectors) {
+			best = &services[i]
+		}
+	}
+	return best
+}
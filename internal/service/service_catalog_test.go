@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+type serviceCatalogRepoMock struct {
+	services       map[int64]*model.Service
+	created        model.Service
+	incidentStatus string
+	metricsSince   time.Time
+}
+
+func (m *serviceCatalogRepoMock) ListServices(_ context.Context) ([]model.Service, error) {
+	var out []model.Service
+	for _, s := range m.services {
+		out = append(out, *s)
+	}
+	return out, nil
+}
+
+func (m *serviceCatalogRepoMock) GetService(_ context.Context, id int64) (*model.Service, error) {
+	if s, ok := m.services[id]; ok {
+		copied := *s
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (m *serviceCatalogRepoMock) CreateService(_ context.Context, s model.Service) (int64, error) {
+	m.created = s
+	return 1, nil
+}
+
+func (m *serviceCatalogRepoMock) UpdateService(_ context.Context, _ int64, _ model.Service) error {
+	return nil
+}
+
+func (m *serviceCatalogRepoMock) DeleteService(_ context.Context, _ int64) error {
+	return nil
+}
+
+func (m *serviceCatalogRepoMock) ListServiceIncidents(_ context.Context, _ int64, status string) ([]model.IncidentListResponse, error) {
+	m.incidentStatus = status
+	return nil, nil
+}
+
+func (m *serviceCatalogRepoMock) GetServiceMetrics(_ context.Context, since time.Time) ([]model.ServiceMetrics, error) {
+	m.metricsSince = since
+	return nil, nil
+}
+
+func TestServiceCatalog_CreateNormalizes(t *testing.T) {
+	repo := &serviceCatalogRepoMock{}
+	svc := NewServiceCatalogService(repo)
+
+	_, err := svc.Create(context.Background(), model.ServiceRequest{
+		Name:         " payments ",
+		Team:         " team-pay ",
+		Namespaces:   []string{"payments", " payments ", ""},
+		SlackChannel: " C-PAY ",
+	}, "admin")
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if repo.created.Name != "payments" || repo.created.Team != "team-pay" || repo.created.SlackChannel != "C-PAY" {
+		t.Fatalf("created = %+v; want trimmed fields", repo.created)
+	}
+	if len(repo.created.Namespaces) != 1 || repo.created.CreatedBy != "admin" {
+		t.Fatalf("created = %+v; want one namespace and created_by admin", repo.created)
+	}
+}
+
+func TestServiceCatalog_CreateRejectsInvalid(t *testing.T) {
+	svc := NewServiceCatalogService(&serviceCatalogRepoMock{})
+	cases := map[string]model.ServiceRequest{
+		"missing name":     {Team: "t", Namespaces: []string{"ns"}},
+		"missing team":     {Name: "s", Namespaces: []string{"ns"}},
+		"no match target":  {Name: "s", Team: "t"},
+		"invalid selector": {Name: "s", Team: "t", Selectors: model.LabelMatchers{{Name: "app", Value: "(", IsRegex: true, IsEqual: true}}},
+	}
+	for name, req := range cases {
+		if _, err := svc.Create(context.Background(), req, ""); !errors.Is(err, ErrInvalidService) {
+			t.Fatalf("%s: Create() error = %v; want ErrInvalidService", name, err)
+		}
+	}
+}
+
+func TestMatchService_PrefersMoreSpecificSelectors(t *testing.T) {
+	services := []model.Service{
+		{ID: 1, Name: "platform", Namespaces: []string{"payments"}},
+		{ID: 2, Name: "checkout", Namespaces: []string{"payments"}, Selectors: model.LabelMatchers{{Name: "app", Value: "checkout", IsEqual: true}}},
+	}
+
+	if got := matchService(services, map[string]string{"namespace": "payments", "app": "checkout"}); got == nil || got.ID != 2 {
+		t.Fatalf("matchService(checkout) = %+v; want service 2", got)
+	}
+	if got := matchService(services, map[string]string{"namespace": "payments", "app": "ledger"}); got == nil || got.ID != 1 {
+		t.Fatalf("matchService(ledger) = %+v; want service 1", got)
+	}
+	if got := matchService(services, map[string]string{"namespace": "default"}); got != nil {
+		t.Fatalf("matchService(default) = %+v; want nil", got)
+	}
+}
+
+func TestServiceCatalog_ListIncidentsStatus(t *testing.T) {
+	repo := &serviceCatalogRepoMock{services: map[int64]*model.Service{1: {ID: 1, Name: "payments"}}}
+	svc := NewServiceCatalogService(repo)
+	ctx := context.Background()
+
+	cases := map[string]string{"": "firing", "resolved": "resolved", "ALL": ""}
+	for input, want := range cases {
+		if _, err := svc.ListIncidents(ctx, 1, input); err != nil {
+			t.Fatalf("ListIncidents(%q) error = %v", input, err)
+		}
+		if repo.incidentStatus != want {
+			t.Fatalf("ListIncidents(%q) status = %q; want %q", input, repo.incidentStatus, want)
+		}
+	}
+	if _, err := svc.ListIncidents(ctx, 1, "bogus"); !errors.Is(err, ErrInvalidService) {
+		t.Fatalf("ListIncidents(bogus) error = %v; want ErrInvalidService", err)
+	}
+	if _, err := svc.ListIncidents(ctx, 99, ""); !errors.Is(err, ErrServiceNotFound) {
+		t.Fatalf("ListIncidents(99) error = %v; want ErrServiceNotFound", err)
+	}
+}
+
+func TestServiceCatalog_MetricsWindow(t *testing.T) {
+	repo := &serviceCatalogRepoMock{}
+	svc := NewServiceCatalogService(repo)
+	now := time.Unix(1_700_000_000, 0)
+	svc.now = func() time.Time { return now }
+
+	_, window, err := svc.Metrics(context.Background(), "7d")
+	if err != nil {
+		t.Fatalf("Metrics() error = %v", err)
+	}
+	if window != "7d" || !repo.metricsSince.Equal(now.Add(-7*24*time.Hour)) {
+		t.Fatalf("window = %q, since = %v; want 7d, %v", window, repo.metricsSince, now.Add(-7*24*time.Hour))
+	}
+	if _, _, err := svc.Metrics(context.Background(), "bogus"); !errors.Is(err, ErrInvalidService) {
+		t.Fatalf("Metrics(bogus) error = %v; want ErrInvalidService", err)
+	}
+}
@@ -0,0 +1,131 @@
+// Severity 분류 체계 (app_settings "severity")
+//
+// severity 라벨 값을 정규 레벨로 매핑하고, 레벨별로 저장/알림/자동 분석 여부와 순위를 결정한다.
+//   - 레벨 이름 또는 별칭(대소문자 무시)으로 매핑
+//   - 매핑되지 않는 값(라벨 없음 포함)은 Default 레벨, Default가 비어 있으면 무시
+//   - Incident severity는 rank가 더 높은 alert가 들어올 때만 올라간다 (escalation)
+
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// DefaultSeveritySettings - 기본 분류 체계
+// 기존 동작(critical/warning/info 처리, none·라벨 없음·알 수 없는 값 무시)을 유지하면서 흔히 쓰이는 표기를 별칭으로 매핑
+// 매핑되지 않는 alert도 처리하려면 Default를 지정 (opt-in)
+func DefaultSeveritySettings() model.SeveritySettings {
+	return model.SeveritySettings{
+		Levels: []model.SeverityLevel{
+			{Name: "critical", Rank: 30, Store: true, Notify: true, AutoAnalyze: true},
+			{Name: "warning", Rank: 20, Store: true, Notify: true, AutoAnalyze: true},
+			{Name: "info", Rank: 10, Store: true, Notify: true, AutoAnalyze: true},
+			{Name: "none", Rank: 0, Store: false, Notify: false, AutoAnalyze: false},
+		},
+		Aliases: map[string]string{
+			"error":         "critical",
+			"high":          "critical",
+			"fatal":         "critical",
+			"emergency":     "critical",
+			"page":          "critical",
+			"p1":            "critical",
+			"p2":            "warning",
+			"warn":          "warning",
+			"medium":        "warning",
+			"p3":            "warning",
+			"low":           "info",
+			"informational": "info",
+			"p4":            "info",
+			"p5":            "info",
+		},
+		Default: "",
+	}
+}
+
+// validateSeveritySettings - 레벨 이름 중복/빈 값, 별칭·기본값이 존재하지 않는 레벨을 가리키는지 검증
+func validateSeveritySettings(v model.SeveritySettings) error {
+	if len(v.Levels) == 0 {
+		return fmt.Errorf("levels must not be empty")
+	}
+	names := make(map[string]bool, len(v.Levels))
+	for _, level := range v.Levels {
+		name := strings.ToLower(strings.TrimSpace(level.Name))
+		if name == "" {
+			return fmt.Errorf("level name must not be empty")
+		}
+		if names[name] {
+			return fmt.Errorf("duplicate level: %s", level.Name)
+		}
+		if level.ReminderMinutes < 0 {
+			return fmt.Errorf("level %s: reminderMinutes must not be negative", level.Name)
+		}
+		names[name] = true
+	}
+	for alias, target := range v.Aliases {
+		if !names[strings.ToLower(strings.TrimSpace(target))] {
+			return fmt.Errorf("alias %q refers to unknown level %q", alias, target)
+		}
+	}
+	if v.Default != "" && !names[strings.ToLower(strings.TrimSpace(v.Default))] {
+		return fmt.Errorf("default refers to unknown level %q", v.Default)
+	}
+	return nil
+}
+
+// resolveSeverity - 라벨 값을 정규 레벨로 매핑 (매핑 실패 시 Default, Default도 없으면 false)
+func resolveSeverity(settings model.SeveritySettings, raw string) (model.SeverityLevel, bool) {
+	value := strings.ToLower(strings.TrimSpace(raw))
+	if value != "" {
+		if level, ok := findSeverityLevel(settings, value); ok {
+			return level, true
+		}
+		for alias, target := range settings.Aliases {
+			if strings.ToLower(strings.TrimSpace(alias)) == value {
+				return findSeverityLevel(settings, strings.ToLower(strings.TrimSpace(target)))
+			}
+		}
+	}
+	if settings.Default != "" {
+		return findSeverityLevel(settings, strings.ToLower(strings.TrimSpace(settings.Default)))
+	}
+	return model.SeverityLevel{}, false
+}
+
+func findSeverityLevel(settings model.SeveritySettings, name string) (model.SeverityLevel, bool) {
+	for _, level := range settings.Levels {
+		if strings.ToLower(strings.TrimSpace(level.Name)) == name {
+			return level, true
+		}
+	}
+	return model.SeverityLevel{}, false
+}
+
+// canonicalizeSeverity - severity 라벨을 정규 레벨 이름으로 바꾼 alert 사본 반환 (원래 값은 source_severity annotation에 보존)
+func canonicalizeSeverity(alert model.Alert, level model.SeverityLevel) model.Alert {
+	raw := alert.Labels["severity"]
+	if raw == level.Name {
+		return alert
+	}
+
+	labels := make(map[string]string, len(alert.Labels)+1)
+	for k, v := range alert.Labels {
+		labels[k] = v
+	}
+	labels["severity"] = level.Name
+	alert.Labels = labels
+
+	if raw != "" {
+		annotations := make(map[string]string, len(alert.Annotations)+1)
+		for k, v := range alert.Annotations {
+			annotations[k] = v
+		}
+		if annotations["source_severity"] == "" {
+			annotations["source_severity"] = raw
+		}
+		alert.Annotations = annotations
+	}
+	return alert
+}
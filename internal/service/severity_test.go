@@ -0,0 +1,80 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+func TestResolveSeverity(t *testing.T) {
+	settings := DefaultSeveritySettings()
+
+	tests := []struct {
+		raw  string
+		want string
+	}{
+		{"critical", "critical"},
+		{"Warning", "warning"},
+		{"error", "critical"},
+		{" PAGE ", "critical"},
+		{"p3", "warning"},
+		{"low", "info"},
+		{"none", "none"},
+	}
+	for _, tt := range tests {
+		level, ok := resolveSeverity(settings, tt.raw)
+		if !ok || level.Name != tt.want {
+			t.Errorf("resolveSeverity(%q) = %q, %v; want %q", tt.raw, level.Name, ok, tt.want)
+		}
+	}
+
+	// 기본 설정은 라벨 없음/알 수 없는 값을 무시 (기존 동작)
+	for _, raw := range []string{"", "something-else"} {
+		if level, ok := resolveSeverity(settings, raw); ok {
+			t.Fatalf("resolveSeverity(%q) without default = %q; want not ok", raw, level.Name)
+		}
+	}
+
+	// Default를 지정하면 매핑되지 않는 값도 해당 레벨로 처리
+	settings.Default = "warning"
+	for _, raw := range []string{"", "something-else"} {
+		if level, ok := resolveSeverity(settings, raw); !ok || level.Name != "warning" {
+			t.Fatalf("resolveSeverity(%q) with default = %q, %v; want warning", raw, level.Name, ok)
+		}
+	}
+}
+
+func TestValidateSeveritySettings(t *testing.T) {
+	if err := validateSeveritySettings(DefaultSeveritySettings()); err != nil {
+		t.Fatalf("default settings invalid: %v", err)
+	}
+
+	invalid := []model.SeveritySettings{
+		{},
+		{Levels: []model.SeverityLevel{{Name: ""}}},
+		{Levels: []model.SeverityLevel{{Name: "high"}, {Name: "HIGH"}}},
+		{Levels: []model.SeverityLevel{{Name: "high"}}, Aliases: map[string]string{"p1": "critical"}},
+		{Levels: []model.SeverityLevel{{Name: "high"}}, Default: "low"},
+		{Levels: []model.SeverityLevel{{Name: "high", ReminderMinutes: -5}}},
+	}
+	for i, v := range invalid {
+		if err := validateSeveritySettings(v); err == nil {
+			t.Errorf("case %d: validateSeveritySettings(%+v) = nil; want error", i, v)
+		}
+	}
+}
+
+func TestCanonicalizeSeverity_CopiesMaps(t *testing.T) {
+	alert := model.Alert{
+		Labels:      map[string]string{"severity": "high"},
+		Annotations: map[string]string{},
+	}
+	out := canonicalizeSeverity(alert, model.SeverityLevel{Name: "critical"})
+
+	if out.Labels["severity"] != "critical" || out.Annotations["source_severity"] != "high" {
+		t.Fatalf("canonicalized = %+v", out)
+	}
+	if alert.Labels["severity"] != "high" || len(alert.Annotations) != 0 {
+		t.Fatalf("original alert mutated: %+v", alert)
+	}
+}
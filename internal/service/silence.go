@@ -0,0 +1,146 @@
+// Silence 규칙 관리 및 매칭 로직
+//
+// 처리 흐름:
+//  1. 관리자가 라벨 매처(=, !=, =~, !~) + 시작/종료 시각 + 코멘트로 규칙 생성 (생성자는 로그인 사용자)
+//  2. AlertService가 웹훅 처리 시 유효한 규칙을 한 번 조회하여 alert 라벨과 매칭
+//  3. 매칭된 alert는 DB에 저장하고 alerts.silenced_by에 규칙 ID 기록, 알림 전송/자동 분석은 스킵
+//
+// 매처가 모든 alert에 매칭되는 규칙(예: 빈 값 = 조건만 있는 경우)은 전체 음소거를 막기 위해 거부한다.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+var (
+	ErrInvalidSilenceRule  = errors.New("invalid silence rule")
+	ErrSilenceRuleNotFound = errors.New("silence rule not found")
+)
+
+// silenceRepo - SilenceService가 사용하는 DB 인터페이스
+type silenceRepo interface {
+	ListSilenceRules(ctx context.Context) ([]model.SilenceRule, error)
+	GetSilenceRule(ctx context.Context, id int64) (*model.SilenceRule, error)
+	CreateSilenceRule(ctx context.Context, rule model.SilenceRule) (int64, error)
+	UpdateSilenceRule(ctx context.Context, id int64, rule model.SilenceRule) error
+	DeleteSilenceRule(ctx context.Context, id int64) error
+}
+
+// SilenceService - Silence 규칙 CRUD
+type SilenceService struct {
+	db  silenceRepo
+	now func() time.Time
+}
+
+func NewSilenceService(db silenceRepo) *SilenceService {
+	return &SilenceService{db: db, now: time.Now}
+}
+
+// List - 전체 규칙 조회 (조회 시점 기준 상태 포함)
+func (s *SilenceService) List(ctx context.Context) ([]model.SilenceRule, error) {
+	rules, err := s.db.ListSilenceRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	for i := range rules {
+		rules[i].Status = rules[i].StatusAt(now)
+	}
+	return rules, nil
+}
+
+// Get - 단건 조회 (없으면 nil)
+func (s *SilenceService) Get(ctx context.Context, id int64) (*model.SilenceRule, error) {
+	rule, err := s.db.GetSilenceRule(ctx, id)
+	if err != nil || rule == nil {
+		return rule, err
+	}
+	rule.Status = rule.StatusAt(s.now())
+	return rule, nil
+}
+
+// Create - 규칙 생성 (createdBy: 로그인 사용자 ID)
+func (s *SilenceService) Create(ctx context.Context, req model.SilenceRuleRequest, createdBy string) (int64, error) {
+	rule, err := s.buildRule(req, s.now())
+	if err != nil {
+		return 0, err
+	}
+	rule.CreatedBy = createdBy
+	return s.db.CreateSilenceRule(ctx, rule)
+}
+
+// Update - 규칙 수정
+func (s *SilenceService) Update(ctx context.Context, id int64, req model.SilenceRuleRequest) error {
+	existing, err := s.db.GetSilenceRule(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return fmt.Errorf("%w: id=%d", ErrSilenceRuleNotFound, id)
+	}
+	// starts_at 생략 시 기존 시작 시각 유지
+	rule, err := s.buildRule(req, existing.StartsAt)
+	if err != nil {
+		return err
+	}
+	return s.db.UpdateSilenceRule(ctx, id, rule)
+}
+
+// Delete - 규칙 삭제
+func (s *SilenceService) Delete(ctx context.Context, id int64) error {
+	existing, err := s.db.GetSilenceRule(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return fmt.Errorf("%w: id=%d", ErrSilenceRuleNotFound, id)
+	}
+	return s.db.DeleteSilenceRule(ctx, id)
+}
+
+// buildRule - 요청 검증 및 정규화 (starts_at 생략 시 defaultStart)
+func (s *SilenceService) buildRule(req model.SilenceRuleRequest, defaultStart time.Time) (model.SilenceRule, error) {
+	matchers := make(model.LabelMatchers, 0, len(req.Matchers))
+	for _, m := range req.Matchers {
+		m.Name = strings.TrimSpace(m.Name)
+		matchers = append(matchers, m)
+	}
+	if err := matchers.Validate(); err != nil {
+		return model.SilenceRule{}, fmt.Errorf("%w: %v", ErrInvalidSilenceRule, err)
+	}
+	if matchers.Matches(map[string]string{}) {
+		return model.SilenceRule{}, fmt.Errorf("%w: matchers must not match every alert", ErrInvalidSilenceRule)
+	}
+
+	startsAt := defaultStart.UTC()
+	if req.StartsAt != nil && !req.StartsAt.IsZero() {
+		startsAt = req.StartsAt.UTC()
+	}
+	if req.EndsAt.IsZero() || !req.EndsAt.After(startsAt) {
+		return model.SilenceRule{}, fmt.Errorf("%w: ends_at must be after starts_at", ErrInvalidSilenceRule)
+	}
+
+	return model.SilenceRule{
+		Matchers: matchers,
+		StartsAt: startsAt,
+		EndsAt:   req.EndsAt.UTC(),
+		Comment:  strings.TrimSpace(req.Comment),
+	}, nil
+}
+
+// matchSilence - alert 라벨에 매칭되는 첫 번째 규칙 반환 (없으면 nil)
+func matchSilence(rules []model.SilenceRule, labels map[string]string) *model.SilenceRule {
+	for i := range rules {
+		if rules[i].Matchers.Matches(labels) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kube-rca/backend/internal/model"
+)
+
+type silenceRepoMock struct {
+	rules   map[int64]*model.SilenceRule
+	created model.SilenceRule
+	updated model.SilenceRule
+	deleted int64
+}
+
+func (m *silenceRepoMock) ListSilenceRules(_ context.Context) ([]model.SilenceRule, error) {
+	var out []model.SilenceRule
+	for _, r := range m.rules {
+		out = append(out, *r)
+	}
+	return out, nil
+}
+
+func (m *silenceRepoMock) GetSilenceRule(_ context.Context, id int64) (*model.SilenceRule, error) {
+	if r, ok := m.rules[id]; ok {
+		copied := *r
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (m *silenceRepoMock) CreateSilenceRule(_ context.Context, rule model.SilenceRule) (int64, error) {
+	m.created = rule
+	return 1, nil
+}
+
+func (m *silenceRepoMock) UpdateSilenceRule(_ context.Context, _ int64, rule model.SilenceRule) error {
+	m.updated = rule
+	return nil
+}
+
+func (m *silenceRepoMock) DeleteSilenceRule(_ context.Context, id int64) error {
+	m.deleted = id
+	return nil
+}
+
+func newTestSilenceService(repo *silenceRepoMock) (*SilenceService, time.Time) {
+	now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	svc := NewSilenceService(repo)
+	svc.now = func() time.Time { return now }
+	return svc, now
+}
+
+func TestSilenceService_CreateDefaultsStartAndCreator(t *testing.T) {
+	repo := &silenceRepoMock{}
+	svc, now := newTestSilenceService(repo)
+
+	_, err := svc.Create(context.Background(), model.SilenceRuleRequest{
+		Matchers: model.LabelMatchers{{Name: " alertname ", Value: "DiskFull", IsEqual: true}},
+		EndsAt:   now.Add(2 * time.Hour),
+		Comment:  "  disk migration  ",
+	}, "admin")
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if !repo.created.StartsAt.Equal(now) || repo.created.CreatedBy != "admin" || repo.created.Comment != "disk migration" {
+		t.Fatalf("created = %+v", repo.created)
+	}
+	if repo.created.Matchers[0].Name != "alertname" {
+		t.Fatalf("matcher name = %q; want trimmed", repo.created.Matchers[0].Name)
+	}
+}
+
+func TestSilenceService_CreateRejectsInvalid(t *testing.T) {
+	repo := &silenceRepoMock{}
+	svc, now := newTestSilenceService(repo)
+
+	cases := map[string]model.SilenceRuleRequest{
+		"no matchers":   {EndsAt: now.Add(time.Hour)},
+		"matches all":   {Matchers: model.LabelMatchers{{Name: "team", Value: "", IsEqual: true}}, EndsAt: now.Add(time.Hour)},
+		"bad regex":     {Matchers: model.LabelMatchers{{Name: "a", Value: "(", IsRegex: true, IsEqual: true}}, EndsAt: now.Add(time.Hour)},
+		"ends in past":  {Matchers: model.LabelMatchers{{Name: "a", Value: "1", IsEqual: true}}, EndsAt: now.Add(-time.Minute)},
+		"missing ends":  {Matchers: model.LabelMatchers{{Name: "a", Value: "1", IsEqual: true}}},
+		"negated empty": {Matchers: model.LabelMatchers{{Name: "a", Value: "x", IsEqual: false}}, EndsAt: now.Add(time.Hour)},
+	}
+	for name, req := range cases {
+		if _, err := svc.Create(context.Background(), req, ""); !errors.Is(err, ErrInvalidSilenceRule) {
+			t.Errorf("%s: Create() error = %v; want ErrInvalidSilenceRule", name, err)
+		}
+	}
+}
+
+func TestSilenceService_UpdateKeepsStartAndReportsNotFound(t *testing.T) {
+	start := time.Date(2026, 4, 1, 8, 0, 0, 0, time.UTC)
+	repo := &silenceRepoMock{rules: map[int64]*model.SilenceRule{
+		3: {ID: 3, StartsAt: start, EndsAt: start.Add(4 * time.Hour)},
+	}}
+	svc, now := newTestSilenceService(repo)
+	req := model.SilenceRuleRequest{
+		Matchers: model.LabelMatchers{{Name: "a", Value: "1", IsEqual: true}},
+		EndsAt:   now.Add(-30 * time.Minute), // 이미 시작된 규칙을 조기 만료
+	}
+
+	if err := svc.Update(context.Background(), 3, req); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if !repo.updated.StartsAt.Equal(start) {
+		t.Fatalf("StartsAt = %v; want existing %v", repo.updated.StartsAt, start)
+	}
+	if err := svc.Update(context.Background(), 99, req); !errors.Is(err, ErrSilenceRuleNotFound) {
+		t.Fatalf("Update(missing) error = %v; want ErrSilenceRuleNotFound", err)
+	}
+	if err := svc.Delete(context.Background(), 99); !errors.Is(err, ErrSilenceRuleNotFound) {
+		t.Fatalf("Delete(missing) error = %v; want ErrSilenceRuleNotFound", err)
+	}
+}
+
+func TestSilenceService_ListComputesStatus(t *testing.T) {
+	repo := &silenceRepoMock{rules: map[int64]*model.SilenceRule{}}
+	svc, now := newTestSilenceService(repo)
+	repo.rules[1] = &model.SilenceRule{ID: 1, StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour)}
+	repo.rules[2] = &model.SilenceRule{ID: 2, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)}
+	repo.rules[3] = &model.SilenceRule{ID: 3, StartsAt: now.Add(-2 * time.Hour), EndsAt: now}
+
+	rules, err := svc.List(context.Background())
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	want := map[int64]string{1: model.SilenceStatusPending, 2: model.SilenceStatusActive, 3: model.SilenceStatusExpired}
+	for _, r := range rules {
+		if r.Status != want[r.ID] {
+			t.Errorf("rule %d status = %s; want %s", r.ID, r.Status, want[r.ID])
+		}
+	}
+}
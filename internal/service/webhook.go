@@ -2,12 +2,24 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"regexp"
 	"strings"
 
+	"github.com/kube-rca/backend/internal/client"
 	"github.com/kube-rca/backend/internal/model"
 )
 
+var (
+	// ErrInvalidWebhookConfig - 웹훅 설정 요청 검증 실패 (매처, 템플릿, 전송 옵션 포함)
+	ErrInvalidWebhookConfig = errors.New("invalid webhook config")
+	// ErrWebhookPreviewNotFound - 미리보기 대상 alert 또는 웹훅 설정 없음
+	ErrWebhookPreviewNotFound = errors.New("alert or webhook config not found")
+)
+
 // webhookRepo - DB 인터페이스
 type webhookRepo interface {
 	GetWebhookConfigs(ctx context.Context) ([]model.WebhookConfig, error)
@@ -15,56 +27,188 @@ type webhookRepo interface {
 	CreateWebhookConfig(ctx context.Context, cfg model.WebhookConfig) (int, error)
 	UpdateWebhookConfig(ctx context.Context, id int, cfg model.WebhookConfig) error
 	DeleteWebhookConfig(ctx context.Context, id int) error
+	GetAlertDetail(alertID string) (*model.AlertDetailResponse, error)
 }
 
 // WebhookService - 웹훅 설정 비즈니스 로직
 type WebhookService struct {
-	db webhookRepo
+	db          webhookRepo
+	frontendURL string // 템플릿 미리보기의 프론트엔드 링크 기준 URL
 }
 
-func NewWebhookService(db webhookRepo) *WebhookService {
-	return &WebhookService{db: db}
+func NewWebhookService(db webhookRepo, frontendURL string) *WebhookService {
+	return &WebhookService{db: db, frontendURL: frontendURL}
 }
 
 func (s *WebhookService) ListWebhookConfigs(ctx context.Context) ([]model.WebhookConfig, error) {
-	return s.db.GetWebhookConfigs(ctx)
+	configs, err := s.db.GetWebhookConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range configs {
+		markWebhookSecrets(&configs[i])
+	}
+	return configs, nil
 }
 
 func (s *WebhookService) GetWebhookConfig(ctx context.Context, id int) (*model.WebhookConfig, error) {
-	return s.db.GetWebhookConfigByID(ctx, id)
+	cfg, err := s.db.GetWebhookConfigByID(ctx, id)
+	if err != nil || cfg == nil {
+		return cfg, err
+	}
+	markWebhookSecrets(cfg)
+	return cfg, nil
 }
 
 func (s *WebhookService) CreateWebhookConfig(ctx context.Context, req model.WebhookConfigRequest) (int, error) {
-	name := strings.TrimSpace(req.Name)
-	if name == "" {
-		return 0, fmt.Errorf("webhook name is required")
+	cfg, err := buildWebhookConfig(req)
+	if err != nil {
+		return 0, err
 	}
+	return s.db.CreateWebhookConfig(ctx, cfg)
+}
 
-	webhookType := strings.ToLower(strings.TrimSpace(req.Type))
-	if webhookType == "" {
-		webhookType = "http"
+func (s *WebhookService) UpdateWebhookConfig(ctx context.Context, id int, req model.WebhookConfigRequest) error {
+	stored, err := s.db.GetWebhookConfigByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if stored != nil {
+		if err := keepStoredWebhookSecrets(&req, *stored); err != nil {
+			return err
+		}
 	}
+	cfg, err := buildWebhookConfig(req)
+	if err != nil {
+		return err
+	}
+	return s.db.UpdateWebhookConfig(ctx, id, cfg)
+}
+
+// markWebhookSecrets - 응답에 노출되지 않는 secret 필드의 설정 여부 표시
+func markWebhookSecrets(cfg *model.WebhookConfig) {
+	cfg.HasSigningSecret = cfg.SigningSecret != ""
+	cfg.HasBasicAuthPassword = cfg.BasicAuthPassword != ""
+	cfg.HasTLSClientKey = cfg.TLSClientKey != ""
+}
 
-	severities := req.Severities
-	if severities == nil {
-		severities = []string{}
+// keepStoredWebhookSecrets - 수정 요청에서 비어 있는 secret 필드는 저장된 값으로 채움
+// 조회 응답에 secret이 포함되지 않으므로 빈 값은 "변경 없음"을 뜻하며, 삭제는 clear_secrets로 명시한다.
+func keepStoredWebhookSecrets(req *model.WebhookConfigRequest, stored model.WebhookConfig) error {
+	secrets := []struct {
+		name   string
+		value  *string
+		stored string
+	}{
+		{"signing_secret", &req.SigningSecret, stored.SigningSecret},
+		{"basic_auth_password", &req.BasicAuthPassword, stored.BasicAuthPassword},
+		{"tls_client_key", &req.TLSClientKey, stored.TLSClientKey},
+	}
+	clear := make(map[string]bool, len(req.ClearSecrets))
+	for _, name := range req.ClearSecrets {
+		known := false
+		for _, secret := range secrets {
+			known = known || secret.name == name
+		}
+		if !known {
+			return fmt.Errorf("%w: clear_secrets: unknown secret field %q", ErrInvalidWebhookConfig, name)
+		}
+		clear[name] = true
 	}
+	for _, secret := range secrets {
+		if *secret.value == "" && !clear[secret.name] {
+			*secret.value = secret.stored
+		}
+	}
+	return nil
+}
 
-	cfg := model.WebhookConfig{
-		Name:       name,
-		URL:        strings.TrimSpace(req.URL),
-		Type:       webhookType,
-		Token:      strings.TrimSpace(req.Token),
-		Channel:    strings.TrimSpace(req.Channel),
-		Severities: severities,
+func (s *WebhookService) DeleteWebhookConfig(ctx context.Context, id int) error {
+	return s.db.DeleteWebhookConfig(ctx, id)
+}
+
+// PreviewTemplates - 저장된 alert로 템플릿 렌더링 (firing alert 메시지 기준)
+func (s *WebhookService) PreviewTemplates(ctx context.Context, req model.WebhookTemplatePreviewRequest) (*model.WebhookTemplatePreview, error) {
+	alertID := strings.TrimSpace(req.AlertID)
+	if alertID == "" {
+		return nil, fmt.Errorf("%w: alert_id is required", ErrInvalidWebhookConfig)
 	}
-	return s.db.CreateWebhookConfig(ctx, cfg)
+	if req.WebhookConfigID != nil {
+		cfg, err := s.db.GetWebhookConfigByID(ctx, *req.WebhookConfigID)
+		if err != nil || cfg == nil {
+			return nil, fmt.Errorf("%w: webhook config id=%d", ErrWebhookPreviewNotFound, *req.WebhookConfigID)
+		}
+		if req.TitleTemplate == "" {
+			req.TitleTemplate = cfg.TitleTemplate
+		}
+		if req.BodyTemplate == "" {
+			req.BodyTemplate = cfg.BodyTemplate
+		}
+		if req.PayloadTemplate == "" {
+			req.PayloadTemplate = cfg.PayloadTemplate
+		}
+	}
+
+	detail, err := s.db.GetAlertDetail(alertID)
+	if err != nil || detail == nil {
+		return nil, fmt.Errorf("%w: alert id=%s", ErrWebhookPreviewNotFound, alertID)
+	}
+	data := client.NewMessageTemplateData(client.AlertStatusChangedEvent{
+		Alert:      alertFromDetail(detail),
+		IncidentID: ptrToString(detail.IncidentID),
+	}, s.frontendURL)
+	data.AlertID = detail.AlertID
+
+	preview := &model.WebhookTemplatePreview{AlertID: detail.AlertID}
+	for _, t := range []struct {
+		name string
+		text string
+		out  *string
+	}{
+		{"title_template", req.TitleTemplate, &preview.Title},
+		{"body_template", req.BodyTemplate, &preview.Body},
+		{"payload_template", req.PayloadTemplate, &preview.Payload},
+	} {
+		if strings.TrimSpace(t.text) == "" {
+			continue
+		}
+		rendered, err := client.RenderMessageTemplate(t.text, data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidWebhookConfig, t.name, err)
+		}
+		*t.out = rendered
+	}
+	return preview, nil
 }
 
-func (s *WebhookService) UpdateWebhookConfig(ctx context.Context, id int, req model.WebhookConfigRequest) error {
+// alertFromDetail - 저장된 alert 상세를 알림 전송용 model.Alert로 변환
+func alertFromDetail(detail *model.AlertDetailResponse) model.Alert {
+	var labels, annotations map[string]string
+	if len(detail.Labels) > 0 {
+		_ = json.Unmarshal(detail.Labels, &labels)
+	}
+	if len(detail.Annotations) > 0 {
+		_ = json.Unmarshal(detail.Annotations, &annotations)
+	}
+	alert := model.Alert{
+		Status:      detail.Status,
+		Labels:      labels,
+		Annotations: annotations,
+		StartsAt:    detail.FiredAt,
+		Fingerprint: detail.Fingerprint,
+	}
+	if detail.ResolvedAt != nil {
+		alert.EndsAt = *detail.ResolvedAt
+	}
+	return alert
+}
+
+// buildWebhookConfig - 요청 검증 및 정규화
+// severities(구버전 필드)는 severity 매처로 변환하며, matchers에 severity 매처가 이미 있으면 무시한다.
+func buildWebhookConfig(req model.WebhookConfigRequest) (model.WebhookConfig, error) {
 	name := strings.TrimSpace(req.Name)
 	if name == "" {
-		return fmt.Errorf("webhook name is required")
+		return model.WebhookConfig{}, fmt.Errorf("%w: webhook name is required", ErrInvalidWebhookConfig)
 	}
 
 	webhookType := strings.ToLower(strings.TrimSpace(req.Type))
@@ -72,22 +216,105 @@ func (s *WebhookService) UpdateWebhookConfig(ctx context.Context, id int, req mo
 		webhookType = "http"
 	}
 
-	severities := req.Severities
-	if severities == nil {
-		severities = []string{}
+	cfg := model.WebhookConfig{
+		Name:              name,
+		URL:               strings.TrimSpace(req.URL),
+		Type:              webhookType,
+		Token:             strings.TrimSpace(req.Token),
+		Channel:           strings.TrimSpace(req.Channel),
+		Matchers:          model.LabelMatchers{},
+		Enabled:           req.Enabled == nil || *req.Enabled,
+		Priority:          model.DefaultWebhookPriority,
+		Continue:          req.Continue,
+		UseServiceChannel: req.UseServiceChannel,
+	}
+	if req.Priority != nil {
+		cfg.Priority = *req.Priority
 	}
 
-	cfg := model.WebhookConfig{
-		Name:       name,
-		URL:        strings.TrimSpace(req.URL),
-		Type:       webhookType,
-		Token:      strings.TrimSpace(req.Token),
-		Channel:    strings.TrimSpace(req.Channel),
-		Severities: severities,
+	hasSeverityMatcher := false
+	for _, m := range req.Matchers {
+		m.Name = strings.TrimSpace(m.Name)
+		hasSeverityMatcher = hasSeverityMatcher || m.Name == "severity"
+		cfg.Matchers = append(cfg.Matchers, m)
 	}
-	return s.db.UpdateWebhookConfig(ctx, id, cfg)
+	if m, ok := model.SeverityMatcher(req.Severities); ok && !hasSeverityMatcher {
+		cfg.Matchers = append(cfg.Matchers, m)
+	}
+	if len(cfg.Matchers) > 0 {
+		if err := cfg.Matchers.Validate(); err != nil {
+			return cfg, fmt.Errorf("%w: matchers: %v", ErrInvalidWebhookConfig, err)
+		}
+	}
+
+	cfg.TitleTemplate = strings.TrimSpace(req.TitleTemplate)
+	cfg.BodyTemplate = strings.TrimSpace(req.BodyTemplate)
+	cfg.PayloadTemplate = strings.TrimSpace(req.PayloadTemplate)
+	for _, t := range []struct{ name, text string }{
+		{"title_template", cfg.TitleTemplate},
+		{"body_template", cfg.BodyTemplate},
+		{"payload_template", cfg.PayloadTemplate},
+	} {
+		if err := client.ValidateMessageTemplate(t.text); err != nil {
+			return cfg, fmt.Errorf("%w: %s: %v", ErrInvalidWebhookConfig, t.name, err)
+		}
+	}
+
+	if err := applyWebhookDeliveryOptions(&cfg, req); err != nil {
+		return cfg, err
+	}
+	return cfg, nil
 }
 
-func (s *WebhookService) DeleteWebhookConfig(ctx context.Context, id int) error {
-	return s.db.DeleteWebhookConfig(ctx, id)
+// webhookHeaderNamePattern - HTTP 헤더 이름(token) 형식
+var webhookHeaderNamePattern = regexp.MustCompile("^[!#$%&'*+\\-.^_`|~0-9A-Za-z]+$")
+
+// applyWebhookDeliveryOptions - HTTP 메서드/사용자 정의 헤더/서명/인증/mTLS 옵션 검증 후 설정에 반영
+func applyWebhookDeliveryOptions(cfg *model.WebhookConfig, req model.WebhookConfigRequest) error {
+	cfg.HTTPMethod = strings.ToUpper(strings.TrimSpace(req.HTTPMethod))
+	switch cfg.HTTPMethod {
+	case "":
+		cfg.HTTPMethod = http.MethodPost
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+	default:
+		return fmt.Errorf("%w: http_method must be POST, PUT or PATCH", ErrInvalidWebhookConfig)
+	}
+
+	cfg.SigningSecret = req.SigningSecret
+	cfg.BasicAuthUsername = strings.TrimSpace(req.BasicAuthUsername)
+	cfg.BasicAuthPassword = req.BasicAuthPassword
+	if cfg.BasicAuthPassword != "" && cfg.BasicAuthUsername == "" {
+		return fmt.Errorf("%w: basic_auth_username is required with basic_auth_password", ErrInvalidWebhookConfig)
+	}
+	if cfg.BasicAuthUsername != "" && cfg.Token != "" {
+		return fmt.Errorf("%w: token and basic auth cannot be used together", ErrInvalidWebhookConfig)
+	}
+
+	cfg.CustomHeaders = map[string]string{}
+	for name, value := range req.CustomHeaders {
+		name = http.CanonicalHeaderKey(strings.TrimSpace(name))
+		if !webhookHeaderNamePattern.MatchString(name) {
+			return fmt.Errorf("%w: custom_headers: invalid header name %q", ErrInvalidWebhookConfig, name)
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return fmt.Errorf("%w: custom_headers: header %q contains a line break", ErrInvalidWebhookConfig, name)
+		}
+		switch {
+		case name == "Host" || name == "Content-Length":
+			return fmt.Errorf("%w: custom_headers: header %q cannot be overridden", ErrInvalidWebhookConfig, name)
+		case strings.HasPrefix(name, "X-Kuberca-"):
+			return fmt.Errorf("%w: custom_headers: X-KubeRCA-* headers are reserved", ErrInvalidWebhookConfig)
+		case name == "Authorization" && (cfg.Token != "" || cfg.BasicAuthUsername != ""):
+			return fmt.Errorf("%w: custom_headers: Authorization conflicts with token or basic auth", ErrInvalidWebhookConfig)
+		}
+		cfg.CustomHeaders[name] = value
+	}
+
+	cfg.TLSClientCert = strings.TrimSpace(req.TLSClientCert)
+	cfg.TLSClientKey = strings.TrimSpace(req.TLSClientKey)
+	cfg.TLSCACert = strings.TrimSpace(req.TLSCACert)
+	if _, err := client.BuildWebhookTLSConfig(*cfg); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidWebhookConfig, err)
+	}
+	return nil
 }
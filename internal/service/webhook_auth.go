@@ -0,0 +1,319 @@
+// 인바운드 웹훅(/webhook/*) 인증 로직
+//
+// 지원 방식:
+//   - bearer: Authorization: Bearer <token>
+//   - basic:  Authorization: Basic base64(<username>:<password>)
+//   - hmac:   <SignatureHeader>: sha256=<hex(hmac_sha256(secret, timestamp + "." + body))>
+//     허용 시간차(MaxClockSkewSeconds)가 설정되어 있으면 TimestampHeader가 필수이며 시간차를 검증 (replay 방지)
+//
+// credential마다 이름을 붙여 어떤 Alertmanager receiver가 보냈는지 식별한다.
+// credential 목록은 JSON 배열(ENV 또는 파일)로 설정하며, secret에 콤마가 없는 경우에 한해 기존 콤마 구분 형식도 허용한다.
+
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+const (
+	webhookAuthTypeBearer = "bearer"
+	webhookAuthTypeBasic  = "basic"
+	webhookAuthTypeHMAC   = "hmac"
+)
+
+// 인증 거부 사유 (통계 집계 키)
+const (
+	WebhookAuthReasonMissing          = "missing_credentials"
+	WebhookAuthReasonInvalid          = "invalid_credentials"
+	WebhookAuthReasonInvalidSignature = "invalid_signature"
+	WebhookAuthReasonStaleTimestamp   = "stale_timestamp"
+	WebhookAuthReasonMissingTimestamp = "missing_timestamp"
+)
+
+// WebhookAuthError - 인증 실패 (Reason은 통계 집계용)
+type WebhookAuthError struct {
+	Reason string
+}
+
+func (e *WebhookAuthError) Error() string {
+	return "webhook authentication failed: " + e.Reason
+}
+
+type webhookCredential struct {
+	name     string
+	kind     string
+	token    string // bearer token 또는 hmac secret
+	username string
+	password string
+}
+
+// WebhookAuthenticator - 인바운드 웹훅 인증 및 거부 통계
+type WebhookAuthenticator struct {
+	credentials     []webhookCredential
+	signatureHeader string
+	timestampHeader string
+	maxClockSkew    time.Duration
+
+	mu             sync.Mutex
+	rejectedTotal  int64
+	rejectedBy     map[string]int64
+	lastRejectedAt *time.Time
+}
+
+// webhookCredentialEntry - JSON 형식 credential 항목
+type webhookCredentialEntry struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Secret   string `json:"secret"` // bearer token 또는 hmac secret
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// NewWebhookAuthenticator - ENV 설정으로 authenticator 생성 (credential 형식 오류 시 에러)
+func NewWebhookAuthenticator(cfg config.WebhookAuthConfig) (*WebhookAuthenticator, error) {
+	raw := cfg.Credentials
+	if cfg.CredentialsFile != "" {
+		if strings.TrimSpace(raw) != "" {
+			return nil, errors.New("only one of WEBHOOK_AUTH_CREDENTIALS and WEBHOOK_AUTH_CREDENTIALS_FILE may be set")
+		}
+		data, err := os.ReadFile(cfg.CredentialsFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read webhook credentials file: %w", err)
+		}
+		raw = string(data)
+	}
+	credentials, err := parseWebhookCredentials(raw)
+	if err != nil {
+		return nil, err
+	}
+	return &WebhookAuthenticator{
+		credentials:     credentials,
+		signatureHeader: cfg.SignatureHeader,
+		timestampHeader: cfg.TimestampHeader,
+		maxClockSkew:    time.Duration(cfg.MaxClockSkewSeconds) * time.Second,
+		rejectedBy:      make(map[string]int64),
+	}, nil
+}
+
+// parseWebhookCredentials - JSON 배열 또는 "name:type:secret,..." 형식 파싱
+func parseWebhookCredentials(raw string) ([]webhookCredential, error) {
+	raw = strings.TrimSpace(raw)
+	if strings.HasPrefix(raw, "[") {
+		var entries []webhookCredentialEntry
+		if err := json.Unmarshal([]byte(raw), &entries); err != nil {
+			// json 오류 원문에는 secret 일부가 포함될 수 있어 형식만 안내
+			return nil, errors.New("invalid webhook credentials: expected a JSON array of {name, type, secret|username+password}")
+		}
+		return buildWebhookCredentials(entries)
+	}
+
+	var entries []webhookCredentialEntry
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, ":", 3)
+		if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("invalid webhook credential %q: expected name:type:secret", redactCredentialEntry(entry))
+		}
+		e := webhookCredentialEntry{Name: parts[0], Type: parts[1], Secret: parts[2]}
+		if strings.EqualFold(e.Type, webhookAuthTypeBasic) {
+			userPass := strings.SplitN(parts[2], ":", 2)
+			if len(userPass) != 2 {
+				return nil, fmt.Errorf("invalid webhook credential %q: basic requires username:password", e.Name)
+			}
+			e.Username, e.Password = userPass[0], userPass[1]
+		}
+		entries = append(entries, e)
+	}
+	return buildWebhookCredentials(entries)
+}
+
+// buildWebhookCredentials - credential 항목 검증 (이름 중복, 타입별 필수 값)
+func buildWebhookCredentials(entries []webhookCredentialEntry) ([]webhookCredential, error) {
+	var credentials []webhookCredential
+	seen := make(map[string]bool)
+	for _, e := range entries {
+		if e.Name == "" {
+			return nil, errors.New("invalid webhook credential: name is required")
+		}
+		cred := webhookCredential{name: e.Name, kind: strings.ToLower(e.Type)}
+		switch cred.kind {
+		case webhookAuthTypeBearer, webhookAuthTypeHMAC:
+			if e.Secret == "" {
+				return nil, fmt.Errorf("invalid webhook credential %q: secret is required", cred.name)
+			}
+			cred.token = e.Secret
+		case webhookAuthTypeBasic:
+			if e.Username == "" {
+				return nil, fmt.Errorf("invalid webhook credential %q: basic requires username:password", cred.name)
+			}
+			cred.username, cred.password = e.Username, e.Password
+		default:
+			return nil, fmt.Errorf("invalid webhook credential %q: unsupported type %q", cred.name, e.Type)
+		}
+		if seen[cred.name] {
+			return nil, fmt.Errorf("duplicate webhook credential name: %s", cred.name)
+		}
+		seen[cred.name] = true
+		credentials = append(credentials, cred)
+	}
+	return credentials, nil
+}
+
+func redactCredentialEntry(entry string) string {
+	if idx := strings.Index(entry, ":"); idx >= 0 {
+		return entry[:idx] + ":***"
+	}
+	return "***"
+}
+
+// Enabled - credential이 하나라도 설정되어 있으면 인증 필요
+func (a *WebhookAuthenticator) Enabled() bool {
+	return a != nil && len(a.credentials) > 0
+}
+
+// Authenticate - 요청 헤더/본문을 검증하고 매칭된 credential 이름 반환
+func (a *WebhookAuthenticator) Authenticate(header http.Header, body []byte, now time.Time) (string, error) {
+	if !a.Enabled() {
+		return "", nil
+	}
+
+	authorization := header.Get("Authorization")
+	signature := header.Get(a.signatureHeader)
+	if authorization == "" && signature == "" {
+		return "", &WebhookAuthError{Reason: WebhookAuthReasonMissing}
+	}
+
+	var bearerToken, basicUser, basicPass string
+	var hasBasic bool
+	if strings.HasPrefix(authorization, "Bearer ") {
+		bearerToken = strings.TrimSpace(strings.TrimPrefix(authorization, "Bearer "))
+	} else if authorization != "" {
+		req := http.Request{Header: http.Header{"Authorization": []string{authorization}}}
+		basicUser, basicPass, hasBasic = req.BasicAuth()
+	}
+
+	var authErr error = &WebhookAuthError{Reason: WebhookAuthReasonInvalid}
+	for _, cred := range a.credentials {
+		switch cred.kind {
+		case webhookAuthTypeBearer:
+			if bearerToken != "" && constantTimeEqual(bearerToken, cred.token) {
+				return cred.name, nil
+			}
+		case webhookAuthTypeBasic:
+			if hasBasic && constantTimeEqual(basicUser, cred.username) && constantTimeEqual(basicPass, cred.password) {
+				return cred.name, nil
+			}
+		case webhookAuthTypeHMAC:
+			if signature == "" {
+				continue
+			}
+			err := a.verifySignature(cred.token, signature, header.Get(a.timestampHeader), body, now)
+			if err == nil {
+				return cred.name, nil
+			}
+			// 서명 헤더가 있으면 서명 관련 사유를 우선 보고
+			authErr = err
+		}
+	}
+	return "", authErr
+}
+
+// verifySignature - timestamp + "." + body의 HMAC 검증
+// timestamp 없는 서명은 만료되지 않아 재전송 공격에 쓰일 수 있으므로 시간차 검증이 켜져 있으면 거부한다.
+func (a *WebhookAuthenticator) verifySignature(secret, signature, timestamp string, body []byte, now time.Time) error {
+	if timestamp == "" && a.maxClockSkew > 0 {
+		return &WebhookAuthError{Reason: WebhookAuthReasonMissingTimestamp}
+	}
+	if timestamp != "" {
+		sec, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			return &WebhookAuthError{Reason: WebhookAuthReasonStaleTimestamp}
+		}
+		skew := now.Sub(time.Unix(sec, 0))
+		if a.maxClockSkew > 0 && (skew > a.maxClockSkew || skew < -a.maxClockSkew) {
+			return &WebhookAuthError{Reason: WebhookAuthReasonStaleTimestamp}
+		}
+	}
+	message := append([]byte(timestamp+"."), body...)
+
+	expected := ComputeWebhookSignature(secret, message)
+	if !hmac.Equal([]byte(strings.TrimSpace(signature)), []byte(expected)) {
+		return &WebhookAuthError{Reason: WebhookAuthReasonInvalidSignature}
+	}
+	return nil
+}
+
+// ComputeWebhookSignature - "sha256=<hex>" 형식의 HMAC-SHA256 서명 생성
+func ComputeWebhookSignature(secret string, message []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(message)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func constantTimeEqual(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
+// RecordRejection - 인증 거부 통계 집계
+func (a *WebhookAuthenticator) RecordRejection(err error, at time.Time) {
+	if a == nil {
+		return
+	}
+	reason := WebhookAuthReasonInvalid
+	var authErr *WebhookAuthError
+	if errors.As(err, &authErr) {
+		reason = authErr.Reason
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.rejectedTotal++
+	a.rejectedBy[reason]++
+	a.lastRejectedAt = &at
+}
+
+// Status - 설정된 credential 목록과 거부 통계 조회
+func (a *WebhookAuthenticator) Status() model.WebhookAuthStatus {
+	status := model.WebhookAuthStatus{
+		Credentials:      []model.WebhookAuthCredentialInfo{},
+		RejectedByReason: map[string]int64{},
+	}
+	if a == nil {
+		return status
+	}
+
+	status.Enabled = a.Enabled()
+	for _, cred := range a.credentials {
+		status.Credentials = append(status.Credentials, model.WebhookAuthCredentialInfo{Name: cred.name, Type: cred.kind})
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	status.RejectedTotal = a.rejectedTotal
+	for reason, count := range a.rejectedBy {
+		status.RejectedByReason[reason] = count
+	}
+	if a.lastRejectedAt != nil {
+		at := *a.lastRejectedAt
+		status.LastRejectedAt = &at
+	}
+	return status
+}
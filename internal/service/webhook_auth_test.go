@@ -0,0 +1,186 @@
+package service
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/kube-rca/backend/internal/config"
+)
+
+func newTestWebhookAuthenticator(t *testing.T, credentials string) *WebhookAuthenticator {
+	t.Helper()
+	auth, err := NewWebhookAuthenticator(config.WebhookAuthConfig{
+		Credentials:         credentials,
+		SignatureHeader:     "X-KubeRCA-Signature",
+		TimestampHeader:     "X-KubeRCA-Timestamp",
+		MaxClockSkewSeconds: 300,
+	})
+	if err != nil {
+		t.Fatalf("NewWebhookAuthenticator() error = %v", err)
+	}
+	return auth
+}
+
+func TestWebhookAuthenticator_DisabledWithoutCredentials(t *testing.T) {
+	auth := newTestWebhookAuthenticator(t, "")
+	if auth.Enabled() {
+		t.Fatal("Enabled() = true; want false without credentials")
+	}
+	name, err := auth.Authenticate(http.Header{}, nil, time.Now())
+	if err != nil || name != "" {
+		t.Fatalf("Authenticate() = (%q, %v); want empty credential and nil error", name, err)
+	}
+}
+
+func TestWebhookAuthenticator_InvalidConfig(t *testing.T) {
+	for _, raw := range []string{"noparts", "a:unknown:secret", "a:basic:onlyuser", "a:bearer:x,a:bearer:y"} {
+		if _, err := NewWebhookAuthenticator(config.WebhookAuthConfig{Credentials: raw}); err == nil {
+			t.Fatalf("NewWebhookAuthenticator(%q) error = nil; want error", raw)
+		}
+	}
+}
+
+func TestWebhookAuthenticator_JSONCredentials(t *testing.T) {
+	// JSON 형식은 secret에 콤마/콜론이 있어도 그대로 사용
+	auth := newTestWebhookAuthenticator(t, `[
+		{"name":"am-prod","type":"bearer","secret":"tok,en:1"},
+		{"name":"am-stg","type":"basic","username":"stg","password":"pa,ss"}
+	]`)
+
+	header := http.Header{}
+	header.Set("Authorization", "Bearer tok,en:1")
+	if name, err := auth.Authenticate(header, nil, time.Now()); err != nil || name != "am-prod" {
+		t.Fatalf("bearer Authenticate() = (%q, %v); want am-prod", name, err)
+	}
+	req, _ := http.NewRequest(http.MethodPost, "/webhook/alertmanager", nil)
+	req.SetBasicAuth("stg", "pa,ss")
+	if name, err := auth.Authenticate(req.Header, nil, time.Now()); err != nil || name != "am-stg" {
+		t.Fatalf("basic Authenticate() = (%q, %v); want am-stg", name, err)
+	}
+
+	for _, raw := range []string{`[{"name":"a","type":"bearer"}]`, `[{"type":"hmac","secret":"x"}]`, `[{"name":"a","type":"basic","password":"p"}]`, `[{"name":"a"`} {
+		if _, err := NewWebhookAuthenticator(config.WebhookAuthConfig{Credentials: raw}); err == nil {
+			t.Fatalf("NewWebhookAuthenticator(%q) error = nil; want error", raw)
+		}
+	}
+}
+
+func TestWebhookAuthenticator_CredentialsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	if err := os.WriteFile(path, []byte(`[{"name":"grafana","type":"hmac","secret":"s3cr,et"}]`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	auth, err := NewWebhookAuthenticator(config.WebhookAuthConfig{CredentialsFile: path})
+	if err != nil {
+		t.Fatalf("NewWebhookAuthenticator(file) error = %v", err)
+	}
+	if status := auth.Status(); len(status.Credentials) != 1 || status.Credentials[0].Name != "grafana" {
+		t.Fatalf("credentials = %+v; want grafana from file", status.Credentials)
+	}
+
+	if _, err := NewWebhookAuthenticator(config.WebhookAuthConfig{Credentials: "a:bearer:x", CredentialsFile: path}); err == nil {
+		t.Fatal("NewWebhookAuthenticator(both) error = nil; want error")
+	}
+	if _, err := NewWebhookAuthenticator(config.WebhookAuthConfig{CredentialsFile: filepath.Join(t.TempDir(), "missing.json")}); err == nil {
+		t.Fatal("NewWebhookAuthenticator(missing file) error = nil; want error")
+	}
+}
+
+func TestWebhookAuthenticator_BearerAndBasic(t *testing.T) {
+	auth := newTestWebhookAuthenticator(t, "am-prod:bearer:prod-token, am-stg:basic:stg:pa:ss")
+
+	header := http.Header{}
+	header.Set("Authorization", "Bearer prod-token")
+	if name, err := auth.Authenticate(header, nil, time.Now()); err != nil || name != "am-prod" {
+		t.Fatalf("bearer Authenticate() = (%q, %v); want am-prod", name, err)
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, "/webhook/alertmanager", nil)
+	req.SetBasicAuth("stg", "pa:ss")
+	if name, err := auth.Authenticate(req.Header, nil, time.Now()); err != nil || name != "am-stg" {
+		t.Fatalf("basic Authenticate() = (%q, %v); want am-stg", name, err)
+	}
+
+	header.Set("Authorization", "Bearer wrong")
+	_, err := auth.Authenticate(header, nil, time.Now())
+	var authErr *WebhookAuthError
+	if !errors.As(err, &authErr) || authErr.Reason != WebhookAuthReasonInvalid {
+		t.Fatalf("Authenticate(wrong) error = %v; want %s", err, WebhookAuthReasonInvalid)
+	}
+}
+
+func TestWebhookAuthenticator_HMACSignature(t *testing.T) {
+	auth := newTestWebhookAuthenticator(t, "grafana:hmac:s3cret")
+	body := []byte(`{"alerts":[]}`)
+	now := time.Unix(1_700_000_000, 0)
+	ts := strconv.FormatInt(now.Unix(), 10)
+
+	header := http.Header{}
+	header.Set("X-KubeRCA-Timestamp", ts)
+	header.Set("X-KubeRCA-Signature", ComputeWebhookSignature("s3cret", append([]byte(ts+"."), body...)))
+	if name, err := auth.Authenticate(header, body, now); err != nil || name != "grafana" {
+		t.Fatalf("hmac Authenticate() = (%q, %v); want grafana", name, err)
+	}
+
+	// 본문이 변조되면 거부
+	_, err := auth.Authenticate(header, []byte(`{"alerts":[{}]}`), now)
+	var authErr *WebhookAuthError
+	if !errors.As(err, &authErr) || authErr.Reason != WebhookAuthReasonInvalidSignature {
+		t.Fatalf("tampered Authenticate() error = %v; want %s", err, WebhookAuthReasonInvalidSignature)
+	}
+
+	// 허용 시간차를 넘은 timestamp는 거부
+	_, err = auth.Authenticate(header, body, now.Add(10*time.Minute))
+	if !errors.As(err, &authErr) || authErr.Reason != WebhookAuthReasonStaleTimestamp {
+		t.Fatalf("stale Authenticate() error = %v; want %s", err, WebhookAuthReasonStaleTimestamp)
+	}
+}
+
+func TestWebhookAuthenticator_HMACRequiresTimestamp(t *testing.T) {
+	auth := newTestWebhookAuthenticator(t, "grafana:hmac:s3cret")
+	body := []byte(`{"alerts":[]}`)
+
+	// 본문만 서명한 요청은 만료되지 않으므로 재전송 방지를 위해 거부
+	header := http.Header{}
+	header.Set("X-KubeRCA-Signature", ComputeWebhookSignature("s3cret", body))
+	_, err := auth.Authenticate(header, body, time.Now())
+	var authErr *WebhookAuthError
+	if !errors.As(err, &authErr) || authErr.Reason != WebhookAuthReasonMissingTimestamp {
+		t.Fatalf("Authenticate(no timestamp) error = %v; want %s", err, WebhookAuthReasonMissingTimestamp)
+	}
+
+	// 시간차 검증을 끈 경우에도 서명 대상은 timestamp + "." + body (빈 timestamp)
+	noSkew, err := NewWebhookAuthenticator(config.WebhookAuthConfig{Credentials: "grafana:hmac:s3cret", SignatureHeader: "X-KubeRCA-Signature", TimestampHeader: "X-KubeRCA-Timestamp"})
+	if err != nil {
+		t.Fatalf("NewWebhookAuthenticator() error = %v", err)
+	}
+	header.Set("X-KubeRCA-Signature", ComputeWebhookSignature("s3cret", append([]byte("."), body...)))
+	if name, err := noSkew.Authenticate(header, body, time.Now()); err != nil || name != "grafana" {
+		t.Fatalf("Authenticate(skew disabled) = (%q, %v); want grafana", name, err)
+	}
+}
+
+func TestWebhookAuthenticator_RecordRejection(t *testing.T) {
+	auth := newTestWebhookAuthenticator(t, "am:bearer:token")
+	now := time.Now()
+
+	_, err := auth.Authenticate(http.Header{}, nil, now)
+	auth.RecordRejection(err, now)
+	auth.RecordRejection(&WebhookAuthError{Reason: WebhookAuthReasonInvalid}, now)
+
+	status := auth.Status()
+	if status.RejectedTotal != 2 {
+		t.Fatalf("RejectedTotal = %d; want 2", status.RejectedTotal)
+	}
+	if status.RejectedByReason[WebhookAuthReasonMissing] != 1 || status.RejectedByReason[WebhookAuthReasonInvalid] != 1 {
+		t.Fatalf("RejectedByReason = %v", status.RejectedByReason)
+	}
+	if len(status.Credentials) != 1 || status.Credentials[0].Name != "am" || status.Credentials[0].Type != "bearer" {
+		t.Fatalf("Credentials = %+v", status.Credentials)
+	}
+}
@@ -0,0 +1,314 @@
+// Webhook inbox 비동기 처리 로직
+//
+// 처리 흐름:
+//  1. handler가 수신한 웹훅 원본을 webhook_inbox에 저장 (pending) 후 즉시 202 응답
+//  2. worker pool이 pending entry를 점유(processing)하여 AlertService.IngestWebhook 실행
+//     - 같은 그룹(수신 경로 + Alertmanager groupKey)은 가장 오래된 미완료 entry만 점유하여 수신 순서대로 처리
+//  3. 성공: done / 실패: 지수 백오프 후 재시도, 최대 시도 횟수 초과 시 dead
+//     - Alert 저장 실패는 실패한 alert만 payload에 남겨 재시도 (처리된 alert의 알림 중복 방지)
+//     - 결과는 점유 시 받은 attempts와 일치할 때만 기록 (stale로 다시 점유된 entry는 새 worker가 기록)
+//     - done entry는 보관 기간(RetentionDays) 후 삭제
+//  4. 관리자 API로 목록/상세 조회 및 저장된 payload 재처리(replay)
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/db"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// webhookInboxStore - WebhookInboxService가 사용하는 DB 인터페이스
+type webhookInboxStore interface {
+	InsertWebhookInboxEntry(ctx context.Context, source, credential, groupKey string, payload json.RawMessage, alertCount, truncatedAlerts int) (int64, error)
+	ClaimWebhookInboxEntries(ctx context.Context, limit int, staleAfter time.Duration) ([]model.WebhookInboxEntry, error)
+	CompleteWebhookInboxEntry(ctx context.Context, id int64, attempts, sent, failed int) error
+	FailWebhookInboxEntry(ctx context.Context, id int64, attempts int, errMsg string, nextAttemptAt time.Time, dead bool) error
+	RetainWebhookInboxAlerts(ctx context.Context, id int64, attempts int, payload json.RawMessage, alertCount int) error
+	PurgeDoneWebhookInbox(ctx context.Context, before time.Time) (int64, error)
+	ListWebhookInboxEntries(ctx context.Context, status string, limit int) ([]model.WebhookInboxEntry, error)
+	GetWebhookInboxEntry(ctx context.Context, id int64) (*model.WebhookInboxEntry, error)
+	RequeueWebhookInboxEntry(ctx context.Context, id int64) error
+}
+
+// webhookIngester - inbox entry를 실제로 처리하는 인터페이스 (AlertService)
+type webhookIngester interface {
+	IngestWebhook(webhook model.AlertmanagerWebhook) (sent, failed int, err error)
+}
+
+// WebhookInboxService - 수신 웹훅 영속화 및 비동기 처리 서비스
+type WebhookInboxService struct {
+	store    webhookInboxStore
+	ingester webhookIngester
+	cfg      config.WebhookInboxConfig
+	wake     chan struct{}
+	now      func() time.Time
+
+	// onTruncated - max_alerts로 생략된 alert가 있는 웹훅 수신 시 호출 (Alertmanager 동기화 트리거)
+	onTruncated func()
+}
+
+func NewWebhookInboxService(store webhookInboxStore, ingester webhookIngester, cfg config.WebhookInboxConfig) *WebhookInboxService {
+	if cfg.Workers <= 0 {
+		cfg.Workers = 1
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 1
+	}
+	if cfg.PollIntervalSecs <= 0 {
+		cfg.PollIntervalSecs = 2
+	}
+	if cfg.StaleLockSeconds <= 0 {
+		cfg.StaleLockSeconds = 300
+	}
+	return &WebhookInboxService{
+		store:    store,
+		ingester: ingester,
+		cfg:      cfg,
+		wake:     make(chan struct{}, 1),
+		now:      time.Now,
+	}
+}
+
+// Enqueue - 웹훅 원본을 inbox에 저장하고 worker를 깨움
+func (s *WebhookInboxService) Enqueue(ctx context.Context, webhook model.AlertmanagerWebhook, source, credential string) (int64, error) {
+	payload, err := json.Marshal(webhook)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+	id, err := s.store.InsertWebhookInboxEntry(ctx, source, credential, webhookInboxGroupKey(source, webhook), payload, len(webhook.Alerts), webhook.TruncatedAlerts)
+	if err != nil {
+		return 0, err
+	}
+	s.notify()
+	if webhook.TruncatedAlerts > 0 {
+		log.Printf("Webhook truncated by Alertmanager (inbox_id=%d, group_key=%s, truncated_alerts=%d)", id, webhook.GroupKey, webhook.TruncatedAlerts)
+		if s.onTruncated != nil {
+			s.onTruncated()
+		}
+	}
+	return id, nil
+}
+
+// webhookInboxGroupKey - 처리 순서를 보장할 단위
+// Alertmanager groupKey가 있으면 수신 경로 + groupKey, 없으면 단일 alert는 fingerprint, 그 밖에는 수신 경로 전체
+func webhookInboxGroupKey(source string, webhook model.AlertmanagerWebhook) string {
+	if webhook.GroupKey != "" {
+		return source + ":" + webhook.GroupKey
+	}
+	if len(webhook.Alerts) == 1 && webhook.Alerts[0].Fingerprint != "" {
+		return source + ":fingerprint:" + webhook.Alerts[0].Fingerprint
+	}
+	return source
+}
+
+// SetTruncationHandler - truncated 웹훅 수신 시 호출할 함수 등록
+func (s *WebhookInboxService) SetTruncationHandler(fn func()) {
+	s.onTruncated = fn
+}
+
+func (s *WebhookInboxService) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Start - worker pool 시작 (ctx 종료 시 중단)
+// 각 worker는 wake 신호 또는 poll 주기마다 처리 가능한 entry를 하나씩 점유한다.
+func (s *WebhookInboxService) Start(ctx context.Context) {
+	for i := 0; i < s.cfg.Workers; i++ {
+		go s.runWorker(ctx)
+	}
+	if s.cfg.RetentionDays > 0 {
+		go s.runPurge(ctx)
+	}
+	log.Printf("Webhook inbox started (workers=%d, max_attempts=%d, retention_days=%d)", s.cfg.Workers, s.cfg.MaxAttempts, s.cfg.RetentionDays)
+}
+
+func (s *WebhookInboxService) runWorker(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(s.cfg.PollIntervalSecs) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		// 처리할 entry가 남아 있는 동안 연속 처리
+		for s.ProcessNext(ctx) {
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *WebhookInboxService) runPurge(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		s.PurgeDone(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeDone - 보관 기간이 지난 done entry 삭제
+func (s *WebhookInboxService) PurgeDone(ctx context.Context) {
+	before := s.now().AddDate(0, 0, -s.cfg.RetentionDays)
+	if purged, err := s.store.PurgeDoneWebhookInbox(ctx, before); err != nil {
+		log.Printf("Failed to purge webhook inbox: %v", err)
+	} else if purged > 0 {
+		log.Printf("Purged done webhook inbox entries (count=%d)", purged)
+	}
+}
+
+// ProcessNext - entry 하나를 점유하여 처리 (처리한 entry가 있으면 true)
+func (s *WebhookInboxService) ProcessNext(ctx context.Context) bool {
+	entries, err := s.store.ClaimWebhookInboxEntries(ctx, 1, time.Duration(s.cfg.StaleLockSeconds)*time.Second)
+	if err != nil {
+		log.Printf("Failed to claim webhook inbox entries: %v", err)
+		return false
+	}
+	if len(entries) == 0 {
+		return false
+	}
+	for _, entry := range entries {
+		s.processEntry(ctx, entry)
+	}
+	return true
+}
+
+func (s *WebhookInboxService) processEntry(ctx context.Context, entry model.WebhookInboxEntry) {
+	sent, failed, err := s.ingest(entry)
+	if err == nil {
+		if err := s.store.CompleteWebhookInboxEntry(ctx, entry.ID, entry.Attempts, sent, failed); errors.Is(err, db.ErrWebhookInboxEntryNotOwned) {
+			log.Printf("Webhook inbox entry was reclaimed by another worker, skipping completion (id=%d, attempts=%d)", entry.ID, entry.Attempts)
+		} else if err != nil {
+			log.Printf("Failed to complete webhook inbox entry (id=%d): %v", entry.ID, err)
+		}
+		return
+	}
+
+	dead := entry.Attempts >= s.cfg.MaxAttempts
+	var saveErr *AlertSaveError
+	if errors.As(err, &saveErr) && !s.retainFailedAlerts(ctx, entry, saveErr.Alerts) {
+		return
+	}
+	nextAttemptAt := s.now().Add(s.retryBackoff(entry.Attempts))
+	if dead {
+		log.Printf("Webhook inbox entry moved to dead-letter (id=%d, attempts=%d): %v", entry.ID, entry.Attempts, err)
+	} else {
+		log.Printf("Webhook inbox entry failed, retrying at %s (id=%d, attempts=%d): %v", nextAttemptAt.Format(time.RFC3339), entry.ID, entry.Attempts, err)
+	}
+	if err := s.store.FailWebhookInboxEntry(ctx, entry.ID, entry.Attempts, err.Error(), nextAttemptAt, dead); errors.Is(err, db.ErrWebhookInboxEntryNotOwned) {
+		log.Printf("Webhook inbox entry was reclaimed by another worker, skipping failure record (id=%d, attempts=%d)", entry.ID, entry.Attempts)
+	} else if err != nil {
+		log.Printf("Failed to record webhook inbox failure (id=%d): %v", entry.ID, err)
+	}
+}
+
+// retainFailedAlerts - 저장에 실패한 alert만 payload에 남김 (이미 처리된 alert는 재시도에서 다시 알림을 보내지 않음)
+// 다른 worker가 entry를 다시 점유했으면 false (실패 기록도 하지 않음)
+func (s *WebhookInboxService) retainFailedAlerts(ctx context.Context, entry model.WebhookInboxEntry, alerts []model.Alert) bool {
+	var webhook model.AlertmanagerWebhook
+	if err := json.Unmarshal(entry.Payload, &webhook); err != nil || len(alerts) == 0 || len(alerts) >= len(webhook.Alerts) {
+		return true
+	}
+	webhook.Alerts = alerts
+	payload, err := json.Marshal(webhook)
+	if err != nil {
+		log.Printf("Failed to encode webhook inbox retry payload (id=%d): %v", entry.ID, err)
+		return true
+	}
+	if err := s.store.RetainWebhookInboxAlerts(ctx, entry.ID, entry.Attempts, payload, len(alerts)); errors.Is(err, db.ErrWebhookInboxEntryNotOwned) {
+		log.Printf("Webhook inbox entry was reclaimed by another worker, skipping retry payload (id=%d, attempts=%d)", entry.ID, entry.Attempts)
+		return false
+	} else if err != nil {
+		log.Printf("Failed to narrow webhook inbox entry to failed alerts (id=%d): %v", entry.ID, err)
+	}
+	return true
+}
+
+// ingest - 저장된 payload를 복원하여 처리 (panic도 실패로 기록)
+func (s *WebhookInboxService) ingest(entry model.WebhookInboxEntry) (sent, failed int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while processing webhook: %v", r)
+		}
+	}()
+
+	var webhook model.AlertmanagerWebhook
+	if err := json.Unmarshal(entry.Payload, &webhook); err != nil {
+		return 0, 0, fmt.Errorf("failed to decode stored payload: %w", err)
+	}
+	// 수신 경로와 인증에 사용된 credential 이름을 Alert에 기록 (어떤 receiver가 보냈는지 식별)
+	for i := range webhook.Alerts {
+		webhook.Alerts[i].Source = entry.Source
+		webhook.Alerts[i].Credential = entry.Credential
+	}
+	return s.ingester.IngestWebhook(webhook)
+}
+
+// retryBackoff - 지수 백오프 (base * 2^(attempts-1), 최대 max)
+func (s *WebhookInboxService) retryBackoff(attempts int) time.Duration {
+	return exponentialBackoff(s.cfg.RetryBaseBackoffSecs, s.cfg.RetryMaxBackoffSecs, attempts)
+}
+
+// exponentialBackoff - base * 2^(attempts-1), 최대 max (초 단위 설정, max 0 = 상한 없음)
+func exponentialBackoff(baseSecs, maxSecs, attempts int) time.Duration {
+	base := time.Duration(baseSecs) * time.Second
+	maxBackoff := time.Duration(maxSecs) * time.Second
+	if base <= 0 {
+		return 0
+	}
+	backoff := base
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if maxBackoff > 0 && backoff >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	if maxBackoff > 0 && backoff > maxBackoff {
+		return maxBackoff
+	}
+	return backoff
+}
+
+// List - inbox 목록 조회 (payload 제외)
+func (s *WebhookInboxService) List(ctx context.Context, status string, limit int) ([]model.WebhookInboxEntry, error) {
+	switch status {
+	case "", model.WebhookInboxStatusPending, model.WebhookInboxStatusProcessing, model.WebhookInboxStatusDone, model.WebhookInboxStatusDead:
+	default:
+		return nil, fmt.Errorf("invalid status: %s", status)
+	}
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	return s.store.ListWebhookInboxEntries(ctx, status, limit)
+}
+
+// Get - inbox 단건 조회 (payload 포함, 없으면 nil)
+func (s *WebhookInboxService) Get(ctx context.Context, id int64) (*model.WebhookInboxEntry, error) {
+	return s.store.GetWebhookInboxEntry(ctx, id)
+}
+
+// Replay - 저장된 payload를 다시 처리하도록 pending으로 되돌림
+func (s *WebhookInboxService) Replay(ctx context.Context, id int64) error {
+	if err := s.store.RequeueWebhookInboxEntry(ctx, id); err != nil {
+		return err
+	}
+	s.notify()
+	return nil
+}
@@ -0,0 +1,427 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kube-rca/backend/internal/config"
+	"github.com/kube-rca/backend/internal/db"
+	"github.com/kube-rca/backend/internal/model"
+)
+
+// ============================================================================
+// Mock: webhookInboxStore / webhookIngester
+// ============================================================================
+
+type webhookInboxStoreMock struct {
+	entries   map[int64]*model.WebhookInboxEntry
+	nextID    int64
+	completed map[int64][2]int
+	failures  []inboxFailure
+	purgedAt  []time.Time
+}
+
+type inboxFailure struct {
+	ID            int64
+	Err           string
+	NextAttemptAt time.Time
+	Dead          bool
+}
+
+func newWebhookInboxStoreMock() *webhookInboxStoreMock {
+	return &webhookInboxStoreMock{
+		entries:   make(map[int64]*model.WebhookInboxEntry),
+		completed: make(map[int64][2]int),
+	}
+}
+
+func (m *webhookInboxStoreMock) InsertWebhookInboxEntry(_ context.Context, source, credential, groupKey string, payload json.RawMessage, alertCount, truncatedAlerts int) (int64, error) {
+	m.nextID++
+	m.entries[m.nextID] = &model.WebhookInboxEntry{
+		ID:              m.nextID,
+		Source:          source,
+		Credential:      credential,
+		GroupKey:        groupKey,
+		Status:          model.WebhookInboxStatusPending,
+		AlertCount:      alertCount,
+		TruncatedAlerts: truncatedAlerts,
+		Payload:         payload,
+	}
+	return m.nextID, nil
+}
+
+func (m *webhookInboxStoreMock) ClaimWebhookInboxEntries(_ context.Context, limit int, _ time.Duration) ([]model.WebhookInboxEntry, error) {
+	var claimed []model.WebhookInboxEntry
+	blocked := make(map[string]bool)
+	for id := int64(1); id <= m.nextID && len(claimed) < limit; id++ {
+		e, ok := m.entries[id]
+		if !ok || (e.Status != model.WebhookInboxStatusPending && e.Status != model.WebhookInboxStatusProcessing) {
+			continue
+		}
+		if blocked[e.GroupKey] || e.Status != model.WebhookInboxStatusPending {
+			blocked[e.GroupKey] = true
+			continue
+		}
+		blocked[e.GroupKey] = true
+		e.Status = model.WebhookInboxStatusProcessing
+		e.Attempts++
+		claimed = append(claimed, *e)
+	}
+	return claimed, nil
+}
+
+// owns - 점유 시 받은 attempts로 아직 점유 중인지 확인 (DB의 status/attempts 조건과 동일)
+func (m *webhookInboxStoreMock) owns(id int64, attempts int) bool {
+	e := m.entries[id]
+	return e.Status == model.WebhookInboxStatusProcessing && e.Attempts == attempts
+}
+
+func (m *webhookInboxStoreMock) CompleteWebhookInboxEntry(_ context.Context, id int64, attempts, sent, failed int) error {
+	if !m.owns(id, attempts) {
+		return db.ErrWebhookInboxEntryNotOwned
+	}
+	m.entries[id].Status = model.WebhookInboxStatusDone
+	m.completed[id] = [2]int{sent, failed}
+	return nil
+}
+
+func (m *webhookInboxStoreMock) RetainWebhookInboxAlerts(_ context.Context, id int64, attempts int, payload json.RawMessage, alertCount int) error {
+	if !m.owns(id, attempts) {
+		return db.ErrWebhookInboxEntryNotOwned
+	}
+	m.entries[id].Payload = payload
+	m.entries[id].AlertCount = alertCount
+	return nil
+}
+
+func (m *webhookInboxStoreMock) FailWebhookInboxEntry(_ context.Context, id int64, attempts int, errMsg string, nextAttemptAt time.Time, dead bool) error {
+	if !m.owns(id, attempts) {
+		return db.ErrWebhookInboxEntryNotOwned
+	}
+	m.failures = append(m.failures, inboxFailure{ID: id, Err: errMsg, NextAttemptAt: nextAttemptAt, Dead: dead})
+	m.entries[id].LastError = errMsg
+	if dead {
+		m.entries[id].Status = model.WebhookInboxStatusDead
+	} else {
+		m.entries[id].Status = model.WebhookInboxStatusPending
+	}
+	return nil
+}
+
+func (m *webhookInboxStoreMock) PurgeDoneWebhookInbox(_ context.Context, before time.Time) (int64, error) {
+	m.purgedAt = append(m.purgedAt, before)
+	var purged int64
+	for id, e := range m.entries {
+		if e.Status == model.WebhookInboxStatusDone {
+			delete(m.entries, id)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+func (m *webhookInboxStoreMock) ListWebhookInboxEntries(_ context.Context, status string, _ int) ([]model.WebhookInboxEntry, error) {
+	var list []model.WebhookInboxEntry
+	for _, e := range m.entries {
+		if status == "" || e.Status == status {
+			list = append(list, *e)
+		}
+	}
+	return list, nil
+}
+
+func (m *webhookInboxStoreMock) GetWebhookInboxEntry(_ context.Context, id int64) (*model.WebhookInboxEntry, error) {
+	return m.entries[id], nil
+}
+
+func (m *webhookInboxStoreMock) RequeueWebhookInboxEntry(_ context.Context, id int64) error {
+	e, ok := m.entries[id]
+	if !ok {
+		return errors.New("not found")
+	}
+	e.Status = model.WebhookInboxStatusPending
+	e.Attempts = 0
+	return nil
+}
+
+type webhookIngesterMock struct {
+	calls    []model.AlertmanagerWebhook
+	errs     []error
+	panics   bool
+	onIngest func()
+}
+
+func (m *webhookIngesterMock) IngestWebhook(webhook model.AlertmanagerWebhook) (int, int, error) {
+	m.calls = append(m.calls, webhook)
+	if m.onIngest != nil {
+		m.onIngest()
+	}
+	if m.panics {
+		panic("boom")
+	}
+	if len(m.errs) > 0 {
+		err := m.errs[0]
+		m.errs = m.errs[1:]
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	return len(webhook.Alerts), 0, nil
+}
+
+func newTestWebhookInboxService(store *webhookInboxStoreMock, ingester *webhookIngesterMock, maxAttempts int) *WebhookInboxService {
+	svc := NewWebhookInboxService(store, ingester, config.WebhookInboxConfig{
+		Workers:              1,
+		MaxAttempts:          maxAttempts,
+		RetryBaseBackoffSecs: 5,
+		RetryMaxBackoffSecs:  60,
+	})
+	svc.now = func() time.Time { return time.Unix(1_700_000_000, 0) }
+	return svc
+}
+
+// ============================================================================
+// Tests
+// ============================================================================
+
+func TestWebhookInbox_ProcessesEntryWithCredential(t *testing.T) {
+	store := newWebhookInboxStoreMock()
+	ingester := &webhookIngesterMock{}
+	svc := newTestWebhookInboxService(store, ingester, 3)
+	ctx := context.Background()
+
+	id, err := svc.Enqueue(ctx, makeWebhook(makeAlert("fp-1", "firing", "warning")), "alertmanager", "am-prod")
+	if err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	if !svc.ProcessNext(ctx) {
+		t.Fatal("ProcessNext() = false; want true")
+	}
+	if svc.ProcessNext(ctx) {
+		t.Fatal("ProcessNext() on empty inbox = true; want false")
+	}
+
+	if len(ingester.calls) != 1 || ingester.calls[0].Alerts[0].Credential != "am-prod" {
+		t.Fatalf("IngestWebhook calls = %+v; want one call with credential am-prod", ingester.calls)
+	}
+	if store.entries[id].Status != model.WebhookInboxStatusDone || store.completed[id] != [2]int{1, 0} {
+		t.Fatalf("entry status = %s, completed = %v; want done with sent=1", store.entries[id].Status, store.completed[id])
+	}
+}
+
+func TestWebhookInbox_RecordsTruncationAndTriggersHandler(t *testing.T) {
+	store := newWebhookInboxStoreMock()
+	svc := newTestWebhookInboxService(store, &webhookIngesterMock{}, 3)
+	triggered := 0
+	svc.SetTruncationHandler(func() { triggered++ })
+
+	webhook := makeWebhook(makeAlert("fp-1", "firing", "warning"))
+	if _, err := svc.Enqueue(context.Background(), webhook, "alertmanager", ""); err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	webhook.TruncatedAlerts = 7
+	id, err := svc.Enqueue(context.Background(), webhook, "alertmanager", "")
+	if err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+
+	if store.entries[id].TruncatedAlerts != 7 {
+		t.Fatalf("truncated_alerts = %d; want 7", store.entries[id].TruncatedAlerts)
+	}
+	if triggered != 1 {
+		t.Fatalf("truncation handler called %d times; want 1", triggered)
+	}
+}
+
+func TestWebhookInbox_RetriesThenDeadLetters(t *testing.T) {
+	store := newWebhookInboxStoreMock()
+	ingester := &webhookIngesterMock{errs: []error{errMock, errMock}}
+	svc := newTestWebhookInboxService(store, ingester, 2)
+	ctx := context.Background()
+
+	id, _ := svc.Enqueue(ctx, makeWebhook(makeAlert("fp-1", "firing", "warning")), "alertmanager", "")
+
+	svc.ProcessNext(ctx)
+	if len(store.failures) != 1 || store.failures[0].Dead {
+		t.Fatalf("first failure = %+v; want retryable failure", store.failures)
+	}
+	if want := svc.now().Add(5 * time.Second); !store.failures[0].NextAttemptAt.Equal(want) {
+		t.Fatalf("NextAttemptAt = %v; want %v", store.failures[0].NextAttemptAt, want)
+	}
+
+	svc.ProcessNext(ctx)
+	if len(store.failures) != 2 || !store.failures[1].Dead {
+		t.Fatalf("second failure = %+v; want dead-letter", store.failures)
+	}
+	if store.entries[id].Status != model.WebhookInboxStatusDead {
+		t.Fatalf("entry status = %s; want dead", store.entries[id].Status)
+	}
+
+	// replay 후에는 다시 처리된다
+	if err := svc.Replay(ctx, id); err != nil {
+		t.Fatalf("Replay() error = %v", err)
+	}
+	svc.ProcessNext(ctx)
+	if store.entries[id].Status != model.WebhookInboxStatusDone {
+		t.Fatalf("entry status after replay = %s; want done", store.entries[id].Status)
+	}
+}
+
+func TestWebhookInbox_RetriesOnlyFailedAlerts(t *testing.T) {
+	store := newWebhookInboxStoreMock()
+	failedAlert := makeAlert("fp-2", "firing", "warning")
+	ingester := &webhookIngesterMock{errs: []error{&AlertSaveError{Alerts: []model.Alert{failedAlert}, Err: errMock}}}
+	svc := newTestWebhookInboxService(store, ingester, 3)
+	ctx := context.Background()
+
+	id, _ := svc.Enqueue(ctx, makeWebhook(makeAlert("fp-1", "firing", "warning"), failedAlert), "alertmanager", "")
+	svc.ProcessNext(ctx)
+	svc.ProcessNext(ctx)
+
+	if len(ingester.calls) != 2 {
+		t.Fatalf("IngestWebhook calls = %d; want 2", len(ingester.calls))
+	}
+	retry := ingester.calls[1]
+	if len(retry.Alerts) != 1 || retry.Alerts[0].Fingerprint != "fp-2" {
+		t.Fatalf("retry alerts = %+v; want only fp-2", retry.Alerts)
+	}
+	if store.entries[id].AlertCount != 1 || store.entries[id].Status != model.WebhookInboxStatusDone {
+		t.Fatalf("entry = %+v; want done with alert_count=1", store.entries[id])
+	}
+}
+
+func TestWebhookInbox_ReclaimedEntryIsNotOverwritten(t *testing.T) {
+	store := newWebhookInboxStoreMock()
+	ingester := &webhookIngesterMock{}
+	svc := newTestWebhookInboxService(store, ingester, 3)
+	ctx := context.Background()
+
+	id, _ := svc.Enqueue(ctx, makeWebhook(makeAlert("fp-1", "firing", "warning")), "alertmanager", "")
+	// 처리 중 lock이 만료되어 다른 worker가 다시 점유한 상황
+	ingester.onIngest = func() { store.entries[id].Attempts++ }
+	svc.ProcessNext(ctx)
+
+	if store.entries[id].Status != model.WebhookInboxStatusProcessing {
+		t.Fatalf("entry status = %s; want processing (owned by the new worker)", store.entries[id].Status)
+	}
+	if _, ok := store.completed[id]; ok {
+		t.Fatal("stale worker completed an entry it no longer owns")
+	}
+
+	// 실패 기록도 마찬가지로 건너뛴다
+	store.entries[id].Status = model.WebhookInboxStatusPending
+	ingester.errs = []error{errMock}
+	svc.ProcessNext(ctx)
+	if len(store.failures) != 0 || store.entries[id].Status != model.WebhookInboxStatusProcessing {
+		t.Fatalf("failures = %+v, status = %s; want no failure recorded by the stale worker", store.failures, store.entries[id].Status)
+	}
+}
+
+func TestWebhookInbox_PurgeDoneUsesRetention(t *testing.T) {
+	store := newWebhookInboxStoreMock()
+	svc := newTestWebhookInboxService(store, &webhookIngesterMock{}, 3)
+	svc.cfg.RetentionDays = 7
+	ctx := context.Background()
+
+	doneID, _ := svc.Enqueue(ctx, makeWebhook(makeAlert("fp-1", "firing", "warning")), "alertmanager", "")
+	svc.ProcessNext(ctx)
+	pendingID, _ := svc.Enqueue(ctx, makeWebhook(makeAlert("fp-2", "firing", "warning")), "alertmanager", "")
+
+	svc.PurgeDone(ctx)
+
+	if want := svc.now().AddDate(0, 0, -7); len(store.purgedAt) != 1 || !store.purgedAt[0].Equal(want) {
+		t.Fatalf("purge cutoff = %v; want %v", store.purgedAt, want)
+	}
+	if _, ok := store.entries[doneID]; ok {
+		t.Fatal("done entry was not purged")
+	}
+	if _, ok := store.entries[pendingID]; !ok {
+		t.Fatal("pending entry was purged")
+	}
+}
+
+func TestWebhookInbox_PanicIsRecordedAsFailure(t *testing.T) {
+	store := newWebhookInboxStoreMock()
+	ingester := &webhookIngesterMock{panics: true}
+	svc := newTestWebhookInboxService(store, ingester, 5)
+	ctx := context.Background()
+
+	svc.Enqueue(ctx, makeWebhook(makeAlert("fp-1", "firing", "warning")), "alertmanager", "")
+	svc.ProcessNext(ctx)
+
+	if len(store.failures) != 1 || store.failures[0].Dead {
+		t.Fatalf("failures = %+v; want one retryable failure", store.failures)
+	}
+}
+
+func TestWebhookInbox_SameGroupWaitsForOlderEntry(t *testing.T) {
+	store := newWebhookInboxStoreMock()
+	ingester := &webhookIngesterMock{errs: []error{errMock}}
+	svc := newTestWebhookInboxService(store, ingester, 5)
+	ctx := context.Background()
+
+	first := makeWebhook(makeAlert("fp-1", "firing", "warning"))
+	first.GroupKey = "{}:{alertname=\"A\"}"
+	second := makeWebhook(makeAlert("fp-1", "resolved", "warning"))
+	second.GroupKey = first.GroupKey
+	other := makeWebhook(makeAlert("fp-9", "firing", "warning"))
+	other.GroupKey = "{}:{alertname=\"B\"}"
+	for _, w := range []model.AlertmanagerWebhook{first, second, other} {
+		if _, err := svc.Enqueue(ctx, w, "alertmanager", ""); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+	}
+
+	// 첫 entry가 실패해도 같은 그룹의 resolved는 먼저 처리되지 않는다
+	for svc.ProcessNext(ctx) {
+	}
+	var order []string
+	for _, call := range ingester.calls {
+		order = append(order, call.Alerts[0].Fingerprint+"/"+call.Alerts[0].Status)
+	}
+	want := []string{"fp-1/firing", "fp-1/firing", "fp-1/resolved", "fp-9/firing"}
+	if len(order) != len(want) {
+		t.Fatalf("ingest order = %v; want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("ingest order = %v; want %v", order, want)
+		}
+	}
+}
+
+func TestWebhookInboxGroupKey(t *testing.T) {
+	grouped := makeWebhook(makeAlert("fp-1", "firing", "warning"))
+	grouped.GroupKey = "{}:{alertname=\"A\"}"
+	if got := webhookInboxGroupKey("alertmanager", grouped); got != "alertmanager:{}:{alertname=\"A\"}" {
+		t.Fatalf("group key = %q", got)
+	}
+	single := makeWebhook(makeAlert("fp-1", "firing", "warning"))
+	if got := webhookInboxGroupKey("pagerduty", single); got != "pagerduty:fingerprint:fp-1" {
+		t.Fatalf("single alert group key = %q", got)
+	}
+	batch := makeWebhook(makeAlert("fp-1", "firing", "warning"), makeAlert("fp-2", "firing", "warning"))
+	if got := webhookInboxGroupKey("generic", batch); got != "generic" {
+		t.Fatalf("batch group key = %q", got)
+	}
+}
+
+func TestWebhookInbox_RetryBackoff(t *testing.T) {
+	svc := newTestWebhookInboxService(newWebhookInboxStoreMock(), &webhookIngesterMock{}, 5)
+	cases := map[int]time.Duration{1: 5 * time.Second, 2: 10 * time.Second, 3: 20 * time.Second, 5: 60 * time.Second, 10: 60 * time.Second}
+	for attempts, want := range cases {
+		if got := svc.retryBackoff(attempts); got != want {
+			t.Fatalf("retryBackoff(%d) = %v; want %v", attempts, got, want)
+		}
+	}
+}
+
+func TestWebhookInbox_ListRejectsUnknownStatus(t *testing.T) {
+	svc := newTestWebhookInboxService(newWebhookInboxStoreMock(), &webhookIngesterMock{}, 5)
+	if _, err := svc.List(context.Background(), "bogus", 10); err == nil {
+		t.Fatal("List(bogus) error = nil; want error")
+	}
+}
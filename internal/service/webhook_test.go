@@ -2,7 +2,11 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/kube-rca/backend/internal/model"
 )
@@ -11,14 +15,23 @@ type webhookRepoMock struct {
 	createdCfg model.WebhookConfig
 	updatedCfg model.WebhookConfig
 	updatedID  int
+	configs    map[int]*model.WebhookConfig
+	alerts     map[string]*model.AlertDetailResponse
 }
 
 func (m *webhookRepoMock) GetWebhookConfigs(ctx context.Context) ([]model.WebhookConfig, error) {
-	return nil, nil
+	var list []model.WebhookConfig
+	for _, cfg := range m.configs {
+		list = append(list, *cfg)
+	}
+	return list, nil
 }
 
 func (m *webhookRepoMock) GetWebhookConfigByID(ctx context.Context, id int) (*model.WebhookConfig, error) {
-	return nil, nil
+	if cfg, ok := m.configs[id]; ok {
+		return cfg, nil
+	}
+	return nil, errors.New("webhook config not found")
 }
 
 func (m *webhookRepoMock) CreateWebhookConfig(ctx context.Context, cfg model.WebhookConfig) (int, error) {
@@ -36,9 +49,16 @@ func (m *webhookRepoMock) DeleteWebhookConfig(ctx context.Context, id int) error
 	return nil
 }
 
+func (m *webhookRepoMock) GetAlertDetail(alertID string) (*model.AlertDetailResponse, error) {
+	if a, ok := m.alerts[alertID]; ok {
+		return a, nil
+	}
+	return nil, errors.New("no rows in result set")
+}
+
 func TestCreateWebhookConfig_MapsAllWebhookFields(t *testing.T) {
 	repo := &webhookRepoMock{}
-	svc := NewWebhookService(repo)
+	svc := NewWebhookService(repo, "")
 
 	req := model.WebhookConfigRequest{
 		Name:    "  Primary Slack Alerts  ",
@@ -70,8 +90,8 @@ func TestCreateWebhookConfig_MapsAllWebhookFields(t *testing.T) {
 }
 
 func TestUpdateWebhookConfig_MapsAllWebhookFields(t *testing.T) {
-	repo := &webhookRepoMock{}
-	svc := NewWebhookService(repo)
+	repo := &webhookRepoMock{configs: map[int]*model.WebhookConfig{77: {ID: 77}}}
+	svc := NewWebhookService(repo, "")
 
 	req := model.WebhookConfigRequest{
 		Name:    "  Incident Webhook  ",
@@ -108,7 +128,7 @@ func TestUpdateWebhookConfig_MapsAllWebhookFields(t *testing.T) {
 
 func TestCreateWebhookConfig_RejectsBlankName(t *testing.T) {
 	repo := &webhookRepoMock{}
-	svc := NewWebhookService(repo)
+	svc := NewWebhookService(repo, "")
 
 	_, err := svc.CreateWebhookConfig(context.Background(), model.WebhookConfigRequest{
 		Name: "   ",
@@ -125,7 +145,7 @@ func TestCreateWebhookConfig_RejectsBlankName(t *testing.T) {
 
 func TestUpdateWebhookConfig_RejectsBlankName(t *testing.T) {
 	repo := &webhookRepoMock{}
-	svc := NewWebhookService(repo)
+	svc := NewWebhookService(repo, "")
 
 	err := svc.UpdateWebhookConfig(context.Background(), 77, model.WebhookConfigRequest{
 		Name: "   ",
@@ -139,3 +159,239 @@ func TestUpdateWebhookConfig_RejectsBlankName(t *testing.T) {
 		t.Fatalf("repo should not be called when name is blank, got id=%d cfg=%+v", repo.updatedID, repo.updatedCfg)
 	}
 }
+
+func TestCreateWebhookConfig_RoutingDefaultsAndLegacySeverities(t *testing.T) {
+	repo := &webhookRepoMock{}
+	svc := NewWebhookService(repo, "")
+
+	_, err := svc.CreateWebhookConfig(context.Background(), model.WebhookConfigRequest{
+		Name:       "Payments",
+		Type:       "slack",
+		Matchers:   model.LabelMatchers{{Name: " namespace ", Value: "payments", IsEqual: true}},
+		Severities: []string{"critical", "warning"},
+	})
+	if err != nil {
+		t.Fatalf("CreateWebhookConfig() error = %v", err)
+	}
+
+	cfg := repo.createdCfg
+	if !cfg.Enabled || cfg.Priority != model.DefaultWebhookPriority || cfg.Continue {
+		t.Fatalf("defaults = enabled %v priority %d continue %v; want true, %d, false", cfg.Enabled, cfg.Priority, cfg.Continue, model.DefaultWebhookPriority)
+	}
+	if len(cfg.Matchers) != 2 || cfg.Matchers[0].Name != "namespace" {
+		t.Fatalf("matchers = %+v; want trimmed namespace matcher plus severity matcher", cfg.Matchers)
+	}
+	severity := cfg.Matchers[1]
+	if severity.Name != "severity" || !severity.IsRegex || severity.Value != "critical|warning" {
+		t.Fatalf("severity matcher = %+v; want severity=~\"critical|warning\"", severity)
+	}
+	if !cfg.Matchers.Matches(map[string]string{"namespace": "payments", "severity": "warning"}) ||
+		cfg.Matchers.Matches(map[string]string{"namespace": "payments", "severity": "info"}) {
+		t.Fatal("converted matchers do not behave like the severity filter")
+	}
+}
+
+func TestCreateWebhookConfig_RejectsInvalidMatcher(t *testing.T) {
+	repo := &webhookRepoMock{}
+	svc := NewWebhookService(repo, "")
+
+	_, err := svc.CreateWebhookConfig(context.Background(), model.WebhookConfigRequest{
+		Name:     "Prod",
+		Type:     "teams",
+		Matchers: model.LabelMatchers{{Name: "cluster", Value: "prod-(", IsRegex: true, IsEqual: true}},
+	})
+	if !errors.Is(err, ErrInvalidWebhookConfig) {
+		t.Fatalf("err = %v; want ErrInvalidWebhookConfig", err)
+	}
+	if repo.createdCfg.Name != "" {
+		t.Fatalf("repo should not be called for an invalid matcher, got %+v", repo.createdCfg)
+	}
+}
+
+func TestCreateWebhookConfig_RejectsInvalidTemplate(t *testing.T) {
+	repo := &webhookRepoMock{}
+	svc := NewWebhookService(repo, "")
+
+	_, err := svc.CreateWebhookConfig(context.Background(), model.WebhookConfigRequest{
+		Name:          "Slack",
+		Type:          "slack",
+		TitleTemplate: "{{ .Labels.alertname ",
+	})
+	if !errors.Is(err, ErrInvalidWebhookConfig) {
+		t.Fatalf("err = %v; want ErrInvalidWebhookConfig", err)
+	}
+}
+
+func TestPreviewTemplates_RendersStoredAlert(t *testing.T) {
+	incidentID := "INC-1"
+	repo := &webhookRepoMock{
+		configs: map[int]*model.WebhookConfig{
+			3: {ID: 3, TitleTemplate: "stored title", PayloadTemplate: `{"alert":"{{ label "alertname" }}"}`},
+		},
+		alerts: map[string]*model.AlertDetailResponse{
+			"ALR-1": {
+				AlertID:     "ALR-1",
+				IncidentID:  &incidentID,
+				Status:      "firing",
+				FiredAt:     time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+				Labels:      []byte(`{"alertname":"KubePodCrashLooping","namespace":"payments"}`),
+				Annotations: []byte(`{"description":"pod restarts"}`),
+			},
+		},
+	}
+	svc := NewWebhookService(repo, "https://rca.example.com/")
+	configID := 3
+
+	preview, err := svc.PreviewTemplates(context.Background(), model.WebhookTemplatePreviewRequest{
+		AlertID:         "ALR-1",
+		WebhookConfigID: &configID,
+		BodyTemplate:    `{{ annotation "description" }} in {{ .Labels.namespace }} since {{ formatTime "2006-01-02 15:04" .Alert.StartsAt }} {{ incidentURL }}`,
+	})
+	if err != nil {
+		t.Fatalf("PreviewTemplates() error = %v", err)
+	}
+	if preview.Title != "stored title" {
+		t.Fatalf("title = %q; want the stored config template", preview.Title)
+	}
+	if want := "pod restarts in payments since 2026-01-02 03:04 https://rca.example.com/incidents/INC-1"; preview.Body != want {
+		t.Fatalf("body = %q; want %q", preview.Body, want)
+	}
+	if preview.Payload != `{"alert":"KubePodCrashLooping"}` {
+		t.Fatalf("payload = %q", preview.Payload)
+	}
+
+	if _, err := svc.PreviewTemplates(context.Background(), model.WebhookTemplatePreviewRequest{AlertID: "ALR-missing", TitleTemplate: "x"}); !errors.Is(err, ErrWebhookPreviewNotFound) {
+		t.Fatalf("missing alert err = %v; want ErrWebhookPreviewNotFound", err)
+	}
+	if _, err := svc.PreviewTemplates(context.Background(), model.WebhookTemplatePreviewRequest{AlertID: "ALR-1", TitleTemplate: "{{ .Nope.Field }}"}); !errors.Is(err, ErrInvalidWebhookConfig) {
+		t.Fatalf("bad template err = %v; want ErrInvalidWebhookConfig", err)
+	}
+}
+
+func TestWebhookConfig_SecretsAreNotExposed(t *testing.T) {
+	repo := &webhookRepoMock{configs: map[int]*model.WebhookConfig{
+		1: {ID: 1, Name: "Receiver", SigningSecret: "s3cret", BasicAuthUsername: "rca", BasicAuthPassword: "pw"},
+	}}
+	svc := NewWebhookService(repo, "")
+
+	cfg, err := svc.GetWebhookConfig(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetWebhookConfig() error = %v", err)
+	}
+	if !cfg.HasSigningSecret || !cfg.HasBasicAuthPassword || cfg.HasTLSClientKey {
+		t.Fatalf("secret flags = %v/%v/%v, want true/true/false", cfg.HasSigningSecret, cfg.HasBasicAuthPassword, cfg.HasTLSClientKey)
+	}
+	configs, err := svc.ListWebhookConfigs(context.Background())
+	if err != nil {
+		t.Fatalf("ListWebhookConfigs() error = %v", err)
+	}
+	body, err := json.Marshal(model.WebhookConfigListResponse{Status: "success", Data: configs})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	for _, secret := range []string{"s3cret", `"pw"`, `"signing_secret"`, `"basic_auth_password"`} {
+		if strings.Contains(string(body), secret) {
+			t.Fatalf("response %s exposes %s", body, secret)
+		}
+	}
+}
+
+func TestUpdateWebhookConfig_KeepsStoredSecrets(t *testing.T) {
+	repo := &webhookRepoMock{configs: map[int]*model.WebhookConfig{
+		5: {ID: 5, Name: "Receiver", SigningSecret: "s3cret", BasicAuthUsername: "rca", BasicAuthPassword: "pw"},
+	}}
+	svc := NewWebhookService(repo, "")
+	req := model.WebhookConfigRequest{Name: "Receiver", Type: "http", URL: "https://example.com/hook", BasicAuthUsername: "rca"}
+
+	if err := svc.UpdateWebhookConfig(context.Background(), 5, req); err != nil {
+		t.Fatalf("UpdateWebhookConfig() error = %v", err)
+	}
+	if repo.updatedCfg.SigningSecret != "s3cret" || repo.updatedCfg.BasicAuthPassword != "pw" {
+		t.Fatalf("updated secrets = %q/%q, want stored values kept", repo.updatedCfg.SigningSecret, repo.updatedCfg.BasicAuthPassword)
+	}
+
+	req.SigningSecret = "rotated"
+	req.ClearSecrets = []string{"basic_auth_password"}
+	if err := svc.UpdateWebhookConfig(context.Background(), 5, req); err != nil {
+		t.Fatalf("UpdateWebhookConfig() error = %v", err)
+	}
+	if repo.updatedCfg.SigningSecret != "rotated" || repo.updatedCfg.BasicAuthPassword != "" {
+		t.Fatalf("updated secrets = %q/%q, want rotated secret and cleared password", repo.updatedCfg.SigningSecret, repo.updatedCfg.BasicAuthPassword)
+	}
+
+	req.ClearSecrets = []string{"token"}
+	if err := svc.UpdateWebhookConfig(context.Background(), 5, req); !errors.Is(err, ErrInvalidWebhookConfig) {
+		t.Fatalf("UpdateWebhookConfig() error = %v, want ErrInvalidWebhookConfig for unknown clear_secrets field", err)
+	}
+}
+
+func TestCreateWebhookConfig_DeliveryOptions(t *testing.T) {
+	repo := &webhookRepoMock{}
+	svc := NewWebhookService(repo, "")
+
+	_, err := svc.CreateWebhookConfig(context.Background(), model.WebhookConfigRequest{
+		Name:              "Receiver",
+		Type:              "http",
+		URL:               "https://example.com/hook",
+		HTTPMethod:        " put ",
+		CustomHeaders:     map[string]string{" x-tenant ": "team-a"},
+		SigningSecret:     "s3cret",
+		BasicAuthUsername: "rca",
+		BasicAuthPassword: "pw",
+	})
+	if err != nil {
+		t.Fatalf("CreateWebhookConfig() error = %v", err)
+	}
+	cfg := repo.createdCfg
+	if cfg.HTTPMethod != "PUT" {
+		t.Fatalf("HTTPMethod = %q, want PUT", cfg.HTTPMethod)
+	}
+	if cfg.CustomHeaders["X-Tenant"] != "team-a" {
+		t.Fatalf("CustomHeaders = %v, want canonical X-Tenant", cfg.CustomHeaders)
+	}
+	if cfg.SigningSecret != "s3cret" || cfg.BasicAuthUsername != "rca" || cfg.BasicAuthPassword != "pw" {
+		t.Fatalf("unexpected auth options: %+v", cfg)
+	}
+
+	if _, err := svc.CreateWebhookConfig(context.Background(), model.WebhookConfigRequest{Name: "Default", Type: "http"}); err != nil {
+		t.Fatalf("CreateWebhookConfig() error = %v", err)
+	}
+	if repo.createdCfg.HTTPMethod != "POST" {
+		t.Fatalf("default HTTPMethod = %q, want POST", repo.createdCfg.HTTPMethod)
+	}
+}
+
+func TestCreateWebhookConfig_RejectsInvalidDeliveryOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		req  model.WebhookConfigRequest
+	}{
+		{name: "method", req: model.WebhookConfigRequest{HTTPMethod: "GET"}},
+		{name: "header name", req: model.WebhookConfigRequest{CustomHeaders: map[string]string{"Bad Header": "v"}}},
+		{name: "header value line break", req: model.WebhookConfigRequest{CustomHeaders: map[string]string{"X-Tenant": "a\r\nX-Evil: 1"}}},
+		{name: "reserved header", req: model.WebhookConfigRequest{CustomHeaders: map[string]string{"X-KubeRCA-Signature": "v"}}},
+		{name: "host header", req: model.WebhookConfigRequest{CustomHeaders: map[string]string{"host": "v"}}},
+		{name: "authorization with token", req: model.WebhookConfigRequest{Token: "t", CustomHeaders: map[string]string{"Authorization": "v"}}},
+		{name: "token and basic auth", req: model.WebhookConfigRequest{Token: "t", BasicAuthUsername: "u"}},
+		{name: "password without username", req: model.WebhookConfigRequest{BasicAuthPassword: "p"}},
+		{name: "cert without key", req: model.WebhookConfigRequest{TLSClientCert: "-----BEGIN CERTIFICATE-----"}},
+		{name: "invalid ca", req: model.WebhookConfigRequest{TLSCACert: "not a pem"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &webhookRepoMock{}
+			svc := NewWebhookService(repo, "")
+			req := tt.req
+			req.Name, req.Type = "Receiver", "http"
+
+			_, err := svc.CreateWebhookConfig(context.Background(), req)
+			if !errors.Is(err, ErrInvalidWebhookConfig) {
+				t.Fatalf("err = %v; want ErrInvalidWebhookConfig", err)
+			}
+			if repo.createdCfg.Name != "" {
+				t.Fatalf("repo should not be called, got %+v", repo.createdCfg)
+			}
+		})
+	}
+}
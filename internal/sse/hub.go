@@ -20,15 +20,17 @@ import (
 type EventType string
 
 const (
-	EventAlertCreated      EventType = "alert_created"
-	EventAlertResolved     EventType = "alert_resolved"
-	EventAnalysisStarted   EventType = "analysis_started"
-	EventAnalysisCompleted EventType = "analysis_completed"
-	EventAnalysisFailed    EventType = "analysis_failed"
-	EventIncidentCreated   EventType = "incident_created"
-	EventIncidentUpdated   EventType = "incident_updated"
-	EventIncidentResolved  EventType = "incident_resolved"
-	EventHeartbeat         EventType = "heartbeat"
+	EventAlertCreated         EventType = "alert_created"
+	EventAlertResolved        EventType = "alert_resolved"
+	EventAlertAcknowledged    EventType = "alert_acknowledged"
+	EventAnalysisStarted      EventType = "analysis_started"
+	EventAnalysisCompleted    EventType = "analysis_completed"
+	EventAnalysisFailed       EventType = "analysis_failed"
+	EventIncidentCreated      EventType = "incident_created"
+	EventIncidentUpdated      EventType = "incident_updated"
+	EventIncidentResolved     EventType = "incident_resolved"
+	EventIncidentAcknowledged EventType = "incident_acknowledged"
+	EventHeartbeat            EventType = "heartbeat"
 )
 
 // Event is the payload broadcast to all SSE clients.
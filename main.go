@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 	"strings"
+	_ "time/tzdata" // scratch 이미지에는 zoneinfo가 없으므로 점검 시간대 타임존 계산용으로 내장
 
 	"github.com/gin-gonic/gin"
 	"github.com/kube-rca/backend/internal/client"
@@ -68,6 +69,76 @@ func main() {
 		log.Fatalf("Failed to ensure app settings schema: %v", err)
 	}
 
+	// Webhook inbox 스키마 생성 (수신 웹훅 원본 보관 + 비동기 처리 큐)
+	if err := pgRepo.EnsureWebhookInboxSchema(); err != nil {
+		log.Fatalf("Failed to ensure webhook inbox schema: %v", err)
+	}
+
+	// 알림 outbox 스키마 생성 (대상별 전송 기록 + 재시도 큐 + dead-letter)
+	if err := pgRepo.EnsureNotificationOutboxSchema(); err != nil {
+		log.Fatalf("Failed to ensure notification outbox schema: %v", err)
+	}
+
+	// 알림 전송 기록 스키마 생성 (대상별 전송 시도: 상태 코드, 지연 시간, 오류)
+	if err := pgRepo.EnsureNotificationAttemptSchema(); err != nil {
+		log.Fatalf("Failed to ensure notification attempt schema: %v", err)
+	}
+
+	// 예약 작업 스키마 생성 (flapping clearance 등 재시작/다중 replica에서도 유지되는 지연 작업)
+	if err := pgRepo.EnsureScheduledJobSchema(); err != nil {
+		log.Fatalf("Failed to ensure scheduled job schema: %v", err)
+	}
+
+	// Alert 멱등 키 스키마 생성 (Alertmanager HA 복제본 중복 수신 제거)
+	if err := pgRepo.EnsureAlertIngestKeySchema(); err != nil {
+		log.Fatalf("Failed to ensure alert ingest key schema: %v", err)
+	}
+
+	// Silence 규칙 스키마 생성 (라벨 매처 기반 알림 음소거)
+	if err := pgRepo.EnsureSilenceSchema(); err != nil {
+		log.Fatalf("Failed to ensure silence schema: %v", err)
+	}
+
+	// 점검 시간대 스키마 생성 (cron 기반 반복 maintenance window)
+	if err := pgRepo.EnsureMaintenanceSchema(); err != nil {
+		log.Fatalf("Failed to ensure maintenance schema: %v", err)
+	}
+
+	// 억제 규칙 스키마 생성 (source alert firing 중 관련 target alert 억제)
+	if err := pgRepo.EnsureInhibitionSchema(); err != nil {
+		log.Fatalf("Failed to ensure inhibition schema: %v", err)
+	}
+
+	// Alertmanager silence 기록 스키마 생성 (alert 상세에서 생성한 silence ID/만료 시각)
+	if err := pgRepo.EnsureAlertmanagerSilenceSchema(); err != nil {
+		log.Fatalf("Failed to ensure alertmanager silence schema: %v", err)
+	}
+
+	// 서비스 카탈로그 스키마 생성 (소유 팀/namespace/selector/Slack 채널, alerts/incidents.service_id)
+	if err := pgRepo.EnsureServiceSchema(); err != nil {
+		log.Fatalf("Failed to ensure service schema: %v", err)
+	}
+
+	// Flapping 정책 스키마 생성 (라벨 매처별 window/threshold/clearance 또는 never_flapping, alerts.flapping_policy_id)
+	if err := pgRepo.EnsureFlappingPolicySchema(); err != nil {
+		log.Fatalf("Failed to ensure flapping policy schema: %v", err)
+	}
+
+	// 에스컬레이션 스키마 생성 (다단계 정책, Incident별 진행 상태)
+	if err := pgRepo.EnsureEscalationSchema(); err != nil {
+		log.Fatalf("Failed to ensure escalation schema: %v", err)
+	}
+
+	// On-call 스키마 생성 (레이어별 로테이션 스케줄, override)
+	if err := pgRepo.EnsureOncallSchema(); err != nil {
+		log.Fatalf("Failed to ensure oncall schema: %v", err)
+	}
+
+	// Incident 감사 기록 스키마 생성 (확인/에스컬레이션 단계)
+	if err := pgRepo.EnsureIncidentAuditSchema(); err != nil {
+		log.Fatalf("Failed to ensure incident audit schema: %v", err)
+	}
+
 	// Embedding 스키마 생성 (pgvector 확장 및 embeddings 테이블)
 	// todo: pgvector 확장 먼저 db에 설치해아함
 	if err := pgRepo.EnsureEmbeddingSchema(ctx); err != nil {
@@ -118,6 +189,19 @@ func main() {
 	appSettingsSvc.SyncEnvDefaults(ctx) // Helm 값 변경 시 DB 동기화
 
 	notifier := client.NewWebhookRoutingNotifier(pgRepo, slackClient, slackClient, cfg.Slack.FrontendURL)
+	// NotificationOutboxService: 대상별 전송을 기록하고 worker가 전송, 실패한 전송은 지수 백오프로 재시도 (최대 시도 초과 시 dead-letter)
+	notificationOutboxSvc := service.NewNotificationOutboxService(pgRepo, cfg.Outbox)
+	outboxAttached := notificationOutboxSvc.AttachNotifier(notifier)
+	if !outboxAttached {
+		log.Println("WARNING: notifier does not support notification outbox, failed notifications are not retried")
+	}
+	notificationOutboxSvc.Start(ctx)
+	// NotificationAttemptService: 모든 대상별 전송 시도를 기록 (알림/웹훅 설정별 delivery log)
+	notificationAttemptSvc := service.NewNotificationAttemptService(pgRepo, cfg.Attempts)
+	if !notificationAttemptSvc.AttachNotifier(notifier) {
+		log.Println("WARNING: notifier does not support delivery logging, notification attempts are not recorded")
+	}
+	notificationAttemptSvc.Start(ctx)
 
 	// 3. 비즈니스 로직 서비스 초기화
 	// AgentService: Agent 요청 및 Slack 쓰레드 응답 처리 + DB 저장
@@ -125,20 +209,76 @@ func main() {
 	chatService := service.NewChatService(pgRepo, agentClient)
 	analyticsSvc := service.NewAnalyticsService(pgRepo)
 	// AlertService: 알림 필터링 및 Slack 전송 로직 담당 + DB 저장
-	alertService := service.NewAlertService(notifier, agentService, pgRepo, cfg.Flapping, sseHub, appSettingsSvc)
+	alertService := service.NewAlertService(notifier, agentService, pgRepo, cfg.Flapping, cfg.AlertDedupe, sseHub, appSettingsSvc)
+	if outboxAttached {
+		// alert 저장 트랜잭션에 라우팅된 대상별 알림을 outbox entry로 함께 기록 (전송은 outbox worker)
+		alertService.SetNotificationOutbox(notificationOutboxSvc)
+	}
+	// ScheduledJobService: scheduled_jobs를 poll하여 예약 작업 실행 (FOR UPDATE SKIP LOCKED, 다중 replica 안전)
+	scheduledJobSvc := service.NewScheduledJobService(pgRepo, cfg.ScheduledJob)
+	alertService.SetJobScheduler(scheduledJobSvc)
+	alertService.ScheduleMissingFlappingClearances()
+	// EscalationService: 확인되지 않은 Incident를 정책 레벨별 대상에 순차 에스컬레이션 (진행 상태는 DB, 다음 레벨은 예약 작업)
+	escalationSvc := service.NewEscalationService(pgRepo, notifier)
+	escalationSvc.SetJobScheduler(scheduledJobSvc)
+	escalationSvc.ScheduleMissingEscalations(ctx)
+	alertService.SetEscalator(escalationSvc)
+	scheduledJobSvc.Start(ctx)
+	// AlertReminderService: 장시간 firing alert를 severity별 reminderMinutes 간격으로 기존 스레드에 재알림
+	alertReminderSvc := service.NewAlertReminderService(pgRepo, notifier, appSettingsSvc, cfg.Reminder)
+	alertReminderSvc.Start(ctx)
 	// RcaService: Incident/Alert 조회 및 종료 처리 + Agent 최종 분석 요청 + 임베딩 생성
 	rcaSvc := service.NewRcaService(pgRepo, agentService, embeddingService, sseHub)
 	chatHandler := handler.NewChatHandler(chatService)
-	webhookSvc := service.NewWebhookService(pgRepo)
+	webhookSvc := service.NewWebhookService(pgRepo, cfg.Slack.FrontendURL)
+	// WebhookInboxService: 수신 웹훅을 inbox에 저장하고 worker pool로 비동기 처리 (재시도 + dead-letter)
+	webhookInboxSvc := service.NewWebhookInboxService(pgRepo, alertService, cfg.WebhookInbox)
+	webhookInboxSvc.Start(ctx)
+	// Alertmanager v2 API 동기화: truncated 웹훅/다운타임 동안 누락된 alert 복구 (ALERTMANAGER_URL 미설정 시 비활성)
+	alertmanagerClient := client.NewAlertmanagerClient(cfg.Alertmanager)
+	alertmanagerSyncSvc := service.NewAlertmanagerSyncService(alertmanagerClient, pgRepo, webhookInboxSvc, cfg.Alertmanager)
+	alertmanagerSyncSvc.SetIngestFilter(alertService)
+	webhookInboxSvc.SetTruncationHandler(alertmanagerSyncSvc.Trigger)
+	alertmanagerSyncSvc.Start(ctx)
+	// Alertmanager silence 생성/만료 (alert의 externalURL로 대상 Alertmanager 결정)
+	alertmanagerSilenceSvc := service.NewAlertmanagerSilenceService(alertmanagerClient, pgRepo, cfg.Alertmanager)
+
+	// 인바운드 웹훅 인증 (credential 미설정 시 비활성)
+	webhookAuth, err := service.NewWebhookAuthenticator(cfg.WebhookAuth)
+	if err != nil {
+		log.Fatalf("Failed to initialize webhook authenticator: %v", err)
+	}
+	if !webhookAuth.Enabled() {
+		log.Println("WARNING: WEBHOOK_AUTH_CREDENTIALS is empty, /webhook endpoints accept unauthenticated requests")
+	}
 
 	// 4. HTTP 핸들러 초기화
 	// Alertmanager 웹훅 요청 수신 및 응답 처리
-	alertHandler := handler.NewAlertHandler(alertService)
+	// Grafana/PagerDuty/일반 JSON 웹훅은 adapter로 정규화 후 동일한 inbox 파이프라인으로 처리
+	ingestAdapterSvc := service.NewIngestAdapterService(pgRepo)
+	alertHandler := handler.NewAlertHandler(webhookInboxSvc, ingestAdapterSvc)
+	// Kubernetes Warning Event → Alert (involvedObject + reason 기준 중복 제거, 미수신 시 자동 해결)
+	kubeEventSvc := service.NewKubeEventService(pgRepo, webhookInboxSvc, cfg.KubeEvent)
+	kubeEventSvc.Start(ctx)
+	kubeEventHndlr := handler.NewKubeEventHandler(kubeEventSvc)
 	rcaHndlr := handler.NewRcaHandler(rcaSvc, alertService)
 	webhookHndlr := handler.NewWebhookSettingsHandler(webhookSvc)
 	appSettingsHndlr := handler.NewAppSettingsHandler(appSettingsSvc, agentClient)
 	analyticsHndlr := handler.NewAnalyticsHandler(analyticsSvc)
 	eventHandler := handler.NewEventHandler(sseHub)
+	webhookAuthHndlr := handler.NewWebhookAuthHandler(webhookAuth)
+	webhookInboxHndlr := handler.NewWebhookInboxHandler(webhookInboxSvc)
+	notificationOutboxHndlr := handler.NewNotificationOutboxHandler(notificationOutboxSvc)
+	notificationAttemptHndlr := handler.NewNotificationAttemptHandler(notificationAttemptSvc)
+	silenceHndlr := handler.NewSilenceHandler(service.NewSilenceService(pgRepo))
+	maintenanceHndlr := handler.NewMaintenanceHandler(service.NewMaintenanceService(pgRepo))
+	inhibitionHndlr := handler.NewInhibitionHandler(service.NewInhibitionService(pgRepo))
+	alertmanagerHndlr := handler.NewAlertmanagerHandler(alertmanagerSyncSvc, alertmanagerSilenceSvc)
+	scheduledJobHndlr := handler.NewScheduledJobHandler(scheduledJobSvc)
+	serviceCatalogHndlr := handler.NewServiceCatalogHandler(service.NewServiceCatalogService(pgRepo))
+	flappingPolicyHndlr := handler.NewFlappingPolicyHandler(service.NewFlappingPolicyService(pgRepo, appSettingsSvc, cfg.Flapping))
+	escalationPolicyHndlr := handler.NewEscalationPolicyHandler(escalationSvc)
+	oncallHndlr := handler.NewOncallHandler(service.NewOncallService(pgRepo))
 
 	// HTTP 라우터 설정
 	router := gin.New()
@@ -193,6 +333,11 @@ func main() {
 		protected.PUT("/incidents/:id/comments/:commentId", rcaHndlr.UpdateIncidentComment)
 		protected.DELETE("/incidents/:id/comments/:commentId", rcaHndlr.DeleteIncidentComment)
 		protected.POST("/incidents/:id/vote", rcaHndlr.VoteIncidentFeedback)
+		// Incident 확인 (소속 firing alert 일괄 확인, time_to_ack 기록)
+		protected.POST("/incidents/:id/ack", rcaHndlr.AcknowledgeIncident)
+		// Incident 에스컬레이션 진행 상태 및 감사 기록 (확인/에스컬레이션 단계)
+		protected.GET("/incidents/:id/escalation", escalationPolicyHndlr.GetIncidentEscalation)
+		protected.GET("/incidents/:id/audit", escalationPolicyHndlr.ListIncidentAuditEvents)
 
 		// Alert 엔드포인트
 		protected.GET("/alerts", rcaHndlr.GetAlerts)
@@ -206,6 +351,11 @@ func main() {
 		protected.POST("/alerts/:id/vote", rcaHndlr.VoteAlertFeedback)
 		protected.POST("/alerts/bulk-resolve", rcaHndlr.BulkResolveAlerts)
 		protected.POST("/alerts/:id/resolve", rcaHndlr.ResolveAlert)
+		// Flapping 수동 해제 (pending clearance 작업 취소)
+		protected.POST("/alerts/:id/flapping/clear", rcaHndlr.ClearAlertFlapping)
+		// Alert 확인 (재알림 중단, 미확인 Incident 함께 확인, 스레드에 확인 메시지)
+		protected.POST("/alerts/:id/ack", rcaHndlr.AcknowledgeAlert)
+		protected.GET("/alerts/:id/deliveries", notificationAttemptHndlr.ListAlertDeliveries)
 
 		protected.POST("/embeddings", embeddingHandler.CreateEmbedding)
 		protected.POST("/embeddings/search", embeddingHandler.SearchEmbeddings)
@@ -215,14 +365,93 @@ func main() {
 		// Settings 엔드포인트 (Webhook 설정 CRUD)
 		protected.GET("/settings/webhooks", webhookHndlr.ListWebhookConfigs)
 		protected.POST("/settings/webhooks", webhookHndlr.CreateWebhookConfig)
+		protected.POST("/settings/webhooks/preview", webhookHndlr.PreviewWebhookTemplates)
 		protected.GET("/settings/webhooks/:id", webhookHndlr.GetWebhookConfig)
 		protected.PUT("/settings/webhooks/:id", webhookHndlr.UpdateWebhookConfig)
 		protected.DELETE("/settings/webhooks/:id", webhookHndlr.DeleteWebhookConfig)
+		protected.GET("/settings/webhooks/:id/deliveries", notificationAttemptHndlr.ListWebhookDeliveries)
 
 		// App Settings 엔드포인트 (Flapping, Slack, AI 설정)
 		protected.GET("/settings/app", appSettingsHndlr.ListAppSettings)
 		protected.GET("/settings/app/:key", appSettingsHndlr.GetAppSetting)
 		protected.PUT("/settings/app/:key", appSettingsHndlr.UpdateAppSetting)
+
+		// 인바운드 웹훅 인증 상태 (credential 목록 + 거부 통계)
+		protected.GET("/settings/webhook-auth", webhookAuthHndlr.GetWebhookAuthStatus)
+
+		// 수신 웹훅 inbox (저장된 payload 조회 + 재처리)
+		protected.GET("/webhook-inbox", webhookInboxHndlr.ListWebhookInbox)
+		protected.GET("/webhook-inbox/:id", webhookInboxHndlr.GetWebhookInboxEntry)
+		protected.POST("/webhook-inbox/:id/replay", webhookInboxHndlr.ReplayWebhookInboxEntry)
+		protected.GET("/notification-outbox", notificationOutboxHndlr.ListNotificationOutbox)
+		protected.GET("/notification-outbox/:id", notificationOutboxHndlr.GetNotificationOutboxEntry)
+		protected.POST("/notification-outbox/:id/retry", notificationOutboxHndlr.RetryNotificationOutboxEntry)
+		protected.POST("/notification-outbox/:id/discard", notificationOutboxHndlr.DiscardNotificationOutboxEntry)
+
+		// 예약 작업 조회 (?type=flapping_clearance: 대기 중인 flapping 해제 체크)
+		protected.GET("/scheduled-jobs", scheduledJobHndlr.ListScheduledJobs)
+
+		// Alertmanager v2 API 수동 동기화 (누락 firing 복구 + 사라진 alert resolved 처리)
+		protected.POST("/alertmanager/reconcile", alertmanagerHndlr.ReconcileAlertmanager)
+
+		// Alertmanager silence (alert 라벨 기반 생성, alert/incident별 조회, 조기 만료)
+		protected.POST("/alerts/:id/silence", alertmanagerHndlr.SilenceAlert)
+		protected.GET("/alerts/:id/silences", alertmanagerHndlr.ListAlertSilences)
+		protected.GET("/incidents/:id/silences", alertmanagerHndlr.ListIncidentSilences)
+		protected.DELETE("/alertmanager/silences/:id", alertmanagerHndlr.ExpireAlertmanagerSilence)
+
+		// Silence 규칙 CRUD (매칭된 alert는 저장하되 알림/자동 분석 스킵)
+		protected.GET("/silences", silenceHndlr.ListSilences)
+		protected.POST("/silences", silenceHndlr.CreateSilence)
+		protected.GET("/silences/:id", silenceHndlr.GetSilence)
+		protected.PUT("/silences/:id", silenceHndlr.UpdateSilence)
+		protected.DELETE("/silences/:id", silenceHndlr.DeleteSilence)
+
+		// 반복 점검 시간대 CRUD (범위 내 alert는 in_maintenance로 저장, 알림/자동 분석/MTTR 제외)
+		protected.GET("/maintenance-windows", maintenanceHndlr.ListMaintenanceWindows)
+		protected.POST("/maintenance-windows", maintenanceHndlr.CreateMaintenanceWindow)
+		protected.GET("/maintenance-windows/:id", maintenanceHndlr.GetMaintenanceWindow)
+		protected.PUT("/maintenance-windows/:id", maintenanceHndlr.UpdateMaintenanceWindow)
+		protected.DELETE("/maintenance-windows/:id", maintenanceHndlr.DeleteMaintenanceWindow)
+		// 억제 규칙 CRUD (source alert firing 중 target alert는 inhibited_by 기록, 알림/자동 분석 스킵)
+		protected.GET("/inhibition-rules", inhibitionHndlr.ListInhibitionRules)
+		protected.POST("/inhibition-rules", inhibitionHndlr.CreateInhibitionRule)
+		protected.GET("/inhibition-rules/:id", inhibitionHndlr.GetInhibitionRule)
+		protected.PUT("/inhibition-rules/:id", inhibitionHndlr.UpdateInhibitionRule)
+		protected.DELETE("/inhibition-rules/:id", inhibitionHndlr.DeleteInhibitionRule)
+		// 서비스 소유권 카탈로그 CRUD 및 서비스별 Incident/MTTR 조회 (수신 시 alert/incident에 service_id 기록)
+		protected.GET("/services", serviceCatalogHndlr.ListServices)
+		protected.POST("/services", serviceCatalogHndlr.CreateService)
+		protected.GET("/services/metrics", serviceCatalogHndlr.GetServiceMetrics)
+		protected.GET("/services/:id", serviceCatalogHndlr.GetService)
+		protected.PUT("/services/:id", serviceCatalogHndlr.UpdateService)
+		protected.DELETE("/services/:id", serviceCatalogHndlr.DeleteService)
+		protected.GET("/services/:id/incidents", serviceCatalogHndlr.ListServiceIncidents)
+		// Flapping 정책 CRUD (가장 구체적인 매칭 정책의 threshold 적용, match로 적용 정책 미리보기)
+		protected.GET("/flapping-policies", flappingPolicyHndlr.ListFlappingPolicies)
+		protected.POST("/flapping-policies", flappingPolicyHndlr.CreateFlappingPolicy)
+		protected.POST("/flapping-policies/match", flappingPolicyHndlr.MatchFlappingPolicy)
+		protected.GET("/flapping-policies/:id", flappingPolicyHndlr.GetFlappingPolicy)
+		protected.PUT("/flapping-policies/:id", flappingPolicyHndlr.UpdateFlappingPolicy)
+		protected.DELETE("/flapping-policies/:id", flappingPolicyHndlr.DeleteFlappingPolicy)
+		// 에스컬레이션 정책 CRUD (가장 구체적인 매칭 정책으로 확인되지 않은 Incident 단계별 에스컬레이션)
+		protected.GET("/escalation-policies", escalationPolicyHndlr.ListEscalationPolicies)
+		protected.POST("/escalation-policies", escalationPolicyHndlr.CreateEscalationPolicy)
+		protected.GET("/escalation-policies/:id", escalationPolicyHndlr.GetEscalationPolicy)
+		protected.PUT("/escalation-policies/:id", escalationPolicyHndlr.UpdateEscalationPolicy)
+		protected.DELETE("/escalation-policies/:id", escalationPolicyHndlr.DeleteEscalationPolicy)
+		// On-call 스케줄/override CRUD, 현재 on-call 조회, 사용자 Slack ID 매핑 (on-call 멘션용)
+		protected.GET("/oncall/now", oncallHndlr.GetOncallNow)
+		protected.GET("/oncall/schedules", oncallHndlr.ListOncallSchedules)
+		protected.POST("/oncall/schedules", oncallHndlr.CreateOncallSchedule)
+		protected.GET("/oncall/schedules/:id", oncallHndlr.GetOncallSchedule)
+		protected.PUT("/oncall/schedules/:id", oncallHndlr.UpdateOncallSchedule)
+		protected.DELETE("/oncall/schedules/:id", oncallHndlr.DeleteOncallSchedule)
+		protected.GET("/oncall/schedules/:id/overrides", oncallHndlr.ListOncallOverrides)
+		protected.POST("/oncall/schedules/:id/overrides", oncallHndlr.CreateOncallOverride)
+		protected.DELETE("/oncall/schedules/:id/overrides/:overrideId", oncallHndlr.DeleteOncallOverride)
+		protected.GET("/oncall/users", oncallHndlr.ListOncallUsers)
+		protected.PUT("/oncall/users/:loginId/slack", oncallHndlr.UpdateOncallUserSlack)
 	}
 
 	// SSE Events endpoint
@@ -230,9 +459,19 @@ func main() {
 	sseGroup.Use(handler.SSEAuthMiddleware(authService))
 	sseGroup.GET("/events", eventHandler.Stream)
 
-	// Alertmanager 웹훅 엔드포인트
+	// 알림 수신 웹훅 엔드포인트 (WEBHOOK_AUTH_CREDENTIALS(_FILE) 설정 시 인증 필요, 10MiB 초과 본문은 413)
 	// - POST /webhook/alertmanager: Alertmanager에서 알림 수신
-	router.POST("/webhook/alertmanager", alertHandler.Webhook)
+	// - POST /webhook/grafana: Grafana unified alerting
+	// - POST /webhook/pagerduty: PagerDuty Events API v2 형식
+	// - POST /webhook/generic: 일반 JSON 형식
+	// - POST /webhook/kubernetes-events: core/v1 Event (event-exporter webhook sink)
+	webhookGroup := router.Group("/webhook")
+	webhookGroup.Use(handler.WebhookAuthMiddleware(webhookAuth))
+	webhookGroup.POST("/alertmanager", alertHandler.Webhook)
+	webhookGroup.POST("/grafana", alertHandler.GrafanaWebhook)
+	webhookGroup.POST("/pagerduty", alertHandler.PagerDutyWebhook)
+	webhookGroup.POST("/generic", alertHandler.GenericWebhook)
+	webhookGroup.POST("/kubernetes-events", kubeEventHndlr.Webhook)
 
 	// 8080 서버 실행
 	log.Println("Starting kube-rca-backend on :8080")